@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -53,7 +54,7 @@ func (api *API) ListAccounts(_ context.Context, _ *proto.ListAccountsRequest) (*
 }
 
 // CreateAccount registers a new account
-func (api *API) CreateAccount(_ context.Context, request *proto.CreateAccountRequest) (*proto.CreateAccountResponse, error) {
+func (api *API) CreateAccount(ctx context.Context, request *proto.CreateAccountRequest) (*proto.CreateAccountResponse, error) {
 	if request.Name == "" {
 		return &proto.CreateAccountResponse{}, status.Error(codes.FailedPrecondition, "account name required")
 	}
@@ -74,9 +75,16 @@ func (api *API) CreateAccount(_ context.Context, request *proto.CreateAccountReq
 
 	account := models.NewAccount(request.Name, string(hash))
 
-	err = api.db.InsertAccount(api.db, account.ToStorage())
+	err = api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		err := api.db.InsertAccount(tx, account.ToStorage())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account.ID, models.EntityKindAccount, account.ID, nil, redactAccount(*account))
+	})
 	if err != nil {
-		if err == storage.ErrEntityExists {
+		if errors.Is(err, storage.ErrEntityExists) {
 			return &proto.CreateAccountResponse{}, status.Error(codes.AlreadyExists, "could not save account; account already exists")
 		}
 		log.Error().Err(err).Msg("could not save account")
@@ -90,7 +98,7 @@ func (api *API) CreateAccount(_ context.Context, request *proto.CreateAccountReq
 }
 
 // UpdateAccount registers a new account
-func (api *API) UpdateAccount(_ context.Context, request *proto.UpdateAccountRequest) (*proto.UpdateAccountResponse, error) {
+func (api *API) UpdateAccount(ctx context.Context, request *proto.UpdateAccountRequest) (*proto.UpdateAccountResponse, error) {
 	if request.Id == "" {
 		return &proto.UpdateAccountResponse{}, status.Error(codes.FailedPrecondition, "account id required")
 	}
@@ -120,13 +128,32 @@ func (api *API) UpdateAccount(_ context.Context, request *proto.UpdateAccountReq
 		hash = ptr(string(hashBytes))
 	}
 
-	err = api.db.UpdateAccount(api.db, request.Id, storage.UpdatableAccountFields{
-		Name:     name,
-		Hash:     hash,
-		Modified: ptr(time.Now().UnixMilli()),
+	err = api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		before := models.Account{}
+		before.FromStorage(&account)
+
+		err := api.db.UpdateAccount(tx, request.Id, storage.UpdatableAccountFields{
+			Name:     name,
+			Hash:     hash,
+			Modified: ptr(time.Now().UnixMilli()),
+		})
+		if err != nil {
+			return err
+		}
+
+		accountRaw, err := api.db.GetAccount(tx, request.Id)
+		if err != nil {
+			return err
+		}
+
+		after := models.Account{}
+		after.FromStorage(&accountRaw)
+
+		return api.recordAuditEvent(ctx, tx, request.Id, models.EntityKindAccount, request.Id,
+			redactAccount(before), redactAccount(after))
 	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return &proto.UpdateAccountResponse{}, status.Error(codes.NotFound, "account requested not found")
 		}
 		log.Error().Err(err).Msg("could not save account")
@@ -138,17 +165,18 @@ func (api *API) UpdateAccount(_ context.Context, request *proto.UpdateAccountReq
 }
 
 // ToggleAccountState enables or disables an account depending on what it's original state was.
-func (api *API) ToggleAccountState(_ context.Context, request *proto.ToggleAccountStateRequest) (*proto.ToggleAccountStateResponse, error) {
+func (api *API) ToggleAccountState(ctx context.Context, request *proto.ToggleAccountStateRequest) (*proto.ToggleAccountStateResponse, error) {
 	account := models.Account{}
 	newState := models.AccountStateUnknown
 
-	err := storage.InsideTx(api.db.DB, func(*sqlx.Tx) error {
-		accountRaw, err := api.db.GetAccount(api.db, request.Id)
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		accountRaw, err := api.db.GetAccount(tx, request.Id)
 		if err != nil {
 			return fmt.Errorf("could not get account: %w", err)
 		}
 
 		account.FromStorage(&accountRaw)
+		before := account
 
 		if account.State == models.AccountStateDisabled {
 			newState = models.AccountStateActive
@@ -156,21 +184,25 @@ func (api *API) ToggleAccountState(_ context.Context, request *proto.ToggleAccou
 			newState = models.AccountStateDisabled
 		}
 
-		err = api.db.UpdateAccount(api.db, request.Id, storage.UpdatableAccountFields{
+		err = api.db.UpdateAccount(tx, request.Id, storage.UpdatableAccountFields{
 			State: ptr(string(newState)),
 		})
 		if err != nil {
 			return fmt.Errorf("could not update account: %w", err)
 		}
 
-		return nil
+		after := account
+		after.State = newState
+
+		return api.recordAuditEvent(ctx, tx, request.Id, models.EntityKindAccount, request.Id,
+			redactAccount(before), redactAccount(after))
 	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return nil, status.Error(codes.NotFound, "account requested not found")
 		}
 
-		if err == storage.ErrEntityExists {
+		if errors.Is(err, storage.ErrEntityExists) {
 			return nil, status.Error(codes.AlreadyExists, "could not save account; account already exists")
 		}
 
@@ -183,3 +215,9 @@ func (api *API) ToggleAccountState(_ context.Context, request *proto.ToggleAccou
 		State: account.ToProto().State,
 	}, nil
 }
+
+// redactAccount removes the password hash from an account so that it can be safely recorded in the audit log.
+func redactAccount(account models.Account) models.Account {
+	account.Hash = ""
+	return account
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/clintjedwards/basecoat/internal/models"
 	"github.com/clintjedwards/basecoat/internal/search"
 	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/internal/webhook"
 	proto "github.com/clintjedwards/basecoat/proto"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/gorilla/mux"
@@ -28,6 +29,7 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -52,6 +54,11 @@ type API struct {
 
 	search *search.Search
 
+	watchers watchers
+
+	// Webhooks posts deliveries of the account's events to their webhooks in the background.
+	webhooks *webhook.Dispatcher
+
 	// We opt out of forward compatibility with this embedded interface. This is required by GRPC.
 	//
 	// We don't embed the "proto.UnimplementedBasecoatServer" as there should never(I assume this will come back to bite me)
@@ -79,6 +86,7 @@ func NewAPI(config *config.API, db storage.DB) (*API, error) {
 	api.config = config
 	api.db = db
 	api.search = searchIndex
+	api.webhooks = webhook.NewDispatcher(db, config.Webhooks)
 
 	// For dev mode we auto create an account which can be used for development purposes.
 	if config.Development.AutoCreateAccount {
@@ -114,11 +122,14 @@ func (api *API) StartAPIService() {
 		log.Fatal().Err(err).Msg("could not get proper TLS config")
 	}
 
-	httpServer := wrapGRPCServer(api.config, grpcServer)
+	httpServer := api.wrapGRPCServer(grpcServer)
 	httpServer.TLSConfig = tlsConfig
 
 	go metrics.InitPrometheusService(api.config.Metrics.Endpoint)
 
+	go api.webhooks.Run()
+	log.Info().Dur("poll_interval", api.config.Webhooks.PollInterval).Msg("started webhook dispatcher")
+
 	if api.config.Development.UseLocalhostTLS {
 		log.Warn().Msg("loaded localhost TLS due to development config use_localhost_tls")
 	}
@@ -135,6 +146,8 @@ func (api *API) StartAPIService() {
 	signal.Notify(c, syscall.SIGTERM, syscall.SIGINT)
 	<-c
 
+	api.watchers.stop()
+
 	// Doesn't block if no connections, otherwise will wait until the timeout deadline or connections to finish,
 	// whichever comes first.
 	ctx, cancel := context.WithTimeout(context.Background(), api.config.Server.ShutdownTimeout) // shutdown gracefully
@@ -173,12 +186,15 @@ func loggingMiddleware(next http.Handler) http.Handler {
 // Rather than going through the trouble of setting up a separate proxy and extra for the service in order to server http/grpc/grpc-web
 // this keeps things simple by enabling the operator to deploy a single binary and serve them all from one endpoint.
 // This reduces operational burden, configuration headache and overall just makes for a better time for both client and operator.
-func wrapGRPCServer(config *config.API, grpcServer *grpc.Server) *http.Server {
+func (api *API) wrapGRPCServer(grpcServer *grpc.Server) *http.Server {
 	wrappedGrpc := grpcweb.WrapServer(grpcServer)
 
 	router := mux.NewRouter()
 
-	if config.Frontend.Enable {
+	// API routes must be registered before the frontend's, which catches every path it is given.
+	api.registerHTTPRoutes(router)
+
+	if api.config.Frontend.Enable {
 		frontend := frontend.New()
 		frontend.RegisterUIRoutes(router)
 		log.Info().Msg("frontend enabled")
@@ -194,7 +210,7 @@ func wrapGRPCServer(config *config.API, grpcServer *grpc.Server) *http.Server {
 	})
 
 	httpServer := http.Server{
-		Addr:    config.Server.Host,
+		Addr:    api.config.Server.Host,
 		Handler: loggingMiddleware(GRPCandHTTPHandler),
 		// Timeouts set here unfortunately also apply to the backing GRPC server. Because GRPC might have long running calls
 		// we have to set these to 0 or a very high number. This creates an issue where running the frontend in this configuration
@@ -207,6 +223,75 @@ func wrapGRPCServer(config *config.API, grpcServer *grpc.Server) *http.Server {
 	return &httpServer
 }
 
+// registerHTTPRoutes registers the API's plain HTTP endpoints; the JSON gateway to every RPC among them.
+func (api *API) registerHTTPRoutes(router *mux.Router) {
+	gateway, err := newGateway(api)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not create JSON gateway")
+	}
+	gateway.registerRoutes(router)
+
+	router.HandleFunc("/api/formulas/{id}/label", api.handleLabel).Methods(http.MethodGet)
+	router.HandleFunc("/api/jobs/{id}/report", api.handleJobReport).Methods(http.MethodGet)
+	router.HandleFunc("/c/{code}", api.handleCode).Methods(http.MethodGet)
+}
+
+// httpStatus maps the gRPC status of an error onto the closest HTTP status code.
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.FailedPrecondition, codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func recoveryHandler(p interface{}) (err error) {
+	log.Error().Err(err).Interface("panic", p).Msg("server has encountered a fatal error")
+	log.Error().Msg(string(debug.Stack()))
+	return status.Errorf(codes.Unknown, "server has encountered a fatal error and could not process request")
+}
+
+// unaryInterceptor chains every interceptor unary calls pass through, whether they come from the gRPC server or the
+// JSON gateway.
+func (api *API) unaryInterceptor() grpc.UnaryServerInterceptor {
+	// recovery should always be first
+	return grpc_middleware.ChainUnaryServer(
+		grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(recoveryHandler)),
+		grpc_auth.UnaryServerInterceptor(api.authenticate),
+		api.watchInterceptor,
+		api.idempotencyInterceptor,
+		grpc_prometheus.UnaryServerInterceptor,
+	)
+}
+
+// streamInterceptor chains every interceptor streaming calls pass through, whether they come from the gRPC server or
+// the JSON gateway.
+func (api *API) streamInterceptor() grpc.StreamServerInterceptor {
+	// recovery should always be first
+	return grpc_middleware.ChainStreamServer(
+		grpc_recovery.StreamServerInterceptor(grpc_recovery.WithRecoveryHandler(recoveryHandler)),
+		grpc_auth.StreamServerInterceptor(api.authenticate),
+		api.watchStreamInterceptor,
+	)
+}
+
 // createGRPCServer creates the basecoat grpc server with all the proper settings; TLS enabled.
 func (api *API) createGRPCServer() (*grpc.Server, error) {
 	tlsConfig, err := api.generateTLSConfig(api.config.Server.TLSCertPath, api.config.Server.TLSKeyPath)
@@ -214,27 +299,9 @@ func (api *API) createGRPCServer() (*grpc.Server, error) {
 		return nil, err
 	}
 
-	panicHandler := func(p interface{}) (err error) {
-		log.Error().Err(err).Interface("panic", p).Msg("server has encountered a fatal error")
-		log.Error().Msg(string(debug.Stack()))
-		return status.Errorf(codes.Unknown, "server has encountered a fatal error and could not process request")
-	}
-
 	grpcServer := grpc.NewServer(
-		// recovery should always be first
-		grpc.UnaryInterceptor(
-			grpc_middleware.ChainUnaryServer(
-				grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandler(panicHandler)),
-				grpc_auth.UnaryServerInterceptor(api.authenticate),
-				grpc_prometheus.UnaryServerInterceptor,
-			),
-		),
-		grpc.StreamInterceptor(
-			grpc_middleware.ChainStreamServer(
-				grpc_recovery.StreamServerInterceptor(grpc_recovery.WithRecoveryHandler(panicHandler)),
-				grpc_auth.StreamServerInterceptor(api.authenticate),
-			),
-		),
+		grpc.UnaryInterceptor(api.unaryInterceptor()),
+		grpc.StreamInterceptor(api.streamInterceptor()),
 
 		// Handle TLS
 		grpc.Creds(credentials.NewTLS(tlsConfig)),
@@ -248,3 +315,68 @@ func (api *API) createGRPCServer() (*grpc.Server, error) {
 
 	return grpcServer, nil
 }
+
+// contextTx holds the transaction a request is part of, opened by a batch or by the idempotency interceptor. Handlers
+// run inside it rather than opening their own.
+var contextTx = contextKey("tx")
+
+// insideTx runs fn inside a transaction. When the request is already part of one fn instead runs inside a savepoint of
+// it so that a failure only undoes the changes this request made.
+func (api *API) insideTx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, present := ctx.Value(contextTx).(*sqlx.Tx)
+	if present {
+		return storage.InsideSavepoint(tx, fn)
+	}
+
+	return storage.InsideTx(api.db, fn)
+}
+
+// contextIndexed holds the search index updates waiting on the idempotency interceptor's transaction to commit.
+var contextIndexed = contextKey("indexed")
+
+// pendingIndex collects the formulas and jobs written inside a transaction so they can be indexed once it commits.
+type pendingIndex struct {
+	entities []pendingIndexEntity
+}
+
+type pendingIndexEntity struct {
+	kind    models.EntityKind
+	account string
+	id      string
+	deleted bool
+}
+
+// apply indexes every entity collected.
+func (p *pendingIndex) apply(api *API) {
+	for _, entity := range p.entities {
+		api.indexEntity(entity.kind, entity.account, entity.id, entity.deleted)
+	}
+}
+
+// updateSearchIndex brings the search index up to date after a formula or job was written; other kinds are ignored.
+// Writes made inside a transaction can't be read until it commits so they're indexed by whoever opened it: a batch
+// indexes the items it kept itself and the idempotency interceptor indexes what was collected for it.
+func (api *API) updateSearchIndex(ctx context.Context, kind models.EntityKind, account, id string, deleted bool) {
+	if _, present := ctx.Value(contextTx).(*sqlx.Tx); present {
+		if pending, ok := ctx.Value(contextIndexed).(*pendingIndex); ok {
+			pending.entities = append(pending.entities, pendingIndexEntity{kind, account, id, deleted})
+		}
+		return
+	}
+
+	api.indexEntity(kind, account, id, deleted)
+}
+
+// indexEntity adds, refreshes or removes a single formula or job in the search index.
+func (api *API) indexEntity(kind models.EntityKind, account, id string, deleted bool) {
+	switch {
+	case kind == models.EntityKindFormula && deleted:
+		api.search.DeleteFormulaIndex(account, id)
+	case kind == models.EntityKindFormula:
+		api.search.UpdateFormulaIndex(account, id)
+	case kind == models.EntityKindJob && deleted:
+		api.search.DeleteJobIndex(account, id)
+	case kind == models.EntityKindJob:
+		api.search.UpdateJobIndex(account, id)
+	}
+}
@@ -0,0 +1,828 @@
+package api
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// archiveVersion is the version of the account archive format written by ExportAccount. It should be incremented
+// whenever a change is made that an older ImportAccount would not be able to understand.
+const archiveVersion = 1
+
+// ExportAccount streams a portable archive of everything owned by an account. The first record sent is always the
+// archive header; the remaining records are ordered such that every record only refers to records sent before it.
+func (api *API) ExportAccount(request *proto.ExportAccountRequest, stream proto.Basecoat_ExportAccountServer) error {
+	if request.Id == "" {
+		return status.Error(codes.FailedPrecondition, "account id required")
+	}
+
+	_, err := api.db.GetAccount(api.db, request.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return status.Error(codes.NotFound, "account not found")
+		}
+		log.Error().Err(err).Msg("could not get account")
+		return status.Error(codes.Internal, "could not get account")
+	}
+
+	// We read everything inside of a single transaction so that the archive reflects the account at a single
+	// point in time.
+	records := []*proto.AccountArchiveRecord{}
+	err = storage.InsideTx(api.db, func(tx *sqlx.Tx) error {
+		records, err = api.collectAccountArchive(tx, request.Id)
+		return err
+	})
+	if err != nil {
+		log.Error().Err(err).Str("account", request.Id).Msg("could not collect account archive")
+		return status.Error(codes.Internal, "could not collect account archive")
+	}
+
+	err = stream.Send(&proto.ExportAccountResponse{
+		Record: &proto.AccountArchiveRecord{Record: &proto.AccountArchiveRecord_Header{
+			Header: &proto.AccountArchiveHeader{
+				Version: archiveVersion,
+				Account: request.Id,
+				Created: time.Now().UnixMilli(),
+			},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		err = stream.Send(&proto.ExportAccountResponse{Record: record})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Info().Str("account", request.Id).Int("records", len(records)).Msg("account exported")
+	return nil
+}
+
+// listAll pages through a storage list function until it runs out of results.
+func listAll[T any](list func(offset int) ([]T, error)) ([]T, error) {
+	all := []T{}
+
+	for {
+		page, err := list(len(all))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page) == 0 {
+			return all, nil
+		}
+
+		all = append(all, page...)
+	}
+}
+
+func (api *API) collectAccountArchive(tx *sqlx.Tx, account string) ([]*proto.AccountArchiveRecord, error) {
+	records := []*proto.AccountArchiveRecord{}
+
+	contactsRaw, err := listAll(func(offset int) ([]storage.Contact, error) {
+		return api.db.ListContacts(tx, account, offset, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, contactRaw := range contactsRaw {
+		var contact models.Contact
+		contact.FromStorage(&contactRaw)
+		records = append(records, &proto.AccountArchiveRecord{Record: &proto.AccountArchiveRecord_Contact{
+			Contact: contact.ToProto(),
+		}})
+	}
+
+	contractorsRaw, err := listAll(func(offset int) ([]storage.Contractor, error) {
+		return api.db.ListContractors(tx, account, offset, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, contractorRaw := range contractorsRaw {
+		var contractor models.Contractor
+		contractor.FromStorage(&contractorRaw)
+		records = append(records, &proto.AccountArchiveRecord{Record: &proto.AccountArchiveRecord_Contractor{
+			Contractor: contractor.ToProto(),
+		}})
+	}
+
+	basesRaw, err := listAll(func(offset int) ([]storage.Base, error) {
+		return api.db.ListBases(tx, account, offset, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, baseRaw := range basesRaw {
+		var base models.BaseMetadata
+		base.FromStorage(&baseRaw)
+		records = append(records, &proto.AccountArchiveRecord{Record: &proto.AccountArchiveRecord_Base{
+			Base: base.ToProto(),
+		}})
+	}
+
+	colorantsRaw, err := listAll(func(offset int) ([]storage.Colorant, error) {
+		return api.db.ListColorants(tx, account, offset, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, colorantRaw := range colorantsRaw {
+		var colorant models.ColorantMetadata
+		colorant.FromStorage(&colorantRaw)
+		records = append(records, &proto.AccountArchiveRecord{Record: &proto.AccountArchiveRecord_Colorant{
+			Colorant: colorant.ToProto(),
+		}})
+	}
+
+	formulasRaw, err := listAll(func(offset int) ([]storage.Formula, error) {
+		return api.db.ListFormulas(tx, account, offset, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, formulaRaw := range formulasRaw {
+		var formula models.FormulaMetadata
+		formula.FromStorage(&formulaRaw)
+		records = append(records, &proto.AccountArchiveRecord{Record: &proto.AccountArchiveRecord_Formula{
+			Formula: formula.ToProto(),
+		}})
+
+		formulaBasesRaw, err := api.db.ListFormulaBases(tx, account, formulaRaw.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, formulaBaseRaw := range formulaBasesRaw {
+			var formulaBase models.FormulaBase
+			formulaBase.FromStorage(&formulaBaseRaw)
+			records = append(records, &proto.AccountArchiveRecord{Record: &proto.AccountArchiveRecord_FormulaBase{
+				FormulaBase: formulaBase.ToProto(),
+			}})
+		}
+
+		formulaColorantsRaw, err := api.db.ListFormulaColorants(tx, account, formulaRaw.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, formulaColorantRaw := range formulaColorantsRaw {
+			var formulaColorant models.FormulaColorant
+			formulaColorant.FromStorage(&formulaColorantRaw)
+			records = append(records, &proto.AccountArchiveRecord{Record: &proto.AccountArchiveRecord_FormulaColorant{
+				FormulaColorant: formulaColorant.ToProto(),
+			}})
+		}
+	}
+
+	jobsRaw, err := listAll(func(offset int) ([]storage.Job, error) {
+		return api.db.ListJobs(tx, account, offset, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, jobRaw := range jobsRaw {
+		var job models.Job
+		job.FromStorage(&jobRaw)
+		records = append(records, &proto.AccountArchiveRecord{Record: &proto.AccountArchiveRecord_Job{
+			Job: job.ToProto(),
+		}})
+
+		formulaJobsRaw, err := api.db.ListJobFormulas(tx, account, jobRaw.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, formulaJobRaw := range formulaJobsRaw {
+			var formulaJob models.FormulaJob
+			formulaJob.FromStorage(&formulaJobRaw)
+			records = append(records, &proto.AccountArchiveRecord{Record: &proto.AccountArchiveRecord_FormulaJob{
+				FormulaJob: formulaJob.ToProto(),
+			}})
+		}
+	}
+
+	return records, nil
+}
+
+// ImportAccount restores an archive produced by ExportAccount into an already existing account. The whole archive is
+// applied inside a single transaction; either every record is imported or none are.
+//
+// IDs found in the archive are kept where possible. When an entity with the same ID already exists in the target
+// account the conflict policy decides whether the archived entity is skipped, overwrites the existing entity or is
+// stored under a newly generated ID. References between records are remapped to match.
+func (api *API) ImportAccount(stream proto.Basecoat_ImportAccountServer) error {
+	var account string
+	var policy proto.ImportConflictPolicy
+	var header *proto.AccountArchiveHeader
+	records := []*proto.AccountArchiveRecord{}
+
+	for first := true; ; first = false {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if first {
+			account = request.Account
+			policy = request.Policy
+		}
+
+		if request.Record == nil {
+			continue
+		}
+
+		if request.Record.GetHeader() != nil {
+			header = request.Record.GetHeader()
+			continue
+		}
+
+		records = append(records, request.Record)
+	}
+
+	if account == "" {
+		return status.Error(codes.FailedPrecondition, "account id required")
+	}
+
+	if header == nil {
+		return status.Error(codes.FailedPrecondition, "archive header required")
+	}
+
+	if header.Version > archiveVersion {
+		return status.Errorf(codes.FailedPrecondition,
+			"archive version %d is newer than the latest supported version %d", header.Version, archiveVersion)
+	}
+
+	_, err := api.db.GetAccount(api.db, account)
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return status.Error(codes.NotFound, "account not found")
+		}
+		log.Error().Err(err).Msg("could not get account")
+		return status.Error(codes.Internal, "could not get account")
+	}
+
+	importer := newAccountImporter(api.db, account, policy)
+
+	err = storage.InsideTx(api.db, func(tx *sqlx.Tx) error {
+		err := importer.apply(tx, records)
+		if err != nil {
+			return err
+		}
+
+		// An import is recorded as a single event summarizing what changed rather than an event per entity.
+		return api.recordAuditEvent(stream.Context(), tx, account, models.EntityKindAccount, account, nil, &importer.result)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		log.Error().Err(err).Str("account", account).Msg("could not import account archive")
+		return status.Error(codes.Internal, "could not import account archive")
+	}
+
+	for _, id := range importer.formulas {
+		if importer.skipped[entityRef{models.EntityKindFormula, id}] {
+			continue
+		}
+		api.search.UpdateFormulaIndex(account, id)
+	}
+
+	for _, id := range importer.jobs {
+		if importer.skipped[entityRef{models.EntityKindJob, id}] {
+			continue
+		}
+		api.search.UpdateJobIndex(account, id)
+	}
+
+	log.Info().Str("account", account).Str("source_account", header.Account).
+		Int64("created", importer.result.Created).Int64("overwritten", importer.result.Overwritten).
+		Int64("skipped", importer.result.Skipped).Int64("renamed", importer.result.Renamed).
+		Msg("account imported")
+
+	return stream.SendAndClose(&importer.result)
+}
+
+// importAction is what should happen to a single archived entity once conflicts have been taken into account.
+type importAction int
+
+const (
+	importInsert importAction = iota
+	importOverwrite
+	importSkip
+)
+
+// accountImporter applies archive records to an account, keeping track of how archived IDs map to stored IDs.
+type accountImporter struct {
+	db      storage.DB
+	account string
+	policy  proto.ImportConflictPolicy
+	result  proto.ImportAccountResponse
+
+	// Maps of archived ID to the ID the entity was stored under in the target account.
+	contacts    map[string]string
+	contractors map[string]string
+	bases       map[string]string
+	colorants   map[string]string
+	formulas    map[string]string
+	jobs        map[string]string
+
+	// Entities left as they were under the skip policy. Associations which would change them aren't imported.
+	skipped map[entityRef]bool
+}
+
+// entityRef identifies a stored entity of any kind.
+type entityRef struct {
+	kind models.EntityKind
+	id   string
+}
+
+func newAccountImporter(db storage.DB, account string, policy proto.ImportConflictPolicy) *accountImporter {
+	return &accountImporter{
+		db:          db,
+		account:     account,
+		policy:      policy,
+		contacts:    map[string]string{},
+		contractors: map[string]string{},
+		bases:       map[string]string{},
+		colorants:   map[string]string{},
+		formulas:    map[string]string{},
+		jobs:        map[string]string{},
+		skipped:     map[entityRef]bool{},
+	}
+}
+
+// resolve decides what to do with an archived entity and returns the ID it should be stored under. lookupErr is the
+// result of looking the entity up under its archived ID.
+//
+// Entities in the trash keep their ID, so an entity which isn't found is looked for in the trash as well. One that's
+// there conflicts like any other: it's restored from the trash to be overwritten, or left in the trash when skipped.
+func (i *accountImporter) resolve(tx *sqlx.Tx, kind models.EntityKind, id string, lookupErr error) (string,
+	importAction, error,
+) {
+	if lookupErr != nil && !errors.Is(lookupErr, storage.ErrEntityNotFound) {
+		return "", importSkip, lookupErr
+	}
+
+	trashed := false
+	if lookupErr != nil {
+		_, err := i.db.GetDeleted(tx, string(kind), i.account, id)
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			i.result.Created++
+			return id, importInsert, nil
+		}
+		if err != nil {
+			return "", importSkip, err
+		}
+
+		trashed = true
+	}
+
+	switch i.policy {
+	case proto.ImportConflictPolicy_OVERWRITE:
+		if trashed {
+			err := i.db.Undelete(tx, string(kind), i.account, id)
+			if err != nil {
+				return "", importSkip, err
+			}
+		}
+
+		i.result.Overwritten++
+		return id, importOverwrite, nil
+	case proto.ImportConflictPolicy_RENAME:
+		i.result.Renamed++
+		return shortuuid.New()[0:7], importInsert, nil
+	default:
+		i.result.Skipped++
+		i.skipped[entityRef{kind, id}] = true
+		return id, importSkip, nil
+	}
+}
+
+// importVersion returns the version an archived entity is inserted with. Archives written before entities were
+// versioned carry no version at all; those start again from the first version.
+func importVersion(version int64) int64 {
+	if version < 1 {
+		return 1
+	}
+
+	return version
+}
+
+// remap returns the stored ID for an archived reference. References to entities that were not part of the archive
+// are returned as nil.
+func remap(ids map[string]string, id *string) *string {
+	if id == nil {
+		return nil
+	}
+
+	newID, ok := ids[*id]
+	if !ok {
+		return nil
+	}
+
+	return &newID
+}
+
+// apply writes records to the database in dependency order so that references always resolve.
+func (i *accountImporter) apply(tx *sqlx.Tx, records []*proto.AccountArchiveRecord) error {
+	byKind := map[string][]*proto.AccountArchiveRecord{}
+	for _, record := range records {
+		var kind string
+		switch record.Record.(type) {
+		case *proto.AccountArchiveRecord_Contact:
+			kind = "contact"
+		case *proto.AccountArchiveRecord_Contractor:
+			kind = "contractor"
+		case *proto.AccountArchiveRecord_Base:
+			kind = "base"
+		case *proto.AccountArchiveRecord_Colorant:
+			kind = "colorant"
+		case *proto.AccountArchiveRecord_Formula:
+			kind = "formula"
+		case *proto.AccountArchiveRecord_FormulaBase:
+			kind = "formula_base"
+		case *proto.AccountArchiveRecord_FormulaColorant:
+			kind = "formula_colorant"
+		case *proto.AccountArchiveRecord_Job:
+			kind = "job"
+		case *proto.AccountArchiveRecord_FormulaJob:
+			kind = "formula_job"
+		default:
+			return status.Error(codes.InvalidArgument, "archive contains an unknown record type")
+		}
+
+		byKind[kind] = append(byKind[kind], record)
+	}
+
+	for _, record := range byKind["contact"] {
+		if err := i.importContact(tx, record.GetContact()); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range byKind["contractor"] {
+		if err := i.importContractor(tx, record.GetContractor()); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range byKind["base"] {
+		if err := i.importBase(tx, record.GetBase()); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range byKind["colorant"] {
+		if err := i.importColorant(tx, record.GetColorant()); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range byKind["formula"] {
+		if err := i.importFormula(tx, record.GetFormula()); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range byKind["formula_base"] {
+		if err := i.importFormulaBase(tx, record.GetFormulaBase()); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range byKind["formula_colorant"] {
+		if err := i.importFormulaColorant(tx, record.GetFormulaColorant()); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range byKind["job"] {
+		if err := i.importJob(tx, record.GetJob()); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range byKind["formula_job"] {
+		if err := i.importFormulaJob(tx, record.GetFormulaJob()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *accountImporter) importContact(tx *sqlx.Tx, record *proto.Contact) error {
+	var contact models.Contact
+	contact.FromProto(record)
+	contact.Account = i.account
+	contact.Version = importVersion(contact.Version)
+
+	_, err := i.db.GetContact(tx, i.account, contact.ID)
+	id, action, err := i.resolve(tx, models.EntityKindContact, contact.ID, err)
+	if err != nil {
+		return err
+	}
+
+	i.contacts[contact.ID] = id
+	contact.ID = id
+
+	switch action {
+	case importInsert:
+		return i.db.InsertContact(tx, contact.ToStorage())
+	case importOverwrite:
+		return i.db.UpdateContact(tx, i.account, contact.ID, storage.UpdatableContactFields{
+			Name:     &contact.Name,
+			Email:    &contact.Email,
+			Phone:    &contact.Phone,
+			Modified: ptr(time.Now().UnixMilli()),
+		})
+	}
+
+	return nil
+}
+
+func (i *accountImporter) importContractor(tx *sqlx.Tx, record *proto.Contractor) error {
+	var contractor models.Contractor
+	contractor.FromProto(record)
+	contractor.Account = i.account
+	contractor.Version = importVersion(contractor.Version)
+	contractor.Contact = remap(i.contacts, contractor.Contact)
+
+	_, err := i.db.GetContractor(tx, i.account, contractor.ID)
+	id, action, err := i.resolve(tx, models.EntityKindContractor, contractor.ID, err)
+	if err != nil {
+		return err
+	}
+
+	i.contractors[contractor.ID] = id
+	contractor.ID = id
+
+	switch action {
+	case importInsert:
+		return i.db.InsertContractor(tx, contractor.ToStorage())
+	case importOverwrite:
+		return i.db.UpdateContractor(tx, i.account, contractor.ID, storage.UpdatableContractorFields{
+			Company:  &contractor.Company,
+			Contact:  contractor.Contact,
+			Modified: ptr(time.Now().UnixMilli()),
+		})
+	}
+
+	return nil
+}
+
+func (i *accountImporter) importBase(tx *sqlx.Tx, record *proto.BaseMetadata) error {
+	var base models.BaseMetadata
+	base.FromProto(record)
+	base.Account = i.account
+	base.Version = importVersion(base.Version)
+
+	_, err := i.db.GetBase(tx, i.account, base.ID)
+	id, action, err := i.resolve(tx, models.EntityKindBase, base.ID, err)
+	if err != nil {
+		return err
+	}
+
+	i.bases[base.ID] = id
+	base.ID = id
+
+	switch action {
+	case importInsert:
+		return i.db.InsertBase(tx, base.ToStorage())
+	case importOverwrite:
+		return i.db.UpdateBase(tx, i.account, base.ID, storage.UpdatableBaseFields{
+			Label:        &base.Label,
+			Manufacturer: &base.Manufacturer,
+		})
+	}
+
+	return nil
+}
+
+func (i *accountImporter) importColorant(tx *sqlx.Tx, record *proto.ColorantMetadata) error {
+	var colorant models.ColorantMetadata
+	colorant.FromProto(record)
+	colorant.Account = i.account
+	colorant.Version = importVersion(colorant.Version)
+
+	_, err := i.db.GetColorant(tx, i.account, colorant.ID)
+	id, action, err := i.resolve(tx, models.EntityKindColorant, colorant.ID, err)
+	if err != nil {
+		return err
+	}
+
+	i.colorants[colorant.ID] = id
+	colorant.ID = id
+
+	switch action {
+	case importInsert:
+		return i.db.InsertColorant(tx, colorant.ToStorage())
+	case importOverwrite:
+		return i.db.UpdateColorant(tx, i.account, colorant.ID, storage.UpdatableColorantFields{
+			Label:        &colorant.Label,
+			Manufacturer: &colorant.Manufacturer,
+		})
+	}
+
+	return nil
+}
+
+func (i *accountImporter) importFormula(tx *sqlx.Tx, record *proto.FormulaMetadata) error {
+	var formula models.FormulaMetadata
+	formula.FromProto(record)
+	formula.Account = i.account
+	formula.Version = importVersion(formula.Version)
+
+	_, err := i.db.GetFormula(tx, i.account, formula.ID)
+	id, action, err := i.resolve(tx, models.EntityKindFormula, formula.ID, err)
+	if err != nil {
+		return err
+	}
+
+	i.formulas[formula.ID] = id
+	formula.ID = id
+
+	switch action {
+	case importInsert:
+		return i.db.InsertFormula(tx, formula.ToStorage())
+	case importOverwrite:
+		return i.db.UpdateFormula(tx, i.account, formula.ID, storage.UpdatableFormulaFields{
+			Name:     &formula.Name,
+			Number:   &formula.Number,
+			Notes:    &formula.Notes,
+			Sheen:    &formula.Sheen,
+			Color:    &formula.Color,
+			Modified: ptr(time.Now().UnixMilli()),
+		})
+	}
+
+	return nil
+}
+
+func (i *accountImporter) importJob(tx *sqlx.Tx, record *proto.Job) error {
+	var job models.Job
+	job.FromProto(record)
+	job.Account = i.account
+	job.Version = importVersion(job.Version)
+	job.Contact = remap(i.contacts, job.Contact)
+	if contractor := remap(i.contractors, &job.Contractor); contractor != nil {
+		job.Contractor = *contractor
+	}
+
+	_, err := i.db.GetJob(tx, i.account, job.ID)
+	id, action, err := i.resolve(tx, models.EntityKindJob, job.ID, err)
+	if err != nil {
+		return err
+	}
+
+	i.jobs[job.ID] = id
+	job.ID = id
+
+	switch action {
+	case importInsert:
+		return i.db.InsertJob(tx, job.ToStorage())
+	case importOverwrite:
+		return i.db.UpdateJob(tx, i.account, job.ID, storage.UpdatableJobFields{
+			Name:       &job.Name,
+			Contractor: &job.Contractor,
+			Address:    ptr(job.Address.ToJSON()),
+			Notes:      &job.Notes,
+			Contact:    job.Contact,
+			Modified:   ptr(time.Now().UnixMilli()),
+		})
+	}
+
+	return nil
+}
+
+// importAssociation inserts an association between two entities. Associations that already exist are replaced
+// when the policy is overwrite and otherwise left as they are.
+func (i *accountImporter) importAssociation(insert, remove func() error) error {
+	err := insert()
+	if err == nil {
+		i.result.Created++
+		return nil
+	}
+
+	if !errors.Is(err, storage.ErrEntityExists) {
+		return err
+	}
+
+	if i.policy != proto.ImportConflictPolicy_OVERWRITE || remove == nil {
+		i.result.Skipped++
+		return nil
+	}
+
+	err = remove()
+	if err != nil {
+		return err
+	}
+
+	err = insert()
+	if err != nil {
+		return err
+	}
+
+	i.result.Overwritten++
+	return nil
+}
+
+func (i *accountImporter) importFormulaBase(tx *sqlx.Tx, record *proto.FormulaBase) error {
+	formula, formulaOK := i.formulas[record.Formula]
+	base, baseOK := i.bases[record.Base]
+	if !formulaOK || !baseOK {
+		return status.Errorf(codes.InvalidArgument,
+			"archive associates formula %q with base %q but one of them is missing from the archive", record.Formula, record.Base)
+	}
+
+	if i.skipped[entityRef{models.EntityKindFormula, formula}] {
+		i.result.Skipped++
+		return nil
+	}
+
+	return i.importAssociation(func() error {
+		return i.db.AssociateBaseWithFormula(tx, &storage.FormulaBase{
+			Account: i.account,
+			Formula: formula,
+			Base:    base,
+			Amount:  record.Amount,
+		})
+	}, func() error {
+		return i.db.DeleteFormulaBase(tx, i.account, formula, base)
+	})
+}
+
+func (i *accountImporter) importFormulaColorant(tx *sqlx.Tx, record *proto.FormulaColorant) error {
+	formula, formulaOK := i.formulas[record.Formula]
+	colorant, colorantOK := i.colorants[record.Colorant]
+	if !formulaOK || !colorantOK {
+		return status.Errorf(codes.InvalidArgument,
+			"archive associates formula %q with colorant %q but one of them is missing from the archive",
+			record.Formula, record.Colorant)
+	}
+
+	if i.skipped[entityRef{models.EntityKindFormula, formula}] {
+		i.result.Skipped++
+		return nil
+	}
+
+	return i.importAssociation(func() error {
+		return i.db.AssociateColorantWithFormula(tx, &storage.FormulaColorant{
+			Account:  i.account,
+			Formula:  formula,
+			Colorant: colorant,
+			Amount:   record.Amount,
+		})
+	}, func() error {
+		return i.db.DeleteFormulaColorant(tx, i.account, formula, colorant)
+	})
+}
+
+func (i *accountImporter) importFormulaJob(tx *sqlx.Tx, record *proto.FormulaJob) error {
+	formula, formulaOK := i.formulas[record.Formula]
+	job, jobOK := i.jobs[record.Job]
+	if !formulaOK || !jobOK {
+		return status.Errorf(codes.InvalidArgument,
+			"archive associates formula %q with job %q but one of them is missing from the archive", record.Formula, record.Job)
+	}
+
+	// The association is listed on both the formula and the job, so it changes either one.
+	if i.skipped[entityRef{models.EntityKindFormula, formula}] || i.skipped[entityRef{models.EntityKindJob, job}] {
+		i.result.Skipped++
+		return nil
+	}
+
+	return i.importAssociation(func() error {
+		return i.db.AssociateFormulaWithJob(tx, &storage.FormulaJob{
+			Account: i.account,
+			Formula: formula,
+			Job:     job,
+			Area:    record.Area,
+		})
+	}, func() error {
+		return i.db.DeleteJobFormula(tx, i.account, job, formula)
+	})
+}
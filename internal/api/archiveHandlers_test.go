@@ -0,0 +1,178 @@
+package api
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/jmoiron/sqlx"
+)
+
+// newTestDB returns an empty database with a single account, "test_account".
+func newTestDB(t *testing.T) storage.DB {
+	t.Helper()
+
+	db, err := storage.New(storage.EngineSQLite, filepath.Join(t.TempDir(), "basecoat.db"), 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	err = db.InsertAccount(db, &storage.Account{ID: "test_account"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func TestImportOverTrashedEntity(t *testing.T) {
+	tests := map[string]struct {
+		policy       proto.ImportConflictPolicy
+		result       *proto.ImportAccountResponse
+		name         string // Name of the formula stored under the archived ID once imported.
+		stillInTrash bool
+	}{
+		"skip": {
+			policy:       proto.ImportConflictPolicy_SKIP,
+			result:       &proto.ImportAccountResponse{Skipped: 1},
+			stillInTrash: true,
+		},
+		"overwrite": {
+			policy: proto.ImportConflictPolicy_OVERWRITE,
+			result: &proto.ImportAccountResponse{Overwritten: 1},
+			name:   "archived_name",
+		},
+		"rename": {
+			policy:       proto.ImportConflictPolicy_RENAME,
+			result:       &proto.ImportAccountResponse{Renamed: 1},
+			stillInTrash: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db := newTestDB(t)
+
+			err := db.InsertFormula(db, &storage.Formula{
+				Account: "test_account",
+				ID:      "test_formula",
+				Name:    "trashed_name",
+				Version: 1,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.SoftDelete(db, "FORMULA", "test_account", "test_formula", 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			records := []*proto.AccountArchiveRecord{{
+				Record: &proto.AccountArchiveRecord_Formula{Formula: &proto.FormulaMetadata{
+					Id:      "test_formula",
+					Name:    "archived_name",
+					Version: 1,
+				}},
+			}}
+
+			importer := newAccountImporter(db, "test_account", tc.policy)
+			err = storage.InsideTx(db, func(tx *sqlx.Tx) error {
+				return importer.apply(tx, records)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if importer.result.Created != tc.result.Created || importer.result.Overwritten != tc.result.Overwritten ||
+				importer.result.Skipped != tc.result.Skipped || importer.result.Renamed != tc.result.Renamed {
+				t.Errorf("unexpected result; want %v got %v", tc.result, &importer.result)
+			}
+
+			formula, err := db.GetFormula(db, "test_account", "test_formula")
+			if tc.stillInTrash {
+				if !errors.Is(err, storage.ErrEntityNotFound) {
+					t.Fatalf("expected formula to still be in the trash; found %v", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if formula.Name != tc.name {
+					t.Errorf("expected formula name %q; got %q", tc.name, formula.Name)
+				}
+			}
+
+			if tc.policy == proto.ImportConflictPolicy_RENAME {
+				renamed, err := db.GetFormula(db, "test_account", importer.formulas["test_formula"])
+				if err != nil {
+					t.Fatal(err)
+				}
+				if renamed.Name != "archived_name" {
+					t.Errorf("expected renamed formula name %q; got %q", "archived_name", renamed.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestImportSkipLeavesAssociationsUntouched(t *testing.T) {
+	db := newTestDB(t)
+
+	err := db.InsertFormula(db, &storage.Formula{Account: "test_account", ID: "test_formula", Name: "existing_name", Version: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"existing_base", "archived_base"} {
+		err = db.InsertBase(db, &storage.Base{Account: "test_account", ID: id, Label: id, Version: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = db.AssociateBaseWithFormula(db, &storage.FormulaBase{
+		Account: "test_account", Formula: "test_formula", Base: "existing_base", Amount: "1 gal",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := []*proto.AccountArchiveRecord{
+		{Record: &proto.AccountArchiveRecord_Formula{Formula: &proto.FormulaMetadata{
+			Id: "test_formula", Name: "archived_name", Version: 1,
+		}}},
+		{Record: &proto.AccountArchiveRecord_Base{Base: &proto.BaseMetadata{
+			Id: "archived_base", Label: "archived_base", Version: 1,
+		}}},
+		{Record: &proto.AccountArchiveRecord_FormulaBase{FormulaBase: &proto.FormulaBase{
+			Formula: "test_formula", Base: "archived_base", Amount: "2 gal",
+		}}},
+	}
+
+	importer := newAccountImporter(db, "test_account", proto.ImportConflictPolicy_SKIP)
+	err = storage.InsideTx(db, func(tx *sqlx.Tx) error {
+		return importer.apply(tx, records)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if importer.result.Skipped != 3 || importer.result.Created != 0 {
+		t.Errorf("expected the formula, base and association to be skipped; got %v", &importer.result)
+	}
+
+	bases, err := db.ListFormulaBases(db, "test_account", "test_formula")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []storage.FormulaBase{{Account: "test_account", Formula: "test_formula", Base: "existing_base", Amount: "1 gal"}}
+	if diff := cmp.Diff(expected, bases); diff != "" {
+		t.Errorf("unexpected bases for skipped formula (-want +got):\n%s", diff)
+	}
+}
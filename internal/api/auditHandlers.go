@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/internal/webhook"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// recordAuditEvent appends an event to the audit log describing a change made by the current caller. It must be
+// called with the same transaction that made the change so that a change is never committed without its event. The
+// event's deliveries to the account's webhooks are queued with it for the same reason.
+//
+// Before and after are the entity as it was on either side of the change and are stored as JSON. A nil value means
+// the entity did not exist on that side of the change.
+func (api *API) recordAuditEvent(ctx context.Context, conn storage.Queryable, account string, kind models.EntityKind,
+	id string, before, after any,
+) error {
+	method, _ := grpc.Method(ctx)
+
+	event := models.NewAuditEvent(account, getActorFromContext(ctx), method, kind, id, getSourceIPFromContext(ctx))
+
+	var err error
+	event.Before, err = auditJSON(before)
+	if err != nil {
+		return err
+	}
+
+	event.After, err = auditJSON(after)
+	if err != nil {
+		return err
+	}
+
+	eventRaw := event.ToStorage()
+	err = api.db.InsertAuditEvent(conn, eventRaw)
+	if err != nil {
+		return err
+	}
+	event.ID = eventRaw.ID
+	markChanged(ctx, account)
+
+	return webhook.Queue(api.db, conn, event)
+}
+
+func auditJSON(entity any) (*string, error) {
+	if entity == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return ptr(string(raw)), nil
+}
+
+// getAuditEntity returns the current state of an entity in the form it is recorded in the audit log.
+func (api *API) getAuditEntity(conn storage.Queryable, kind models.EntityKind, account, id string) (any, error) {
+	switch kind {
+	case models.EntityKindFormula:
+		formulaRaw, err := api.db.GetFormula(conn, account, id)
+		if err != nil {
+			return nil, err
+		}
+		formula := models.FormulaMetadata{}
+		formula.FromStorage(&formulaRaw)
+		return formula, nil
+	case models.EntityKindBase:
+		baseRaw, err := api.db.GetBase(conn, account, id)
+		if err != nil {
+			return nil, err
+		}
+		base := models.BaseMetadata{}
+		base.FromStorage(&baseRaw)
+		return base, nil
+	case models.EntityKindColorant:
+		colorantRaw, err := api.db.GetColorant(conn, account, id)
+		if err != nil {
+			return nil, err
+		}
+		colorant := models.ColorantMetadata{}
+		colorant.FromStorage(&colorantRaw)
+		return colorant, nil
+	case models.EntityKindContact:
+		contactRaw, err := api.db.GetContact(conn, account, id)
+		if err != nil {
+			return nil, err
+		}
+		contact := models.Contact{}
+		contact.FromStorage(&contactRaw)
+		return contact, nil
+	case models.EntityKindContractor:
+		contractorRaw, err := api.db.GetContractor(conn, account, id)
+		if err != nil {
+			return nil, err
+		}
+		contractor := models.Contractor{}
+		contractor.FromStorage(&contractorRaw)
+		return contractor, nil
+	case models.EntityKindJob:
+		jobRaw, err := api.db.GetJob(conn, account, id)
+		if err != nil {
+			return nil, err
+		}
+		job := models.Job{}
+		job.FromStorage(&jobRaw)
+		return job, nil
+	case models.EntityKindMix:
+		mixRaw, err := api.db.GetMix(conn, account, id)
+		if err != nil {
+			return nil, err
+		}
+		mix := models.Mix{}
+		mix.FromStorage(&mixRaw)
+		return mix, nil
+	default:
+		return nil, fmt.Errorf("entity kind %q has no audit representation; %w", kind, storage.ErrPreconditionFailure)
+	}
+}
+
+// getSourceIPFromContext returns the address of the client making the current request.
+func getSourceIPFromContext(ctx context.Context) string {
+	p, present := peer.FromContext(ctx)
+	if !present || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+// ListAuditEvents returns the audit log for the account; newest events first.
+func (api *API) ListAuditEvents(ctx context.Context, request *proto.ListAuditEventsRequest) (*proto.ListAuditEventsResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.ListAuditEventsResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	filter := storage.AuditEventFilter{
+		EntityID: request.EntityId,
+		Since:    request.Since,
+		Until:    request.Until,
+	}
+
+	if request.EntityKind != proto.EntityKind_ENTITY_KIND_UNKNOWN {
+		filter.EntityKind = request.EntityKind.String()
+	}
+
+	eventsRaw, err := api.db.ListAuditEvents(api.db, account, filter, int(request.Offset), int(request.Limit))
+	if err != nil {
+		log.Error().Err(err).Msg("could not retrieve audit events")
+		return &proto.ListAuditEventsResponse{}, status.Error(codes.Internal, "failed to retrieve audit events from database")
+	}
+
+	protoEvents := []*proto.AuditEvent{}
+	for _, eventRaw := range eventsRaw {
+		var event models.AuditEvent
+		event.FromStorage(&eventRaw)
+		protoEvents = append(protoEvents, event.ToProto())
+	}
+
+	return &proto.ListAuditEventsResponse{Events: protoEvents}, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/clintjedwards/basecoat/proto"
 	jwt "github.com/dgrijalva/jwt-go"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/lithammer/shortuuid/v4"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -20,19 +21,31 @@ import (
 
 type contextKey string
 
-var contextAccount = contextKey("account")
+var (
+	contextAccount = contextKey("account")
+
+	// contextActor holds who is making the request; the ID of the API token used or "admin".
+	contextActor = contextKey("actor")
+)
+
+const (
+	adminActor = "admin"
+	devActor   = "dev"
+)
 
 var adminMethods = []string{
-	"/api.Basecoat/GetAccount",
-	"/api.Basecoat/CreateAccount",
-	"/api.Basecoat/ListAccounts",
-	"/api.Basecoat/UpdateAccount",
-	"/api.Basecoat/ToggleAccountState",
+	proto.Basecoat_GetAccount_FullMethodName,
+	proto.Basecoat_CreateAccount_FullMethodName,
+	proto.Basecoat_ListAccounts_FullMethodName,
+	proto.Basecoat_UpdateAccount_FullMethodName,
+	proto.Basecoat_ToggleAccountState_FullMethodName,
+	proto.Basecoat_ExportAccount_FullMethodName,
+	proto.Basecoat_ImportAccount_FullMethodName,
 }
 
 var authlessMethods = []string{
-	"/api.Basecoat/CreateAPIToken",
-	"/api.Basecoat/GetSystemInfo",
+	proto.Basecoat_CreateAPIToken_FullMethodName,
+	proto.Basecoat_GetSystemInfo_FullMethodName,
 }
 
 // CreateAPIToken returns a temporary api key that can be used on all subsequent requests
@@ -68,7 +81,10 @@ func (api *API) CreateAPIToken(_ context.Context, request *proto.CreateAPITokenR
 		return &proto.CreateAPITokenResponse{}, status.Error(codes.NotFound, "could not authenticate account")
 	}
 
+	tokenID := shortuuid.New()
+
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"id":      tokenID,
 		"account": account.ID,
 		"expiry":  int64(time.Now().Unix() + request.Duration),
 	})
@@ -79,7 +95,7 @@ func (api *API) CreateAPIToken(_ context.Context, request *proto.CreateAPITokenR
 		return &proto.CreateAPITokenResponse{}, status.Error(codes.Internal, "could not authenticate account; internal error")
 	}
 
-	log.Info().Str("account", account.ID).Msg("api token created")
+	log.Info().Str("account", account.ID).Str("token_id", tokenID).Msg("api token created")
 	return &proto.CreateAPITokenResponse{Key: tokenString}, nil
 }
 
@@ -104,13 +120,13 @@ func (api *API) authenticate(ctx context.Context) (context.Context, error) {
 		if method == route {
 			if api.config.Development.BypassAuth {
 				log.Debug().Msg("admin route accessed due to bypass_auth config set to true")
-				return ctx, nil
+				return context.WithValue(ctx, contextActor, adminActor), nil
 			}
 
 			admin := handleAdminRoutes(token, api.config.AdminToken)
 			if admin {
 				log.Info().Str("method", method).Msg("admin route accessed")
-				return ctx, err
+				return context.WithValue(ctx, contextActor, adminActor), err
 			}
 			log.Debug().Str("method", method).Msg("could not verify admin token")
 			return ctx, status.Errorf(codes.Unauthenticated, "could not verify admin token")
@@ -119,6 +135,7 @@ func (api *API) authenticate(ctx context.Context) (context.Context, error) {
 
 	if api.config.Development.BypassAuth {
 		newCtx := context.WithValue(ctx, contextAccount, "dev")
+		newCtx = context.WithValue(newCtx, contextActor, devActor)
 		log.Debug().Msg("automatically authed due to bypass_auth config set to true; authed as 'dev' account")
 		return newCtx, nil
 	}
@@ -164,7 +181,14 @@ func (api *API) authenticate(ctx context.Context) (context.Context, error) {
 		return ctx, status.Errorf(codes.Unauthenticated, "token has expired: %v", time.Unix(expiry, 0).UTC())
 	}
 
+	// Tokens issued before tokens carried an ID are still accepted but can't be told apart from each other.
+	actor := "unknown"
+	if id, ok := claims["id"].(string); ok {
+		actor = id
+	}
+
 	newCtx := context.WithValue(ctx, contextAccount, claims["account"].(string))
+	newCtx = context.WithValue(newCtx, contextActor, actor)
 	return newCtx, nil
 }
 
@@ -174,6 +198,15 @@ func getAccountFromContext(ctx context.Context) (string, bool) {
 	return account, present
 }
 
+// getActorFromContext gets who is making the current request from the context
+func getActorFromContext(ctx context.Context) string {
+	actor, present := ctx.Value(contextActor).(string)
+	if !present {
+		return "unknown"
+	}
+	return actor
+}
+
 func handleAdminRoutes(token, adminKey string) bool {
 	return token == adminKey
 }
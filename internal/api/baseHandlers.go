@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/clintjedwards/basecoat/internal/models"
 	"github.com/clintjedwards/basecoat/internal/storage"
@@ -26,7 +28,7 @@ func (api *API) GetBase(ctx context.Context, request *proto.GetBaseRequest) (*pr
 
 	base := models.Base{}
 
-	err := storage.InsideTx(api.db.DB, func(tx *sqlx.Tx) error {
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
 		baseRaw, err := api.db.GetBase(tx, account, request.Id)
 		if err != nil {
 			return err
@@ -100,9 +102,16 @@ func (api *API) CreateBase(ctx context.Context, request *proto.CreateBaseRequest
 
 	base := models.NewBaseMetadata(account, request.Label, request.Manufacturer)
 
-	err := api.db.InsertBase(api.db, base.ToStorage())
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		err := api.db.InsertBase(tx, base.ToStorage())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindBase, base.ID, nil, base)
+	})
 	if err != nil {
-		if err == storage.ErrEntityExists {
+		if errors.Is(err, storage.ErrEntityExists) {
 			return &proto.CreateBaseResponse{}, status.Error(codes.AlreadyExists, "could not save base; base already exists")
 		}
 		log.Error().Err(err).Msg("could not save base")
@@ -127,20 +136,56 @@ func (api *API) UpdateBase(ctx context.Context, request *proto.UpdateBaseRequest
 		return &proto.UpdateBaseResponse{}, status.Error(codes.FailedPrecondition, "base id required")
 	}
 
-	err := api.db.UpdateBase(api.db, account, request.Id, storage.UpdatableBaseFields{
-		Label:        &request.Label,
-		Manufacturer: &request.Manufacturer,
+	if request.Version == 0 {
+		return &proto.UpdateBaseResponse{}, status.Error(codes.FailedPrecondition, "base version required")
+	}
+
+	var after models.BaseMetadata
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		baseRaw, err := api.db.GetBase(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.BaseMetadata{}
+		before.FromStorage(&baseRaw)
+
+		err = api.db.UpdateBase(tx, account, request.Id, storage.UpdatableBaseFields{
+			Version:      &request.Version,
+			Label:        request.Label,
+			Manufacturer: request.Manufacturer,
+		})
+		if err != nil {
+			return err
+		}
+
+		baseRaw, err = api.db.GetBase(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		after = models.BaseMetadata{}
+		after.FromStorage(&baseRaw)
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindBase, request.Id, before, after)
 	})
 	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.UpdateBaseResponse{}, status.Error(codes.NotFound, "base requested not found")
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return &proto.UpdateBaseResponse{}, status.Error(codes.Aborted,
+				"base was changed by someone else; fetch it again and retry")
+		}
 		log.Error().Err(err).Msg("could not save base")
 		return &proto.UpdateBaseResponse{}, status.Error(codes.Internal, "could not save base")
 	}
 
 	log.Debug().Str("id", request.Id).Msg("base updated")
-	return &proto.UpdateBaseResponse{}, nil
+	return &proto.UpdateBaseResponse{Base: after.ToProto()}, nil
 }
 
-// AssociateBaseWithFormula records the base as belonging to the formula given.
+// AssociateBaseWithFormula records the base as belonging to the formula given. It changes the formula's version.
 func (api *API) AssociateBaseWithFormula(ctx context.Context, request *proto.AssociateBaseWithFormulaRequest) (*proto.AssociateBaseWithFormulaResponse, error) {
 	account, present := getAccountFromContext(ctx)
 	if !present {
@@ -159,22 +204,48 @@ func (api *API) AssociateBaseWithFormula(ctx context.Context, request *proto.Ass
 		return &proto.AssociateBaseWithFormulaResponse{}, status.Error(codes.FailedPrecondition, "base amount required")
 	}
 
-	err := api.db.AssociateBaseWithFormula(api.db, &storage.FormulaBase{
-		Account: account,
-		Formula: request.Formula,
-		Base:    request.Base,
-		Amount:  request.Amount,
+	formulaBase := models.NewFormulaBase(request.Formula, request.Base, request.Amount)
+
+	var version int64
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		version, err = api.changeFormulaIngredients(tx, account, request.Formula, request.Version)
+		if err != nil {
+			return err
+		}
+
+		err = api.db.AssociateBaseWithFormula(tx, &storage.FormulaBase{
+			Account: account,
+			Formula: request.Formula,
+			Base:    request.Base,
+			Amount:  request.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindFormula, request.Formula, nil, formulaBase)
 	})
 	if err != nil {
+		if errors.Is(err, storage.ErrEntityExists) {
+			return &proto.AssociateBaseWithFormulaResponse{}, status.Error(codes.AlreadyExists, "base is already attached to formula")
+		}
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.AssociateBaseWithFormulaResponse{}, status.Error(codes.NotFound, "formula requested not found")
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return &proto.AssociateBaseWithFormulaResponse{}, status.Error(codes.Aborted,
+				"formula was changed by someone else; fetch it again and retry")
+		}
 		log.Error().Err(err).Msg("could not attach base to formula")
 		return &proto.AssociateBaseWithFormulaResponse{}, status.Error(codes.Internal, "could not attach base to formula")
 	}
 
 	log.Debug().Str("formula", request.Formula).Str("base", request.Base).Msg("base added to formula")
-	return &proto.AssociateBaseWithFormulaResponse{}, nil
+	return &proto.AssociateBaseWithFormulaResponse{Version: version}, nil
 }
 
-// DisassociateBaseFromFormula removes a base entry from a formula.
+// DisassociateBaseFromFormula removes a base entry from a formula. It changes the formula's version.
 func (api *API) DisassociateBaseFromFormula(ctx context.Context, request *proto.DisassociateBaseFromFormulaRequest) (*proto.DisassociateBaseFromFormulaResponse, error) {
 	account, present := getAccountFromContext(ctx)
 	if !present {
@@ -189,14 +260,58 @@ func (api *API) DisassociateBaseFromFormula(ctx context.Context, request *proto.
 		return &proto.DisassociateBaseFromFormulaResponse{}, status.Error(codes.FailedPrecondition, "base id required")
 	}
 
-	err := api.db.DeleteFormulaBase(api.db, account, request.Formula, request.Base)
+	var version int64
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		formulaBases, err := api.db.ListFormulaBases(tx, account, request.Formula)
+		if err != nil {
+			return err
+		}
+
+		var before *models.FormulaBase
+		for _, formulaBaseRaw := range formulaBases {
+			if formulaBaseRaw.Base == request.Base {
+				before = &models.FormulaBase{}
+				before.FromStorage(&formulaBaseRaw)
+			}
+		}
+
+		// Removing an association which doesn't exist is not an error but there's also nothing to record.
+		if before == nil {
+			formulaRaw, err := api.db.GetFormula(tx, account, request.Formula)
+			if err != nil {
+				return err
+			}
+
+			version = formulaRaw.Version
+			return nil
+		}
+
+		version, err = api.changeFormulaIngredients(tx, account, request.Formula, request.Version)
+		if err != nil {
+			return err
+		}
+
+		err = api.db.DeleteFormulaBase(tx, account, request.Formula, request.Base)
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindFormula, request.Formula, before, nil)
+	})
 	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.DisassociateBaseFromFormulaResponse{}, status.Error(codes.NotFound, "formula requested not found")
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return &proto.DisassociateBaseFromFormulaResponse{}, status.Error(codes.Aborted,
+				"formula was changed by someone else; fetch it again and retry")
+		}
 		log.Error().Err(err).Msg("could not remove base from Formula")
 		return &proto.DisassociateBaseFromFormulaResponse{}, status.Error(codes.Internal, "could not remove base from Formula")
 	}
 
 	log.Debug().Str("formula", request.Formula).Str("base", request.Base).Msg("base removed to formula")
-	return &proto.DisassociateBaseFromFormulaResponse{}, nil
+	return &proto.DisassociateBaseFromFormulaResponse{Version: version}, nil
 }
 
 func (api *API) DeleteBase(ctx context.Context, request *proto.DeleteBaseRequest) (*proto.DeleteBaseResponse, error) {
@@ -209,15 +324,63 @@ func (api *API) DeleteBase(ctx context.Context, request *proto.DeleteBaseRequest
 		return &proto.DeleteBaseResponse{}, status.Error(codes.FailedPrecondition, "base id required")
 	}
 
-	err := api.db.DeleteBase(api.db, account, request.Id)
+	formulas := []*proto.FormulaMetadata{}
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		baseRaw, err := api.db.GetBase(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.BaseMetadata{}
+		before.FromStorage(&baseRaw)
+
+		formulaBases, err := api.db.ListBaseFormulas(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, formulaBase := range formulaBases {
+			formulaRaw, err := api.db.GetFormula(tx, account, formulaBase.Formula)
+			if err != nil {
+				return err
+			}
+
+			var formula models.FormulaMetadata
+			formula.FromStorage(&formulaRaw)
+			formulas = append(formulas, formula.ToProto())
+		}
+
+		if request.DryRun {
+			return nil
+		}
+
+		if len(formulas) > 0 && !request.Force {
+			return errHasDependents
+		}
+
+		err = api.db.SoftDelete(tx, string(models.EntityKindBase), account, request.Id, time.Now().UnixMilli())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindBase, request.Id, before, nil)
+	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return &proto.DeleteBaseResponse{}, status.Error(codes.NotFound, "could not delete Base; base key not found")
 		}
 
+		if errors.Is(err, errHasDependents) {
+			ids := []string{}
+			for _, formula := range formulas {
+				ids = append(ids, formula.Id)
+			}
+			return &proto.DeleteBaseResponse{}, dependentsError("base", "formulas", ids)
+		}
+
 		log.Error().Err(err).Msg("could not delete Base")
 		return &proto.DeleteBaseResponse{}, status.Error(codes.Internal, "could not delete Base")
 	}
 
-	return &proto.DeleteBaseResponse{}, nil
+	return &proto.DeleteBaseResponse{Formulas: formulas}, nil
 }
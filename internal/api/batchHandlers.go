@@ -0,0 +1,281 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errBatchFailed is returned from inside an atomic batch's transaction when one of its items failed.
+var errBatchFailed = errors.New("batch item failed")
+
+// validateBatch checks the size of a batch against the configured limit.
+func (api *API) validateBatch(count int) error {
+	if count == 0 {
+		return status.Error(codes.FailedPrecondition, "items required")
+	}
+
+	if count > api.config.Server.BatchSizeLimit {
+		return status.Errorf(codes.FailedPrecondition, "batch of %d items is larger than the limit of %d; split it up",
+			count, api.config.Server.BatchSizeLimit)
+	}
+
+	return nil
+}
+
+// runBatch applies count items inside a single transaction; apply is called with the index of each item in turn and
+// returns the ID of the entity it applied to. A result is returned for every item along with whether the transaction
+// was committed. A batch made with an idempotency key runs inside a savepoint of the key's transaction instead.
+//
+// In atomic mode the first failing item causes the rest of the batch to be skipped and everything to be rolled back.
+// In best effort mode failing items are rolled back on their own and the rest of the batch carries on.
+func (api *API) runBatch(ctx context.Context, mode proto.BatchMode, count int,
+	apply func(ctx context.Context, index int) (string, error),
+) ([]*proto.BatchResult, bool, error) {
+	results := make([]*proto.BatchResult, 0, count)
+	failed := -1
+
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		// Items which end up rolled back mustn't be indexed, so the batch indexes the ones it kept itself.
+		ctx := context.WithValue(context.WithValue(ctx, contextTx, tx), contextIndexed, nil)
+
+		for index := 0; index < count; index++ {
+			if failed >= 0 && mode == proto.BatchMode_ATOMIC {
+				results = append(results, &proto.BatchResult{
+					Index:      uint32(index),
+					Code:       int32(codes.Aborted),
+					Error:      fmt.Sprintf("not attempted; item %d failed", failed),
+					RolledBack: true,
+				})
+				continue
+			}
+
+			id, err := apply(ctx, index)
+			if err != nil {
+				if failed < 0 {
+					failed = index
+				}
+				results = append(results, &proto.BatchResult{
+					Index: uint32(index),
+					Code:  int32(status.Code(err)),
+					Error: status.Convert(err).Message(),
+				})
+				continue
+			}
+
+			results = append(results, &proto.BatchResult{
+				Index: uint32(index),
+				Id:    id,
+				Code:  int32(codes.OK),
+			})
+		}
+
+		if failed >= 0 && mode == proto.BatchMode_ATOMIC {
+			return errBatchFailed
+		}
+
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, errBatchFailed) {
+			return nil, false, err
+		}
+
+		for _, result := range results {
+			if result.Code == int32(codes.OK) {
+				result.Id = ""
+				result.Code = int32(codes.Aborted)
+				result.Error = fmt.Sprintf("rolled back; item %d failed", failed)
+				result.RolledBack = true
+			}
+		}
+
+		return results, false, nil
+	}
+
+	return results, true, nil
+}
+
+// BatchCreate creates many formulas, bases, colorants, contacts and jobs in a single transaction.
+func (api *API) BatchCreate(ctx context.Context, request *proto.BatchCreateRequest) (*proto.BatchCreateResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.BatchCreateResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	err := api.validateBatch(len(request.Items))
+	if err != nil {
+		return &proto.BatchCreateResponse{}, err
+	}
+
+	results, committed, err := api.runBatch(ctx, request.Mode, len(request.Items),
+		func(ctx context.Context, index int) (string, error) {
+			switch item := request.Items[index].Item.(type) {
+			case *proto.BatchCreateItem_Formula:
+				resp, err := api.CreateFormula(ctx, item.Formula)
+				return resp.GetFormula().GetId(), err
+			case *proto.BatchCreateItem_Base:
+				resp, err := api.CreateBase(ctx, item.Base)
+				return resp.GetBase().GetId(), err
+			case *proto.BatchCreateItem_Colorant:
+				resp, err := api.CreateColorant(ctx, item.Colorant)
+				return resp.GetColorant().GetId(), err
+			case *proto.BatchCreateItem_Contact:
+				resp, err := api.CreateContact(ctx, item.Contact)
+				return resp.GetContact().GetId(), err
+			case *proto.BatchCreateItem_Job:
+				resp, err := api.CreateJob(ctx, item.Job)
+				return resp.GetJob().GetId(), err
+			default:
+				return "", status.Error(codes.FailedPrecondition,
+					"item must be one of formula, base, colorant, contact or job")
+			}
+		})
+	if err != nil {
+		log.Error().Err(err).Msg("could not run batch create")
+		return &proto.BatchCreateResponse{}, status.Error(codes.Internal, "could not run batch create")
+	}
+
+	if committed {
+		for _, result := range results {
+			if result.Code != int32(codes.OK) {
+				continue
+			}
+
+			switch request.Items[result.Index].Item.(type) {
+			case *proto.BatchCreateItem_Formula:
+				api.updateSearchIndex(ctx, models.EntityKindFormula, account, result.Id, false)
+			case *proto.BatchCreateItem_Job:
+				api.updateSearchIndex(ctx, models.EntityKindJob, account, result.Id, false)
+			}
+		}
+	}
+
+	log.Debug().Int("items", len(request.Items)).Bool("committed", committed).Msg("batch create finished")
+	return &proto.BatchCreateResponse{Results: results, Committed: committed}, nil
+}
+
+// BatchUpdate updates many formulas, bases, colorants, contacts and jobs in a single transaction.
+func (api *API) BatchUpdate(ctx context.Context, request *proto.BatchUpdateRequest) (*proto.BatchUpdateResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.BatchUpdateResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	err := api.validateBatch(len(request.Items))
+	if err != nil {
+		return &proto.BatchUpdateResponse{}, err
+	}
+
+	results, committed, err := api.runBatch(ctx, request.Mode, len(request.Items),
+		func(ctx context.Context, index int) (string, error) {
+			switch item := request.Items[index].Item.(type) {
+			case *proto.BatchUpdateItem_Formula:
+				_, err := api.UpdateFormula(ctx, item.Formula)
+				return item.Formula.Id, err
+			case *proto.BatchUpdateItem_Base:
+				_, err := api.UpdateBase(ctx, item.Base)
+				return item.Base.Id, err
+			case *proto.BatchUpdateItem_Colorant:
+				_, err := api.UpdateColorant(ctx, item.Colorant)
+				return item.Colorant.Id, err
+			case *proto.BatchUpdateItem_Contact:
+				_, err := api.UpdateContact(ctx, item.Contact)
+				return item.Contact.Id, err
+			case *proto.BatchUpdateItem_Job:
+				_, err := api.UpdateJob(ctx, item.Job)
+				return item.Job.Id, err
+			default:
+				return "", status.Error(codes.FailedPrecondition,
+					"item must be one of formula, base, colorant, contact or job")
+			}
+		})
+	if err != nil {
+		log.Error().Err(err).Msg("could not run batch update")
+		return &proto.BatchUpdateResponse{}, status.Error(codes.Internal, "could not run batch update")
+	}
+
+	if committed {
+		for _, result := range results {
+			if result.Code != int32(codes.OK) {
+				continue
+			}
+
+			switch request.Items[result.Index].Item.(type) {
+			case *proto.BatchUpdateItem_Formula:
+				api.updateSearchIndex(ctx, models.EntityKindFormula, account, result.Id, false)
+			case *proto.BatchUpdateItem_Job:
+				api.updateSearchIndex(ctx, models.EntityKindJob, account, result.Id, false)
+			}
+		}
+	}
+
+	log.Debug().Int("items", len(request.Items)).Bool("committed", committed).Msg("batch update finished")
+	return &proto.BatchUpdateResponse{Results: results, Committed: committed}, nil
+}
+
+// BatchDelete moves many formulas, bases, colorants, contacts and jobs to the trash in a single transaction.
+func (api *API) BatchDelete(ctx context.Context, request *proto.BatchDeleteRequest) (*proto.BatchDeleteResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.BatchDeleteResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	err := api.validateBatch(len(request.Items))
+	if err != nil {
+		return &proto.BatchDeleteResponse{}, err
+	}
+
+	results, committed, err := api.runBatch(ctx, request.Mode, len(request.Items),
+		func(ctx context.Context, index int) (string, error) {
+			switch item := request.Items[index].Item.(type) {
+			case *proto.BatchDeleteItem_Formula:
+				_, err := api.DeleteFormula(ctx, item.Formula)
+				return item.Formula.Id, err
+			case *proto.BatchDeleteItem_Base:
+				_, err := api.DeleteBase(ctx, item.Base)
+				return item.Base.Id, err
+			case *proto.BatchDeleteItem_Colorant:
+				_, err := api.DeleteColorant(ctx, item.Colorant)
+				return item.Colorant.Id, err
+			case *proto.BatchDeleteItem_Contact:
+				_, err := api.DeleteContact(ctx, item.Contact)
+				return item.Contact.Id, err
+			case *proto.BatchDeleteItem_Job:
+				_, err := api.DeleteJob(ctx, item.Job)
+				return item.Job.Id, err
+			default:
+				return "", status.Error(codes.FailedPrecondition,
+					"item must be one of formula, base, colorant, contact or job")
+			}
+		})
+	if err != nil {
+		log.Error().Err(err).Msg("could not run batch delete")
+		return &proto.BatchDeleteResponse{}, status.Error(codes.Internal, "could not run batch delete")
+	}
+
+	if committed {
+		for _, result := range results {
+			if result.Code != int32(codes.OK) {
+				continue
+			}
+
+			switch request.Items[result.Index].Item.(type) {
+			case *proto.BatchDeleteItem_Formula:
+				api.updateSearchIndex(ctx, models.EntityKindFormula, account, result.Id, true)
+			case *proto.BatchDeleteItem_Job:
+				api.updateSearchIndex(ctx, models.EntityKindJob, account, result.Id, true)
+			}
+		}
+	}
+
+	log.Debug().Int("items", len(request.Items)).Bool("committed", committed).Msg("batch delete finished")
+	return &proto.BatchDeleteResponse{Results: results, Committed: committed}, nil
+}
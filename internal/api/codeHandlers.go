@@ -0,0 +1,365 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// codeAttempts is how many freshly generated codes are tried before giving up. Codes are random and there are
+// hundreds of billions of them so a collision is already unlikely; several in a row means something is wrong.
+const codeAttempts = 5
+
+// errNoCodes is returned when a code is asked for an entity kind which doesn't get codes.
+var errNoCodes = errors.New("only formulas, jobs and mixes have codes")
+
+// entityCode returns an entity's code, giving the entity one if it doesn't have one yet. Codes are handed out when
+// formulas and jobs are created and mixes recorded; entities which existed before codes did get theirs the first time
+// one is needed.
+func (api *API) entityCode(tx *sqlx.Tx, account string, kind models.EntityKind, id string) (models.Code, error) {
+	codeRaw, err := api.db.GetEntityCode(tx, account, string(kind), id)
+	if err == nil {
+		var code models.Code
+		code.FromStorage(&codeRaw)
+		return code, nil
+	}
+	if !errors.Is(err, storage.ErrEntityNotFound) {
+		return models.Code{}, err
+	}
+
+	switch kind {
+	case models.EntityKindFormula:
+		_, err = api.db.GetFormula(tx, account, id)
+	case models.EntityKindJob:
+		_, err = api.db.GetJob(tx, account, id)
+	case models.EntityKindMix:
+		_, err = api.db.GetMix(tx, account, id)
+	default:
+		return models.Code{}, errNoCodes
+	}
+	if err != nil {
+		return models.Code{}, err
+	}
+
+	for attempt := 0; attempt < codeAttempts; attempt++ {
+		code := models.NewCode(account, kind, id)
+
+		// A failed insert aborts the whole transaction on postgres; the savepoint keeps it usable for the next try.
+		err = storage.InsideSavepoint(tx, func(tx *sqlx.Tx) error {
+			return api.db.InsertCode(tx, code.ToStorage())
+		})
+		if err == nil {
+			return *code, nil
+		}
+		if !errors.Is(err, storage.ErrEntityExists) {
+			return models.Code{}, err
+		}
+	}
+
+	return models.Code{}, fmt.Errorf("could not generate an unused code after %d attempts", codeAttempts)
+}
+
+// GetCode returns the code printed on labels for a formula, job or mix.
+func (api *API) GetCode(ctx context.Context, request *proto.GetCodeRequest) (*proto.GetCodeResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.GetCodeResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Id == "" {
+		return &proto.GetCodeResponse{}, status.Error(codes.FailedPrecondition, "id required")
+	}
+
+	var code models.Code
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		code, err = api.entityCode(tx, account, models.EntityKind(request.Kind.String()), request.Id)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, errNoCodes) {
+			return &proto.GetCodeResponse{}, status.Error(codes.FailedPrecondition, errNoCodes.Error())
+		}
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.GetCodeResponse{}, status.Error(codes.NotFound, "entity requested not found")
+		}
+		log.Error().Err(err).Msg("could not retrieve code")
+		return &proto.GetCodeResponse{}, status.Error(codes.Internal, "could not retrieve code")
+	}
+
+	return &proto.GetCodeResponse{Code: code.ToProto()}, nil
+}
+
+// ResolveCode returns the formula, job or mix a code was given to. Codes belonging to other accounts are reported as not
+// found.
+func (api *API) ResolveCode(ctx context.Context, request *proto.ResolveCodeRequest) (*proto.ResolveCodeResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.ResolveCodeResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	value := models.NormalizeCode(request.Code)
+	if value == "" {
+		return &proto.ResolveCodeResponse{}, status.Error(codes.FailedPrecondition, "code required")
+	}
+
+	codeRaw, err := api.db.GetCode(api.db, value)
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.ResolveCodeResponse{}, status.Error(codes.NotFound, "code not found")
+		}
+		log.Error().Err(err).Msg("could not resolve code")
+		return &proto.ResolveCodeResponse{}, status.Error(codes.Internal, "could not resolve code")
+	}
+
+	if codeRaw.Account != account {
+		return &proto.ResolveCodeResponse{}, status.Error(codes.NotFound, "code not found")
+	}
+
+	var code models.Code
+	code.FromStorage(&codeRaw)
+
+	response := &proto.ResolveCodeResponse{Code: code.ToProto()}
+
+	switch code.EntityKind {
+	case models.EntityKindFormula:
+		formula, err := api.GetFormula(ctx, &proto.GetFormulaRequest{Id: code.EntityID})
+		if err != nil {
+			return &proto.ResolveCodeResponse{}, err
+		}
+		response.Entity = &proto.ResolveCodeResponse_Formula{Formula: formula.Formula}
+	case models.EntityKindJob:
+		job, err := api.GetJob(ctx, &proto.GetJobRequest{Id: code.EntityID})
+		if err != nil {
+			return &proto.ResolveCodeResponse{}, err
+		}
+		response.Entity = &proto.ResolveCodeResponse_Job{Job: job.Job}
+	case models.EntityKindMix:
+		mix, err := api.GetMix(ctx, &proto.GetMixRequest{Id: code.EntityID})
+		if err != nil {
+			return &proto.ResolveCodeResponse{}, err
+		}
+		response.Entity = &proto.ResolveCodeResponse_Mix{Mix: mix.Mix}
+	default:
+		log.Error().Str("code", code.Code).Str("kind", string(code.EntityKind)).Msg("code refers to unknown entity kind")
+		return &proto.ResolveCodeResponse{}, status.Error(codes.Internal, "could not resolve code")
+	}
+
+	return response, nil
+}
+
+// codeRecipe is a formula as shown on the page a code leads to.
+type codeRecipe struct {
+	Name      string
+	Number    string
+	Notes     string
+	Sheen     string
+	Color     string
+	Bases     []formulaIngredient
+	Colorants []formulaIngredient
+}
+
+// codePage is everything shown on the page a code leads to. Jobs show the recipe of each of their formulas and mixes
+// the recipe of the formula mixed; addresses and contact details are left off since the page may be public.
+type codePage struct {
+	Code     string
+	Job      string
+	JobNotes string
+	Mixed    string // How much was mixed and when; ex. "2 x 1 gal mixed Jan 2, 2023". Empty unless a mix.
+	Recipes  []codeRecipe
+}
+
+var codePageTemplate = template.Must(template.New("code").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{if .Job}}{{.Job}}{{else}}{{(index .Recipes 0).Name}}{{end}} | Basecoat</title>
+<style>
+body { font-family: sans-serif; max-width: 40em; margin: 1em auto; padding: 0 1em; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 1em; }
+td, th { text-align: left; padding: 0.25em 0.5em; border-bottom: 1px solid #ddd; }
+.code { font-family: monospace; color: #666; }
+.swatch { width: 4em; height: 4em; border: 1px solid #aaa; }
+</style>
+</head>
+<body>
+<p class="code">{{.Code}}</p>
+{{if .Job}}<h1>{{.Job}}</h1>{{if .JobNotes}}<p>{{.JobNotes}}</p>{{end}}{{end}}
+{{if .Mixed}}<p>{{.Mixed}}</p>{{end}}
+{{range .Recipes}}
+<h2>{{.Name}}{{if .Number}} ({{.Number}}){{end}}</h2>
+{{if .Color}}<div class="swatch" style="background-color: {{.Color}}"></div>{{end}}
+{{if .Sheen}}<p>Sheen: {{.Sheen}}</p>{{end}}
+{{if .Notes}}<p>{{.Notes}}</p>{{end}}
+<table>
+<tr><th>Base</th><th>Amount</th></tr>
+{{range .Bases}}<tr><td>{{.Name}}</td><td>{{.Amount}}</td></tr>
+{{end}}</table>
+<table>
+<tr><th>Colorant</th><th>Amount per gallon</th></tr>
+{{range .Colorants}}<tr><td>{{.Name}}</td><td>{{.Amount}}</td></tr>
+{{end}}</table>
+{{else}}<p>No formulas.</p>
+{{end}}
+</body>
+</html>
+`))
+
+// codeRecipe gathers a formula into the form shown on the page a code leads to.
+func (api *API) codeRecipe(tx *sqlx.Tx, account, id string) (codeRecipe, error) {
+	formula, err := api.db.GetFormula(tx, account, id)
+	if err != nil {
+		return codeRecipe{}, err
+	}
+
+	bases, colorants, err := api.listFormulaIngredients(tx, account, id)
+	if err != nil {
+		return codeRecipe{}, err
+	}
+
+	return codeRecipe{
+		Name:      formula.Name,
+		Number:    formula.Number,
+		Notes:     formula.Notes,
+		Sheen:     formula.Sheen,
+		Color:     formula.Color,
+		Bases:     bases,
+		Colorants: colorants,
+	}, nil
+}
+
+// codePageFor gathers the page shown for a code.
+func (api *API) codePageFor(tx *sqlx.Tx, code models.Code) (codePage, error) {
+	page := codePage{Code: code.Display()}
+
+	switch code.EntityKind {
+	case models.EntityKindFormula:
+		recipe, err := api.codeRecipe(tx, code.Account, code.EntityID)
+		if err != nil {
+			return codePage{}, err
+		}
+		page.Recipes = append(page.Recipes, recipe)
+	case models.EntityKindJob:
+		job, err := api.db.GetJob(tx, code.Account, code.EntityID)
+		if err != nil {
+			return codePage{}, err
+		}
+		page.Job = job.Name
+		page.JobNotes = job.Notes
+
+		jobFormulas, err := api.db.ListJobFormulas(tx, code.Account, code.EntityID)
+		if err != nil {
+			return codePage{}, err
+		}
+
+		for _, jobFormula := range jobFormulas {
+			recipe, err := api.codeRecipe(tx, code.Account, jobFormula.Formula)
+			if err != nil {
+				return codePage{}, err
+			}
+			page.Recipes = append(page.Recipes, recipe)
+		}
+	case models.EntityKindMix:
+		mix, err := api.db.GetMix(tx, code.Account, code.EntityID)
+		if err != nil {
+			return codePage{}, err
+		}
+		page.Mixed = fmt.Sprintf("%d x %s mixed %s", mix.Quantity, mix.Container,
+			time.UnixMilli(mix.Created).Format("Jan 2, 2006"))
+
+		// Mixes outlive the job and formula they were made for; a can's label keeps working once those are deleted,
+		// it just has less to show.
+		if mix.Job != "" {
+			job, err := api.db.GetJob(tx, code.Account, mix.Job)
+			if err != nil && !errors.Is(err, storage.ErrEntityNotFound) {
+				return codePage{}, err
+			}
+			page.Job = job.Name
+		}
+
+		recipe, err := api.codeRecipe(tx, code.Account, mix.Formula)
+		if err != nil && !errors.Is(err, storage.ErrEntityNotFound) {
+			return codePage{}, err
+		}
+		if err == nil {
+			page.Recipes = append(page.Recipes, recipe)
+		}
+	default:
+		return codePage{}, fmt.Errorf("code refers to unknown entity kind %q", code.EntityKind)
+	}
+
+	return page, nil
+}
+
+// handleCode serves the page a scanned label leads to:
+//
+//	GET /c/{code}
+//
+// Codes can't be guessed so by default the page is public; anyone holding a can can look up what's in it. With
+// server.public_codes turned off requests must carry a bearer token for the account the code belongs to.
+func (api *API) handleCode(w http.ResponseWriter, r *http.Request) {
+	codeRaw, err := api.db.GetCode(api.db, models.NormalizeCode(mux.Vars(r)["code"]))
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			http.Error(w, "code not found", http.StatusNotFound)
+			return
+		}
+		log.Error().Err(err).Msg("could not resolve code")
+		http.Error(w, "could not resolve code", http.StatusInternalServerError)
+		return
+	}
+
+	if !api.config.Server.PublicCodes {
+		md := metadata.Pairs("authorization", r.Header.Get("Authorization"))
+		ctx, err := api.authenticate(metadata.NewIncomingContext(r.Context(), md))
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+			return
+		}
+
+		account, _ := getAccountFromContext(ctx)
+		if account != codeRaw.Account {
+			http.Error(w, "code not found", http.StatusNotFound)
+			return
+		}
+	}
+
+	var code models.Code
+	code.FromStorage(&codeRaw)
+
+	var page codePage
+	err = api.insideTx(r.Context(), func(tx *sqlx.Tx) error {
+		var err error
+		page, err = api.codePageFor(tx, code)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			http.Error(w, "code not found", http.StatusNotFound)
+			return
+		}
+		log.Error().Err(err).Msg("could not resolve code")
+		http.Error(w, "could not resolve code", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err = codePageTemplate.Execute(w, page)
+	if err != nil {
+		log.Error().Err(err).Msg("could not render code page")
+	}
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestMixCodes(t *testing.T) {
+	db := newTestDB(t)
+	api := &API{db: db, config: &config.API{Server: &config.Server{ExternalURL: "https://paint.example.com"}}}
+	ctx := context.WithValue(context.Background(), contextAccount, "test_account")
+
+	err := db.InsertFormula(db, &storage.Formula{Account: "test_account", ID: "test_formula", Name: "Hale Navy", Version: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorded, err := api.RecordMix(ctx, &proto.RecordMixRequest{Formula: "test_formula", Container: "1 qt", Quantity: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mixID := recorded.Mix.Id
+
+	code, err := api.GetCode(ctx, &proto.GetCodeRequest{Kind: proto.EntityKind_MIX, Id: mixID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolved, err := api.ResolveCode(ctx, &proto.ResolveCodeRequest{Code: code.Code.Code})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.GetMix().GetId() != mixID {
+		t.Errorf("expected code to resolve to mix %q; got %v", mixID, resolved)
+	}
+
+	var storedCode models.Code
+	codeRaw, err := db.GetCode(db, code.Code.Code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storedCode.FromStorage(&codeRaw)
+
+	err = storage.InsideTx(db, func(tx *sqlx.Tx) error {
+		page, err := api.codePageFor(tx, storedCode)
+		if err != nil {
+			return err
+		}
+
+		if !strings.HasPrefix(page.Mixed, "2 x 1 qt mixed") {
+			t.Errorf("expected page to show how much was mixed; got %q", page.Mixed)
+		}
+		if len(page.Recipes) != 1 || page.Recipes[0].Name != "Hale Navy" {
+			t.Errorf("expected page to show the recipe of the formula mixed; got %v", page.Recipes)
+		}
+
+		canLabel, err := api.mixLabel(tx, "test_account", "test_formula", mixID)
+		if err != nil {
+			return err
+		}
+
+		if canLabel.Code != storedCode.Display() || canLabel.Container != "1 qt" {
+			t.Errorf("expected label of mix's code %s for 1 qt; got %s for %s", storedCode.Display(), canLabel.Code,
+				canLabel.Container)
+		}
+
+		_, err = api.mixLabel(tx, "test_account", "other_formula", mixID)
+		if !errors.Is(err, errMixOfOtherFormula) {
+			t.Errorf("expected label of a mix of another formula to be refused; got %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Labels already on cans keep working once the formula they were mixed from is deleted.
+	err = db.SoftDelete(db, "FORMULA", "test_account", "test_formula", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = storage.InsideTx(db, func(tx *sqlx.Tx) error {
+		page, err := api.codePageFor(tx, storedCode)
+		if err != nil {
+			return err
+		}
+
+		if page.Mixed == "" || len(page.Recipes) != 0 {
+			t.Errorf("expected page of a mix of a deleted formula to show only how much was mixed; got %+v", page)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
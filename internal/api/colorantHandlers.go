@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/clintjedwards/basecoat/internal/models"
 	"github.com/clintjedwards/basecoat/internal/storage"
@@ -26,7 +28,7 @@ func (api *API) GetColorant(ctx context.Context, request *proto.GetColorantReque
 
 	colorant := models.Colorant{}
 
-	err := storage.InsideTx(api.db.DB, func(tx *sqlx.Tx) error {
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
 		colorantRaw, err := api.db.GetColorant(tx, account, request.Id)
 		if err != nil {
 			return err
@@ -99,10 +101,18 @@ func (api *API) CreateColorant(ctx context.Context, request *proto.CreateColoran
 	}
 
 	colorant := models.NewColorantMetadata(account, request.Label, request.Manufacturer)
+	colorant.DispenserCode = request.DispenserCode
 
-	err := api.db.InsertColorant(api.db, colorant.ToStorage())
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		err := api.db.InsertColorant(tx, colorant.ToStorage())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindColorant, colorant.ID, nil, colorant)
+	})
 	if err != nil {
-		if err == storage.ErrEntityExists {
+		if errors.Is(err, storage.ErrEntityExists) {
 			return &proto.CreateColorantResponse{}, status.Error(codes.AlreadyExists, "could not save colorant; colorant already exists")
 		}
 		log.Error().Err(err).Msg("could not save colorant")
@@ -127,20 +137,57 @@ func (api *API) UpdateColorant(ctx context.Context, request *proto.UpdateColoran
 		return &proto.UpdateColorantResponse{}, status.Error(codes.FailedPrecondition, "colorant id required")
 	}
 
-	err := api.db.UpdateColorant(api.db, account, request.Id, storage.UpdatableColorantFields{
-		Label:        &request.Label,
-		Manufacturer: &request.Manufacturer,
+	if request.Version == 0 {
+		return &proto.UpdateColorantResponse{}, status.Error(codes.FailedPrecondition, "colorant version required")
+	}
+
+	var after models.ColorantMetadata
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		colorantRaw, err := api.db.GetColorant(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.ColorantMetadata{}
+		before.FromStorage(&colorantRaw)
+
+		err = api.db.UpdateColorant(tx, account, request.Id, storage.UpdatableColorantFields{
+			Version:       &request.Version,
+			Label:         request.Label,
+			Manufacturer:  request.Manufacturer,
+			DispenserCode: request.DispenserCode,
+		})
+		if err != nil {
+			return err
+		}
+
+		colorantRaw, err = api.db.GetColorant(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		after = models.ColorantMetadata{}
+		after.FromStorage(&colorantRaw)
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindColorant, request.Id, before, after)
 	})
 	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.UpdateColorantResponse{}, status.Error(codes.NotFound, "colorant requested not found")
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return &proto.UpdateColorantResponse{}, status.Error(codes.Aborted,
+				"colorant was changed by someone else; fetch it again and retry")
+		}
 		log.Error().Err(err).Msg("could not save colorant")
 		return &proto.UpdateColorantResponse{}, status.Error(codes.Internal, "could not save colorant")
 	}
 
 	log.Debug().Str("id", request.Id).Msg("colorant updated")
-	return &proto.UpdateColorantResponse{}, nil
+	return &proto.UpdateColorantResponse{Colorant: after.ToProto()}, nil
 }
 
-// AssociateColorantWithFormula records the colorant as belonging to the formula given.
+// AssociateColorantWithFormula records the colorant as belonging to the formula given. It changes the formula's version.
 func (api *API) AssociateColorantWithFormula(ctx context.Context, request *proto.AssociateColorantWithFormulaRequest) (*proto.AssociateColorantWithFormulaResponse, error) {
 	account, present := getAccountFromContext(ctx)
 	if !present {
@@ -159,22 +206,48 @@ func (api *API) AssociateColorantWithFormula(ctx context.Context, request *proto
 		return &proto.AssociateColorantWithFormulaResponse{}, status.Error(codes.FailedPrecondition, "colorant amount required")
 	}
 
-	err := api.db.AssociateColorantWithFormula(api.db, &storage.FormulaColorant{
-		Account:  account,
-		Formula:  request.Formula,
-		Colorant: request.Colorant,
-		Amount:   request.Amount,
+	formulaColorant := models.NewFormulaColorant(request.Formula, request.Colorant, request.Amount)
+
+	var version int64
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		version, err = api.changeFormulaIngredients(tx, account, request.Formula, request.Version)
+		if err != nil {
+			return err
+		}
+
+		err = api.db.AssociateColorantWithFormula(tx, &storage.FormulaColorant{
+			Account:  account,
+			Formula:  request.Formula,
+			Colorant: request.Colorant,
+			Amount:   request.Amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindFormula, request.Formula, nil, formulaColorant)
 	})
 	if err != nil {
+		if errors.Is(err, storage.ErrEntityExists) {
+			return &proto.AssociateColorantWithFormulaResponse{}, status.Error(codes.AlreadyExists, "colorant is already attached to formula")
+		}
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.AssociateColorantWithFormulaResponse{}, status.Error(codes.NotFound, "formula requested not found")
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return &proto.AssociateColorantWithFormulaResponse{}, status.Error(codes.Aborted,
+				"formula was changed by someone else; fetch it again and retry")
+		}
 		log.Error().Err(err).Msg("could not attach colorant to formula")
 		return &proto.AssociateColorantWithFormulaResponse{}, status.Error(codes.Internal, "could not attach colorant to formula")
 	}
 
 	log.Debug().Str("formula", request.Formula).Str("colorant", request.Colorant).Msg("colorant added to formula")
-	return &proto.AssociateColorantWithFormulaResponse{}, nil
+	return &proto.AssociateColorantWithFormulaResponse{Version: version}, nil
 }
 
-// DisassociateColorantFromFormula removes a colorant entry from a formula.
+// DisassociateColorantFromFormula removes a colorant entry from a formula. It changes the formula's version.
 func (api *API) DisassociateColorantFromFormula(ctx context.Context, request *proto.DisassociateColorantFromFormulaRequest) (*proto.DisassociateColorantFromFormulaResponse, error) {
 	account, present := getAccountFromContext(ctx)
 	if !present {
@@ -189,14 +262,58 @@ func (api *API) DisassociateColorantFromFormula(ctx context.Context, request *pr
 		return &proto.DisassociateColorantFromFormulaResponse{}, status.Error(codes.FailedPrecondition, "colorant id required")
 	}
 
-	err := api.db.DeleteFormulaColorant(api.db, account, request.Formula, request.Colorant)
+	var version int64
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		formulaColorants, err := api.db.ListFormulaColorants(tx, account, request.Formula)
+		if err != nil {
+			return err
+		}
+
+		var before *models.FormulaColorant
+		for _, formulaColorantRaw := range formulaColorants {
+			if formulaColorantRaw.Colorant == request.Colorant {
+				before = &models.FormulaColorant{}
+				before.FromStorage(&formulaColorantRaw)
+			}
+		}
+
+		// Removing an association which doesn't exist is not an error but there's also nothing to record.
+		if before == nil {
+			formulaRaw, err := api.db.GetFormula(tx, account, request.Formula)
+			if err != nil {
+				return err
+			}
+
+			version = formulaRaw.Version
+			return nil
+		}
+
+		version, err = api.changeFormulaIngredients(tx, account, request.Formula, request.Version)
+		if err != nil {
+			return err
+		}
+
+		err = api.db.DeleteFormulaColorant(tx, account, request.Formula, request.Colorant)
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindFormula, request.Formula, before, nil)
+	})
 	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.DisassociateColorantFromFormulaResponse{}, status.Error(codes.NotFound, "formula requested not found")
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return &proto.DisassociateColorantFromFormulaResponse{}, status.Error(codes.Aborted,
+				"formula was changed by someone else; fetch it again and retry")
+		}
 		log.Error().Err(err).Msg("could not remove colorant from Formula")
 		return &proto.DisassociateColorantFromFormulaResponse{}, status.Error(codes.Internal, "could not remove colorant from Formula")
 	}
 
 	log.Debug().Str("formula", request.Formula).Str("colorant", request.Colorant).Msg("colorant removed to formula")
-	return &proto.DisassociateColorantFromFormulaResponse{}, nil
+	return &proto.DisassociateColorantFromFormulaResponse{Version: version}, nil
 }
 
 func (api *API) DeleteColorant(ctx context.Context, request *proto.DeleteColorantRequest) (*proto.DeleteColorantResponse, error) {
@@ -209,15 +326,63 @@ func (api *API) DeleteColorant(ctx context.Context, request *proto.DeleteColoran
 		return &proto.DeleteColorantResponse{}, status.Error(codes.FailedPrecondition, "colorant id required")
 	}
 
-	err := api.db.DeleteColorant(api.db, account, request.Id)
+	formulas := []*proto.FormulaMetadata{}
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		colorantRaw, err := api.db.GetColorant(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.ColorantMetadata{}
+		before.FromStorage(&colorantRaw)
+
+		formulaColorants, err := api.db.ListColorantFormulas(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, formulaColorant := range formulaColorants {
+			formulaRaw, err := api.db.GetFormula(tx, account, formulaColorant.Formula)
+			if err != nil {
+				return err
+			}
+
+			var formula models.FormulaMetadata
+			formula.FromStorage(&formulaRaw)
+			formulas = append(formulas, formula.ToProto())
+		}
+
+		if request.DryRun {
+			return nil
+		}
+
+		if len(formulas) > 0 && !request.Force {
+			return errHasDependents
+		}
+
+		err = api.db.SoftDelete(tx, string(models.EntityKindColorant), account, request.Id, time.Now().UnixMilli())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindColorant, request.Id, before, nil)
+	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return &proto.DeleteColorantResponse{}, status.Error(codes.NotFound, "could not delete Colorant; colorant key not found")
 		}
 
+		if errors.Is(err, errHasDependents) {
+			ids := []string{}
+			for _, formula := range formulas {
+				ids = append(ids, formula.Id)
+			}
+			return &proto.DeleteColorantResponse{}, dependentsError("colorant", "formulas", ids)
+		}
+
 		log.Error().Err(err).Msg("could not delete Colorant")
 		return &proto.DeleteColorantResponse{}, status.Error(codes.Internal, "could not delete Colorant")
 	}
 
-	return &proto.DeleteColorantResponse{}, nil
+	return &proto.DeleteColorantResponse{Formulas: formulas}, nil
 }
@@ -2,11 +2,13 @@ package api
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/clintjedwards/basecoat/internal/models"
 	"github.com/clintjedwards/basecoat/internal/storage"
 	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -74,9 +76,16 @@ func (api *API) CreateContact(ctx context.Context, request *proto.CreateContactR
 	contact.Phone = request.Phone
 	contact.Email = request.Email
 
-	err := api.db.InsertContact(api.db, contact.ToStorage())
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		err := api.db.InsertContact(tx, contact.ToStorage())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindContact, contact.ID, nil, contact)
+	})
 	if err != nil {
-		if err == storage.ErrEntityExists {
+		if errors.Is(err, storage.ErrEntityExists) {
 			return &proto.CreateContactResponse{}, status.Error(codes.AlreadyExists, "could not save contact; contact already exists")
 		}
 		log.Error().Err(err).Msg("could not save contact")
@@ -101,22 +110,55 @@ func (api *API) UpdateContact(ctx context.Context, request *proto.UpdateContactR
 		return &proto.UpdateContactResponse{}, status.Error(codes.FailedPrecondition, "contact id required")
 	}
 
-	err := api.db.UpdateContact(api.db, account, request.Id, storage.UpdatableContactFields{
-		Name:     request.Name,
-		Email:    request.Email,
-		Phone:    request.Phone,
-		Modified: ptr(time.Now().UnixMilli()),
+	if request.Version == 0 {
+		return &proto.UpdateContactResponse{}, status.Error(codes.FailedPrecondition, "contact version required")
+	}
+
+	var after models.Contact
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		contactRaw, err := api.db.GetContact(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.Contact{}
+		before.FromStorage(&contactRaw)
+
+		err = api.db.UpdateContact(tx, account, request.Id, storage.UpdatableContactFields{
+			Version:  &request.Version,
+			Name:     request.Name,
+			Email:    request.Email,
+			Phone:    request.Phone,
+			Modified: ptr(time.Now().UnixMilli()),
+		})
+		if err != nil {
+			return err
+		}
+
+		contactRaw, err = api.db.GetContact(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		after = models.Contact{}
+		after.FromStorage(&contactRaw)
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindContact, request.Id, before, after)
 	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return &proto.UpdateContactResponse{}, status.Error(codes.NotFound, "contact requested not found")
 		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return &proto.UpdateContactResponse{}, status.Error(codes.Aborted,
+				"contact was changed by someone else; fetch it again and retry")
+		}
 		log.Error().Err(err).Msg("could not save contact")
 		return &proto.UpdateContactResponse{}, status.Error(codes.Internal, "could not save contact")
 	}
 
 	log.Debug().Str("id", request.Id).Msg("contact updated")
-	return &proto.UpdateContactResponse{}, nil
+	return &proto.UpdateContactResponse{Contact: after.ToProto()}, nil
 }
 
 func (api *API) DeleteContact(ctx context.Context, request *proto.DeleteContactRequest) (*proto.DeleteContactResponse, error) {
@@ -129,9 +171,24 @@ func (api *API) DeleteContact(ctx context.Context, request *proto.DeleteContactR
 		return &proto.DeleteContactResponse{}, status.Error(codes.FailedPrecondition, "contact id required")
 	}
 
-	err := api.db.DeleteContact(api.db, account, request.Id)
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		contactRaw, err := api.db.GetContact(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.Contact{}
+		before.FromStorage(&contactRaw)
+
+		err = api.db.SoftDelete(tx, string(models.EntityKindContact), account, request.Id, time.Now().UnixMilli())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindContact, request.Id, before, nil)
+	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return &proto.DeleteContactResponse{}, status.Error(codes.NotFound, "could not delete contact; contact key not found")
 		}
 
@@ -2,11 +2,13 @@ package api
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/clintjedwards/basecoat/internal/models"
 	"github.com/clintjedwards/basecoat/internal/storage"
 	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -73,9 +75,16 @@ func (api *API) CreateContractor(ctx context.Context, request *proto.CreateContr
 	contractor := models.NewContractor(account, request.Company)
 	contractor.Contact = request.Contact
 
-	err := api.db.InsertContractor(api.db, contractor.ToStorage())
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		err := api.db.InsertContractor(tx, contractor.ToStorage())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindContractor, contractor.ID, nil, contractor)
+	})
 	if err != nil {
-		if err == storage.ErrEntityExists {
+		if errors.Is(err, storage.ErrEntityExists) {
 			return &proto.CreateContractorResponse{}, status.Error(codes.AlreadyExists, "could not save contractor; contractor already exists")
 		}
 		log.Error().Err(err).Msg("could not save contractor")
@@ -100,21 +109,54 @@ func (api *API) UpdateContractor(ctx context.Context, request *proto.UpdateContr
 		return &proto.UpdateContractorResponse{}, status.Error(codes.FailedPrecondition, "contractor id required")
 	}
 
-	err := api.db.UpdateContractor(api.db, account, request.Id, storage.UpdatableContractorFields{
-		Company:  request.Company,
-		Contact:  request.Contact,
-		Modified: ptr(time.Now().UnixMilli()),
+	if request.Version == 0 {
+		return &proto.UpdateContractorResponse{}, status.Error(codes.FailedPrecondition, "contractor version required")
+	}
+
+	var after models.Contractor
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		contractorRaw, err := api.db.GetContractor(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.Contractor{}
+		before.FromStorage(&contractorRaw)
+
+		err = api.db.UpdateContractor(tx, account, request.Id, storage.UpdatableContractorFields{
+			Version:  &request.Version,
+			Company:  request.Company,
+			Contact:  request.Contact,
+			Modified: ptr(time.Now().UnixMilli()),
+		})
+		if err != nil {
+			return err
+		}
+
+		contractorRaw, err = api.db.GetContractor(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		after = models.Contractor{}
+		after.FromStorage(&contractorRaw)
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindContractor, request.Id, before, after)
 	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return &proto.UpdateContractorResponse{}, status.Error(codes.NotFound, "contractor requested not found")
 		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return &proto.UpdateContractorResponse{}, status.Error(codes.Aborted,
+				"contractor was changed by someone else; fetch it again and retry")
+		}
 		log.Error().Err(err).Msg("could not save contractor")
 		return &proto.UpdateContractorResponse{}, status.Error(codes.Internal, "could not save contractor")
 	}
 
 	log.Debug().Str("id", request.Id).Msg("contractor updated")
-	return &proto.UpdateContractorResponse{}, nil
+	return &proto.UpdateContractorResponse{Contractor: after.ToProto()}, nil
 }
 
 func (api *API) DeleteContractor(ctx context.Context, request *proto.DeleteContractorRequest) (*proto.DeleteContractorResponse, error) {
@@ -127,15 +169,58 @@ func (api *API) DeleteContractor(ctx context.Context, request *proto.DeleteContr
 		return &proto.DeleteContractorResponse{}, status.Error(codes.FailedPrecondition, "contractor id required")
 	}
 
-	err := api.db.DeleteContractor(api.db, account, request.Id)
+	jobs := []*proto.Job{}
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		contractorRaw, err := api.db.GetContractor(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.Contractor{}
+		before.FromStorage(&contractorRaw)
+
+		jobsRaw, err := api.db.ListContractorJobs(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, jobRaw := range jobsRaw {
+			var job models.Job
+			job.FromStorage(&jobRaw)
+			jobs = append(jobs, job.ToProto())
+		}
+
+		if request.DryRun {
+			return nil
+		}
+
+		if len(jobs) > 0 && !request.Force {
+			return errHasDependents
+		}
+
+		err = api.db.SoftDelete(tx, string(models.EntityKindContractor), account, request.Id, time.Now().UnixMilli())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindContractor, request.Id, before, nil)
+	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return &proto.DeleteContractorResponse{}, status.Error(codes.NotFound, "could not delete contractor; contractor key not found")
 		}
 
+		if errors.Is(err, errHasDependents) {
+			ids := []string{}
+			for _, job := range jobs {
+				ids = append(ids, job.Id)
+			}
+			return &proto.DeleteContractorResponse{}, dependentsError("contractor", "jobs", ids)
+		}
+
 		log.Error().Err(err).Msg("could not delete contractor")
 		return &proto.DeleteContractorResponse{}, status.Error(codes.Internal, "could not delete contractor")
 	}
 
-	return &proto.DeleteContractorResponse{}, nil
+	return &proto.DeleteContractorResponse{Jobs: jobs}, nil
 }
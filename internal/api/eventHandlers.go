@@ -0,0 +1,272 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchPollInterval is how often watchers check the audit log for events they weren't woken up for; changes made
+// through another server sharing the same database or by the admin token.
+const watchPollInterval = 5 * time.Second
+
+// watchGapGracePeriod is how long watchers wait for a missing audit event ID to be committed before giving up on it.
+// IDs are handed out as events are recorded but transactions can commit in any order, so on Postgres a later event
+// can be visible before an earlier one. The ID of an event whose transaction rolled back is never filled in.
+const watchGapGracePeriod = 15 * time.Second
+
+// watchers keeps track of the calls watching each account so they can be woken up as soon as a change is made to it.
+type watchers struct {
+	mu       sync.Mutex
+	accounts map[string]map[chan struct{}]struct{}
+
+	// stopped is closed when the server shuts down; watching would otherwise hold up a graceful shutdown forever.
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+// add registers a new watcher of the account. The channel returned receives a value whenever the account may have
+// new events and must be removed once the watcher is done.
+func (w *watchers) add(account string) chan struct{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.accounts == nil {
+		w.accounts = map[string]map[chan struct{}]struct{}{}
+	}
+	if w.accounts[account] == nil {
+		w.accounts[account] = map[chan struct{}]struct{}{}
+	}
+
+	wake := make(chan struct{}, 1)
+	w.accounts[account][wake] = struct{}{}
+	return wake
+}
+
+// done returns a channel which is closed once the server has started shutting down.
+func (w *watchers) done() <-chan struct{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.stopped == nil {
+		w.stopped = make(chan struct{})
+	}
+
+	return w.stopped
+}
+
+// stop ends every watch; watchers are told the server is unavailable so they know to reconnect.
+func (w *watchers) stop() {
+	w.done()
+	w.stopOnce.Do(func() {
+		close(w.stopped)
+	})
+}
+
+func (w *watchers) remove(account string, wake chan struct{}) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.accounts[account], wake)
+	if len(w.accounts[account]) == 0 {
+		delete(w.accounts, account)
+	}
+}
+
+// notify wakes up every watcher of the account. Watchers already due to wake up aren't woken twice.
+func (w *watchers) notify(account string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for wake := range w.accounts[account] {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// contextChanged holds the accounts a call has recorded audit events for.
+var contextChanged = contextKey("changed")
+
+// changedAccounts collects the accounts changed by a single call.
+type changedAccounts struct {
+	mu       sync.Mutex
+	accounts map[string]struct{}
+}
+
+func (c *changedAccounts) add(account string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.accounts == nil {
+		c.accounts = map[string]struct{}{}
+	}
+	c.accounts[account] = struct{}{}
+}
+
+// markChanged notes that the call has changed the account, so that its watchers are woken once the call is done.
+func markChanged(ctx context.Context, account string) {
+	changed, ok := ctx.Value(contextChanged).(*changedAccounts)
+	if ok {
+		changed.add(account)
+	}
+}
+
+// notifyChanged wakes up the watchers of every account the call changed.
+func (api *API) notifyChanged(changed *changedAccounts) {
+	changed.mu.Lock()
+	defer changed.mu.Unlock()
+
+	for account := range changed.accounts {
+		api.watchers.notify(account)
+	}
+}
+
+// watchInterceptor wakes up the watchers of the accounts a successful call recorded audit events for; calls which
+// only read wake no one. Changes are only visible to watchers once the call's transaction has committed, so this has
+// to happen after the handler returns rather than when the change's audit event is recorded.
+func (api *API) watchInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	changed := &changedAccounts{}
+
+	resp, err := handler(context.WithValue(ctx, contextChanged, changed), req)
+	if err != nil {
+		return resp, err
+	}
+
+	api.notifyChanged(changed)
+	return resp, nil
+}
+
+// watchStreamInterceptor does the same as watchInterceptor for streaming calls, like imports.
+func (api *API) watchStreamInterceptor(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	changed := &changedAccounts{}
+
+	wrapped := grpc_middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = context.WithValue(stream.Context(), contextChanged, changed)
+
+	err := handler(srv, wrapped)
+	if err != nil {
+		return err
+	}
+
+	api.notifyChanged(changed)
+	return nil
+}
+
+// settledAuditEventID returns the highest audit event ID up to which every event is known to be committed, given the
+// IDs committed after the one given. A missing ID is waited on until the event after it is older than the grace
+// period; whatever held it has rolled back by then or is taken to have.
+func settledAuditEventID(after int64, committed []storage.AuditEvent, now time.Time) int64 {
+	settled := after
+	for _, event := range committed {
+		if event.ID != settled+1 && now.Sub(time.UnixMilli(event.Created)) < watchGapGracePeriod {
+			break
+		}
+		settled = event.ID
+	}
+
+	return settled
+}
+
+// WatchEvents sends every change made to the account's entities as it happens until the caller hangs up. Events come
+// from the audit log, so a watcher which reconnects can resume from the last event it saw without missing any.
+//
+// Events are sent in ID order and only once every event before them is committed, so that resuming after an ID never
+// skips an event committed late; see watchGapGracePeriod.
+func (api *API) WatchEvents(request *proto.WatchEventsRequest, stream proto.Basecoat_WatchEventsServer) error {
+	ctx := stream.Context()
+
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.After < 0 {
+		return status.Error(codes.InvalidArgument, "after must not be negative")
+	}
+
+	kinds := []string{}
+	for _, kind := range request.EntityKinds {
+		if kind == proto.EntityKind_ENTITY_KIND_UNKNOWN {
+			return status.Error(codes.InvalidArgument, "unknown entity kind")
+		}
+		kinds = append(kinds, kind.String())
+	}
+
+	// Watching starts before looking up where to start from so that no change made in between is missed.
+	wake := api.watchers.add(account)
+	defer api.watchers.remove(account, wake)
+
+	after := request.After
+	if after == 0 {
+		latest, err := api.db.GetLatestAuditEventID(api.db, account)
+		if err != nil {
+			log.Error().Err(err).Msg("could not retrieve latest audit event")
+			return status.Error(codes.Internal, "failed to retrieve events from database")
+		}
+		after = latest
+	}
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			committed, err := api.db.ListAuditEventIDsAfter(api.db, after, 0)
+			if err != nil {
+				log.Error().Err(err).Msg("could not retrieve audit events")
+				return status.Error(codes.Internal, "failed to retrieve events from database")
+			}
+
+			settled := settledAuditEventID(after, committed, time.Now())
+			if settled == after {
+				break
+			}
+
+			eventsRaw, err := api.db.ListAuditEventsAfter(api.db, account, after, kinds, len(committed))
+			if err != nil {
+				log.Error().Err(err).Msg("could not retrieve audit events")
+				return status.Error(codes.Internal, "failed to retrieve events from database")
+			}
+
+			for _, eventRaw := range eventsRaw {
+				if eventRaw.ID > settled {
+					break
+				}
+
+				var event models.AuditEvent
+				event.FromStorage(&eventRaw)
+
+				err = stream.Send(&proto.WatchEventsResponse{Event: event.ToEventProto()})
+				if err != nil {
+					return err
+				}
+			}
+
+			after = settled
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-api.watchers.done():
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
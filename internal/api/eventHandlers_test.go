@@ -0,0 +1,36 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/storage"
+)
+
+func TestSettledAuditEventID(t *testing.T) {
+	now := time.UnixMilli(100_000)
+	recent := now.Add(-time.Second).UnixMilli()
+	old := now.Add(-2 * watchGapGracePeriod).UnixMilli()
+
+	tests := map[string]struct {
+		after     int64
+		committed []storage.AuditEvent
+		want      int64
+	}{
+		"nothing new":     {after: 5, committed: nil, want: 5},
+		"no gaps":         {after: 5, committed: []storage.AuditEvent{{ID: 6, Created: recent}, {ID: 7, Created: recent}}, want: 7},
+		"recent gap":      {after: 5, committed: []storage.AuditEvent{{ID: 6, Created: recent}, {ID: 8, Created: recent}}, want: 6},
+		"gap at start":    {after: 5, committed: []storage.AuditEvent{{ID: 7, Created: recent}}, want: 5},
+		"old gap":         {after: 5, committed: []storage.AuditEvent{{ID: 7, Created: old}, {ID: 8, Created: recent}}, want: 8},
+		"old then recent": {after: 5, committed: []storage.AuditEvent{{ID: 7, Created: old}, {ID: 9, Created: recent}}, want: 7},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := settledAuditEventID(tc.after, tc.committed, now)
+			if got != tc.want {
+				t.Errorf("settledAuditEventID(%d, %v) = %d; want %d", tc.after, tc.committed, got, tc.want)
+			}
+		})
+	}
+}
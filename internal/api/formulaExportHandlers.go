@@ -0,0 +1,171 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/dispenser"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportError explains why a formula can't be written out for a dispenser.
+type exportError struct {
+	reason string
+}
+
+func (e *exportError) Error() string {
+	return e.reason
+}
+
+// formulaRecipe gathers a formula and its ingredients into a recipe for the container given. Colorants are written
+// using their dispenser codes and their amounts, which are kept for a gallon, are scaled to the container.
+func (api *API) formulaRecipe(tx *sqlx.Tx, account, id string, container dispenser.Container) (dispenser.Recipe, error) {
+	formula, err := api.db.GetFormula(tx, account, id)
+	if err != nil {
+		return dispenser.Recipe{}, err
+	}
+
+	recipe := dispenser.Recipe{
+		Name:      formula.Name,
+		Number:    formula.Number,
+		Notes:     formula.Notes,
+		Container: container,
+	}
+
+	formulaBases, err := api.db.ListFormulaBases(tx, account, id)
+	if err != nil {
+		return dispenser.Recipe{}, err
+	}
+
+	if len(formulaBases) > 1 {
+		return dispenser.Recipe{}, &exportError{"formula has more than one base; dispensers tint a single base"}
+	}
+
+	for _, formulaBase := range formulaBases {
+		base, err := api.db.GetBase(tx, account, formulaBase.Base)
+		if err != nil {
+			return dispenser.Recipe{}, err
+		}
+		recipe.Base = base.Label
+	}
+
+	formulaColorants, err := api.db.ListFormulaColorants(tx, account, id)
+	if err != nil {
+		return dispenser.Recipe{}, err
+	}
+
+	missingCodes := []string{}
+	for _, formulaColorant := range formulaColorants {
+		colorant, err := api.db.GetColorant(tx, account, formulaColorant.Colorant)
+		if err != nil {
+			return dispenser.Recipe{}, err
+		}
+
+		if colorant.DispenserCode == "" {
+			missingCodes = append(missingCodes, fmt.Sprintf("%s (%s)", colorant.Label, colorant.ID))
+			continue
+		}
+
+		amount, err := dispenser.ParseAmount(formulaColorant.Amount)
+		if err != nil {
+			return dispenser.Recipe{}, &exportError{fmt.Sprintf("colorant %s: %v", colorant.Label, err)}
+		}
+
+		recipe.Colorants = append(recipe.Colorants, dispenser.Dose{
+			Code:   colorant.DispenserCode,
+			Amount: amount.Scale(dispenser.Gallon, container),
+		})
+	}
+
+	if len(missingCodes) > 0 {
+		return dispenser.Recipe{}, &exportError{fmt.Sprintf("colorants have no dispenser code: %s",
+			strings.Join(missingCodes, ", "))}
+	}
+
+	return recipe, nil
+}
+
+// exportFileName suggests a file name for an exported formula; the formula's number if it has one, its ID otherwise.
+func exportFileName(recipe dispenser.Recipe, id, extension string) string {
+	name := strings.TrimSpace(recipe.Number)
+	if name == "" {
+		name = id
+	}
+
+	name = strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == ' ' {
+			return '_'
+		}
+		return r
+	}, name)
+
+	return name + "." + extension
+}
+
+// ExportFormula writes a formula as a file a tinting dispenser can load, scaled to the container size requested.
+func (api *API) ExportFormula(ctx context.Context, request *proto.ExportFormulaRequest) (*proto.ExportFormulaResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.ExportFormulaResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Id == "" {
+		return &proto.ExportFormulaResponse{}, status.Error(codes.FailedPrecondition, "formula id required")
+	}
+
+	format, err := dispenser.Get(request.Format)
+	if err != nil {
+		return &proto.ExportFormulaResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	container := dispenser.Gallon
+	if strings.TrimSpace(request.Container) != "" {
+		container, err = dispenser.ParseContainer(request.Container)
+		if err != nil {
+			return &proto.ExportFormulaResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+
+	var recipe dispenser.Recipe
+	err = api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		recipe, err = api.formulaRecipe(tx, account, request.Id, container)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.ExportFormulaResponse{}, status.Error(codes.NotFound, "formula requested not found")
+		}
+
+		var exportErr *exportError
+		if errors.As(err, &exportErr) {
+			return &proto.ExportFormulaResponse{}, status.Errorf(codes.FailedPrecondition,
+				"could not export formula; %v", exportErr)
+		}
+
+		log.Error().Err(err).Msg("could not export formula")
+		return &proto.ExportFormulaResponse{}, status.Error(codes.Internal, "could not export formula")
+	}
+
+	var content bytes.Buffer
+	err = format.Write(&content, []dispenser.Recipe{recipe})
+	if err != nil {
+		log.Error().Err(err).Msg("could not write formula export")
+		return &proto.ExportFormulaResponse{}, status.Error(codes.Internal, "could not export formula")
+	}
+
+	log.Debug().Str("id", request.Id).Str("format", format.Name()).Str("container", container.String()).
+		Msg("formula exported")
+
+	return &proto.ExportFormulaResponse{
+		Content:  content.Bytes(),
+		FileName: exportFileName(recipe, request.Id, format.Extension()),
+	}, nil
+}
@@ -2,6 +2,10 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/clintjedwards/basecoat/internal/models"
@@ -28,7 +32,7 @@ func (api *API) GetFormula(ctx context.Context, request *proto.GetFormulaRequest
 
 	formula := models.Formula{}
 
-	err := storage.InsideTx(api.db.DB, func(tx *sqlx.Tx) error {
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
 		// Metadata
 		formulaRaw, err := api.db.GetFormula(tx, account, request.Id)
 		if err != nil {
@@ -71,7 +75,7 @@ func (api *API) GetFormula(ctx context.Context, request *proto.GetFormulaRequest
 		// Jobs
 		jobSet := map[string]struct{}{}
 
-		formulaJobs, err := api.db.ListJobFormulas(tx, account, request.Id)
+		formulaJobs, err := api.db.ListFormulaJobs(tx, account, request.Id)
 		if err != nil {
 			return err
 		}
@@ -146,19 +150,63 @@ func (api *API) CreateFormula(ctx context.Context, request *proto.CreateFormulaR
 		return &proto.CreateFormulaResponse{}, status.Error(codes.FailedPrecondition, "formula name required")
 	}
 
+	err := validateIngredients("base", request.Bases)
+	if err != nil {
+		return &proto.CreateFormulaResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	err = validateIngredients("colorant", request.Colorants)
+	if err != nil {
+		return &proto.CreateFormulaResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	color, err := normalizeColor(request.Color)
+	if err != nil {
+		return &proto.CreateFormulaResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
 	formula := models.NewFormulaMetadata(account, request.Name)
 	formula.Number = request.Number
 	formula.Notes = request.Notes
+	formula.Sheen = strings.TrimSpace(request.Sheen)
+	formula.Color = color
+
+	err = api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		err := api.db.InsertFormula(tx, formula.ToStorage())
+		if err != nil {
+			return err
+		}
+
+		err = api.recordAuditEvent(ctx, tx, account, models.EntityKindFormula, formula.ID, nil, formula)
+		if err != nil {
+			return err
+		}
+
+		_, err = api.entityCode(tx, account, models.EntityKindFormula, formula.ID)
+		if err != nil {
+			return err
+		}
 
-	err := api.db.InsertFormula(api.db, formula.ToStorage())
+		err = api.setFormulaBases(ctx, tx, account, formula.ID, request.Bases, false)
+		if err != nil {
+			return err
+		}
+
+		return api.setFormulaColorants(ctx, tx, account, formula.ID, request.Colorants, false)
+	})
 	if err != nil {
-		if err == storage.ErrEntityExists {
+		if errors.Is(err, storage.ErrEntityExists) {
 			return &proto.CreateFormulaResponse{}, status.Error(codes.AlreadyExists, "could not save formula; formula already exists")
 		}
+		var notFound *ingredientNotFoundError
+		if errors.As(err, &notFound) {
+			return &proto.CreateFormulaResponse{}, status.Errorf(codes.FailedPrecondition, "could not save formula; %v", notFound)
+		}
 		log.Error().Err(err).Msg("could not save formula")
 		return &proto.CreateFormulaResponse{}, status.Error(codes.Internal, "could not save formula")
 	}
 
+	api.updateSearchIndex(ctx, models.EntityKindFormula, account, formula.ID, false)
 	log.Info().Str("id", formula.ID).Str("name", formula.Name).Msg("formula created")
 
 	return &proto.CreateFormulaResponse{
@@ -177,22 +225,97 @@ func (api *API) UpdateFormula(ctx context.Context, request *proto.UpdateFormulaR
 		return &proto.UpdateFormulaResponse{}, status.Error(codes.FailedPrecondition, "formula id required")
 	}
 
-	err := api.db.UpdateFormula(api.db, account, request.Id, storage.UpdatableFormulaFields{
-		Name:     &request.Name,
-		Number:   &request.Number,
-		Notes:    &request.Notes,
-		Modified: ptr(time.Now().UnixMilli()),
+	if request.Version == 0 {
+		return &proto.UpdateFormulaResponse{}, status.Error(codes.FailedPrecondition, "formula version required")
+	}
+
+	err := validateIngredients("base", request.Bases)
+	if err != nil {
+		return &proto.UpdateFormulaResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	err = validateIngredients("colorant", request.Colorants)
+	if err != nil {
+		return &proto.UpdateFormulaResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if request.Color != nil {
+		color, err := normalizeColor(*request.Color)
+		if err != nil {
+			return &proto.UpdateFormulaResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		request.Color = &color
+	}
+
+	if request.Sheen != nil {
+		request.Sheen = ptr(strings.TrimSpace(*request.Sheen))
+	}
+
+	var after models.FormulaMetadata
+	err = api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		formulaRaw, err := api.db.GetFormula(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.FormulaMetadata{}
+		before.FromStorage(&formulaRaw)
+
+		err = api.db.UpdateFormula(tx, account, request.Id, storage.UpdatableFormulaFields{
+			Version:  &request.Version,
+			Name:     request.Name,
+			Number:   request.Number,
+			Notes:    request.Notes,
+			Sheen:    request.Sheen,
+			Color:    request.Color,
+			Modified: ptr(time.Now().UnixMilli()),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = api.setFormulaBases(ctx, tx, account, request.Id, request.Bases, request.ReplaceBases)
+		if err != nil {
+			return err
+		}
+
+		err = api.setFormulaColorants(ctx, tx, account, request.Id, request.Colorants, request.ReplaceColorants)
+		if err != nil {
+			return err
+		}
+
+		formulaRaw, err = api.db.GetFormula(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		after = models.FormulaMetadata{}
+		after.FromStorage(&formulaRaw)
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindFormula, request.Id, before, after)
 	})
 	if err != nil {
-		if err == storage.ErrEntityExists {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.UpdateFormulaResponse{}, status.Error(codes.NotFound, "formula requested not found")
+		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return &proto.UpdateFormulaResponse{}, status.Error(codes.Aborted,
+				"formula was changed by someone else; fetch it again and retry")
+		}
+		if errors.Is(err, storage.ErrEntityExists) {
 			return &proto.UpdateFormulaResponse{}, status.Error(codes.AlreadyExists, "could not save formula; formula already exists")
 		}
+		var notFound *ingredientNotFoundError
+		if errors.As(err, &notFound) {
+			return &proto.UpdateFormulaResponse{}, status.Errorf(codes.FailedPrecondition, "could not save formula; %v", notFound)
+		}
 		log.Error().Err(err).Msg("could not save formula")
 		return &proto.UpdateFormulaResponse{}, status.Error(codes.Internal, "could not save formula")
 	}
 
+	api.updateSearchIndex(ctx, models.EntityKindFormula, account, request.Id, false)
 	log.Debug().Str("id", request.Id).Msg("formula updated")
-	return &proto.UpdateFormulaResponse{}, nil
+	return &proto.UpdateFormulaResponse{Formula: after.ToProto()}, nil
 }
 
 func (api *API) DeleteFormula(ctx context.Context, request *proto.DeleteFormulaRequest) (*proto.DeleteFormulaResponse, error) {
@@ -205,9 +328,24 @@ func (api *API) DeleteFormula(ctx context.Context, request *proto.DeleteFormulaR
 		return &proto.DeleteFormulaResponse{}, status.Error(codes.FailedPrecondition, "formula id required")
 	}
 
-	err := api.db.DeleteFormula(api.db, account, request.Id)
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		formulaRaw, err := api.db.GetFormula(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.FormulaMetadata{}
+		before.FromStorage(&formulaRaw)
+
+		err = api.db.SoftDelete(tx, string(models.EntityKindFormula), account, request.Id, time.Now().UnixMilli())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindFormula, request.Id, before, nil)
+	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return &proto.DeleteFormulaResponse{}, status.Error(codes.NotFound, "could not delete formula; formula key not found")
 		}
 
@@ -215,5 +353,256 @@ func (api *API) DeleteFormula(ctx context.Context, request *proto.DeleteFormulaR
 		return &proto.DeleteFormulaResponse{}, status.Error(codes.Internal, "could not delete formula")
 	}
 
+	api.updateSearchIndex(ctx, models.EntityKindFormula, account, request.Id, true)
 	return &proto.DeleteFormulaResponse{}, nil
 }
+
+// ingredientNotFoundError is returned from inside a formula transaction when a base or colorant given as an ingredient
+// does not exist in the account.
+type ingredientNotFoundError struct {
+	kind string
+	id   string
+}
+
+func (e *ingredientNotFoundError) Error() string {
+	return fmt.Sprintf("%s %q not found", e.kind, e.id)
+}
+
+// validateIngredients checks that a list of ingredients is well formed before anything is written. Whether the
+// ingredients exist is checked later inside the transaction.
+func validateIngredients(kind string, ingredients []*proto.FormulaIngredient) error {
+	seen := map[string]struct{}{}
+	for _, ingredient := range ingredients {
+		if ingredient.Id == "" {
+			return fmt.Errorf("%s id required", kind)
+		}
+
+		if ingredient.Amount == "" {
+			return fmt.Errorf("%s amount required for %q", kind, ingredient.Id)
+		}
+
+		if _, duplicate := seen[ingredient.Id]; duplicate {
+			return fmt.Errorf("%s %q listed more than once", kind, ingredient.Id)
+		}
+		seen[ingredient.Id] = struct{}{}
+	}
+
+	return nil
+}
+
+// normalizeColor checks that a formula's swatch color is a hex RGB value and writes it the same way every time; ex.
+// "4a5a6b" becomes "#4A5A6B". An empty color is left empty.
+func normalizeColor(color string) (string, error) {
+	color = strings.TrimPrefix(strings.TrimSpace(color), "#")
+	if color == "" {
+		return "", nil
+	}
+
+	if len(color) != 6 {
+		return "", fmt.Errorf("color %q must be a hex RGB value; ex. #4A5A6B", color)
+	}
+
+	if _, err := strconv.ParseUint(color, 16, 32); err != nil {
+		return "", fmt.Errorf("color %q must be a hex RGB value; ex. #4A5A6B", color)
+	}
+
+	return "#" + strings.ToUpper(color), nil
+}
+
+// mergeIngredients returns the amount of each ingredient a formula should end up with. Listed ingredients are added
+// to the current ones, overriding their amount, or replace them entirely if replace is set.
+func mergeIngredients(current map[string]string, listed []*proto.FormulaIngredient, replace bool) map[string]string {
+	desired := map[string]string{}
+	if !replace {
+		maps.Copy(desired, current)
+	}
+
+	for _, ingredient := range listed {
+		desired[ingredient.Id] = ingredient.Amount
+	}
+
+	return desired
+}
+
+// changeFormulaIngredients records that a formula's ingredients are about to change outside of UpdateFormula. The
+// formula's version is incremented so that clients holding the previous version find out its recipe changed, and
+// concurrent changes to the same formula are serialized. If expected isn't 0 the change is refused with
+// storage.ErrVersionMismatch unless the formula is still at that version. Returns the formula's new version.
+func (api *API) changeFormulaIngredients(tx *sqlx.Tx, account, formula string, expected int64) (int64, error) {
+	fields := storage.UpdatableFormulaFields{
+		Modified: ptr(time.Now().UnixMilli()),
+	}
+	if expected != 0 {
+		fields.Version = &expected
+	}
+
+	err := api.db.UpdateFormula(tx, account, formula, fields)
+	if err != nil {
+		return 0, err
+	}
+
+	formulaRaw, err := api.db.GetFormula(tx, account, formula)
+	if err != nil {
+		return 0, err
+	}
+
+	return formulaRaw.Version, nil
+}
+
+// setFormulaBases changes the bases of a formula to those given, recording an audit event for each base added,
+// removed or changed in amount.
+func (api *API) setFormulaBases(ctx context.Context, tx *sqlx.Tx, account, formula string,
+	bases []*proto.FormulaIngredient, replace bool,
+) error {
+	if len(bases) == 0 && !replace {
+		return nil
+	}
+
+	for _, base := range bases {
+		_, err := api.db.GetBase(tx, account, base.Id)
+		if err != nil {
+			if errors.Is(err, storage.ErrEntityNotFound) {
+				return &ingredientNotFoundError{kind: "base", id: base.Id}
+			}
+			return err
+		}
+	}
+
+	formulaBasesRaw, err := api.db.ListFormulaBases(tx, account, formula)
+	if err != nil {
+		return err
+	}
+
+	current := map[string]string{}
+	for _, formulaBase := range formulaBasesRaw {
+		current[formulaBase.Base] = formulaBase.Amount
+	}
+
+	desired := mergeIngredients(current, bases, replace)
+
+	ids := maps.Keys(current)
+	for id := range desired {
+		if _, present := current[id]; !present {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		currentAmount, wasPresent := current[id]
+		desiredAmount, isPresent := desired[id]
+
+		if wasPresent && isPresent && currentAmount == desiredAmount {
+			continue
+		}
+
+		var before, after any
+
+		if wasPresent {
+			before = models.NewFormulaBase(formula, id, currentAmount)
+			err := api.db.DeleteFormulaBase(tx, account, formula, id)
+			if err != nil {
+				return err
+			}
+		}
+
+		if isPresent {
+			after = models.NewFormulaBase(formula, id, desiredAmount)
+			err := api.db.AssociateBaseWithFormula(tx, &storage.FormulaBase{
+				Account: account,
+				Formula: formula,
+				Base:    id,
+				Amount:  desiredAmount,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err := api.recordAuditEvent(ctx, tx, account, models.EntityKindFormula, formula, before, after)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setFormulaColorants changes the colorants of a formula to those given, recording an audit event for each colorant
+// added, removed or changed in amount.
+func (api *API) setFormulaColorants(ctx context.Context, tx *sqlx.Tx, account, formula string,
+	colorants []*proto.FormulaIngredient, replace bool,
+) error {
+	if len(colorants) == 0 && !replace {
+		return nil
+	}
+
+	for _, colorant := range colorants {
+		_, err := api.db.GetColorant(tx, account, colorant.Id)
+		if err != nil {
+			if errors.Is(err, storage.ErrEntityNotFound) {
+				return &ingredientNotFoundError{kind: "colorant", id: colorant.Id}
+			}
+			return err
+		}
+	}
+
+	formulaColorantsRaw, err := api.db.ListFormulaColorants(tx, account, formula)
+	if err != nil {
+		return err
+	}
+
+	current := map[string]string{}
+	for _, formulaColorant := range formulaColorantsRaw {
+		current[formulaColorant.Colorant] = formulaColorant.Amount
+	}
+
+	desired := mergeIngredients(current, colorants, replace)
+
+	ids := maps.Keys(current)
+	for id := range desired {
+		if _, present := current[id]; !present {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		currentAmount, wasPresent := current[id]
+		desiredAmount, isPresent := desired[id]
+
+		if wasPresent && isPresent && currentAmount == desiredAmount {
+			continue
+		}
+
+		var before, after any
+
+		if wasPresent {
+			before = models.NewFormulaColorant(formula, id, currentAmount)
+			err := api.db.DeleteFormulaColorant(tx, account, formula, id)
+			if err != nil {
+				return err
+			}
+		}
+
+		if isPresent {
+			after = models.NewFormulaColorant(formula, id, desiredAmount)
+			err := api.db.AssociateColorantWithFormula(tx, &storage.FormulaColorant{
+				Account:  account,
+				Formula:  formula,
+				Colorant: id,
+				Amount:   desiredAmount,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err := api.recordAuditEvent(ctx, tx, account, models.EntityKindFormula, formula, before, after)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIngredientChangesBumpFormulaVersion(t *testing.T) {
+	type change func(api *API, ctx context.Context, version int64) (int64, error)
+
+	tests := map[string]struct {
+		insert       func(db storage.DB) error
+		associate    change
+		disassociate change
+	}{
+		"base": {
+			insert: func(db storage.DB) error {
+				return db.InsertBase(db, &storage.Base{Account: "test_account", ID: "test_base"})
+			},
+			associate: func(api *API, ctx context.Context, version int64) (int64, error) {
+				resp, err := api.AssociateBaseWithFormula(ctx, &proto.AssociateBaseWithFormulaRequest{
+					Formula: "test_formula", Base: "test_base", Amount: "1 gal", Version: version,
+				})
+				return resp.Version, err
+			},
+			disassociate: func(api *API, ctx context.Context, version int64) (int64, error) {
+				resp, err := api.DisassociateBaseFromFormula(ctx, &proto.DisassociateBaseFromFormulaRequest{
+					Formula: "test_formula", Base: "test_base", Version: version,
+				})
+				return resp.Version, err
+			},
+		},
+		"colorant": {
+			insert: func(db storage.DB) error {
+				return db.InsertColorant(db, &storage.Colorant{Account: "test_account", ID: "test_colorant"})
+			},
+			associate: func(api *API, ctx context.Context, version int64) (int64, error) {
+				resp, err := api.AssociateColorantWithFormula(ctx, &proto.AssociateColorantWithFormulaRequest{
+					Formula: "test_formula", Colorant: "test_colorant", Amount: "2Y24", Version: version,
+				})
+				return resp.Version, err
+			},
+			disassociate: func(api *API, ctx context.Context, version int64) (int64, error) {
+				resp, err := api.DisassociateColorantFromFormula(ctx, &proto.DisassociateColorantFromFormulaRequest{
+					Formula: "test_formula", Colorant: "test_colorant", Version: version,
+				})
+				return resp.Version, err
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db := newTestDB(t)
+			api := &API{db: db}
+			ctx := context.WithValue(context.Background(), contextAccount, "test_account")
+
+			err := db.InsertFormula(db, &storage.Formula{Account: "test_account", ID: "test_formula", Version: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = tc.insert(db)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			version, err := tc.associate(api, ctx, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if version != 2 {
+				t.Errorf("expected adding a %s to change the formula to version 2; found %d", name, version)
+			}
+
+			// A client still holding the version before the recipe changed is refused.
+			_, err = api.UpdateFormula(ctx, &proto.UpdateFormulaRequest{Id: "test_formula", Name: ptr("Sea Salt"), Version: 1})
+			if status.Code(err) != codes.Aborted {
+				t.Errorf("expected update of the previous version to be aborted; found %v", err)
+			}
+
+			_, err = tc.disassociate(api, ctx, 1)
+			if status.Code(err) != codes.Aborted {
+				t.Errorf("expected removing a %s from the previous version to be aborted; found %v", name, err)
+			}
+
+			// Without a version the change is made against whatever the formula is at.
+			version, err = tc.disassociate(api, ctx, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if version != 3 {
+				t.Errorf("expected removing a %s to change the formula to version 3; found %d", name, version)
+			}
+
+			// Removing it again changes nothing, so neither does the version.
+			version, err = tc.disassociate(api, ctx, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if version != 3 {
+				t.Errorf("expected formula to stay at version 3; found %d", version)
+			}
+
+			_, err = api.AssociateBaseWithFormula(ctx, &proto.AssociateBaseWithFormulaRequest{
+				Formula: "missing_formula", Base: "test_base", Amount: "1 gal",
+			})
+			if status.Code(err) != codes.NotFound {
+				t.Errorf("expected missing formula to be not found; found %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errDryRun is returned from inside an import's transaction to roll back a dry run once its report is complete.
+var errDryRun = errors.New("dry run")
+
+// ingredientKey identifies a base or colorant by what a legacy formula book calls it rather than by ID.
+func ingredientKey(manufacturer, label string) string {
+	return strings.ToLower(strings.TrimSpace(manufacturer)) + "\x00" + strings.ToLower(strings.TrimSpace(label))
+}
+
+// formulaImporter turns records read from a legacy formula book into formulas, creating the bases and colorants they
+// need along the way.
+type formulaImporter struct {
+	api     *API
+	ctx     context.Context
+	account string
+
+	bases     map[string]string   // Base IDs by ingredient key.
+	colorants map[string]string   // Colorant IDs by ingredient key.
+	numbers   map[string]struct{} // Formula numbers already in use.
+
+	result *proto.ImportFormulasResponse
+}
+
+func (api *API) newFormulaImporter(ctx context.Context, tx *sqlx.Tx, account string) (*formulaImporter, error) {
+	importer := &formulaImporter{
+		api:       api,
+		ctx:       ctx,
+		account:   account,
+		bases:     map[string]string{},
+		colorants: map[string]string{},
+		numbers:   map[string]struct{}{},
+		result:    &proto.ImportFormulasResponse{},
+	}
+
+	basesRaw, err := listAll(func(offset int) ([]storage.Base, error) {
+		return api.db.ListBases(tx, account, offset, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, base := range basesRaw {
+		importer.bases[ingredientKey(base.Manufacturer, base.Label)] = base.ID
+	}
+
+	colorantsRaw, err := listAll(func(offset int) ([]storage.Colorant, error) {
+		return api.db.ListColorants(tx, account, offset, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, colorant := range colorantsRaw {
+		importer.colorants[ingredientKey(colorant.Manufacturer, colorant.Label)] = colorant.ID
+	}
+
+	formulasRaw, err := listAll(func(offset int) ([]storage.Formula, error) {
+		return api.db.ListFormulas(tx, account, offset, 0)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, formula := range formulasRaw {
+		if formula.Number != "" {
+			importer.numbers[formula.Number] = struct{}{}
+		}
+	}
+
+	return importer, nil
+}
+
+func (i *formulaImporter) skip(record *proto.ImportFormulaRecord, reason string) {
+	i.result.Skipped = append(i.result.Skipped, &proto.ImportSkippedRecord{
+		Row:    record.Row,
+		Number: record.Number,
+		Reason: reason,
+	})
+}
+
+// checkIngredients returns why a record's ingredients can't be imported or an empty string if they can.
+func checkIngredients(kind string, ingredients []*proto.ImportIngredient) string {
+	seen := map[string]struct{}{}
+	for _, ingredient := range ingredients {
+		if strings.TrimSpace(ingredient.Label) == "" {
+			return fmt.Sprintf("%s label missing", kind)
+		}
+
+		if strings.TrimSpace(ingredient.Manufacturer) == "" {
+			return fmt.Sprintf("manufacturer missing for %s %q", kind, ingredient.Label)
+		}
+
+		if strings.TrimSpace(ingredient.Amount) == "" {
+			return fmt.Sprintf("amount missing for %s %q", kind, ingredient.Label)
+		}
+
+		key := ingredientKey(ingredient.Manufacturer, ingredient.Label)
+		if _, duplicate := seen[key]; duplicate {
+			return fmt.Sprintf("%s %q listed more than once", kind, ingredient.Label)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return ""
+}
+
+// importRecord creates the formula described by a single record. Records which can't be imported are added to the
+// report as skipped rather than failing the import.
+func (i *formulaImporter) importRecord(tx *sqlx.Tx, record *proto.ImportFormulaRecord) error {
+	name := strings.TrimSpace(record.Name)
+	number := strings.TrimSpace(record.Number)
+
+	if name == "" {
+		i.skip(record, "formula name missing")
+		return nil
+	}
+
+	if number != "" {
+		if _, duplicate := i.numbers[number]; duplicate {
+			i.skip(record, fmt.Sprintf("formula number %q already exists", number))
+			return nil
+		}
+	}
+
+	if reason := checkIngredients("base", record.Bases); reason != "" {
+		i.skip(record, reason)
+		return nil
+	}
+
+	if reason := checkIngredients("colorant", record.Colorants); reason != "" {
+		i.skip(record, reason)
+		return nil
+	}
+
+	bases := []*proto.FormulaIngredient{}
+	for _, ingredient := range record.Bases {
+		id, err := i.base(tx, ingredient)
+		if err != nil {
+			return err
+		}
+		bases = append(bases, &proto.FormulaIngredient{Id: id, Amount: strings.TrimSpace(ingredient.Amount)})
+	}
+
+	colorants := []*proto.FormulaIngredient{}
+	for _, ingredient := range record.Colorants {
+		id, err := i.colorant(tx, ingredient)
+		if err != nil {
+			return err
+		}
+		colorants = append(colorants, &proto.FormulaIngredient{Id: id, Amount: strings.TrimSpace(ingredient.Amount)})
+	}
+
+	formula := models.NewFormulaMetadata(i.account, name)
+	formula.Number = number
+	formula.Notes = strings.TrimSpace(record.Notes)
+
+	err := i.api.db.InsertFormula(tx, formula.ToStorage())
+	if err != nil {
+		return err
+	}
+
+	err = i.api.recordAuditEvent(i.ctx, tx, i.account, models.EntityKindFormula, formula.ID, nil, formula)
+	if err != nil {
+		return err
+	}
+
+	_, err = i.api.entityCode(tx, i.account, models.EntityKindFormula, formula.ID)
+	if err != nil {
+		return err
+	}
+
+	err = i.api.setFormulaBases(i.ctx, tx, i.account, formula.ID, bases, false)
+	if err != nil {
+		return err
+	}
+
+	err = i.api.setFormulaColorants(i.ctx, tx, i.account, formula.ID, colorants, false)
+	if err != nil {
+		return err
+	}
+
+	if number != "" {
+		i.numbers[number] = struct{}{}
+	}
+	i.result.FormulasCreated = append(i.result.FormulasCreated, formula.ToProto())
+
+	return nil
+}
+
+// base returns the ID of the base an ingredient refers to, creating the base if the account doesn't have it yet.
+func (i *formulaImporter) base(tx *sqlx.Tx, ingredient *proto.ImportIngredient) (string, error) {
+	key := ingredientKey(ingredient.Manufacturer, ingredient.Label)
+	if id, present := i.bases[key]; present {
+		return id, nil
+	}
+
+	base := models.NewBaseMetadata(i.account, strings.TrimSpace(ingredient.Label), strings.TrimSpace(ingredient.Manufacturer))
+
+	err := i.api.db.InsertBase(tx, base.ToStorage())
+	if err != nil {
+		return "", err
+	}
+
+	err = i.api.recordAuditEvent(i.ctx, tx, i.account, models.EntityKindBase, base.ID, nil, base)
+	if err != nil {
+		return "", err
+	}
+
+	i.bases[key] = base.ID
+	i.result.BasesCreated = append(i.result.BasesCreated, base.ToProto())
+
+	return base.ID, nil
+}
+
+// colorant returns the ID of the colorant an ingredient refers to, creating the colorant if the account doesn't have
+// it yet.
+func (i *formulaImporter) colorant(tx *sqlx.Tx, ingredient *proto.ImportIngredient) (string, error) {
+	key := ingredientKey(ingredient.Manufacturer, ingredient.Label)
+	if id, present := i.colorants[key]; present {
+		return id, nil
+	}
+
+	colorant := models.NewColorantMetadata(i.account, strings.TrimSpace(ingredient.Label),
+		strings.TrimSpace(ingredient.Manufacturer))
+
+	err := i.api.db.InsertColorant(tx, colorant.ToStorage())
+	if err != nil {
+		return "", err
+	}
+
+	err = i.api.recordAuditEvent(i.ctx, tx, i.account, models.EntityKindColorant, colorant.ID, nil, colorant)
+	if err != nil {
+		return "", err
+	}
+
+	i.colorants[key] = colorant.ID
+	i.result.ColorantsCreated = append(i.result.ColorantsCreated, colorant.ToProto())
+
+	return colorant.ID, nil
+}
+
+// ImportFormulas creates formulas from records read out of a legacy formula book, such as a spreadsheet exported from
+// a tinting machine. The whole import is applied inside a single transaction.
+//
+// Bases and colorants are matched to those already in the account by manufacturer and label; any which are missing
+// are created. Formulas whose number is already in use, either by an existing formula or an earlier record, are
+// skipped as duplicates. A dry run reports exactly what the import would do without saving anything.
+func (api *API) ImportFormulas(stream proto.Basecoat_ImportFormulasServer) error {
+	ctx := stream.Context()
+
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	var dryRun bool
+	records := []*proto.ImportFormulaRecord{}
+
+	for first := true; ; first = false {
+		request, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if first {
+			dryRun = request.DryRun
+		}
+
+		if request.Record == nil {
+			continue
+		}
+
+		records = append(records, request.Record)
+	}
+
+	if len(records) == 0 {
+		return status.Error(codes.FailedPrecondition, "formula records required")
+	}
+
+	var result *proto.ImportFormulasResponse
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		importer, err := api.newFormulaImporter(ctx, tx, account)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			err := importer.importRecord(tx, record)
+			if err != nil {
+				return err
+			}
+		}
+
+		result = importer.result
+
+		if dryRun {
+			return errDryRun
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		log.Error().Err(err).Msg("could not import formulas")
+		return status.Error(codes.Internal, "could not import formulas")
+	}
+
+	result.Committed = !dryRun
+
+	if result.Committed {
+		for _, formula := range result.FormulasCreated {
+			api.indexEntity(models.EntityKindFormula, account, formula.Id, false)
+		}
+	}
+
+	log.Info().Str("account", account).Bool("dry_run", dryRun).Int("formulas", len(result.FormulasCreated)).
+		Int("bases", len(result.BasesCreated)).Int("colorants", len(result.ColorantsCreated)).
+		Int("skipped", len(result.Skipped)).Msg("formulas imported")
+
+	return stream.SendAndClose(result)
+}
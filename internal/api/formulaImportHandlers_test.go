@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/clintjedwards/basecoat/internal/search"
+	"github.com/clintjedwards/basecoat/proto"
+	"google.golang.org/grpc"
+)
+
+// fakeImportFormulasStream sends the requests given and keeps the response.
+type fakeImportFormulasStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*proto.ImportFormulasRequest
+	response *proto.ImportFormulasResponse
+}
+
+func (f *fakeImportFormulasStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeImportFormulasStream) Recv() (*proto.ImportFormulasRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	request := f.requests[0]
+	f.requests = f.requests[1:]
+	return request, nil
+}
+
+func (f *fakeImportFormulasStream) SendAndClose(response *proto.ImportFormulasResponse) error {
+	f.response = response
+	return nil
+}
+
+func TestImportFormulasIndexesSearch(t *testing.T) {
+	tests := map[string]struct {
+		dryRun  bool
+		matches int
+	}{
+		"committed": {matches: 1},
+		"dry run":   {dryRun: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db := newTestDB(t)
+
+			searchIndex, err := search.InitSearch(db)
+			if err != nil {
+				t.Fatal(err)
+			}
+			api := &API{db: db, search: searchIndex}
+
+			stream := &fakeImportFormulasStream{
+				ctx: context.WithValue(context.Background(), contextAccount, "test_account"),
+				requests: []*proto.ImportFormulasRequest{{
+					DryRun: tc.dryRun,
+					Record: &proto.ImportFormulaRecord{Row: 2, Name: "Hale Navy", Number: "HC-154"},
+				}},
+			}
+
+			err = api.ImportFormulas(stream)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(stream.response.FormulasCreated) != 1 {
+				t.Fatalf("expected 1 formula to be imported; got %v", stream.response)
+			}
+
+			// An account with nothing indexed has no index to search at all.
+			matches, _ := searchIndex.SearchFormulas("test_account", "navy")
+			if len(matches) != tc.matches {
+				t.Errorf("expected %d formulas found searching for the imported formula; found %v", tc.matches, matches)
+			}
+		})
+	}
+}
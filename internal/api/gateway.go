@@ -0,0 +1,631 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// gatewayPrefix is the path the JSON gateway is served under.
+const gatewayPrefix = "/api/v1"
+
+// maxGatewayRequestSize caps the body of unary calls made through the gateway; the same as the gRPC server's limit.
+const maxGatewayRequestSize = 4 << 20
+
+// gatewayHeaders are the HTTP headers passed on to calls made through the gateway as gRPC metadata.
+var gatewayHeaders = []string{"authorization", idempotencyKeyHeader}
+
+// gatewayRoute maps an RPC onto a REST route of the JSON gateway.
+//
+// Path parameters are named after the request field they set. GET and DELETE requests set the remaining fields from
+// the query string; other requests from a JSON body of the request message. Streamed requests and responses are
+// newline delimited JSON; a document per message.
+type gatewayRoute struct {
+	rpc    string // The name of the RPC; ex. "GetFormula".
+	method string // The HTTP method.
+	path   string // Relative to gatewayPrefix; ex. "/formulas/{id}".
+}
+
+// gatewayRoutes is every route of the JSON gateway. Every RPC must have a route; the server refuses to start otherwise.
+var gatewayRoutes = []gatewayRoute{
+	{"CreateAPIToken", http.MethodPost, "/tokens"},
+	{"GetSystemInfo", http.MethodGet, "/system"},
+
+	{"GetAccount", http.MethodGet, "/accounts/{id}"},
+	{"ListAccounts", http.MethodGet, "/accounts"},
+	{"CreateAccount", http.MethodPost, "/accounts"},
+	{"UpdateAccount", http.MethodPatch, "/accounts/{id}"},
+	{"ToggleAccountState", http.MethodPost, "/accounts/{id}/toggle-state"},
+	{"ExportAccount", http.MethodGet, "/accounts/{id}/export"},
+	{"ImportAccount", http.MethodPost, "/accounts/import"},
+
+	{"GetFormula", http.MethodGet, "/formulas/{id}"},
+	{"ListFormulas", http.MethodGet, "/formulas"},
+	{"CreateFormula", http.MethodPost, "/formulas"},
+	{"AssociateFormulaWithJob", http.MethodPut, "/jobs/{job}/formulas/{formula}"},
+	{"DisassociateFormulaFromJob", http.MethodDelete, "/jobs/{job}/formulas/{formula}"},
+	{"UpdateFormula", http.MethodPatch, "/formulas/{id}"},
+	{"DeleteFormula", http.MethodDelete, "/formulas/{id}"},
+	{"ImportFormulas", http.MethodPost, "/formulas/import"},
+	{"ExportFormula", http.MethodGet, "/formulas/{id}/export"},
+	{"RenderLabel", http.MethodGet, "/formulas/{id}/label"},
+	{"GetFormulaRecipe", http.MethodGet, "/formulas/{id}/recipe"},
+
+	{"RecordMix", http.MethodPost, "/mixes"},
+	{"GetMix", http.MethodGet, "/mixes/{id}"},
+	{"ListMixes", http.MethodGet, "/mixes"},
+
+	{"GetCode", http.MethodGet, "/codes/{kind}/{id}"},
+	{"ResolveCode", http.MethodGet, "/codes/{code}"},
+
+	{"GetBase", http.MethodGet, "/bases/{id}"},
+	{"ListBases", http.MethodGet, "/bases"},
+	{"CreateBase", http.MethodPost, "/bases"},
+	{"AssociateBaseWithFormula", http.MethodPut, "/formulas/{formula}/bases/{base}"},
+	{"DisassociateBaseFromFormula", http.MethodDelete, "/formulas/{formula}/bases/{base}"},
+	{"UpdateBase", http.MethodPatch, "/bases/{id}"},
+	{"DeleteBase", http.MethodDelete, "/bases/{id}"},
+
+	{"GetColorant", http.MethodGet, "/colorants/{id}"},
+	{"ListColorants", http.MethodGet, "/colorants"},
+	{"CreateColorant", http.MethodPost, "/colorants"},
+	{"AssociateColorantWithFormula", http.MethodPut, "/formulas/{formula}/colorants/{colorant}"},
+	{"DisassociateColorantFromFormula", http.MethodDelete, "/formulas/{formula}/colorants/{colorant}"},
+	{"UpdateColorant", http.MethodPatch, "/colorants/{id}"},
+	{"DeleteColorant", http.MethodDelete, "/colorants/{id}"},
+
+	{"GetContact", http.MethodGet, "/contacts/{id}"},
+	{"ListContacts", http.MethodGet, "/contacts"},
+	{"CreateContact", http.MethodPost, "/contacts"},
+	{"UpdateContact", http.MethodPatch, "/contacts/{id}"},
+	{"DeleteContact", http.MethodDelete, "/contacts/{id}"},
+
+	{"GetContractor", http.MethodGet, "/contractors/{id}"},
+	{"ListContractors", http.MethodGet, "/contractors"},
+	{"CreateContractor", http.MethodPost, "/contractors"},
+	{"UpdateContractor", http.MethodPatch, "/contractors/{id}"},
+	{"DeleteContractor", http.MethodDelete, "/contractors/{id}"},
+
+	{"GetJob", http.MethodGet, "/jobs/{id}"},
+	{"ListJobs", http.MethodGet, "/jobs"},
+	{"CreateJob", http.MethodPost, "/jobs"},
+	{"UpdateJob", http.MethodPatch, "/jobs/{id}"},
+	{"DeleteJob", http.MethodDelete, "/jobs/{id}"},
+	{"GenerateJobReport", http.MethodGet, "/jobs/{id}/report"},
+
+	{"ListDeleted", http.MethodGet, "/trash"},
+	{"Undelete", http.MethodPost, "/trash/{kind}/{id}/restore"},
+
+	{"ListAuditEvents", http.MethodGet, "/audit-events"},
+
+	{"WatchEvents", http.MethodGet, "/events"},
+
+	{"GetWebhook", http.MethodGet, "/webhooks/{id}"},
+	{"ListWebhooks", http.MethodGet, "/webhooks"},
+	{"CreateWebhook", http.MethodPost, "/webhooks"},
+	{"UpdateWebhook", http.MethodPatch, "/webhooks/{id}"},
+	{"DeleteWebhook", http.MethodDelete, "/webhooks/{id}"},
+	{"ListWebhookDeliveries", http.MethodGet, "/webhooks/{webhook}/deliveries"},
+	{"TestWebhook", http.MethodPost, "/webhooks/{id}/test"},
+
+	{"BatchCreate", http.MethodPost, "/batch/create"},
+	{"BatchUpdate", http.MethodPost, "/batch/update"},
+	{"BatchDelete", http.MethodPost, "/batch/delete"},
+}
+
+var gatewayPathParameter = regexp.MustCompile(`\{(\w+)\}`)
+
+// pathParameters returns the names of the route's path parameters in the order they appear.
+func (route gatewayRoute) pathParameters() []string {
+	parameters := []string{}
+	for _, match := range gatewayPathParameter.FindAllStringSubmatch(route.path, -1) {
+		parameters = append(parameters, match[1])
+	}
+
+	return parameters
+}
+
+// hasBody returns true if the route's request fields are sent as a JSON body instead of in the query string.
+func (route gatewayRoute) hasBody() bool {
+	return route.method != http.MethodGet && route.method != http.MethodDelete
+}
+
+// gatewayError is the body of every failed response from the gateway. Code and status are the gRPC status of the error,
+// ex. 5 and "NotFound".
+type gatewayError struct {
+	Code    codes.Code `json:"code"`
+	Status  string     `json:"status"`
+	Message string     `json:"message"`
+}
+
+func newGatewayError(err error) gatewayError {
+	s := status.Convert(err)
+	return gatewayError{
+		Code:    s.Code(),
+		Status:  s.Code().String(),
+		Message: s.Message(),
+	}
+}
+
+// encodeGatewayResponse writes a response with the proto3 JSON mapping; fields named as in the proto and always present.
+func encodeGatewayResponse(response protobuf.Message) ([]byte, error) {
+	body, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+
+	// protojson varies its whitespace on purpose; compacting it keeps responses the same from one call to the next.
+	var compacted bytes.Buffer
+	err = json.Compact(&compacted, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return compacted.Bytes(), nil
+}
+
+// gateway serves every RPC as JSON over plain HTTP. Calls go through the same interceptors as the gRPC server's, so
+// they're authenticated, honor idempotency keys and wake watchers the same way.
+type gateway struct {
+	api     *API
+	unary   grpc.UnaryServerInterceptor
+	stream  grpc.StreamServerInterceptor
+	methods map[string]grpc.MethodDesc
+	streams map[string]grpc.StreamDesc
+	openAPI []byte
+}
+
+func newGateway(api *API) (*gateway, error) {
+	g := &gateway{
+		api:     api,
+		unary:   api.unaryInterceptor(),
+		stream:  api.streamInterceptor(),
+		methods: map[string]grpc.MethodDesc{},
+		streams: map[string]grpc.StreamDesc{},
+	}
+
+	for _, method := range proto.Basecoat_ServiceDesc.Methods {
+		g.methods[method.MethodName] = method
+	}
+
+	for _, stream := range proto.Basecoat_ServiceDesc.Streams {
+		g.streams[stream.StreamName] = stream
+	}
+
+	service := proto.File_basecoat_proto.Services().ByName(protoreflect.Name("Basecoat"))
+
+	routed := map[string]bool{}
+	for _, route := range gatewayRoutes {
+		method := service.Methods().ByName(protoreflect.Name(route.rpc))
+		if method == nil {
+			return nil, fmt.Errorf("gateway route %s %s is for unknown rpc %q", route.method, route.path, route.rpc)
+		}
+
+		for _, parameter := range route.pathParameters() {
+			if method.Input().Fields().ByName(protoreflect.Name(parameter)) == nil {
+				return nil, fmt.Errorf("gateway route %s %s has path parameter %q which isn't a field of %s",
+					route.method, route.path, parameter, method.Input().Name())
+			}
+		}
+
+		routed[route.rpc] = true
+	}
+
+	for name := range g.methods {
+		if !routed[name] {
+			return nil, fmt.Errorf("rpc %q has no gateway route", name)
+		}
+	}
+
+	for name := range g.streams {
+		if !routed[name] {
+			return nil, fmt.Errorf("rpc %q has no gateway route", name)
+		}
+	}
+
+	document, err := json.Marshal(newOpenAPIDocument(gatewayRoutes))
+	if err != nil {
+		return nil, fmt.Errorf("could not generate openapi document: %w", err)
+	}
+	g.openAPI = document
+
+	return g, nil
+}
+
+// registerRoutes registers every route of the gateway and the OpenAPI document describing them.
+func (g *gateway) registerRoutes(router *mux.Router) {
+	router.HandleFunc(gatewayPrefix+"/openapi.json", g.handleOpenAPI).Methods(http.MethodGet)
+
+	for _, route := range gatewayRoutes {
+		route := route
+		router.HandleFunc(gatewayPrefix+route.path, func(w http.ResponseWriter, r *http.Request) {
+			g.serve(w, r, route)
+		}).Methods(route.method)
+	}
+
+	// Anything else under the prefix would otherwise be caught by the frontend.
+	router.PathPrefix(gatewayPrefix + "/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeGatewayError(w, status.Errorf(codes.NotFound, "no route for %s %s", r.Method, r.URL.Path))
+	})
+}
+
+func (g *gateway) handleOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(g.openAPI)
+}
+
+func (g *gateway) serve(w http.ResponseWriter, r *http.Request, route gatewayRoute) {
+	fullMethod := "/" + proto.Basecoat_ServiceDesc.ServiceName + "/" + route.rpc
+	ctx := gatewayContext(r, fullMethod)
+
+	if method, present := g.methods[route.rpc]; present {
+		g.serveUnary(ctx, w, r, method)
+		return
+	}
+
+	g.serveStream(ctx, w, r, g.streams[route.rpc], fullMethod)
+}
+
+func (g *gateway) serveUnary(ctx context.Context, w http.ResponseWriter, r *http.Request, method grpc.MethodDesc) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxGatewayRequestSize)
+
+	decode := func(request interface{}) error {
+		return decodeGatewayRequest(r, request.(protobuf.Message))
+	}
+
+	response, err := method.Handler(g.api, ctx, decode, g.unary)
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+
+	body, err := encodeGatewayResponse(response.(protobuf.Message))
+	if err != nil {
+		log.Error().Err(err).Str("rpc", method.MethodName).Msg("could not encode gateway response")
+		writeGatewayError(w, status.Error(codes.Internal, "could not encode response"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+func (g *gateway) serveStream(ctx context.Context, w http.ResponseWriter, r *http.Request, desc grpc.StreamDesc,
+	fullMethod string,
+) {
+	stream := &gatewayStream{
+		ctx:     ctx,
+		w:       w,
+		r:       r,
+		desc:    desc,
+		decoder: json.NewDecoder(r.Body),
+	}
+
+	err := g.stream(g.api, stream, &grpc.StreamServerInfo{
+		FullMethod:     fullMethod,
+		IsClientStream: desc.ClientStreams,
+		IsServerStream: desc.ServerStreams,
+	}, desc.Handler)
+	if err != nil {
+		if !stream.sent {
+			writeGatewayError(w, err)
+			return
+		}
+
+		// The status has already been sent; the error ends the stream instead.
+		line, _ := json.Marshal(struct {
+			Error gatewayError `json:"error"`
+		}{newGatewayError(err)})
+		_, _ = w.Write(append(line, '\n'))
+		return
+	}
+
+	if !stream.sent {
+		stream.writeHeader()
+	}
+}
+
+// gatewayContext gives a call made through the gateway the context it would have had if made to the gRPC server.
+func gatewayContext(r *http.Request, fullMethod string) context.Context {
+	md := metadata.MD{}
+	for _, header := range gatewayHeaders {
+		if value := r.Header.Get(header); value != "" {
+			md.Set(header, value)
+		}
+	}
+
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	ctx = grpc.NewContextWithServerTransportStream(ctx, &gatewayTransportStream{method: fullMethod})
+
+	addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr)
+	if err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+
+	return ctx
+}
+
+// gatewayTransportStream lets grpc.Method report the RPC being called through the gateway. Headers and trailers set
+// by calls are dropped; none of them set any.
+type gatewayTransportStream struct {
+	method string
+}
+
+func (s *gatewayTransportStream) Method() string                 { return s.method }
+func (s *gatewayTransportStream) SetHeader(_ metadata.MD) error  { return nil }
+func (s *gatewayTransportStream) SendHeader(_ metadata.MD) error { return nil }
+func (s *gatewayTransportStream) SetTrailer(_ metadata.MD) error { return nil }
+
+// gatewayStream adapts an HTTP request and response to a gRPC stream. Streamed requests are read from the body as
+// newline delimited JSON; streamed responses are written the same way and flushed as they're sent.
+type gatewayStream struct {
+	ctx     context.Context
+	w       http.ResponseWriter
+	r       *http.Request
+	desc    grpc.StreamDesc
+	decoder *json.Decoder
+
+	received bool // Whether the single request of a call which only streams responses has been read.
+	sent     bool // Whether the response's status has been written.
+}
+
+func (s *gatewayStream) Context() context.Context       { return s.ctx }
+func (s *gatewayStream) SetHeader(_ metadata.MD) error  { return nil }
+func (s *gatewayStream) SendHeader(_ metadata.MD) error { return nil }
+func (s *gatewayStream) SetTrailer(_ metadata.MD)       {}
+
+// writeHeader sends the response's status. Calls which stream responses are sent as newline delimited JSON; calls
+// which only stream requests answer with a single JSON document.
+func (s *gatewayStream) writeHeader() {
+	if s.desc.ServerStreams {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+	} else {
+		s.w.Header().Set("Content-Type", "application/json")
+	}
+
+	s.w.WriteHeader(http.StatusOK)
+	s.sent = true
+}
+
+func (s *gatewayStream) RecvMsg(m interface{}) error {
+	request := m.(protobuf.Message)
+
+	if !s.desc.ClientStreams {
+		if s.received {
+			return io.EOF
+		}
+		s.received = true
+
+		return decodeGatewayRequest(s.r, request)
+	}
+
+	var raw json.RawMessage
+	err := s.decoder.Decode(&raw)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.EOF
+		}
+		return status.Errorf(codes.InvalidArgument, "could not read request body: %v", err)
+	}
+
+	err = protojson.Unmarshal(raw, request)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "could not parse request body: %v", err)
+	}
+
+	return setGatewayFields(request, s.r.URL.Query(), mux.Vars(s.r))
+}
+
+func (s *gatewayStream) SendMsg(m interface{}) error {
+	body, err := encodeGatewayResponse(m.(protobuf.Message))
+	if err != nil {
+		return status.Errorf(codes.Internal, "could not encode response: %v", err)
+	}
+
+	if !s.sent {
+		s.writeHeader()
+	}
+
+	if s.desc.ServerStreams {
+		body = append(body, '\n')
+	}
+
+	_, err = s.w.Write(body)
+	if err != nil {
+		return err
+	}
+
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+// decodeGatewayRequest fills in a request from the JSON body, query string and path parameters of an HTTP request;
+// later ones taking precedence.
+func decodeGatewayRequest(r *http.Request, request protobuf.Message) error {
+	if r.Method != http.MethodGet && r.Method != http.MethodDelete {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "could not read request body: %v", err)
+		}
+
+		if len(bytes.TrimSpace(body)) > 0 {
+			err = protojson.Unmarshal(body, request)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "could not parse request body: %v", err)
+			}
+		}
+	}
+
+	return setGatewayFields(request, r.URL.Query(), mux.Vars(r))
+}
+
+// setGatewayFields sets request fields from the query string and then path parameters. Fields are named as in the
+// proto or in lowerCamelCase; repeated fields are set by giving the parameter more than once.
+func setGatewayFields(request protobuf.Message, query url.Values, vars map[string]string) error {
+	message := request.ProtoReflect()
+
+	for name, values := range query {
+		err := setGatewayField(message, name, values)
+		if err != nil {
+			return err
+		}
+	}
+
+	for name, value := range vars {
+		err := setGatewayField(message, name, []string{value})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setGatewayField(message protoreflect.Message, name string, values []string) error {
+	fields := message.Descriptor().Fields()
+
+	field := fields.ByName(protoreflect.Name(name))
+	if field == nil {
+		field = fields.ByJSONName(name)
+	}
+	if field == nil {
+		return status.Errorf(codes.InvalidArgument, "unknown parameter %q", name)
+	}
+
+	if field.Message() != nil {
+		return status.Errorf(codes.InvalidArgument, "parameter %q can only be sent in a request body", name)
+	}
+
+	if field.IsList() {
+		list := message.Mutable(field).List()
+		for _, raw := range values {
+			value, err := parseGatewayValue(field, raw)
+			if err != nil {
+				return err
+			}
+			list.Append(value)
+		}
+
+		return nil
+	}
+
+	if len(values) > 1 {
+		return status.Errorf(codes.InvalidArgument, "parameter %q can only be given once", name)
+	}
+
+	value, err := parseGatewayValue(field, values[0])
+	if err != nil {
+		return err
+	}
+	message.Set(field, value)
+
+	return nil
+}
+
+// parseGatewayValue parses a query string or path parameter as the type of the field it sets. Enums can be given by
+// name, in any case, or by number.
+func parseGatewayValue(field protoreflect.FieldDescriptor, raw string) (protoreflect.Value, error) {
+	var value protoreflect.Value
+	var err error
+
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		var v bool
+		v, err = strconv.ParseBool(raw)
+		value = protoreflect.ValueOfBool(v)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		var v int64
+		v, err = strconv.ParseInt(raw, 10, 32)
+		value = protoreflect.ValueOfInt32(int32(v))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		var v int64
+		v, err = strconv.ParseInt(raw, 10, 64)
+		value = protoreflect.ValueOfInt64(v)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		var v uint64
+		v, err = strconv.ParseUint(raw, 10, 32)
+		value = protoreflect.ValueOfUint32(uint32(v))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		var v uint64
+		v, err = strconv.ParseUint(raw, 10, 64)
+		value = protoreflect.ValueOfUint64(v)
+	case protoreflect.FloatKind:
+		var v float64
+		v, err = strconv.ParseFloat(raw, 32)
+		value = protoreflect.ValueOfFloat32(float32(v))
+	case protoreflect.DoubleKind:
+		var v float64
+		v, err = strconv.ParseFloat(raw, 64)
+		value = protoreflect.ValueOfFloat64(v)
+	case protoreflect.StringKind:
+		value = protoreflect.ValueOfString(raw)
+	case protoreflect.BytesKind:
+		var v []byte
+		v, err = base64.StdEncoding.DecodeString(raw)
+		value = protoreflect.ValueOfBytes(v)
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		enum := values.ByName(protoreflect.Name(strings.ToUpper(raw)))
+		if enum == nil {
+			number, parseErr := strconv.ParseInt(raw, 10, 32)
+			if parseErr == nil {
+				enum = values.ByNumber(protoreflect.EnumNumber(number))
+			}
+		}
+		if enum == nil {
+			return value, status.Errorf(codes.InvalidArgument, "parameter %q must be one of %s", field.Name(),
+				strings.Join(enumNames(field.Enum()), ", "))
+		}
+		value = protoreflect.ValueOfEnum(enum.Number())
+	default:
+		err = fmt.Errorf("unsupported type %s", field.Kind())
+	}
+
+	if err != nil {
+		return value, status.Errorf(codes.InvalidArgument, "could not parse parameter %q: %v", field.Name(), err)
+	}
+
+	return value, nil
+}
+
+func enumNames(enum protoreflect.EnumDescriptor) []string {
+	names := []string{}
+	for i := 0; i < enum.Values().Len(); i++ {
+		names = append(names, string(enum.Values().Get(i).Name()))
+	}
+
+	return names
+}
+
+func writeGatewayError(w http.ResponseWriter, err error) {
+	body, _ := json.Marshal(newGatewayError(err))
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(err))
+	_, _ = w.Write(body)
+}
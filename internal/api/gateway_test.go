@@ -0,0 +1,249 @@
+package api
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/search"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/gorilla/mux"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestNewGateway(t *testing.T) {
+	_, err := newGateway(&API{})
+	if err != nil {
+		t.Fatalf("expected every rpc to have a valid route; %v", err)
+	}
+}
+
+// newTestGateway serves the gateway of an API which authenticates every call as the "dev" account.
+func newTestGateway(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	db := newTestDB(t)
+	err := db.InsertAccount(db, &storage.Account{ID: "dev"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	searchIndex, err := search.InitSearch(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiConfig := config.DefaultAPIConfig()
+	apiConfig.Development.BypassAuth = true
+
+	g, err := newGateway(&API{db: db, config: apiConfig, search: searchIndex})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	router := mux.NewRouter()
+	g.registerRoutes(router)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// gatewayCall makes a call through the gateway and returns the response's status, content type and body.
+func gatewayCall(t *testing.T, server *httptest.Server, method, path, body string) (int, string, string) {
+	t.Helper()
+
+	request, err := http.NewRequest(method, server.URL+gatewayPrefix+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Authorization", "Bearer dev")
+
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return response.StatusCode, response.Header.Get("Content-Type"), string(responseBody)
+}
+
+func TestGatewayUnary(t *testing.T) {
+	server := newTestGateway(t)
+
+	code, _, body := gatewayCall(t, server, http.MethodPost, "/formulas", `{"name": "Hale Navy", "number": "HC-154"}`)
+	if code != http.StatusOK {
+		t.Fatalf("could not create formula; %d: %s", code, body)
+	}
+
+	created := &proto.CreateFormulaResponse{}
+	err := protojson.Unmarshal([]byte(body), created)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, contentType, body := gatewayCall(t, server, http.MethodGet, "/formulas/"+created.Formula.Id, "")
+	if code != http.StatusOK || contentType != "application/json" {
+		t.Fatalf("could not get formula; %d %s: %s", code, contentType, body)
+	}
+
+	retrieved := &proto.GetFormulaResponse{}
+	err = protojson.Unmarshal([]byte(body), retrieved)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if retrieved.Formula.Metadata.Name != "Hale Navy" || retrieved.Formula.Metadata.Number != "HC-154" {
+		t.Errorf("unexpected formula retrieved: %v", retrieved.Formula.Metadata)
+	}
+
+	// 64 bit integers are sent as strings and unpopulated fields are always present.
+	var raw struct {
+		Formula struct {
+			Metadata map[string]any `json:"metadata"`
+		} `json:"formula"`
+	}
+	err = json.Unmarshal([]byte(body), &raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, isString := raw.Formula.Metadata["version"].(string); !isString {
+		t.Errorf("expected version to be sent as a string; got %v", raw.Formula.Metadata["version"])
+	}
+	if _, present := raw.Formula.Metadata["notes"]; !present {
+		t.Errorf("expected unpopulated notes to be present; got %v", raw.Formula.Metadata)
+	}
+
+	code, _, body = gatewayCall(t, server, http.MethodGet, "/formulas/missing", "")
+	if code != http.StatusNotFound {
+		t.Errorf("expected not found for a missing formula; got %d: %s", code, body)
+	}
+
+	errorBody := gatewayError{}
+	err = json.Unmarshal([]byte(body), &errorBody)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if errorBody.Status != "NotFound" || errorBody.Message == "" {
+		t.Errorf("unexpected error body: %s", body)
+	}
+}
+
+func TestGatewayParameters(t *testing.T) {
+	server := newTestGateway(t)
+
+	code, _, body := gatewayCall(t, server, http.MethodPost, "/formulas", `{"name": "Hale Navy"}`)
+	if code != http.StatusOK {
+		t.Fatalf("could not create formula; %d: %s", code, body)
+	}
+
+	created := &proto.CreateFormulaResponse{}
+	err := protojson.Unmarshal([]byte(body), created)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.Formula.Id
+
+	tests := map[string]struct {
+		path   string
+		status int
+	}{
+		"enum by name":       {path: "/codes/FORMULA/" + id, status: http.StatusOK},
+		"enum in lower case": {path: "/codes/formula/" + id, status: http.StatusOK},
+		"enum by number":     {path: "/codes/1/" + id, status: http.StatusOK},
+		"unknown enum":       {path: "/codes/paint/" + id, status: http.StatusBadRequest},
+		"unknown entity":     {path: "/codes/FORMULA/missing", status: http.StatusNotFound},
+		"unknown parameter":  {path: "/codes/FORMULA/" + id + "?color=blue", status: http.StatusBadRequest},
+	}
+
+	var formulaCode string
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			code, _, body := gatewayCall(t, server, http.MethodGet, tc.path, "")
+			if code != tc.status {
+				t.Fatalf("expected status %d; got %d: %s", tc.status, code, body)
+			}
+			if code != http.StatusOK {
+				return
+			}
+
+			response := &proto.GetCodeResponse{}
+			err := protojson.Unmarshal([]byte(body), response)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if response.Code.EntityKind != proto.EntityKind_FORMULA || response.Code.EntityId != id {
+				t.Errorf("expected code of formula %s; got %v", id, response.Code)
+			}
+
+			// A formula only ever has the one code, however its kind is spelled.
+			if formulaCode == "" {
+				formulaCode = response.Code.Code
+			}
+			if response.Code.Code != formulaCode {
+				t.Errorf("expected code %s; got %s", formulaCode, response.Code.Code)
+			}
+		})
+	}
+}
+
+func TestGatewayStream(t *testing.T) {
+	server := newTestGateway(t)
+
+	// Requests are streamed as newline delimited JSON; the response to a call which only streams requests is a single
+	// document.
+	records := []string{
+		`{"dry_run": false, "record": {"row": 2, "name": "Hale Navy", "number": "HC-154"}}`,
+		`{"record": {"row": 3, "name": "Sea Salt", "number": "SW-6204"}}`,
+	}
+	code, contentType, body := gatewayCall(t, server, http.MethodPost, "/formulas/import", strings.Join(records, "\n"))
+	if code != http.StatusOK || contentType != "application/json" {
+		t.Fatalf("could not import formulas; %d %s: %s", code, contentType, body)
+	}
+
+	imported := &proto.ImportFormulasResponse{}
+	err := protojson.Unmarshal([]byte(body), imported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(imported.FormulasCreated) != 2 || !imported.Committed {
+		t.Fatalf("expected 2 formulas to be imported; got %v", imported)
+	}
+
+	// Responses are streamed as newline delimited JSON too.
+	code, contentType, body = gatewayCall(t, server, http.MethodGet, "/accounts/dev/export", "")
+	if code != http.StatusOK || contentType != "application/x-ndjson" {
+		t.Fatalf("could not export account; %d %s: %s", code, contentType, body)
+	}
+
+	formulas := 0
+	lines := bufio.NewScanner(strings.NewReader(body))
+	for lines.Scan() {
+		message := &proto.ExportAccountResponse{}
+		err := protojson.Unmarshal(lines.Bytes(), message)
+		if err != nil {
+			t.Fatalf("could not parse line %q: %v", lines.Text(), err)
+		}
+
+		if message.GetRecord().GetFormula() != nil {
+			formulas++
+		}
+	}
+
+	if formulas != 2 {
+		t.Errorf("expected the 2 formulas imported in the export; found %d in:\n%s", formulas, body)
+	}
+}
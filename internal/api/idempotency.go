@@ -0,0 +1,212 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// idempotencyKeyHeader is the metadata header a client can make a call with so that retrying it returns the result of
+// the first attempt instead of making the call again.
+const idempotencyKeyHeader = "idempotency-key"
+
+const maxIdempotencyKeyLength = 255
+
+// idempotentMethods are the calls which create something and so honor idempotency keys.
+var idempotentMethods = []string{
+	proto.Basecoat_CreateFormula_FullMethodName,
+	proto.Basecoat_AssociateFormulaWithJob_FullMethodName,
+	proto.Basecoat_RecordMix_FullMethodName,
+	proto.Basecoat_CreateBase_FullMethodName,
+	proto.Basecoat_AssociateBaseWithFormula_FullMethodName,
+	proto.Basecoat_CreateColorant_FullMethodName,
+	proto.Basecoat_AssociateColorantWithFormula_FullMethodName,
+	proto.Basecoat_CreateContact_FullMethodName,
+	proto.Basecoat_CreateContractor_FullMethodName,
+	proto.Basecoat_CreateJob_FullMethodName,
+	proto.Basecoat_BatchCreate_FullMethodName,
+	proto.Basecoat_CreateWebhook_FullMethodName,
+}
+
+func getIdempotencyKeyFromContext(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(idempotencyKeyHeader)
+	if len(keys) == 0 {
+		return ""
+	}
+
+	return keys[0]
+}
+
+// hashRequest identifies a request so that a key reused for a different one can be told apart from a retry.
+func hashRequest(method string, request protobuf.Message) (string, error) {
+	raw, err := protobuf.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write(raw)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// idempotencyInterceptor answers a call made with an idempotency key used within the configured window with the
+// response it was first given. Only successful responses are kept so that a call which failed can be retried with the
+// same key. It must run after authentication since keys are kept per account, and inside the watch interceptor since
+// the call's changes aren't committed until it returns.
+func (api *API) idempotencyInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if api.config.Idempotency.Window == 0 || !slices.Contains(idempotentMethods, info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	key := getIdempotencyKeyFromContext(ctx)
+	if key == "" {
+		return handler(ctx, req)
+	}
+
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return handler(ctx, req)
+	}
+
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key is too long; greater than %d characters",
+			maxIdempotencyKeyLength)
+	}
+
+	request, ok := req.(protobuf.Message)
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	requestHash, err := hashRequest(info.FullMethod, request)
+	if err != nil {
+		log.Error().Err(err).Str("method", info.FullMethod).Msg("could not hash request for idempotency key")
+		return nil, status.Error(codes.Internal, "could not check idempotency key; internal error")
+	}
+
+	expired := time.Now().Add(-api.config.Idempotency.Window).UnixMilli()
+	indexed := &pendingIndex{}
+
+	var resp interface{}
+	var respErr error
+
+	// The key is claimed inside the same transaction the call makes its changes in and its response is saved there
+	// too, so either both are kept or neither is. A retry sent while the first attempt is still being handled, by this
+	// server or another, waits on the claim and is then answered with the saved response or, if the first attempt
+	// failed, makes the call itself.
+	err = storage.InsideTx(api.db, func(tx *sqlx.Tx) error {
+		err := api.db.InsertIdempotencyKey(tx, &storage.IdempotencyKey{
+			Account:     account,
+			Key:         key,
+			Method:      info.FullMethod,
+			RequestHash: requestHash,
+			Response:    []byte{},
+			Created:     time.Now().UnixMilli(),
+		}, expired)
+		if errors.Is(err, storage.ErrEntityExists) {
+			resp, respErr = api.savedResponse(tx, account, key, info.FullMethod, requestHash, expired)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp, respErr = handler(context.WithValue(context.WithValue(ctx, contextTx, tx), contextIndexed, indexed), req)
+		if respErr != nil {
+			// Rolling back gives up the claim along with the call's changes so the key can be used to try again.
+			return respErr
+		}
+
+		response, ok := resp.(protobuf.Message)
+		if !ok {
+			return fmt.Errorf("response of type %T can't be saved", resp)
+		}
+
+		raw, err := marshalResponse(response)
+		if err != nil {
+			return err
+		}
+
+		return api.db.UpdateIdempotencyKeyResponse(tx, account, key, raw)
+	})
+	if respErr != nil {
+		return resp, respErr
+	}
+	if err != nil {
+		log.Error().Err(err).Str("account", account).Str("key", key).
+			Msg("could not save response for idempotency key")
+		return nil, status.Error(codes.Internal, "could not save response for idempotency key; internal error")
+	}
+
+	indexed.apply(api)
+	return resp, nil
+}
+
+// savedResponse returns the response saved for a key which was already used, as long as it's being retried for the
+// same request.
+func (api *API) savedResponse(conn storage.Queryable, account, key, method, requestHash string, expired int64,
+) (protobuf.Message, error) {
+	saved, err := api.db.GetIdempotencyKey(conn, account, key, expired)
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return nil, status.Error(codes.Aborted, "idempotency key was released while waiting on it; retry the call")
+		}
+		log.Error().Err(err).Str("account", account).Str("key", key).Msg("could not retrieve idempotency key")
+		return nil, status.Error(codes.Internal, "could not check idempotency key; internal error")
+	}
+
+	if saved.Method != method || saved.RequestHash != requestHash {
+		return nil, status.Error(codes.FailedPrecondition, "idempotency key already used for a different request")
+	}
+
+	response, err := unmarshalResponse(saved.Response)
+	if err != nil {
+		log.Error().Err(err).Str("account", account).Str("key", key).
+			Msg("could not read response saved for idempotency key")
+		return nil, status.Error(codes.Internal, "could not check idempotency key; internal error")
+	}
+
+	log.Debug().Str("account", account).Str("key", key).Str("method", method).
+		Msg("answered retried call with saved response")
+	return response, nil
+}
+
+func marshalResponse(response protobuf.Message) ([]byte, error) {
+	wrapped, err := anypb.New(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return protobuf.Marshal(wrapped)
+}
+
+func unmarshalResponse(raw []byte) (protobuf.Message, error) {
+	wrapped := anypb.Any{}
+	err := protobuf.Unmarshal(raw, &wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrapped.UnmarshalNew()
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestIdempotencyInterceptor(t *testing.T) {
+	db := newTestDB(t)
+	api := &API{db: db, config: &config.API{Idempotency: &config.Idempotency{Window: time.Hour}}}
+	info := &grpc.UnaryServerInfo{FullMethod: proto.Basecoat_CreateBase_FullMethodName}
+	request := &proto.CreateBaseRequest{Label: "Regal Select", Manufacturer: "Benjamin Moore"}
+
+	withKey := func(key string) context.Context {
+		ctx := context.WithValue(context.Background(), contextAccount, "test_account")
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(idempotencyKeyHeader, key))
+	}
+
+	createBase := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return api.CreateBase(ctx, req.(*proto.CreateBaseRequest))
+	}
+
+	countBases := func() int {
+		bases, err := db.ListBases(db, "test_account", 0, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(bases)
+	}
+
+	// A call which fails gives the key up along with everything it changed.
+	_, err := api.idempotencyInterceptor(withKey("failed_key"), request, info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			_, err := createBase(ctx, req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return nil, status.Error(codes.Unavailable, "failed after writing")
+		})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected the handler's error; got %v", err)
+	}
+	if count := countBases(); count != 0 {
+		t.Errorf("expected the failed call's base to be rolled back; found %d bases", count)
+	}
+
+	_, err = api.idempotencyInterceptor(withKey("failed_key"), request, info, createBase)
+	if err != nil {
+		t.Errorf("expected a failed call's key to be usable again; got %v", err)
+	}
+
+	// Attempts sent at the same time, even to different servers, make the call once and all get its response.
+	servers := []*API{api, {db: db, config: api.config}}
+	responses := make([]*proto.CreateBaseResponse, 4)
+	errs := make([]error, len(responses))
+	wg := sync.WaitGroup{}
+	for i := range responses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := servers[i%len(servers)].idempotencyInterceptor(withKey("racing_key"), request, info,
+				createBase)
+			errs[i] = err
+			responses[i], _ = resp.(*proto.CreateBaseResponse)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range responses {
+		if errs[i] != nil {
+			t.Fatalf("expected every attempt to succeed; got %v", errs[i])
+		}
+		if responses[i].Base.Id != responses[0].Base.Id {
+			t.Errorf("expected every attempt to get base %q; got %q", responses[0].Base.Id, responses[i].Base.Id)
+		}
+	}
+	if count := countBases(); count != 2 {
+		t.Errorf("expected racing attempts to create a single base; found %d bases", count)
+	}
+
+	saved, err := db.GetIdempotencyKey(db, "test_account", "racing_key", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.Response) == 0 {
+		t.Error("expected the response to be saved along with the key")
+	}
+
+	_, err = api.idempotencyInterceptor(withKey("racing_key"), &proto.CreateBaseRequest{
+		Label: "Aura", Manufacturer: "Benjamin Moore",
+	}, info, createBase)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected a key reused for a different request to be refused; got %v", err)
+	}
+
+	// A key is only kept if the response is; a call whose response can't be saved is undone.
+	_, err = api.idempotencyInterceptor(withKey("unsaved_key"), request, info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+				return db.InsertBase(tx, &storage.Base{Account: "test_account", ID: "unsaved_base"})
+			})
+			return "not a message", err
+		})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("expected an unsaveable response to fail the call; got %v", err)
+	}
+	_, err = db.GetBase(db, "test_account", "unsaved_base")
+	if !errors.Is(err, storage.ErrEntityNotFound) {
+		t.Errorf("expected the call whose response wasn't saved to be rolled back; got %v", err)
+	}
+}
@@ -2,11 +2,13 @@ package api
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/clintjedwards/basecoat/internal/models"
 	"github.com/clintjedwards/basecoat/internal/storage"
 	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog/log"
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
@@ -24,7 +26,28 @@ func (api *API) GetJob(ctx context.Context, request *proto.GetJobRequest) (*prot
 		return &proto.GetJobResponse{}, status.Error(codes.FailedPrecondition, "id required")
 	}
 
-	jobRaw, err := api.db.GetJob(api.db, account, request.Id)
+	job := models.Job{}
+	formulas := []string{}
+
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		jobRaw, err := api.db.GetJob(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		job.FromStorage(&jobRaw)
+
+		jobFormulas, err := api.db.ListJobFormulas(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, jobFormula := range jobFormulas {
+			formulas = append(formulas, jobFormula.Formula)
+		}
+
+		return nil
+	})
 	if err != nil {
 		if err == storage.ErrEntityNotFound {
 			return &proto.GetJobResponse{}, status.Error(codes.NotFound, "job requested not found")
@@ -32,10 +55,10 @@ func (api *API) GetJob(ctx context.Context, request *proto.GetJobRequest) (*prot
 		return &proto.GetJobResponse{}, status.Error(codes.Internal, "failed to retrieve job from database")
 	}
 
-	job := models.Job{}
-	job.FromStorage(&jobRaw)
+	protoJob := job.ToProto()
+	protoJob.Formulas = formulas
 
-	return &proto.GetJobResponse{Job: job.ToProto()}, nil
+	return &proto.GetJobResponse{Job: protoJob}, nil
 }
 
 // ListJobs returns a list of all jobs's metadata.
@@ -90,7 +113,12 @@ func (api *API) CreateJob(ctx context.Context, request *proto.CreateJobRequest)
 		return &proto.CreateJobResponse{}, status.Error(codes.FailedPrecondition, "job name required")
 	}
 
+	if request.ContractorId == "" {
+		return &proto.CreateJobResponse{}, status.Error(codes.FailedPrecondition, "job contractor required")
+	}
+
 	job := models.NewJob(account, request.ContractorId, request.Name)
+	job.Notes = request.Notes
 	job.Contact = request.ContactId
 
 	if request.Address != nil {
@@ -99,15 +127,29 @@ func (api *API) CreateJob(ctx context.Context, request *proto.CreateJobRequest)
 		job.Address = address
 	}
 
-	err := api.db.InsertJob(api.db, job.ToStorage())
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		err := api.db.InsertJob(tx, job.ToStorage())
+		if err != nil {
+			return err
+		}
+
+		err = api.recordAuditEvent(ctx, tx, account, models.EntityKindJob, job.ID, nil, job)
+		if err != nil {
+			return err
+		}
+
+		_, err = api.entityCode(tx, account, models.EntityKindJob, job.ID)
+		return err
+	})
 	if err != nil {
-		if err == storage.ErrEntityExists {
+		if errors.Is(err, storage.ErrEntityExists) {
 			return &proto.CreateJobResponse{}, status.Error(codes.AlreadyExists, "could not save job; job already exists")
 		}
 		log.Error().Err(err).Msg("could not save job")
 		return &proto.CreateJobResponse{}, status.Error(codes.Internal, "could not save job")
 	}
 
+	api.updateSearchIndex(ctx, models.EntityKindJob, account, job.ID, false)
 	log.Info().Str("id", job.ID).Str("name", job.Name).Msg("job created")
 
 	return &proto.CreateJobResponse{
@@ -115,7 +157,8 @@ func (api *API) CreateJob(ctx context.Context, request *proto.CreateJobRequest)
 	}, nil
 }
 
-// AssociateFormulaWithJob records the formula given as associated with the job given.
+// AssociateFormulaWithJob records the formula given as associated with the job given, along with where on the job
+// it was used.
 func (api *API) AssociateFormulaWithJob(ctx context.Context, request *proto.AssociateFormulaWithJobRequest) (*proto.AssociateFormulaWithJobResponse, error) {
 	account, present := getAccountFromContext(ctx)
 	if !present {
@@ -130,12 +173,29 @@ func (api *API) AssociateFormulaWithJob(ctx context.Context, request *proto.Asso
 		return &proto.AssociateFormulaWithJobResponse{}, status.Error(codes.FailedPrecondition, "formula id required")
 	}
 
-	err := api.db.AssociateFormulaWithJob(api.db, &storage.FormulaJob{
-		Account: account,
-		Job:     request.Job,
+	formulaJob := models.FormulaJob{
 		Formula: request.Formula,
+		Job:     request.Job,
+		Area:    request.Area,
+	}
+
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		err := api.db.AssociateFormulaWithJob(tx, &storage.FormulaJob{
+			Account: account,
+			Job:     request.Job,
+			Formula: request.Formula,
+			Area:    formulaJob.Area,
+		})
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindJob, request.Job, nil, formulaJob)
 	})
 	if err != nil {
+		if errors.Is(err, storage.ErrEntityExists) {
+			return &proto.AssociateFormulaWithJobResponse{}, status.Error(codes.AlreadyExists, "formula is already associated with job")
+		}
 		log.Error().Err(err).Msg("could not associate formula with job")
 		return &proto.AssociateFormulaWithJobResponse{}, status.Error(codes.Internal, "could not associate formula with job")
 	}
@@ -159,7 +219,32 @@ func (api *API) DisassociateFormulaFromJob(ctx context.Context, request *proto.D
 		return &proto.DisassociateFormulaFromJobResponse{}, status.Error(codes.FailedPrecondition, "formula id required")
 	}
 
-	err := api.db.DeleteJobFormula(api.db, account, request.Job, request.Formula)
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		jobFormulas, err := api.db.ListJobFormulas(tx, account, request.Job)
+		if err != nil {
+			return err
+		}
+
+		var before *models.FormulaJob
+		for _, jobFormulaRaw := range jobFormulas {
+			if jobFormulaRaw.Formula == request.Formula {
+				before = &models.FormulaJob{}
+				before.FromStorage(&jobFormulaRaw)
+			}
+		}
+
+		// Removing an association which doesn't exist is not an error but there's also nothing to record.
+		if before == nil {
+			return nil
+		}
+
+		err = api.db.DeleteJobFormula(tx, account, request.Job, request.Formula)
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindJob, request.Job, before, nil)
+	})
 	if err != nil {
 		log.Error().Err(err).Msg("could not disassociate formula from job")
 		return &proto.DisassociateFormulaFromJobResponse{}, status.Error(codes.Internal, "could not disassociate formula from job")
@@ -180,6 +265,10 @@ func (api *API) UpdateJob(ctx context.Context, request *proto.UpdateJobRequest)
 		return &proto.UpdateJobResponse{}, status.Error(codes.FailedPrecondition, "job id required")
 	}
 
+	if request.Version == 0 {
+		return &proto.UpdateJobResponse{}, status.Error(codes.FailedPrecondition, "job version required")
+	}
+
 	var jsonAddress *string
 	if request.Address != nil {
 		address := models.Address{}
@@ -187,23 +276,53 @@ func (api *API) UpdateJob(ctx context.Context, request *proto.UpdateJobRequest)
 		jsonAddress = ptr(address.ToJSON())
 	}
 
-	err := api.db.UpdateJob(api.db, account, request.Id, storage.UpdatableJobFields{
-		Name:     request.Name,
-		Address:  jsonAddress,
-		Notes:    request.Notes,
-		Contact:  request.ContactId,
-		Modified: ptr(time.Now().UnixMilli()),
+	var after models.Job
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		jobRaw, err := api.db.GetJob(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.Job{}
+		before.FromStorage(&jobRaw)
+
+		err = api.db.UpdateJob(tx, account, request.Id, storage.UpdatableJobFields{
+			Version:  &request.Version,
+			Name:     request.Name,
+			Address:  jsonAddress,
+			Notes:    request.Notes,
+			Contact:  request.ContactId,
+			Modified: ptr(time.Now().UnixMilli()),
+		})
+		if err != nil {
+			return err
+		}
+
+		jobRaw, err = api.db.GetJob(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		after = models.Job{}
+		after.FromStorage(&jobRaw)
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindJob, request.Id, before, after)
 	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return &proto.UpdateJobResponse{}, status.Error(codes.NotFound, "job requested not found")
 		}
+		if errors.Is(err, storage.ErrVersionMismatch) {
+			return &proto.UpdateJobResponse{}, status.Error(codes.Aborted,
+				"job was changed by someone else; fetch it again and retry")
+		}
 		log.Error().Err(err).Msg("could not save job")
 		return &proto.UpdateJobResponse{}, status.Error(codes.Internal, "could not save job")
 	}
 
+	api.updateSearchIndex(ctx, models.EntityKindJob, account, request.Id, false)
 	log.Debug().Str("id", request.Id).Msg("job updated")
-	return &proto.UpdateJobResponse{}, nil
+	return &proto.UpdateJobResponse{Job: after.ToProto()}, nil
 }
 
 func (api *API) DeleteJob(ctx context.Context, request *proto.DeleteJobRequest) (*proto.DeleteJobResponse, error) {
@@ -216,9 +335,24 @@ func (api *API) DeleteJob(ctx context.Context, request *proto.DeleteJobRequest)
 		return &proto.DeleteJobResponse{}, status.Error(codes.FailedPrecondition, "job id required")
 	}
 
-	err := api.db.DeleteJob(api.db, account, request.Id)
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		jobRaw, err := api.db.GetJob(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		before := models.Job{}
+		before.FromStorage(&jobRaw)
+
+		err = api.db.SoftDelete(tx, string(models.EntityKindJob), account, request.Id, time.Now().UnixMilli())
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, models.EntityKindJob, request.Id, before, nil)
+	})
 	if err != nil {
-		if err == storage.ErrEntityNotFound {
+		if errors.Is(err, storage.ErrEntityNotFound) {
 			return &proto.DeleteJobResponse{}, status.Error(codes.NotFound, "could not delete job; job key not found")
 		}
 
@@ -226,5 +360,6 @@ func (api *API) DeleteJob(ctx context.Context, request *proto.DeleteJobRequest)
 		return &proto.DeleteJobResponse{}, status.Error(codes.Internal, "could not delete job")
 	}
 
+	api.updateSearchIndex(ctx, models.EntityKindJob, account, request.Id, true)
 	return &proto.DeleteJobResponse{}, nil
 }
@@ -0,0 +1,246 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/report"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// reportFormats maps the report formats clients can ask for onto the formats the report package renders.
+var reportFormats = map[proto.GenerateJobReportRequest_Format]report.Format{
+	proto.GenerateJobReportRequest_PDF:  report.PDF,
+	proto.GenerateJobReportRequest_HTML: report.HTML,
+}
+
+// uniqueManufacturers returns the manufacturers of the ingredients given in the order they're first seen.
+func uniqueManufacturers(ingredients []formulaIngredient) []string {
+	seen := map[string]struct{}{}
+	manufacturers := []string{}
+	for _, ingredient := range ingredients {
+		if ingredient.Manufacturer == "" {
+			continue
+		}
+		if _, exists := seen[ingredient.Manufacturer]; exists {
+			continue
+		}
+		seen[ingredient.Manufacturer] = struct{}{}
+		manufacturers = append(manufacturers, ingredient.Manufacturer)
+	}
+
+	return manufacturers
+}
+
+// reportFormula gathers a formula used in the area of a job given into the form printed in job reports.
+func (api *API) reportFormula(tx *sqlx.Tx, account, id, area string) (report.Formula, error) {
+	formula, err := api.db.GetFormula(tx, account, id)
+	if err != nil {
+		return report.Formula{}, err
+	}
+
+	code, err := api.entityCode(tx, account, models.EntityKindFormula, id)
+	if err != nil {
+		return report.Formula{}, err
+	}
+
+	bases, colorants, err := api.listFormulaIngredients(tx, account, id)
+	if err != nil {
+		return report.Formula{}, err
+	}
+
+	baseNames := []string{}
+	for _, base := range bases {
+		baseNames = append(baseNames, base.Name)
+	}
+
+	reportFormula := report.Formula{
+		Name:         formula.Name,
+		Number:       formula.Number,
+		Area:         area,
+		Manufacturer: strings.Join(uniqueManufacturers(bases), ", "),
+		Base:         strings.Join(baseNames, ", "),
+		Sheen:        formula.Sheen,
+		Color:        formula.Color,
+		Code:         code.Display(),
+		Link:         api.codeLink(code.Code),
+	}
+
+	for _, colorant := range colorants {
+		reportFormula.Colorants = append(reportFormula.Colorants, report.Colorant{
+			Name:   colorant.Name,
+			Amount: colorant.Amount,
+		})
+	}
+
+	return reportFormula, nil
+}
+
+// jobReport gathers everything printed in a job's report.
+func (api *API) jobReport(tx *sqlx.Tx, account, id string) (report.Report, error) {
+	jobRaw, err := api.db.GetJob(tx, account, id)
+	if err != nil {
+		return report.Report{}, err
+	}
+
+	var job models.Job
+	job.FromStorage(&jobRaw)
+
+	code, err := api.entityCode(tx, account, models.EntityKindJob, id)
+	if err != nil {
+		return report.Report{}, err
+	}
+
+	jobReport := report.Report{
+		Job:       job.Name,
+		Address:   job.Address.String(),
+		Notes:     job.Notes,
+		Code:      code.Display(),
+		Generated: time.Now(),
+	}
+
+	if job.Contact != nil {
+		contact, err := api.db.GetContact(tx, account, *job.Contact)
+		if err != nil {
+			return report.Report{}, err
+		}
+		jobReport.Customer = contact.Name
+	}
+
+	if job.Contractor != "" {
+		contractor, err := api.db.GetContractor(tx, account, job.Contractor)
+		if err != nil {
+			return report.Report{}, err
+		}
+		jobReport.Contractor = contractor.Company
+
+		// Customers calling back years later need someone to reach, not just a company name.
+		if contractor.Contact != nil {
+			contact, err := api.db.GetContact(tx, account, *contractor.Contact)
+			if err != nil {
+				return report.Report{}, err
+			}
+
+			reach := []string{}
+			for _, part := range []string{contact.Name, contact.Phone, contact.Email} {
+				if part != "" {
+					reach = append(reach, part)
+				}
+			}
+			if len(reach) > 0 {
+				jobReport.Contractor = fmt.Sprintf("%s; %s", jobReport.Contractor, strings.Join(reach, ", "))
+			}
+		}
+	}
+
+	jobFormulas, err := api.db.ListJobFormulas(tx, account, id)
+	if err != nil {
+		return report.Report{}, err
+	}
+
+	for _, jobFormula := range jobFormulas {
+		reportFormula, err := api.reportFormula(tx, account, jobFormula.Formula, jobFormula.Area)
+		if err != nil {
+			return report.Report{}, err
+		}
+		jobReport.Formulas = append(jobReport.Formulas, reportFormula)
+	}
+
+	return jobReport, nil
+}
+
+// GenerateJobReport renders the report handed to customers listing every formula used on a job.
+func (api *API) GenerateJobReport(ctx context.Context, request *proto.GenerateJobReportRequest) (
+	*proto.GenerateJobReportResponse, error,
+) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.GenerateJobReportResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Id == "" {
+		return &proto.GenerateJobReportResponse{}, status.Error(codes.FailedPrecondition, "job id required")
+	}
+
+	format, present := reportFormats[request.Format]
+	if !present {
+		return &proto.GenerateJobReportResponse{}, status.Error(codes.FailedPrecondition, "unknown report format")
+	}
+
+	var jobReport report.Report
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		jobReport, err = api.jobReport(tx, account, request.Id)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.GenerateJobReportResponse{}, status.Error(codes.NotFound, "job requested not found")
+		}
+		log.Error().Err(err).Msg("could not generate job report")
+		return &proto.GenerateJobReportResponse{}, status.Error(codes.Internal, "could not generate job report")
+	}
+
+	var content bytes.Buffer
+	err = report.Render(&content, format, jobReport)
+	if err != nil {
+		log.Error().Err(err).Msg("could not generate job report")
+		return &proto.GenerateJobReportResponse{}, status.Error(codes.Internal, "could not generate job report")
+	}
+
+	return &proto.GenerateJobReportResponse{
+		Content:     content.Bytes(),
+		ContentType: format.ContentType(),
+		FileName:    fmt.Sprintf("%s-report.%s", request.Id, format),
+	}, nil
+}
+
+// handleJobReport serves job reports over plain HTTP so that they can be opened straight from a browser:
+//
+//	GET /api/jobs/{id}/report?format=html
+//
+// Requests are authenticated with the same bearer token as the gRPC API.
+func (api *API) handleJobReport(w http.ResponseWriter, r *http.Request) {
+	md := metadata.Pairs("authorization", r.Header.Get("Authorization"))
+	ctx, err := api.authenticate(metadata.NewIncomingContext(r.Context(), md))
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+		return
+	}
+
+	format := proto.GenerateJobReportRequest_PDF
+	if value := r.URL.Query().Get("format"); value != "" {
+		parsed, present := proto.GenerateJobReportRequest_Format_value[strings.ToUpper(value)]
+		if !present {
+			http.Error(w, fmt.Sprintf("unknown report format %q", value), http.StatusBadRequest)
+			return
+		}
+		format = proto.GenerateJobReportRequest_Format(parsed)
+	}
+
+	resp, err := api.GenerateJobReport(ctx, &proto.GenerateJobReportRequest{
+		Id:     mux.Vars(r)["id"],
+		Format: format,
+	})
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", resp.FileName))
+	_, _ = w.Write(resp.Content)
+}
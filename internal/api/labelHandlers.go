@@ -0,0 +1,328 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/dispenser"
+	"github.com/clintjedwards/basecoat/internal/label"
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/gorilla/mux"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// labelFormats maps the label formats clients can ask for onto the formats the label package renders.
+var labelFormats = map[proto.LabelFormat]label.Format{
+	proto.LabelFormat_PDF: label.PDF,
+	proto.LabelFormat_ZPL: label.ZPL,
+}
+
+// errAmbiguousJob is returned when a label is requested without a job for a formula which belongs to several.
+var errAmbiguousJob = errors.New("formula belongs to more than one job")
+
+// errMixOfOtherFormula is returned when a label is requested for a mix of a different formula than the one named.
+var errMixOfOtherFormula = errors.New("mix is of a different formula")
+
+// codeLink returns the URL a code printed on a label leads to.
+func (api *API) codeLink(code string) string {
+	externalURL := api.config.Server.ExternalURL
+	if externalURL == "" {
+		externalURL = "https://" + api.config.Server.Host
+	}
+
+	return strings.TrimSuffix(externalURL, "/") + "/c/" + code
+}
+
+// formulaIngredient is a base or colorant of a formula as people read it; by name rather than by ID.
+type formulaIngredient struct {
+	ID           string
+	Name         string
+	Manufacturer string
+	Amount       string
+}
+
+// listFormulaIngredients returns the bases and colorants which make up a formula.
+func (api *API) listFormulaIngredients(tx *sqlx.Tx, account, id string) (bases, colorants []formulaIngredient,
+	err error,
+) {
+	formulaBases, err := api.db.ListFormulaBases(tx, account, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, formulaBase := range formulaBases {
+		base, err := api.db.GetBase(tx, account, formulaBase.Base)
+		if err != nil {
+			return nil, nil, err
+		}
+		bases = append(bases, formulaIngredient{
+			ID:           base.ID,
+			Name:         base.Label,
+			Manufacturer: base.Manufacturer,
+			Amount:       formulaBase.Amount,
+		})
+	}
+
+	formulaColorants, err := api.db.ListFormulaColorants(tx, account, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, formulaColorant := range formulaColorants {
+		colorant, err := api.db.GetColorant(tx, account, formulaColorant.Colorant)
+		if err != nil {
+			return nil, nil, err
+		}
+		colorants = append(colorants, formulaIngredient{
+			ID:           colorant.ID,
+			Name:         colorant.Label,
+			Manufacturer: colorant.Manufacturer,
+			Amount:       formulaColorant.Amount,
+		})
+	}
+
+	return bases, colorants, nil
+}
+
+// formulaLabel gathers everything printed on the label of a can mixed from a formula. Colorant amounts, which are kept
+// for a gallon, are scaled to the container given; amounts which can't be read are only printed on a gallon label.
+func (api *API) formulaLabel(tx *sqlx.Tx, account, id, jobID string, container dispenser.Container) (label.Label, error) {
+	formula, err := api.db.GetFormula(tx, account, id)
+	if err != nil {
+		return label.Label{}, err
+	}
+
+	code, err := api.entityCode(tx, account, models.EntityKindFormula, id)
+	if err != nil {
+		return label.Label{}, err
+	}
+
+	canLabel := label.Label{
+		Name:      formula.Name,
+		Number:    formula.Number,
+		Container: container.String(),
+		Mixed:     time.Now(),
+		Code:      code.Display(),
+		Link:      api.codeLink(code.Code),
+	}
+
+	bases, colorants, err := api.listFormulaIngredients(tx, account, id)
+	if err != nil {
+		return label.Label{}, err
+	}
+
+	baseNames := []string{}
+	for _, base := range bases {
+		baseNames = append(baseNames, base.Name)
+	}
+	canLabel.Base = strings.Join(baseNames, ", ")
+
+	for _, colorant := range colorants {
+		amount, err := scaleColorantAmount(colorant, container)
+		if err != nil {
+			return label.Label{}, err
+		}
+
+		canLabel.Colorants = append(canLabel.Colorants, label.Colorant{Name: colorant.Name, Amount: amount})
+	}
+
+	if jobID == "" {
+		formulaJobs, err := api.db.ListFormulaJobs(tx, account, id)
+		if err != nil {
+			return label.Label{}, err
+		}
+
+		switch len(formulaJobs) {
+		case 0:
+			return canLabel, nil
+		case 1:
+			jobID = formulaJobs[0].Job
+		default:
+			return label.Label{}, errAmbiguousJob
+		}
+	}
+
+	job, err := api.db.GetJob(tx, account, jobID)
+	if err != nil {
+		return label.Label{}, err
+	}
+	canLabel.Job = job.Name
+
+	if job.Contractor != "" {
+		contractor, err := api.db.GetContractor(tx, account, job.Contractor)
+		if err != nil {
+			return label.Label{}, err
+		}
+		canLabel.Customer = contractor.Company
+	}
+
+	if job.Contact != nil {
+		contact, err := api.db.GetContact(tx, account, *job.Contact)
+		if err != nil {
+			return label.Label{}, err
+		}
+
+		if canLabel.Customer == "" {
+			canLabel.Customer = contact.Name
+		} else {
+			canLabel.Customer = fmt.Sprintf("%s; %s", canLabel.Customer, contact.Name)
+		}
+	}
+
+	return canLabel, nil
+}
+
+// mixLabel gathers everything printed on the label of a can filled by a mix of the formula given. The mix's code is
+// printed in place of the formula's so that scanning the can brings up the mix.
+func (api *API) mixLabel(tx *sqlx.Tx, account, id, mixID string) (label.Label, error) {
+	mix, err := api.db.GetMix(tx, account, mixID)
+	if err != nil {
+		return label.Label{}, err
+	}
+
+	if mix.Formula != id {
+		return label.Label{}, errMixOfOtherFormula
+	}
+
+	container, err := dispenser.ParseContainer(mix.Container)
+	if err != nil {
+		return label.Label{}, err
+	}
+
+	canLabel, err := api.formulaLabel(tx, account, id, mix.Job, container)
+	if err != nil {
+		return label.Label{}, err
+	}
+
+	code, err := api.entityCode(tx, account, models.EntityKindMix, mix.ID)
+	if err != nil {
+		return label.Label{}, err
+	}
+
+	canLabel.Code = code.Display()
+	canLabel.Link = api.codeLink(code.Code)
+	canLabel.Mixed = time.UnixMilli(mix.Created)
+
+	return canLabel, nil
+}
+
+// RenderLabel renders the label stuck on a can mixed from a formula.
+func (api *API) RenderLabel(ctx context.Context, request *proto.RenderLabelRequest) (*proto.RenderLabelResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.RenderLabelResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Id == "" {
+		return &proto.RenderLabelResponse{}, status.Error(codes.FailedPrecondition, "formula id required")
+	}
+
+	format, present := labelFormats[request.Format]
+	if !present {
+		return &proto.RenderLabelResponse{}, status.Error(codes.FailedPrecondition, "unknown label format")
+	}
+
+	container, err := requestContainer(request.Container)
+	if err != nil {
+		return &proto.RenderLabelResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	var canLabel label.Label
+	err = api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		if request.Mix != "" {
+			canLabel, err = api.mixLabel(tx, account, request.Id, request.Mix)
+			return err
+		}
+
+		canLabel, err = api.formulaLabel(tx, account, request.Id, request.Job, container)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.RenderLabelResponse{}, status.Error(codes.NotFound, "formula, job or mix requested not found")
+		}
+		if errors.Is(err, errMixOfOtherFormula) {
+			return &proto.RenderLabelResponse{}, status.Error(codes.FailedPrecondition,
+				"mix requested is of a different formula")
+		}
+		if errors.Is(err, errAmbiguousJob) {
+			return &proto.RenderLabelResponse{}, status.Error(codes.FailedPrecondition,
+				"formula belongs to more than one job; name the job the paint was mixed for")
+		}
+		var scaleErr *scaleError
+		if errors.As(err, &scaleErr) {
+			return &proto.RenderLabelResponse{}, status.Errorf(codes.FailedPrecondition,
+				"could not render label; %v", scaleErr)
+		}
+		log.Error().Err(err).Msg("could not render label")
+		return &proto.RenderLabelResponse{}, status.Error(codes.Internal, "could not render label")
+	}
+
+	var content bytes.Buffer
+	err = label.Render(&content, format, canLabel)
+	if err != nil {
+		log.Error().Err(err).Msg("could not render label")
+		return &proto.RenderLabelResponse{}, status.Error(codes.Internal, "could not render label")
+	}
+
+	return &proto.RenderLabelResponse{
+		Content:     content.Bytes(),
+		ContentType: format.ContentType(),
+		FileName:    fmt.Sprintf("%s-label.%s", request.Id, format),
+	}, nil
+}
+
+// handleLabel serves labels over plain HTTP so that they can be opened straight from a browser or sent to a printer
+// with curl:
+//
+//	GET /api/formulas/{id}/label?format=zpl&job=<id>&container=1qt&mix=<id>
+//
+// Requests are authenticated with the same bearer token as the gRPC API.
+func (api *API) handleLabel(w http.ResponseWriter, r *http.Request) {
+	md := metadata.Pairs("authorization", r.Header.Get("Authorization"))
+	ctx, err := api.authenticate(metadata.NewIncomingContext(r.Context(), md))
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+
+	format := proto.LabelFormat_PDF
+	if query.Get("format") != "" {
+		value, present := proto.LabelFormat_value[strings.ToUpper(query.Get("format"))]
+		if !present {
+			http.Error(w, fmt.Sprintf("unknown label format %q", query.Get("format")), http.StatusBadRequest)
+			return
+		}
+		format = proto.LabelFormat(value)
+	}
+
+	resp, err := api.RenderLabel(ctx, &proto.RenderLabelRequest{
+		Id:        mux.Vars(r)["id"],
+		Format:    format,
+		Job:       query.Get("job"),
+		Container: query.Get("container"),
+		Mix:       query.Get("mix"),
+	})
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), httpStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", resp.FileName))
+	_, _ = w.Write(resp.Content)
+}
@@ -0,0 +1,259 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/dispenser"
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requestContainer parses the container size of a request, defaulting to a gallon if none was given.
+func requestContainer(container string) (dispenser.Container, error) {
+	if strings.TrimSpace(container) == "" {
+		return dispenser.Gallon, nil
+	}
+
+	return dispenser.ParseContainer(container)
+}
+
+// scaleError explains why an ingredient's amount, which is kept for a gallon, can't be given for another container.
+type scaleError struct {
+	reason string
+}
+
+func (e *scaleError) Error() string {
+	return e.reason
+}
+
+// scaleColorantAmount scales the amount of a colorant to the container given. Amounts which can't be read are fine
+// for a gallon as they are, but would be wrong for any other container.
+func scaleColorantAmount(colorant formulaIngredient, container dispenser.Container) (string, error) {
+	if container == dispenser.Gallon {
+		return colorant.Amount, nil
+	}
+
+	amount, err := dispenser.ParseAmount(colorant.Amount)
+	if err != nil {
+		return "", &scaleError{fmt.Sprintf("amount of colorant %s can't be scaled to %s; %v", colorant.Name,
+			container, err)}
+	}
+
+	return amount.Scale(dispenser.Gallon, container).String(), nil
+}
+
+// scaleBaseAmount scales the amount of a base, usually a can size itself, to the container given. As with colorants,
+// amounts which can't be read are only given for a gallon.
+func scaleBaseAmount(base formulaIngredient, container dispenser.Container) (string, error) {
+	if container == dispenser.Gallon {
+		return base.Amount, nil
+	}
+
+	amount, err := dispenser.ParseContainer(base.Amount)
+	if err != nil {
+		return "", &scaleError{fmt.Sprintf("amount of base %s can't be scaled to %s; %v", base.Name, container, err)}
+	}
+
+	return dispenser.Container(amount.Ounces() * container.Ounces() / dispenser.Gallon.Ounces()).String(), nil
+}
+
+// GetFormulaRecipe returns what goes into a can mixed from a formula. Amounts, which are kept per gallon, are scaled to
+// the container asked for; the recipe is refused if one of them can't be.
+func (api *API) GetFormulaRecipe(ctx context.Context, request *proto.GetFormulaRecipeRequest) (*proto.GetFormulaRecipeResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.GetFormulaRecipeResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Id == "" {
+		return &proto.GetFormulaRecipeResponse{}, status.Error(codes.FailedPrecondition, "formula id required")
+	}
+
+	container, err := requestContainer(request.Container)
+	if err != nil {
+		return &proto.GetFormulaRecipeResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	var bases, colorants []formulaIngredient
+	err = api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		_, err := api.db.GetFormula(tx, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		bases, colorants, err = api.listFormulaIngredients(tx, account, request.Id)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.GetFormulaRecipeResponse{}, status.Error(codes.NotFound, "formula requested not found")
+		}
+		log.Error().Err(err).Msg("could not retrieve formula recipe")
+		return &proto.GetFormulaRecipeResponse{}, status.Error(codes.Internal, "could not retrieve formula recipe")
+	}
+
+	response := &proto.GetFormulaRecipeResponse{
+		Container: container.String(),
+		Bases:     []*proto.RecipeIngredient{},
+		Colorants: []*proto.RecipeIngredient{},
+	}
+
+	for _, base := range bases {
+		// Bases are usually measured as a can size themselves; ex. "1 gal" of base makes a gallon.
+		amount, err := scaleBaseAmount(base, container)
+		if err != nil {
+			return &proto.GetFormulaRecipeResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		response.Bases = append(response.Bases, &proto.RecipeIngredient{
+			Id:           base.ID,
+			Name:         base.Name,
+			Manufacturer: base.Manufacturer,
+			Amount:       amount,
+		})
+	}
+
+	for _, colorant := range colorants {
+		amount, err := scaleColorantAmount(colorant, container)
+		if err != nil {
+			return &proto.GetFormulaRecipeResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		response.Colorants = append(response.Colorants, &proto.RecipeIngredient{
+			Id:           colorant.ID,
+			Name:         colorant.Name,
+			Manufacturer: colorant.Manufacturer,
+			Amount:       amount,
+		})
+	}
+
+	return response, nil
+}
+
+// RecordMix records that paint was mixed from a formula and gives the mix a code to print on the cans filled.
+func (api *API) RecordMix(ctx context.Context, request *proto.RecordMixRequest) (*proto.RecordMixResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.RecordMixResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Formula == "" {
+		return &proto.RecordMixResponse{}, status.Error(codes.FailedPrecondition, "formula id required")
+	}
+
+	if request.Quantity < 0 {
+		return &proto.RecordMixResponse{}, status.Error(codes.FailedPrecondition, "quantity cannot be negative")
+	}
+
+	quantity := request.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	container, err := requestContainer(request.Container)
+	if err != nil {
+		return &proto.RecordMixResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	mix := models.NewMix(account, request.Formula, request.Job, container.String(), quantity, getActorFromContext(ctx))
+	mix.Notes = request.Notes
+
+	err = api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		_, err := api.db.GetFormula(tx, account, mix.Formula)
+		if err != nil {
+			return err
+		}
+
+		if mix.Job != "" {
+			_, err = api.db.GetJob(tx, account, mix.Job)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = api.db.InsertMix(tx, mix.ToStorage())
+		if err != nil {
+			return err
+		}
+
+		err = api.recordAuditEvent(ctx, tx, account, models.EntityKindMix, mix.ID, nil, mix)
+		if err != nil {
+			return err
+		}
+
+		_, err = api.entityCode(tx, account, models.EntityKindMix, mix.ID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.RecordMixResponse{}, status.Error(codes.NotFound, "formula or job requested not found")
+		}
+		if errors.Is(err, storage.ErrEntityExists) {
+			return &proto.RecordMixResponse{}, status.Error(codes.AlreadyExists, "could not save mix; mix already exists")
+		}
+		log.Error().Err(err).Msg("could not save mix")
+		return &proto.RecordMixResponse{}, status.Error(codes.Internal, "could not save mix")
+	}
+
+	log.Info().Str("id", mix.ID).Str("formula", mix.Formula).Msg("mix recorded")
+
+	return &proto.RecordMixResponse{Mix: mix.ToProto()}, nil
+}
+
+// GetMix returns a single mix by id.
+func (api *API) GetMix(ctx context.Context, request *proto.GetMixRequest) (*proto.GetMixResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.GetMixResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Id == "" {
+		return &proto.GetMixResponse{}, status.Error(codes.FailedPrecondition, "id required")
+	}
+
+	mixRaw, err := api.db.GetMix(api.db, account, request.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.GetMixResponse{}, status.Error(codes.NotFound, "mix requested not found")
+		}
+		return &proto.GetMixResponse{}, status.Error(codes.Internal, "failed to retrieve mix from database")
+	}
+
+	mix := models.Mix{}
+	mix.FromStorage(&mixRaw)
+
+	return &proto.GetMixResponse{Mix: mix.ToProto()}, nil
+}
+
+// ListMixes returns the mixes recorded for an account, most recent first, optionally narrowed to a formula or job.
+func (api *API) ListMixes(ctx context.Context, request *proto.ListMixesRequest) (*proto.ListMixesResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.ListMixesResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	mixesRaw, err := api.db.ListMixes(api.db, account, storage.MixFilter{
+		Formula: request.Formula,
+		Job:     request.Job,
+	}, int(request.Offset), int(request.Limit))
+	if err != nil {
+		return &proto.ListMixesResponse{}, status.Error(codes.Internal, "failed to retrieve mixes from database")
+	}
+
+	protoMixes := []*proto.Mix{}
+	for _, mixRaw := range mixesRaw {
+		var mix models.Mix
+		mix.FromStorage(&mixRaw)
+		protoMixes = append(protoMixes, mix.ToProto())
+	}
+
+	return &proto.ListMixesResponse{Mixes: protoMixes}, nil
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/clintjedwards/basecoat/internal/dispenser"
+)
+
+func TestScaleColorantAmount(t *testing.T) {
+	quart := dispenser.Gallon / 4
+
+	tests := map[string]struct {
+		amount    string
+		container dispenser.Container
+		want      string
+		unscaled  bool
+	}{
+		"gallon":                  {amount: "2oz 24/48", container: dispenser.Gallon, want: "2oz 24/48"},
+		"quart":                   {amount: "2oz 24/48", container: quart, want: "30/48"},
+		"spaced unit":             {amount: "1.5 oz", container: quart, want: "18/48"},
+		"unreadable for a gallon": {amount: "a splash", container: dispenser.Gallon, want: "a splash"},
+		"unreadable for a quart":  {amount: "a splash", container: quart, unscaled: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := scaleColorantAmount(formulaIngredient{Name: "Lamp Black", Amount: tc.amount}, tc.container)
+			if tc.unscaled {
+				var scaleErr *scaleError
+				if !errors.As(err, &scaleErr) {
+					t.Fatalf("expected a scale error; got %q, %v", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tc.want {
+				t.Errorf("scaleColorantAmount(%q, %s) = %q; want %q", tc.amount, tc.container, got, tc.want)
+			}
+		})
+	}
+}
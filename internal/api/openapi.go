@@ -0,0 +1,301 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/clintjedwards/basecoat/proto"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The OpenAPI document of the JSON gateway is generated from the proto descriptors compiled into the server and the
+// gateway's routes, so it can't drift from either. Only the parts of OpenAPI 3.0 the gateway needs are modeled.
+
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+	Security   []map[string][]string                   `json:"security"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+type openAPIComponents struct {
+	Schemas         map[string]*openAPISchema         `json:"schemas"`
+	SecuritySchemes map[string]*openAPISecurityScheme `json:"securitySchemes"`
+}
+
+type openAPISecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Tags        []string                    `json:"tags"`
+	Description string                      `json:"description,omitempty"`
+	Security    *[]map[string][]string      `json:"security,omitempty"` // Empty for calls made without a token.
+	Parameters  []*openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIBody                `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required,omitempty"`
+	Schema   *openAPISchema `json:"schema"`
+}
+
+type openAPIBody struct {
+	Description string                       `json:"description,omitempty"`
+	Required    bool                         `json:"required,omitempty"`
+	Content     map[string]*openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Description          string                    `json:"description,omitempty"`
+	Enum                 []string                  `json:"enum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+const ndjsonDescription = "Newline delimited JSON; a document per message."
+
+// newOpenAPIDocument describes the given gateway routes.
+func newOpenAPIDocument(routes []gatewayRoute) *openAPIDocument {
+	version, _ := parseVersion(appVersion)
+	if version == "" {
+		version = appVersion
+	}
+
+	document := &openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title: "Basecoat",
+			Description: "The Basecoat API as JSON over HTTP. Every call of the gRPC API is available. Integers " +
+				"of 64 bits are sent as strings and enums by name, as in the proto3 JSON mapping. Requests made with " +
+				"an Idempotency-Key header are handled as in the gRPC API.",
+			Version: version,
+		},
+		Paths: map[string]map[string]*openAPIOperation{},
+		Components: openAPIComponents{
+			Schemas: map[string]*openAPISchema{
+				"Error": {
+					Type: "object",
+					Properties: map[string]*openAPISchema{
+						"code":    {Type: "integer", Description: "The gRPC status code; ex. 5."},
+						"status":  {Type: "string", Description: "The name of the gRPC status code; ex. \"NotFound\"."},
+						"message": {Type: "string"},
+					},
+				},
+			},
+			SecuritySchemes: map[string]*openAPISecurityScheme{
+				"bearer": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+		Security: []map[string][]string{{"bearer": {}}},
+	}
+
+	service := proto.File_basecoat_proto.Services().ByName(protoreflect.Name("Basecoat"))
+
+	for _, route := range routes {
+		method := service.Methods().ByName(protoreflect.Name(route.rpc))
+		path := gatewayPrefix + route.path
+
+		if document.Paths[path] == nil {
+			document.Paths[path] = map[string]*openAPIOperation{}
+		}
+		document.Paths[path][strings.ToLower(route.method)] = document.operation(route, method)
+	}
+
+	return document
+}
+
+func (d *openAPIDocument) operation(route gatewayRoute, method protoreflect.MethodDescriptor) *openAPIOperation {
+	fullMethod := "/" + proto.Basecoat_ServiceDesc.ServiceName + "/" + route.rpc
+	input := method.Input()
+
+	operation := &openAPIOperation{
+		OperationID: route.rpc,
+		Tags:        []string{strings.Split(strings.TrimPrefix(route.path, "/"), "/")[0]},
+		Responses: map[string]*openAPIResponse{
+			"200": {
+				Description: "OK",
+				Content: map[string]*openAPIMediaType{
+					"application/json": {Schema: d.messageSchema(method.Output())},
+				},
+			},
+			"default": {
+				Description: "Error",
+				Content: map[string]*openAPIMediaType{
+					"application/json": {Schema: &openAPISchema{Ref: "#/components/schemas/Error"}},
+				},
+			},
+		},
+	}
+
+	if slices.Contains(authlessMethods, fullMethod) {
+		operation.Security = &[]map[string][]string{}
+	}
+
+	if method.IsStreamingServer() {
+		operation.Description = "Responses are sent as they're made until the call ends or the caller hangs up."
+		operation.Responses["200"] = &openAPIResponse{
+			Description: "OK. " + ndjsonDescription + " An error after the first message ends the stream as " +
+				"{\"error\": Error}.",
+			Content: map[string]*openAPIMediaType{
+				"application/x-ndjson": {Schema: d.messageSchema(method.Output())},
+			},
+		}
+	}
+
+	pathParameters := route.pathParameters()
+	for _, name := range pathParameters {
+		operation.Parameters = append(operation.Parameters, &openAPIParameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   d.fieldSchema(input.Fields().ByName(protoreflect.Name(name))),
+		})
+	}
+
+	if slices.Contains(idempotentMethods, fullMethod) {
+		operation.Parameters = append(operation.Parameters, &openAPIParameter{
+			Name:   "Idempotency-Key",
+			In:     "header",
+			Schema: &openAPISchema{Type: "string"},
+		})
+	}
+
+	switch {
+	case method.IsStreamingClient():
+		operation.RequestBody = &openAPIBody{
+			Description: ndjsonDescription,
+			Required:    true,
+			Content: map[string]*openAPIMediaType{
+				"application/x-ndjson": {Schema: d.messageSchema(input)},
+			},
+		}
+	case route.hasBody():
+		if input.Fields().Len() > len(pathParameters) {
+			operation.RequestBody = &openAPIBody{
+				Description: "Path parameters take precedence over the same fields in the body.",
+				Content: map[string]*openAPIMediaType{
+					"application/json": {Schema: d.messageSchema(input)},
+				},
+			}
+		}
+	default:
+		for i := 0; i < input.Fields().Len(); i++ {
+			field := input.Fields().Get(i)
+			if field.Message() != nil || slices.Contains(pathParameters, string(field.Name())) {
+				continue
+			}
+
+			operation.Parameters = append(operation.Parameters, &openAPIParameter{
+				Name:   string(field.Name()),
+				In:     "query",
+				Schema: d.fieldSchema(field),
+			})
+		}
+	}
+
+	return operation
+}
+
+// messageSchema returns a reference to the schema of a message, adding it and every message and enum it uses to the
+// document's schemas.
+func (d *openAPIDocument) messageSchema(message protoreflect.MessageDescriptor) *openAPISchema {
+	name := openAPISchemaName(message.FullName())
+	ref := &openAPISchema{Ref: "#/components/schemas/" + name}
+
+	if _, present := d.Components.Schemas[name]; present {
+		return ref
+	}
+
+	schema := &openAPISchema{Type: "object", Properties: map[string]*openAPISchema{}}
+	// Added before its fields so that messages which refer to themselves terminate.
+	d.Components.Schemas[name] = schema
+
+	for i := 0; i < message.Fields().Len(); i++ {
+		field := message.Fields().Get(i)
+		schema.Properties[string(field.Name())] = d.fieldSchema(field)
+	}
+
+	return ref
+}
+
+func (d *openAPIDocument) enumSchema(enum protoreflect.EnumDescriptor) *openAPISchema {
+	name := openAPISchemaName(enum.FullName())
+
+	if _, present := d.Components.Schemas[name]; !present {
+		d.Components.Schemas[name] = &openAPISchema{Type: "string", Enum: enumNames(enum)}
+	}
+
+	return &openAPISchema{Ref: "#/components/schemas/" + name}
+}
+
+func (d *openAPIDocument) fieldSchema(field protoreflect.FieldDescriptor) *openAPISchema {
+	if field.IsMap() {
+		return &openAPISchema{Type: "object", AdditionalProperties: d.singularFieldSchema(field.MapValue())}
+	}
+
+	if field.IsList() {
+		return &openAPISchema{Type: "array", Items: d.singularFieldSchema(field)}
+	}
+
+	return d.singularFieldSchema(field)
+}
+
+// singularFieldSchema returns the schema of a single value of a field as it's written by the proto3 JSON mapping.
+func (d *openAPIDocument) singularFieldSchema(field protoreflect.FieldDescriptor) *openAPISchema {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return &openAPISchema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &openAPISchema{Type: "integer"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &openAPISchema{Type: "string", Format: "int64"}
+	case protoreflect.FloatKind:
+		return &openAPISchema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &openAPISchema{Type: "number", Format: "double"}
+	case protoreflect.BytesKind:
+		return &openAPISchema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		return d.enumSchema(field.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return d.messageSchema(field.Message())
+	default:
+		return &openAPISchema{Type: "string"}
+	}
+}
+
+// openAPISchemaName names the schema of a message or enum after it, without the proto package; ex.
+// "GenerateJobReportRequest.Format".
+func openAPISchemaName(name protoreflect.FullName) string {
+	return strings.TrimPrefix(string(name), "proto.")
+}
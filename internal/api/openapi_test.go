@@ -0,0 +1,150 @@
+package api
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+// schemaRefs returns every reference made by a schema and the schemas inside it.
+func schemaRefs(schema *openAPISchema) []string {
+	if schema == nil {
+		return nil
+	}
+
+	refs := []string{}
+	if schema.Ref != "" {
+		refs = append(refs, schema.Ref)
+	}
+
+	refs = append(refs, schemaRefs(schema.Items)...)
+	refs = append(refs, schemaRefs(schema.AdditionalProperties)...)
+	for _, property := range schema.Properties {
+		refs = append(refs, schemaRefs(property)...)
+	}
+
+	return refs
+}
+
+// operationRefs returns every reference made by an operation's parameters, body and responses.
+func operationRefs(operation *openAPIOperation) []string {
+	refs := []string{}
+
+	for _, parameter := range operation.Parameters {
+		refs = append(refs, schemaRefs(parameter.Schema)...)
+	}
+
+	if operation.RequestBody != nil {
+		for _, media := range operation.RequestBody.Content {
+			refs = append(refs, schemaRefs(media.Schema)...)
+		}
+	}
+
+	for _, response := range operation.Responses {
+		for _, media := range response.Content {
+			refs = append(refs, schemaRefs(media.Schema)...)
+		}
+	}
+
+	return refs
+}
+
+func TestOpenAPIDocumentValid(t *testing.T) {
+	g, err := newGateway(&API{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The document served is checked rather than the one generated so that anything lost in encoding it is caught.
+	document := openAPIDocument{}
+	err = json.Unmarshal(g.openAPI, &document)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(document.OpenAPI, "3.0.") || document.Info.Title == "" || document.Info.Version == "" {
+		t.Errorf("document is missing its version or info: %s %+v", document.OpenAPI, document.Info)
+	}
+
+	for _, requirement := range document.Security {
+		for scheme := range requirement {
+			if document.Components.SecuritySchemes[scheme] == nil {
+				t.Errorf("security requirement names undefined scheme %q", scheme)
+			}
+		}
+	}
+
+	refs := []string{}
+	for _, schema := range document.Components.Schemas {
+		refs = append(refs, schemaRefs(schema)...)
+	}
+
+	operationIDs := map[string]bool{}
+	for path, operations := range document.Paths {
+		declared := gatewayRoute{path: path}.pathParameters()
+
+		for method, operation := range operations {
+			if operationIDs[operation.OperationID] {
+				t.Errorf("operation id %q is used more than once", operation.OperationID)
+			}
+			operationIDs[operation.OperationID] = true
+
+			if operation.Responses["200"] == nil || operation.Responses["default"] == nil {
+				t.Errorf("%s %s is missing its OK or error response", method, path)
+			}
+
+			inPath := []string{}
+			for _, parameter := range operation.Parameters {
+				if parameter.Schema == nil {
+					t.Errorf("%s %s parameter %q has no schema", method, path, parameter.Name)
+				}
+
+				switch parameter.In {
+				case "path":
+					if !parameter.Required {
+						t.Errorf("%s %s path parameter %q must be required", method, path, parameter.Name)
+					}
+					inPath = append(inPath, parameter.Name)
+				case "query", "header":
+				default:
+					t.Errorf("%s %s parameter %q is in unknown location %q", method, path, parameter.Name, parameter.In)
+				}
+			}
+
+			if !slices.Equal(declared, inPath) {
+				t.Errorf("%s %s declares path parameters %v; expected %v", method, path, inPath, declared)
+			}
+
+			if (method == "get" || method == "delete") && operation.RequestBody != nil {
+				t.Errorf("%s %s can't have a request body", method, path)
+			}
+
+			refs = append(refs, operationRefs(operation)...)
+		}
+	}
+
+	for _, ref := range refs {
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+		if name == ref || document.Components.Schemas[name] == nil {
+			t.Errorf("reference %q doesn't resolve to a schema", ref)
+		}
+	}
+
+	for _, route := range gatewayRoutes {
+		operation := document.Paths[gatewayPrefix+route.path][strings.ToLower(route.method)]
+		if operation == nil || operation.OperationID != route.rpc {
+			t.Errorf("route %s %s for %s is not described", route.method, route.path, route.rpc)
+		}
+	}
+
+	if document.Paths[gatewayPrefix+"/events"]["get"].Responses["200"].Content["application/x-ndjson"] == nil {
+		t.Error("expected streamed responses to be described as newline delimited JSON")
+	}
+
+	kind := document.Components.Schemas["EntityKind"]
+	if kind == nil || kind.Type != "string" || !slices.Contains(kind.Enum, "MIX") {
+		t.Errorf("expected enums to be described by name; got %+v", kind)
+	}
+}
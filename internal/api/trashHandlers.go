@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errHasDependents is returned from inside a delete transaction when other entities still depend on the entity being
+// deleted and the caller did not ask to force the delete.
+var errHasDependents = errors.New("entity has dependents")
+
+// dependentsError describes the entities that prevented a delete.
+func dependentsError(entity, dependentKind string, ids []string) error {
+	return status.Errorf(codes.FailedPrecondition,
+		"could not delete %s; still used by %d %s (%s); set force to delete anyway or dry_run to see the full impact",
+		entity, len(ids), dependentKind, strings.Join(ids, ", "))
+}
+
+// ListDeleted returns the entities currently in the trash; most recently deleted first.
+func (api *API) ListDeleted(ctx context.Context, request *proto.ListDeletedRequest) (*proto.ListDeletedResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.ListDeletedResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	kind := ""
+	if request.Kind != proto.EntityKind_ENTITY_KIND_UNKNOWN {
+		kind = request.Kind.String()
+	}
+
+	deletedRaw, err := listAll(func(offset int) ([]storage.DeletedEntity, error) {
+		return api.db.ListDeleted(api.db, account, kind, offset, 0)
+	})
+	if err != nil {
+		return &proto.ListDeletedResponse{}, status.Error(codes.Internal, "failed to retrieve deleted entities from database")
+	}
+
+	protoDeleted := []*proto.DeletedEntity{}
+	for _, deletedRaw := range deletedRaw {
+		var deleted models.DeletedEntity
+		deleted.FromStorage(&deletedRaw)
+		protoDeleted = append(protoDeleted, deleted.ToProto())
+	}
+
+	return &proto.ListDeletedResponse{Entities: protoDeleted}, nil
+}
+
+// Undelete restores an entity from the trash.
+func (api *API) Undelete(ctx context.Context, request *proto.UndeleteRequest) (*proto.UndeleteResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.UndeleteResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Kind == proto.EntityKind_ENTITY_KIND_UNKNOWN {
+		return &proto.UndeleteResponse{}, status.Error(codes.FailedPrecondition, "entity kind required")
+	}
+
+	if request.Id == "" {
+		return &proto.UndeleteResponse{}, status.Error(codes.FailedPrecondition, "id required")
+	}
+
+	err := api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		err := api.db.Undelete(tx, request.Kind.String(), account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		kind := models.EntityKind(request.Kind.String())
+
+		after, err := api.getAuditEntity(tx, kind, account, request.Id)
+		if err != nil {
+			return err
+		}
+
+		return api.recordAuditEvent(ctx, tx, account, kind, request.Id, nil, after)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.UndeleteResponse{}, status.Error(codes.NotFound, "could not restore entity; entity not found in trash")
+		}
+		if errors.Is(err, storage.ErrPreconditionFailure) {
+			return &proto.UndeleteResponse{}, status.Error(codes.FailedPrecondition, "entity kind cannot be restored")
+		}
+
+		log.Error().Err(err).Msg("could not restore entity")
+		return &proto.UndeleteResponse{}, status.Error(codes.Internal, "could not restore entity")
+	}
+
+	switch request.Kind {
+	case proto.EntityKind_FORMULA:
+		api.search.UpdateFormulaIndex(account, request.Id)
+	case proto.EntityKind_JOB:
+		api.search.UpdateJobIndex(account, request.Id)
+	}
+
+	log.Debug().Str("kind", request.Kind.String()).Str("id", request.Id).Msg("entity restored from trash")
+	return &proto.UndeleteResponse{}, nil
+}
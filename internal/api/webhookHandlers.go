@@ -0,0 +1,310 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateWebhookURL makes sure deliveries can be posted to the url given.
+func validateWebhookURL(raw string) error {
+	if raw == "" {
+		return fmt.Errorf("webhook url required")
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("could not parse webhook url: %v", err)
+	}
+
+	if (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("webhook url must be an absolute http or https url")
+	}
+
+	return nil
+}
+
+// validateWebhookEvents makes sure every event type given can be subscribed to and drops duplicates.
+func validateWebhookEvents(events []string) ([]string, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("at least one event type required")
+	}
+
+	known := models.WebhookEventTypes()
+
+	validated := []string{}
+	for _, event := range events {
+		event = strings.ToLower(strings.TrimSpace(event))
+		if !slices.Contains(known, event) {
+			return nil, fmt.Errorf("unknown event type %q; must be an entity kind and created, updated or deleted, "+
+				"ex. \"job.updated\"", event)
+		}
+
+		if !slices.Contains(validated, event) {
+			validated = append(validated, event)
+		}
+	}
+
+	return validated, nil
+}
+
+// GetWebhook returns a single webhook by id; its secret is never returned.
+func (api *API) GetWebhook(ctx context.Context, request *proto.GetWebhookRequest) (*proto.GetWebhookResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.GetWebhookResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Id == "" {
+		return &proto.GetWebhookResponse{}, status.Error(codes.FailedPrecondition, "id required")
+	}
+
+	webhookRaw, err := api.db.GetWebhook(api.db, account, request.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.GetWebhookResponse{}, status.Error(codes.NotFound, "webhook requested not found")
+		}
+		log.Error().Err(err).Msg("could not retrieve webhook")
+		return &proto.GetWebhookResponse{}, status.Error(codes.Internal, "failed to retrieve webhook from database")
+	}
+
+	webhook := models.Webhook{}
+	webhook.FromStorage(&webhookRaw)
+
+	return &proto.GetWebhookResponse{Webhook: webhook.ToProto()}, nil
+}
+
+// ListWebhooks returns every webhook of the account.
+func (api *API) ListWebhooks(ctx context.Context, _ *proto.ListWebhooksRequest) (*proto.ListWebhooksResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.ListWebhooksResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	webhooksRaw, err := api.db.ListWebhooks(api.db, account)
+	if err != nil {
+		log.Error().Err(err).Msg("could not retrieve webhooks")
+		return &proto.ListWebhooksResponse{}, status.Error(codes.Internal, "failed to retrieve webhooks from database")
+	}
+
+	protoWebhooks := []*proto.Webhook{}
+	for _, webhookRaw := range webhooksRaw {
+		var webhook models.Webhook
+		webhook.FromStorage(&webhookRaw)
+		protoWebhooks = append(protoWebhooks, webhook.ToProto())
+	}
+
+	return &proto.ListWebhooksResponse{Webhooks: protoWebhooks}, nil
+}
+
+// CreateWebhook subscribes a url to the account's events of the types given. The secret deliveries are signed with is
+// generated if not given and only ever returned here.
+func (api *API) CreateWebhook(ctx context.Context, request *proto.CreateWebhookRequest) (*proto.CreateWebhookResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.CreateWebhookResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	err := validateWebhookURL(request.Url)
+	if err != nil {
+		return &proto.CreateWebhookResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	events, err := validateWebhookEvents(request.Events)
+	if err != nil {
+		return &proto.CreateWebhookResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	secret := request.Secret
+	if secret == "" {
+		secret, err = models.NewWebhookSecret()
+		if err != nil {
+			log.Error().Err(err).Msg("could not generate webhook secret")
+			return &proto.CreateWebhookResponse{}, status.Error(codes.Internal, "could not generate webhook secret")
+		}
+	}
+
+	webhook := models.NewWebhook(account, request.Url, events, secret)
+
+	err = api.insideTx(ctx, func(tx *sqlx.Tx) error {
+		return api.db.InsertWebhook(tx, webhook.ToStorage())
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityExists) {
+			return &proto.CreateWebhookResponse{}, status.Error(codes.AlreadyExists, "could not save webhook; webhook already exists")
+		}
+		log.Error().Err(err).Msg("could not save webhook")
+		return &proto.CreateWebhookResponse{}, status.Error(codes.Internal, "could not save webhook")
+	}
+
+	log.Info().Str("id", webhook.ID).Str("url", webhook.URL).Strs("events", webhook.Events).Msg("webhook created")
+
+	return &proto.CreateWebhookResponse{Webhook: webhook.ToProto(), Secret: secret}, nil
+}
+
+// UpdateWebhook changes the url, event types or secret of a webhook.
+func (api *API) UpdateWebhook(ctx context.Context, request *proto.UpdateWebhookRequest) (*proto.UpdateWebhookResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.UpdateWebhookResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Id == "" {
+		return &proto.UpdateWebhookResponse{}, status.Error(codes.FailedPrecondition, "webhook id required")
+	}
+
+	fields := storage.UpdatableWebhookFields{
+		URL:      request.Url,
+		Secret:   request.Secret,
+		Modified: ptr(time.Now().UnixMilli()),
+	}
+
+	if request.Url != nil {
+		err := validateWebhookURL(*request.Url)
+		if err != nil {
+			return &proto.UpdateWebhookResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if len(request.Events) > 0 {
+		events, err := validateWebhookEvents(request.Events)
+		if err != nil {
+			return &proto.UpdateWebhookResponse{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		fields.Events = ptr(strings.Join(events, ","))
+	}
+
+	if request.Secret != nil && *request.Secret == "" {
+		return &proto.UpdateWebhookResponse{}, status.Error(codes.InvalidArgument, "webhook secret can't be empty")
+	}
+
+	err := api.db.UpdateWebhook(api.db, account, request.Id, fields)
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.UpdateWebhookResponse{}, status.Error(codes.NotFound, "webhook requested not found")
+		}
+		log.Error().Err(err).Msg("could not save webhook")
+		return &proto.UpdateWebhookResponse{}, status.Error(codes.Internal, "could not save webhook")
+	}
+
+	webhookRaw, err := api.db.GetWebhook(api.db, account, request.Id)
+	if err != nil {
+		log.Error().Err(err).Msg("could not retrieve webhook")
+		return &proto.UpdateWebhookResponse{}, status.Error(codes.Internal, "failed to retrieve webhook from database")
+	}
+
+	webhook := models.Webhook{}
+	webhook.FromStorage(&webhookRaw)
+
+	log.Debug().Str("id", request.Id).Msg("webhook updated")
+	return &proto.UpdateWebhookResponse{Webhook: webhook.ToProto()}, nil
+}
+
+// DeleteWebhook removes a webhook along with its delivery log; deliveries not yet made are dropped.
+func (api *API) DeleteWebhook(ctx context.Context, request *proto.DeleteWebhookRequest) (*proto.DeleteWebhookResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.DeleteWebhookResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Id == "" {
+		return &proto.DeleteWebhookResponse{}, status.Error(codes.FailedPrecondition, "webhook id required")
+	}
+
+	err := api.db.DeleteWebhook(api.db, account, request.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.DeleteWebhookResponse{}, status.Error(codes.NotFound, "could not delete webhook; webhook not found")
+		}
+		log.Error().Err(err).Msg("could not delete webhook")
+		return &proto.DeleteWebhookResponse{}, status.Error(codes.Internal, "could not delete webhook")
+	}
+
+	log.Info().Str("id", request.Id).Msg("webhook deleted")
+	return &proto.DeleteWebhookResponse{}, nil
+}
+
+// ListWebhookDeliveries returns a webhook's delivery log; newest deliveries first.
+func (api *API) ListWebhookDeliveries(ctx context.Context, request *proto.ListWebhookDeliveriesRequest) (
+	*proto.ListWebhookDeliveriesResponse, error,
+) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.ListWebhookDeliveriesResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Webhook == "" {
+		return &proto.ListWebhookDeliveriesResponse{}, status.Error(codes.FailedPrecondition, "webhook id required")
+	}
+
+	_, err := api.db.GetWebhook(api.db, account, request.Webhook)
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.ListWebhookDeliveriesResponse{}, status.Error(codes.NotFound, "webhook requested not found")
+		}
+		log.Error().Err(err).Msg("could not retrieve webhook")
+		return &proto.ListWebhookDeliveriesResponse{}, status.Error(codes.Internal, "failed to retrieve webhook from database")
+	}
+
+	deliveriesRaw, err := api.db.ListWebhookDeliveries(api.db, account, request.Webhook, int(request.Offset),
+		int(request.Limit))
+	if err != nil {
+		log.Error().Err(err).Msg("could not retrieve webhook deliveries")
+		return &proto.ListWebhookDeliveriesResponse{}, status.Error(codes.Internal,
+			"failed to retrieve webhook deliveries from database")
+	}
+
+	protoDeliveries := []*proto.WebhookDelivery{}
+	for _, deliveryRaw := range deliveriesRaw {
+		var delivery models.WebhookDelivery
+		delivery.FromStorage(&deliveryRaw)
+		protoDeliveries = append(protoDeliveries, delivery.ToProto())
+	}
+
+	return &proto.ListWebhookDeliveriesResponse{Deliveries: protoDeliveries}, nil
+}
+
+// TestWebhook posts a ping to a webhook right away and returns how it went. A webhook which can't be reached or fails
+// the ping isn't an error; the delivery returned says why it failed.
+func (api *API) TestWebhook(ctx context.Context, request *proto.TestWebhookRequest) (*proto.TestWebhookResponse, error) {
+	account, present := getAccountFromContext(ctx)
+	if !present {
+		return &proto.TestWebhookResponse{}, status.Error(codes.FailedPrecondition, "account required")
+	}
+
+	if request.Id == "" {
+		return &proto.TestWebhookResponse{}, status.Error(codes.FailedPrecondition, "webhook id required")
+	}
+
+	webhookRaw, err := api.db.GetWebhook(api.db, account, request.Id)
+	if err != nil {
+		if errors.Is(err, storage.ErrEntityNotFound) {
+			return &proto.TestWebhookResponse{}, status.Error(codes.NotFound, "webhook requested not found")
+		}
+		log.Error().Err(err).Msg("could not retrieve webhook")
+		return &proto.TestWebhookResponse{}, status.Error(codes.Internal, "failed to retrieve webhook from database")
+	}
+
+	webhook := models.Webhook{}
+	webhook.FromStorage(&webhookRaw)
+
+	delivery, err := api.webhooks.Ping(ctx, &webhook)
+	if err != nil {
+		log.Error().Err(err).Str("id", webhook.ID).Msg("could not test webhook")
+		return &proto.TestWebhookResponse{}, status.Error(codes.Internal, "could not test webhook")
+	}
+
+	return &proto.TestWebhookResponse{Delivery: delivery.ToProto()}, nil
+}
@@ -20,9 +20,44 @@ func StartServices(config *config.API) {
 		log.Fatal().Err(err).Msg("could not init storage")
 	}
 
-	log.Info().Str("path", config.Server.StoragePath).Int("max_results_limit", config.Server.StorageResultsLimit).
+	log.Info().Str("engine", string(newStorage.Engine())).Int("max_results_limit", config.Server.StorageResultsLimit).
 		Msg("storage initialized")
 
+	if config.Backup.Enable && newStorage.Engine() != storage.EngineSQLite {
+		log.Fatal().Str("engine", string(newStorage.Engine())).
+			Msg("scheduled backups are only supported for sqlite; back up postgres with pg_dump instead")
+	}
+
+	if config.Backup.Enable {
+		go runScheduledBackups(newStorage, config.Backup)
+		log.Info().Str("path", config.Backup.Path).Dur("interval", config.Backup.Interval).
+			Msg("scheduled backups enabled")
+	}
+
+	if config.Backup.Enable && config.Backup.WALPath != "" {
+		go runWALShipping(newStorage.(*storage.SQLite), config.Backup)
+		log.Info().Str("path", config.Backup.WALPath).Dur("interval", config.Backup.WALInterval).
+			Msg("write-ahead log shipping enabled")
+	}
+
+	if config.Trash.Retention > 0 {
+		go runTrashPurge(newStorage, config.Trash)
+		log.Info().Dur("retention", config.Trash.Retention).Dur("interval", config.Trash.PurgeInterval).
+			Msg("trash purge enabled")
+	}
+
+	if config.Idempotency.Window > 0 {
+		go runIdempotencyKeyPurge(newStorage, config.Idempotency)
+		log.Info().Dur("window", config.Idempotency.Window).Dur("interval", config.Idempotency.PurgeInterval).
+			Msg("idempotency key purge enabled")
+	}
+
+	if config.Webhooks.Retention > 0 {
+		go runWebhookDeliveryPurge(newStorage, config.Webhooks)
+		log.Info().Dur("retention", config.Webhooks.Retention).Dur("interval", config.Webhooks.PurgeInterval).
+			Msg("webhook delivery purge enabled")
+	}
+
 	newAPI, err := api.NewAPI(config, newStorage)
 	if err != nil {
 		log.Fatal().Err(err).Msg("could not init api")
@@ -33,5 +68,12 @@ func StartServices(config *config.API) {
 
 // initStorage creates a storage object with the appropriate engine
 func initStorage(config *config.Server) (storage.DB, error) {
-	return storage.New(config.StoragePath, config.StorageResultsLimit)
+	engine := storage.Engine(config.StorageEngine)
+
+	switch engine {
+	case storage.EnginePostgres:
+		return storage.New(engine, config.StorageDSN, config.StorageResultsLimit)
+	default:
+		return storage.New(engine, config.StoragePath, config.StorageResultsLimit)
+	}
 }
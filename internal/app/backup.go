@@ -0,0 +1,88 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/schedule"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// runScheduledBackups takes a backup of the database every interval and then removes any backups that fall outside
+// of the configured retention rules. It blocks forever and should be run in a goroutine.
+func runScheduledBackups(db storage.DB, config *config.Backup) {
+	err := os.MkdirAll(config.Path, 0o700)
+	if err != nil {
+		log.Error().Err(err).Str("path", config.Path).Msg("could not create backup directory; scheduled backups disabled")
+		return
+	}
+
+	schedule.Every(config.Interval, func() {
+		takeBackup(db, config)
+	})
+}
+
+func takeBackup(db storage.DB, config *config.Backup) {
+	start := time.Now()
+	path := filepath.Join(config.Path, storage.BackupName(start))
+
+	err := db.Backup(path)
+	if err != nil {
+		log.Error().Err(err).Str("path", path).Msg("could not take scheduled backup")
+		return
+	}
+
+	err = storage.VerifyIntegrity(path)
+	if err != nil {
+		log.Error().Err(err).Str("path", path).Msg("scheduled backup failed verification; removing")
+		_ = os.Remove(path)
+		return
+	}
+
+	log.Info().Str("path", path).Dur("elapsed_ms", time.Since(start)).Msg("took scheduled backup")
+
+	removed, err := storage.PruneBackups(config.Path, config.RetentionCount, config.RetentionPeriod, time.Now())
+	if err != nil {
+		log.Error().Err(err).Str("path", config.Path).Msg("could not prune old backups")
+		return
+	}
+
+	for _, path := range removed {
+		log.Debug().Str("path", path).Msg("removed expired backup")
+	}
+}
+
+// runWALShipping ships the database's write-ahead log every interval, so that it can be restored to any point in time,
+// and then removes shipped log which has outlived the backup retention period. It blocks forever and should be run in
+// a goroutine.
+func runWALShipping(db *storage.SQLite, config *config.Backup) {
+	err := os.MkdirAll(config.WALPath, 0o700)
+	if err != nil {
+		log.Error().Err(err).Str("path", config.WALPath).
+			Msg("could not create write-ahead log directory; write-ahead log shipping disabled")
+		return
+	}
+
+	shipper := storage.NewWALShipper(db, config.WALPath)
+
+	schedule.Every(config.WALInterval, func() {
+		err := shipper.Ship(time.Now())
+		if err != nil {
+			log.Error().Err(err).Str("path", config.WALPath).Msg("could not ship write-ahead log")
+			return
+		}
+
+		removed, err := storage.PruneWALGenerations(config.WALPath, config.RetentionPeriod, time.Now())
+		if err != nil {
+			log.Error().Err(err).Str("path", config.WALPath).Msg("could not prune old write-ahead log")
+			return
+		}
+
+		for _, path := range removed {
+			log.Debug().Str("path", path).Msg("removed expired write-ahead log generation")
+		}
+	})
+}
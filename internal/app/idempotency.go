@@ -0,0 +1,32 @@
+package app
+
+import (
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/schedule"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// runIdempotencyKeyPurge removes idempotency keys which have outlived the configured window. It blocks forever and
+// should be run in a goroutine.
+func runIdempotencyKeyPurge(db storage.DB, config *config.Idempotency) {
+	schedule.Every(config.PurgeInterval, func() {
+		purgeIdempotencyKeys(db, config)
+	})
+}
+
+func purgeIdempotencyKeys(db storage.DB, config *config.Idempotency) {
+	before := time.Now().Add(-config.Window).UnixMilli()
+
+	purged, err := db.PurgeIdempotencyKeys(db, before)
+	if err != nil {
+		log.Error().Err(err).Msg("could not purge idempotency keys")
+		return
+	}
+
+	if purged > 0 {
+		log.Debug().Int64("purged", purged).Msg("removed expired idempotency keys")
+	}
+}
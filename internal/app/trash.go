@@ -0,0 +1,32 @@
+package app
+
+import (
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/schedule"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// runTrashPurge permanently removes entities which have been in the trash for longer than the configured retention
+// period. It blocks forever and should be run in a goroutine.
+func runTrashPurge(db storage.DB, config *config.Trash) {
+	schedule.Every(config.PurgeInterval, func() {
+		purgeTrash(db, config)
+	})
+}
+
+func purgeTrash(db storage.DB, config *config.Trash) {
+	before := time.Now().Add(-config.Retention).UnixMilli()
+
+	purged, err := db.PurgeDeleted(db, before)
+	if err != nil {
+		log.Error().Err(err).Msg("could not purge trash")
+		return
+	}
+
+	if purged > 0 {
+		log.Info().Int64("purged", purged).Msg("permanently removed expired entities from trash")
+	}
+}
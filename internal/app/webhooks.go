@@ -0,0 +1,32 @@
+package app
+
+import (
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/schedule"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/rs/zerolog/log"
+)
+
+// runWebhookDeliveryPurge removes webhook deliveries which are done with and have outlived the configured retention.
+// It blocks forever and should be run in a goroutine.
+func runWebhookDeliveryPurge(db storage.DB, config *config.Webhooks) {
+	schedule.Every(config.PurgeInterval, func() {
+		purgeWebhookDeliveries(db, config)
+	})
+}
+
+func purgeWebhookDeliveries(db storage.DB, config *config.Webhooks) {
+	before := time.Now().Add(-config.Retention).UnixMilli()
+
+	purged, err := db.PurgeWebhookDeliveries(db, before)
+	if err != nil {
+		log.Error().Err(err).Msg("could not purge webhook deliveries")
+		return
+	}
+
+	if purged > 0 {
+		log.Debug().Int64("purged", purged).Msg("removed expired webhook deliveries")
+	}
+}
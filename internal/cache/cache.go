@@ -0,0 +1,365 @@
+// Package cache keeps a local copy of an account's formulas, bases and colorants so the command line can still show
+// them when the server can't be reached, along with a journal of changes made meanwhile to be sent once it can be.
+//
+// The copy is a SQLite database in which every record and change is sealed with AES-GCM; only the ids and versions
+// used to tell what changed between syncs are left readable. Each server and account pair gets its own snapshot and
+// journal, named by a scope (see Scope), so switching profiles never shows another account's formulas or sends its
+// changes to the wrong account.
+package cache
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // The sqlite driver registers itself.
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNotCached is returned when the record or snapshot asked for isn't in the cache.
+var ErrNotCached = errors.New("not cached")
+
+// Kind is the kind of record kept in the cache.
+type Kind string
+
+const (
+	KindFormula  Kind = "formula"  // A proto.Formula.
+	KindRecipe   Kind = "recipe"   // A proto.GetFormulaRecipeResponse for a gallon, keyed by formula id.
+	KindBase     Kind = "base"     // A proto.BaseMetadata.
+	KindColorant Kind = "colorant" // A proto.ColorantMetadata.
+
+	// Kinds of which only the version last shown is kept; see See.
+	KindContact    Kind = "contact"
+	KindContractor Kind = "contractor"
+	KindJob        Kind = "job"
+
+	kindChange Kind = "change" // A change kept in the journal; see Queue.
+)
+
+const saltSize = 16
+
+const schema = `
+CREATE TABLE IF NOT EXISTS settings (
+    name  TEXT NOT NULL,
+    value BLOB NOT NULL,
+    PRIMARY KEY (name)
+) STRICT;
+
+CREATE TABLE IF NOT EXISTS snapshots (
+    scope  TEXT    NOT NULL,
+    synced INTEGER NOT NULL,
+    PRIMARY KEY (scope)
+) STRICT;
+
+CREATE TABLE IF NOT EXISTS records (
+    scope   TEXT    NOT NULL,
+    kind    TEXT    NOT NULL,
+    id      TEXT    NOT NULL,
+    version INTEGER NOT NULL,
+    data    BLOB    NOT NULL,
+    PRIMARY KEY (scope, kind, id)
+) STRICT;
+
+CREATE TABLE IF NOT EXISTS seen (
+    scope   TEXT    NOT NULL,
+    kind    TEXT    NOT NULL,
+    id      TEXT    NOT NULL,
+    version INTEGER NOT NULL,
+    PRIMARY KEY (scope, kind, id)
+) STRICT;
+
+CREATE TABLE IF NOT EXISTS journal (
+    id           INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    scope        TEXT    NOT NULL,
+    key          TEXT    NOT NULL,
+    method       TEXT    NOT NULL,
+    request      BLOB    NOT NULL,
+    seen_version INTEGER NOT NULL,
+    state        TEXT    NOT NULL,
+    reason       TEXT    NOT NULL,
+    created      INTEGER NOT NULL
+) STRICT;
+`
+
+// KeyFunc returns the key the cache is encrypted with given the salt stored alongside it.
+type KeyFunc func(salt []byte) ([]byte, error)
+
+// Cache is the local copy kept by the command line.
+type Cache struct {
+	db  *sqlx.DB
+	gcm cipher.AEAD
+}
+
+// Open opens the cache kept at the path given, creating it if it doesn't exist yet. Only the user can read the file.
+func Open(path string, key KeyFunc) (*Cache, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sqlx.Connect("sqlite3", fmt.Sprintf("%s?_journal=wal&_timeout=5000", path))
+	if err != nil {
+		return nil, fmt.Errorf("could not open cache %s: %w", path, err)
+	}
+
+	err = os.Chmod(path, 0o600)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	_, err = db.Exec(schema)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("could not create cache %s: %w", path, err)
+	}
+
+	salt, err := loadSalt(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	secret, err := key(salt)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(secret)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Cache{db: db, gcm: gcm}, nil
+}
+
+// loadSalt returns the salt the cache's key is derived with, generating one the first time the cache is opened.
+func loadSalt(db *sqlx.DB) ([]byte, error) {
+	salt := []byte{}
+	err := db.Get(&salt, `SELECT value FROM settings WHERE name = 'salt'`)
+	if err == nil {
+		return salt, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("could not read cache: %w", err)
+	}
+
+	salt = make([]byte, saltSize)
+	_, err = rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = db.Exec(`INSERT INTO settings (name, value) VALUES ('salt', ?)`, salt)
+	if err != nil {
+		return nil, fmt.Errorf("could not write cache: %w", err)
+	}
+
+	return salt, nil
+}
+
+func (c *Cache) Close() error {
+	return c.db.Close()
+}
+
+// Scope names the snapshot of an account on a server. It's a hash so that the cache doesn't give away which servers
+// and accounts were used.
+func Scope(host, account string) string {
+	sum := sha256.Sum256([]byte(host + "\x00" + account))
+	return hex.EncodeToString(sum[:])
+}
+
+// Synced returns when the snapshot of the scope given was last refreshed; ErrNotCached if it never was.
+func (c *Cache) Synced(scope string) (time.Time, error) {
+	var synced int64
+	err := c.db.Get(&synced, `SELECT synced FROM snapshots WHERE scope = ?`, scope)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, ErrNotCached
+		}
+
+		return time.Time{}, fmt.Errorf("could not read cache: %w", err)
+	}
+
+	return time.UnixMilli(synced), nil
+}
+
+// Get reads a single record into message.
+func (c *Cache) Get(scope string, kind Kind, id string, message proto.Message) error {
+	var data []byte
+	err := c.db.Get(&data, `SELECT data FROM records WHERE scope = ? AND kind = ? AND id = ?`, scope, kind, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotCached
+		}
+
+		return fmt.Errorf("could not read cache: %w", err)
+	}
+
+	return c.open(scope, kind, id, data, message)
+}
+
+// List returns every record of a kind, in no particular order.
+func List[M proto.Message](c *Cache, scope string, kind Kind, newMessage func() M) ([]M, error) {
+	rows := []struct {
+		ID   string `db:"id"`
+		Data []byte `db:"data"`
+	}{}
+
+	err := c.db.Select(&rows, `SELECT id, data FROM records WHERE scope = ? AND kind = ?`, scope, kind)
+	if err != nil {
+		return nil, fmt.Errorf("could not read cache: %w", err)
+	}
+
+	messages := []M{}
+	for _, row := range rows {
+		message := newMessage()
+		err = c.open(scope, kind, row.ID, row.Data, message)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, nil
+}
+
+// See keeps the versions of records last shown to the user, keyed by id, so that a later update can be made against
+// what they saw rather than whatever is current by then.
+func (c *Cache) See(scope string, kind Kind, versions map[string]int64) error {
+	tx, err := c.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("could not write cache: %w", err)
+	}
+	defer tx.Rollback() // nolint: errcheck
+
+	for id, version := range versions {
+		_, err = tx.Exec(`INSERT INTO seen (scope, kind, id, version) VALUES (?, ?, ?, ?)
+		ON CONFLICT (scope, kind, id) DO UPDATE SET version = excluded.version`, scope, kind, id, version)
+		if err != nil {
+			return fmt.Errorf("could not write cache: %w", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("could not write cache: %w", err)
+	}
+
+	return nil
+}
+
+// Seen returns the version of a record last shown to the user; ErrNotCached if it never was.
+func (c *Cache) Seen(scope string, kind Kind, id string) (int64, error) {
+	var version int64
+	err := c.db.Get(&version, `SELECT version FROM seen WHERE scope = ? AND kind = ? AND id = ?`, scope, kind, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrNotCached
+		}
+
+		return 0, fmt.Errorf("could not read cache: %w", err)
+	}
+
+	return version, nil
+}
+
+// versions returns the version of every record of a kind keyed by id.
+func (c *Cache) versions(scope string, kind Kind) (map[string]int64, error) {
+	rows := []struct {
+		ID      string `db:"id"`
+		Version int64  `db:"version"`
+	}{}
+
+	err := c.db.Select(&rows, `SELECT id, version FROM records WHERE scope = ? AND kind = ?`, scope, kind)
+	if err != nil {
+		return nil, fmt.Errorf("could not read cache: %w", err)
+	}
+
+	versions := map[string]int64{}
+	for _, row := range rows {
+		versions[row.ID] = row.Version
+	}
+
+	return versions, nil
+}
+
+// put writes a single record, replacing any record of the same kind and id.
+func (c *Cache) put(tx *sqlx.Tx, scope string, kind Kind, id string, version int64, message proto.Message) error {
+	data, err := c.seal(scope, kind, id, message)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`INSERT INTO records (scope, kind, id, version, data) VALUES (?, ?, ?, ?, ?)
+	ON CONFLICT (scope, kind, id) DO UPDATE SET version = excluded.version, data = excluded.data`,
+		scope, kind, id, version, data)
+	if err != nil {
+		return fmt.Errorf("could not write cache: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Cache) delete(tx *sqlx.Tx, scope string, kind Kind, id string) error {
+	_, err := tx.Exec(`DELETE FROM records WHERE scope = ? AND kind = ? AND id = ?`, scope, kind, id)
+	if err != nil {
+		return fmt.Errorf("could not write cache: %w", err)
+	}
+
+	return nil
+}
+
+// seal encrypts a record. The record's scope, kind and id are bound to it so that a sealed record can't be passed
+// off as another.
+func (c *Cache) seal(scope string, kind Kind, id string, message proto.Message) ([]byte, error) {
+	plaintext, err := proto.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, c.gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.gcm.Seal(nonce, nonce, plaintext, recordData(scope, kind, id)), nil
+}
+
+func (c *Cache) open(scope string, kind Kind, id string, data []byte, message proto.Message) error {
+	if len(data) < c.gcm.NonceSize() {
+		return fmt.Errorf("cached %s %s is corrupt", kind, id)
+	}
+
+	plaintext, err := c.gcm.Open(nil, data[:c.gcm.NonceSize()], data[c.gcm.NonceSize():],
+		recordData(scope, kind, id))
+	if err != nil {
+		return fmt.Errorf("could not decrypt cache; was BASECOAT_CLI_CREDENTIALS_KEY or its key file changed?")
+	}
+
+	return proto.Unmarshal(plaintext, message)
+}
+
+func recordData(scope string, kind Kind, id string) []byte {
+	return []byte(scope + "\x00" + string(kind) + "\x00" + id)
+}
@@ -0,0 +1,244 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/clintjedwards/basecoat/internal/dispenser"
+	"github.com/clintjedwards/basecoat/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeClient serves a fixed account and counts the formulas fetched.
+type fakeClient struct {
+	formulas  map[string]*proto.Formula
+	bases     []*proto.BaseMetadata
+	colorants []*proto.ColorantMetadata
+	fetched   int
+}
+
+func (f *fakeClient) ListFormulas(ctx context.Context, in *proto.ListFormulasRequest, opts ...grpc.CallOption) (*proto.ListFormulasResponse, error) {
+	formulas := []*proto.FormulaMetadata{}
+	for _, formula := range f.formulas {
+		formulas = append(formulas, formula.Metadata)
+	}
+
+	return &proto.ListFormulasResponse{Formulas: formulas}, nil
+}
+
+func (f *fakeClient) GetFormula(ctx context.Context, in *proto.GetFormulaRequest, opts ...grpc.CallOption) (*proto.GetFormulaResponse, error) {
+	formula, ok := f.formulas[in.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "formula requested not found")
+	}
+
+	f.fetched++
+	return &proto.GetFormulaResponse{Formula: formula}, nil
+}
+
+func (f *fakeClient) GetFormulaRecipe(ctx context.Context, in *proto.GetFormulaRecipeRequest, opts ...grpc.CallOption) (*proto.GetFormulaRecipeResponse, error) {
+	return &proto.GetFormulaRecipeResponse{
+		Container: "1 gal",
+		Colorants: []*proto.RecipeIngredient{{Id: "c1", Name: "Lamp Black", Amount: "2oz 24/48"}},
+	}, nil
+}
+
+func (f *fakeClient) ListBases(ctx context.Context, in *proto.ListBasesRequest, opts ...grpc.CallOption) (*proto.ListBasesResponse, error) {
+	return &proto.ListBasesResponse{Bases: f.bases}, nil
+}
+
+func (f *fakeClient) ListColorants(ctx context.Context, in *proto.ListColorantsRequest, opts ...grpc.CallOption) (*proto.ListColorantsResponse, error) {
+	return &proto.ListColorantsResponse{Colorants: f.colorants}, nil
+}
+
+func testFormula(id, name string, version int64) *proto.Formula {
+	return &proto.Formula{
+		Metadata:  &proto.FormulaMetadata{Id: id, Name: name, Number: "HC-" + id, Version: version},
+		Colorants: []string{"c1"},
+	}
+}
+
+func testKey(salt []byte) ([]byte, error) {
+	return bytes.Repeat([]byte{7}, 32), nil
+}
+
+func testCache(t *testing.T) (*Cache, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "cache.db")
+	cache, err := Open(path, testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cache.Close() })
+
+	return cache, path
+}
+
+func TestSync(t *testing.T) {
+	cache, _ := testCache(t)
+	scope := Scope("localhost:8080", "shop")
+
+	client := &fakeClient{
+		formulas: map[string]*proto.Formula{
+			"f1": testFormula("f1", "Hale Navy", 1),
+			"f2": testFormula("f2", "Chantilly Lace", 1),
+		},
+		bases:     []*proto.BaseMetadata{{Id: "b1", Label: "Base 1", Version: 1}},
+		colorants: []*proto.ColorantMetadata{{Id: "c1", Label: "Lamp Black", Version: 1}},
+	}
+
+	_, err := cache.Snapshot(scope)
+	if !errors.Is(err, ErrNotCached) {
+		t.Fatalf("expected ErrNotCached before syncing; got %v", err)
+	}
+
+	stats, err := cache.Sync(context.Background(), client, scope, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Formulas != 2 || stats.Fetched != 2 {
+		t.Errorf("unexpected stats of first sync: %+v", stats)
+	}
+
+	// Only formulas which changed are fetched again.
+	client.fetched = 0
+	client.formulas["f1"] = testFormula("f1", "Hale Navy HC-154", 2)
+	delete(client.formulas, "f2")
+
+	stats, err = cache.Sync(context.Background(), client, scope, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.fetched != 1 || stats.Removed != 1 {
+		t.Errorf("unexpected stats of second sync: %+v", stats)
+	}
+
+	// A changed colorant changes every recipe.
+	client.fetched = 0
+	client.colorants[0].Version = 2
+
+	_, err = cache.Sync(context.Background(), client, scope, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.fetched != 1 {
+		t.Errorf("expected formulas to be fetched after a colorant changed; fetched %d", client.fetched)
+	}
+
+	snapshot, err := cache.Snapshot(scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formulas, err := snapshot.Formulas("navy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(formulas) != 1 || formulas[0].Name != "Hale Navy HC-154" {
+		t.Errorf("unexpected formulas: %v", formulas)
+	}
+
+	_, recipe, err := snapshot.Formula("f1", dispenser.Gallon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recipe.Colorants[0].Amount != "2oz 24/48" {
+		t.Errorf("unexpected recipe: %v", recipe)
+	}
+
+	_, recipe, err = snapshot.Formula("f1", 5*dispenser.Gallon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recipe.Container != "5 gal" || recipe.Colorants[0].Amount != "12oz 24/48" {
+		t.Errorf("unexpected recipe for 5 gal: %v", recipe)
+	}
+
+	_, _, err = snapshot.Formula("f2", dispenser.Gallon)
+	if !errors.Is(err, ErrNotCached) {
+		t.Errorf("expected removed formula to be gone; got %v", err)
+	}
+
+	// Snapshots of other accounts are kept apart.
+	_, err = cache.Snapshot(Scope("localhost:8080", "other"))
+	if !errors.Is(err, ErrNotCached) {
+		t.Errorf("expected ErrNotCached for another account; got %v", err)
+	}
+}
+
+func TestCacheEncrypted(t *testing.T) {
+	cache, path := testCache(t)
+	scope := Scope("localhost:8080", "shop")
+
+	client := &fakeClient{formulas: map[string]*proto.Formula{"f1": testFormula("f1", "Hale Navy", 1)}}
+
+	_, err := cache.Sync(context.Background(), client, scope, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Close()
+
+	for _, file := range []string{path, path + "-wal"} {
+		contents, err := os.ReadFile(file)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			t.Fatal(err)
+		}
+		if bytes.Contains(contents, []byte("Hale Navy")) {
+			t.Errorf("formula name found unencrypted in %s", file)
+		}
+	}
+
+	wrongKey := func(salt []byte) ([]byte, error) { return bytes.Repeat([]byte{8}, 32), nil }
+	reopened, err := Open(path, wrongKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	snapshot, err := reopened.Snapshot(scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = snapshot.Formulas("")
+	if err == nil {
+		t.Error("expected formulas to be unreadable with the wrong key")
+	}
+}
+
+func TestSeen(t *testing.T) {
+	cache, _ := testCache(t)
+	scope := Scope("localhost:8080", "shop")
+
+	_, err := cache.Seen(scope, KindJob, "j1")
+	if !errors.Is(err, ErrNotCached) {
+		t.Fatalf("expected job never shown to not be cached; found %v", err)
+	}
+
+	for _, version := range []int64{2, 3} {
+		err = cache.See(scope, KindJob, map[string]int64{"j1": version})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	version, err := cache.Seen(scope, KindJob, "j1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 3 {
+		t.Errorf("expected the version last shown, 3; found %d", version)
+	}
+
+	_, err = cache.Seen(Scope("localhost:8080", "other"), KindJob, "j1")
+	if !errors.Is(err, ErrNotCached) {
+		t.Errorf("expected versions shown to be kept per scope; found %v", err)
+	}
+}
@@ -0,0 +1,390 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// IdempotencyKey is the metadata key queued changes are sent with. Each change keeps the same key however many times
+// it's sent, so a change which reached the server before the connection dropped isn't made twice.
+const IdempotencyKey = "idempotency-key"
+
+// State is where a queued change is in being sent to the server.
+type State string
+
+const (
+	// StatePending changes haven't been sent yet; they're checked for conflicts before they are.
+	StatePending State = "pending"
+	// StateSending changes were sent but no answer came back, so they may or may not have been made. They're sent
+	// again with the same idempotency key and without checking for conflicts, which their first sending would cause.
+	StateSending State = "sending"
+	// StateConflict changes are held back until they're resolved; see Retry and Discard.
+	StateConflict State = "conflict"
+	// StateRetry changes were resolved by sending them anyway and aren't checked for conflicts again.
+	StateRetry State = "retry"
+)
+
+// Change is a change made while the server couldn't be reached, queued to be sent once it can.
+type Change struct {
+	ID     int64
+	Key    string // The idempotency key the change is sent with.
+	Method string // The full name of the API call which makes the change; ex. "/proto.Basecoat/RecordMix".
+
+	// Request is the request the change is made with; a *proto.RecordMixRequest or *proto.CreateFormulaRequest.
+	Request protobuf.Message
+
+	// SeenVersion is the version of the formula a mix was recorded against as read from the snapshot; 0 if it wasn't
+	// cached. A mix recorded against a formula changed on the server since is a conflict.
+	SeenVersion int64
+
+	State  State
+	Reason string // Why the change conflicts with the server; empty unless State is StateConflict.
+
+	Created time.Time
+}
+
+// Summary describes the change in a few words.
+func (c *Change) Summary() string {
+	switch request := c.Request.(type) {
+	case *proto.RecordMixRequest:
+		quantity := request.Quantity
+		if quantity == 0 {
+			quantity = 1
+		}
+		summary := fmt.Sprintf("record mix of %d x %s of formula %s", quantity, request.Container, request.Formula)
+		if request.Job != "" {
+			summary += " for job " + request.Job
+		}
+		return summary
+	case *proto.CreateFormulaRequest:
+		if request.Number != "" {
+			return fmt.Sprintf("create formula %q (%s)", request.Name, request.Number)
+		}
+		return fmt.Sprintf("create formula %q", request.Name)
+	default:
+		return c.Method
+	}
+}
+
+// ReplayClient is the part of the Basecoat API queued changes are sent to.
+type ReplayClient interface {
+	GetFormula(ctx context.Context, in *proto.GetFormulaRequest, opts ...grpc.CallOption) (*proto.GetFormulaResponse, error)
+	ListFormulas(ctx context.Context, in *proto.ListFormulasRequest, opts ...grpc.CallOption) (*proto.ListFormulasResponse, error)
+	CreateFormula(ctx context.Context, in *proto.CreateFormulaRequest, opts ...grpc.CallOption) (*proto.CreateFormulaResponse, error)
+	RecordMix(ctx context.Context, in *proto.RecordMixRequest, opts ...grpc.CallOption) (*proto.RecordMixResponse, error)
+}
+
+// ReplayStats counts what a replay did.
+type ReplayStats struct {
+	Sent      int // Changes made on the server.
+	Conflicts int // Changes found to conflict; includes changes which already did.
+	Pending   int // Changes left to send because the server stopped answering.
+}
+
+// changeRow is a change as kept in the journal.
+type changeRow struct {
+	ID          int64  `db:"id"`
+	Key         string `db:"key"`
+	Method      string `db:"method"`
+	Request     []byte `db:"request"`
+	SeenVersion int64  `db:"seen_version"`
+	State       string `db:"state"`
+	Reason      string `db:"reason"`
+	Created     int64  `db:"created"`
+}
+
+func newRequest(method string) (protobuf.Message, error) {
+	switch method {
+	case proto.Basecoat_RecordMix_FullMethodName:
+		return &proto.RecordMixRequest{}, nil
+	case proto.Basecoat_CreateFormula_FullMethodName:
+		return &proto.CreateFormulaRequest{}, nil
+	default:
+		return nil, fmt.Errorf("changes made by %s can't be queued", method)
+	}
+}
+
+// Queue adds a change to the journal of a scope to be sent the next time the server can be reached. Only recording
+// mixes and creating formulas can be queued.
+func (c *Cache) Queue(scope string, request protobuf.Message, seenVersion int64) (*Change, error) {
+	var method string
+	switch request.(type) {
+	case *proto.RecordMixRequest:
+		method = proto.Basecoat_RecordMix_FullMethodName
+	case *proto.CreateFormulaRequest:
+		method = proto.Basecoat_CreateFormula_FullMethodName
+	default:
+		return nil, fmt.Errorf("changes made with %T can't be queued", request)
+	}
+
+	change := &Change{
+		Key:         shortuuid.New(),
+		Method:      method,
+		Request:     request,
+		SeenVersion: seenVersion,
+		State:       StatePending,
+		Created:     time.Now(),
+	}
+
+	data, err := c.seal(scope, kindChange, change.Key, request)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.db.Exec(`INSERT INTO journal (scope, key, method, request, seen_version, state, reason, created)
+	VALUES (?, ?, ?, ?, ?, ?, '', ?)`, scope, change.Key, change.Method, data, change.SeenVersion, change.State,
+		change.Created.UnixMilli())
+	if err != nil {
+		return nil, fmt.Errorf("could not write cache: %w", err)
+	}
+
+	change.ID, err = result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// Changes returns the changes queued for a scope in the order they were made.
+func (c *Cache) Changes(scope string) ([]*Change, error) {
+	rows := []changeRow{}
+	err := c.db.Select(&rows, `SELECT id, key, method, request, seen_version, state, reason, created FROM journal
+	WHERE scope = ? ORDER BY id`, scope)
+	if err != nil {
+		return nil, fmt.Errorf("could not read cache: %w", err)
+	}
+
+	changes := []*Change{}
+	for _, row := range rows {
+		request, err := newRequest(row.Method)
+		if err != nil {
+			return nil, err
+		}
+
+		err = c.open(scope, kindChange, row.Key, row.Request, request)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, &Change{
+			ID:          row.ID,
+			Key:         row.Key,
+			Method:      row.Method,
+			Request:     request,
+			SeenVersion: row.SeenVersion,
+			State:       State(row.State),
+			Reason:      row.Reason,
+			Created:     time.UnixMilli(row.Created),
+		})
+	}
+
+	return changes, nil
+}
+
+// Queued returns the number of changes waiting to be sent for a scope, leaving out conflicts.
+func (c *Cache) Queued(scope string) (int, error) {
+	var count int
+	err := c.db.Get(&count, `SELECT COUNT(*) FROM journal WHERE scope = ? AND state != ?`, scope, StateConflict)
+	if err != nil {
+		return 0, fmt.Errorf("could not read cache: %w", err)
+	}
+
+	return count, nil
+}
+
+// Retry resolves a conflict by sending the change anyway the next time changes are replayed.
+func (c *Cache) Retry(scope string, id int64) error {
+	return c.resolve(scope, id, func() error {
+		return c.setState(scope, id, StateRetry, "")
+	})
+}
+
+// Discard resolves a conflict by dropping the change; it's never sent.
+func (c *Cache) Discard(scope string, id int64) error {
+	return c.resolve(scope, id, func() error {
+		_, err := c.db.Exec(`DELETE FROM journal WHERE scope = ? AND id = ?`, scope, id)
+		if err != nil {
+			return fmt.Errorf("could not write cache: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// resolve runs a resolution if the change given is a conflict.
+func (c *Cache) resolve(scope string, id int64, resolution func() error) error {
+	var state string
+	err := c.db.Get(&state, `SELECT state FROM journal WHERE scope = ? AND id = ?`, scope, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotCached
+		}
+
+		return fmt.Errorf("could not read cache: %w", err)
+	}
+
+	if State(state) != StateConflict {
+		return fmt.Errorf("change %d doesn't conflict with the server; it's %s", id, state)
+	}
+
+	return resolution()
+}
+
+func (c *Cache) setState(scope string, id int64, state State, reason string) error {
+	_, err := c.db.Exec(`UPDATE journal SET state = ?, reason = ? WHERE scope = ? AND id = ?`, state, reason, scope, id)
+	if err != nil {
+		return fmt.Errorf("could not write cache: %w", err)
+	}
+
+	return nil
+}
+
+// Replay sends the changes queued for a scope in the order they were made. The context must carry the
+// authorization to make them.
+//
+// Pending changes are checked against the server first: a mix whose formula was changed or deleted since it was
+// recorded, or a formula with the same name and number as one created on the server in the meantime, is marked as a
+// conflict and held back until it's resolved. Changes the server turns away are marked as conflicts as well, with its
+// reason. Replaying stops at the first change which can't be sent at all, ex. because the connection dropped, so that
+// changes are always made in order.
+func (c *Cache) Replay(ctx context.Context, client ReplayClient, scope string) (ReplayStats, error) {
+	stats := ReplayStats{}
+
+	changes, err := c.Changes(scope)
+	if err != nil {
+		return stats, err
+	}
+
+	for i, change := range changes {
+		if change.State == StateConflict {
+			stats.Conflicts++
+			continue
+		}
+
+		if change.State == StatePending {
+			reason, err := c.conflict(ctx, client, change)
+			if err != nil {
+				stats.Pending = unsent(changes[i:])
+				return stats, err
+			}
+
+			if reason != "" {
+				err = c.setState(scope, change.ID, StateConflict, reason)
+				if err != nil {
+					return stats, err
+				}
+
+				stats.Conflicts++
+				continue
+			}
+
+			err = c.setState(scope, change.ID, StateSending, "")
+			if err != nil {
+				return stats, err
+			}
+		}
+
+		err = send(metadata.AppendToOutgoingContext(ctx, IdempotencyKey, change.Key), client, change)
+		if err != nil {
+			switch status.Code(err) {
+			case codes.NotFound, codes.FailedPrecondition, codes.AlreadyExists, codes.InvalidArgument, codes.Aborted,
+				codes.PermissionDenied:
+				err = c.setState(scope, change.ID, StateConflict, status.Convert(err).Message())
+				if err != nil {
+					return stats, err
+				}
+
+				stats.Conflicts++
+				continue
+			default:
+				stats.Pending = unsent(changes[i:])
+				return stats, fmt.Errorf("could not send change %d: %w", change.ID, err)
+			}
+		}
+
+		_, err = c.db.Exec(`DELETE FROM journal WHERE scope = ? AND id = ?`, scope, change.ID)
+		if err != nil {
+			return stats, fmt.Errorf("could not write cache: %w", err)
+		}
+
+		stats.Sent++
+	}
+
+	return stats, nil
+}
+
+// conflict returns why a pending change conflicts with the server; empty if it doesn't.
+func (c *Cache) conflict(ctx context.Context, client ReplayClient, change *Change) (string, error) {
+	switch request := change.Request.(type) {
+	case *proto.RecordMixRequest:
+		if change.SeenVersion == 0 {
+			return "", nil
+		}
+
+		resp, err := client.GetFormula(ctx, &proto.GetFormulaRequest{Id: request.Formula})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return fmt.Sprintf("formula %s was deleted on the server", request.Formula), nil
+			}
+
+			return "", fmt.Errorf("could not get formula %s: %w", request.Formula, err)
+		}
+
+		if resp.Formula.Metadata.Version != change.SeenVersion {
+			return fmt.Sprintf("formula %s was changed on the server after the mix was recorded (version %d, now %d)",
+				request.Formula, change.SeenVersion, resp.Formula.Metadata.Version), nil
+		}
+	case *proto.CreateFormulaRequest:
+		resp, err := client.ListFormulas(ctx, &proto.ListFormulasRequest{})
+		if err != nil {
+			return "", fmt.Errorf("could not list formulas: %w", err)
+		}
+
+		for _, formula := range resp.Formulas {
+			if strings.EqualFold(formula.Name, request.Name) && strings.EqualFold(formula.Number, request.Number) {
+				return fmt.Sprintf("formula %q was created on the server in the meantime as %s", formula.Name,
+					formula.Id), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// unsent counts the changes given which are still to be sent.
+func unsent(changes []*Change) int {
+	count := 0
+	for _, change := range changes {
+		if change.State != StateConflict {
+			count++
+		}
+	}
+
+	return count
+}
+
+func send(ctx context.Context, client ReplayClient, change *Change) error {
+	switch request := change.Request.(type) {
+	case *proto.RecordMixRequest:
+		_, err := client.RecordMix(ctx, request)
+		return err
+	case *proto.CreateFormulaRequest:
+		_, err := client.CreateFormula(ctx, request)
+		return err
+	default:
+		return fmt.Errorf("changes made by %s can't be sent", change.Method)
+	}
+}
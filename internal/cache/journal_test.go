@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clintjedwards/basecoat/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeReplayClient records the changes sent to it by their idempotency key.
+type fakeReplayClient struct {
+	fakeClient
+	sent []string
+	err  error // Returned by every change sent if set.
+}
+
+func (f *fakeReplayClient) idempotencyKey(ctx context.Context) string {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	keys := md.Get(IdempotencyKey)
+	if len(keys) == 0 {
+		return ""
+	}
+
+	return keys[0]
+}
+
+func (f *fakeReplayClient) CreateFormula(ctx context.Context, in *proto.CreateFormulaRequest, opts ...grpc.CallOption) (*proto.CreateFormulaResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.sent = append(f.sent, f.idempotencyKey(ctx))
+	return &proto.CreateFormulaResponse{}, nil
+}
+
+func (f *fakeReplayClient) RecordMix(ctx context.Context, in *proto.RecordMixRequest, opts ...grpc.CallOption) (*proto.RecordMixResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	f.sent = append(f.sent, f.idempotencyKey(ctx))
+	return &proto.RecordMixResponse{}, nil
+}
+
+func TestReplay(t *testing.T) {
+	cache, _ := testCache(t)
+	scope := Scope("localhost:8080", "shop")
+
+	client := &fakeReplayClient{fakeClient: fakeClient{
+		formulas: map[string]*proto.Formula{
+			"f1": testFormula("f1", "Hale Navy", 1),
+			"f2": testFormula("f2", "Chantilly Lace", 1),
+		},
+	}}
+
+	mix, err := cache.Queue(scope, &proto.RecordMixRequest{Formula: "f1", Container: "1 gal"}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stale, err := cache.Queue(scope, &proto.RecordMixRequest{Formula: "f2", Container: "1 qt"}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	duplicate, err := cache.Queue(scope, &proto.CreateFormulaRequest{Name: "hale navy", Number: "HC-f1"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing is sent while the server can't be reached and every change stays queued.
+	client.err = status.Error(codes.Unavailable, "connection refused")
+	client.formulas["f2"] = testFormula("f2", "Chantilly Lace", 2)
+
+	stats, err := cache.Replay(context.Background(), client, scope)
+	if err == nil {
+		t.Fatal("expected replay to stop when the server can't be reached")
+	}
+	if stats.Pending != 3 {
+		t.Errorf("unexpected stats of replay while unreachable: %+v", stats)
+	}
+
+	client.err = nil
+
+	stats, err = cache.Replay(context.Background(), client, scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Sent != 1 || stats.Conflicts != 2 || stats.Pending != 0 {
+		t.Errorf("unexpected stats of replay: %+v", stats)
+	}
+
+	// The mix which failed to send is sent again with the key it was first sent with.
+	if len(client.sent) != 1 || client.sent[0] != mix.Key {
+		t.Errorf("expected mix to be sent with key %q; sent %v", mix.Key, client.sent)
+	}
+
+	changes, err := cache.Changes(scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 || changes[0].ID != stale.ID || changes[1].ID != duplicate.ID {
+		t.Fatalf("expected conflicts to stay queued; got %v", changes)
+	}
+	for _, change := range changes {
+		if change.State != StateConflict || change.Reason == "" {
+			t.Errorf("expected change %d to conflict; got %s %q", change.ID, change.State, change.Reason)
+		}
+	}
+
+	// Conflicts are held back until they're resolved.
+	err = cache.Retry(scope, stale.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = cache.Discard(scope, duplicate.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = cache.Discard(scope, mix.ID)
+	if !errors.Is(err, ErrNotCached) {
+		t.Errorf("expected ErrNotCached discarding a change already sent; got %v", err)
+	}
+
+	stats, err = cache.Replay(context.Background(), client, scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Sent != 1 || stats.Conflicts != 0 {
+		t.Errorf("unexpected stats of replay after resolving: %+v", stats)
+	}
+
+	queued, err := cache.Queued(scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if queued != 0 {
+		t.Errorf("expected no changes left; got %d", queued)
+	}
+}
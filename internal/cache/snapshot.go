@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/dispenser"
+	"github.com/clintjedwards/basecoat/proto"
+)
+
+// Snapshot is the copy of a single account kept in the cache.
+type Snapshot struct {
+	cache *Cache
+	scope string
+
+	// Synced is when the snapshot was last refreshed; everything read from it is as of then.
+	Synced time.Time
+}
+
+// Snapshot returns the copy of the scope given; ErrNotCached if it was never synced.
+func (c *Cache) Snapshot(scope string) (*Snapshot, error) {
+	synced, err := c.Synced(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{cache: c, scope: scope, Synced: synced}, nil
+}
+
+// Formulas returns the formulas kept sorted by name. Unlike the server, which searches formulas, the filter simply
+// keeps formulas whose name, number or notes contain it regardless of case.
+func (s *Snapshot) Formulas(filter string) ([]*proto.FormulaMetadata, error) {
+	formulas, err := List(s.cache, s.scope, KindFormula, func() *proto.Formula { return &proto.Formula{} })
+	if err != nil {
+		return nil, err
+	}
+
+	filter = strings.ToLower(strings.TrimSpace(filter))
+
+	metadata := []*proto.FormulaMetadata{}
+	for _, formula := range formulas {
+		if filter != "" && !strings.Contains(strings.ToLower(formula.Metadata.Name), filter) &&
+			!strings.Contains(strings.ToLower(formula.Metadata.Number), filter) &&
+			!strings.Contains(strings.ToLower(formula.Metadata.Notes), filter) {
+			continue
+		}
+
+		metadata = append(metadata, formula.Metadata)
+	}
+
+	sort.Slice(metadata, func(i, j int) bool {
+		return strings.ToLower(metadata[i].Name) < strings.ToLower(metadata[j].Name)
+	})
+
+	return metadata, nil
+}
+
+// Formula returns a single formula along with what goes into the container given. Recipes are kept for a gallon and
+// scaled the same way the server scales them; amounts which can't be read are an error for any other container.
+func (s *Snapshot) Formula(id string, container dispenser.Container) (*proto.Formula, *proto.GetFormulaRecipeResponse, error) {
+	formula := &proto.Formula{}
+	err := s.cache.Get(s.scope, KindFormula, id, formula)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recipe := &proto.GetFormulaRecipeResponse{}
+	err = s.cache.Get(s.scope, KindRecipe, id, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recipe.Container = container.String()
+	if container == dispenser.Gallon {
+		return formula, recipe, nil
+	}
+
+	for _, base := range recipe.Bases {
+		parsed, err := dispenser.ParseContainer(base.Amount)
+		if err != nil {
+			return nil, nil, fmt.Errorf("amount of base %s can't be scaled to %s; %v", base.Name, container, err)
+		}
+		base.Amount = dispenser.Container(parsed.Ounces() * container.Ounces() / dispenser.Gallon.Ounces()).String()
+	}
+
+	for _, colorant := range recipe.Colorants {
+		parsed, err := dispenser.ParseAmount(colorant.Amount)
+		if err != nil {
+			return nil, nil, fmt.Errorf("amount of colorant %s can't be scaled to %s; %v", colorant.Name, container, err)
+		}
+		colorant.Amount = parsed.Scale(dispenser.Gallon, container).String()
+	}
+
+	return formula, recipe, nil
+}
+
+// Bases returns the bases kept sorted by label.
+func (s *Snapshot) Bases() ([]*proto.BaseMetadata, error) {
+	bases, err := List(s.cache, s.scope, KindBase, func() *proto.BaseMetadata { return &proto.BaseMetadata{} })
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(bases, func(i, j int) bool {
+		return strings.ToLower(bases[i].Label) < strings.ToLower(bases[j].Label)
+	})
+
+	return bases, nil
+}
+
+// Colorants returns the colorants kept sorted by label.
+func (s *Snapshot) Colorants() ([]*proto.ColorantMetadata, error) {
+	colorants, err := List(s.cache, s.scope, KindColorant, func() *proto.ColorantMetadata {
+		return &proto.ColorantMetadata{}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(colorants, func(i, j int) bool {
+		return strings.ToLower(colorants[i].Label) < strings.ToLower(colorants[j].Label)
+	})
+
+	return colorants, nil
+}
@@ -0,0 +1,204 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/clintjedwards/basecoat/proto"
+	"google.golang.org/grpc"
+)
+
+// Client is the part of the Basecoat API the cache is synced from.
+type Client interface {
+	ListFormulas(ctx context.Context, in *proto.ListFormulasRequest, opts ...grpc.CallOption) (*proto.ListFormulasResponse, error)
+	GetFormula(ctx context.Context, in *proto.GetFormulaRequest, opts ...grpc.CallOption) (*proto.GetFormulaResponse, error)
+	GetFormulaRecipe(ctx context.Context, in *proto.GetFormulaRecipeRequest, opts ...grpc.CallOption) (*proto.GetFormulaRecipeResponse, error)
+	ListBases(ctx context.Context, in *proto.ListBasesRequest, opts ...grpc.CallOption) (*proto.ListBasesResponse, error)
+	ListColorants(ctx context.Context, in *proto.ListColorantsRequest, opts ...grpc.CallOption) (*proto.ListColorantsResponse, error)
+}
+
+// SyncStats counts what a sync copied.
+type SyncStats struct {
+	Formulas  int // Formulas in the snapshot after the sync.
+	Bases     int
+	Colorants int
+	Fetched   int // Formulas whose ingredients were fetched again.
+	Removed   int // Records dropped because they no longer exist on the server.
+}
+
+// Sync refreshes the snapshot of a scope from the server.
+//
+// Formulas, bases and colorants are listed on every sync but the ingredients of a formula are only fetched again when
+// the formula is new, its version changed, or a base or colorant changed; adding an ingredient to a formula doesn't
+// change its version so a full sync fetches every formula's ingredients regardless. The snapshot is replaced in a
+// single transaction so that a sync cut short leaves the previous snapshot as it was.
+func (c *Cache) Sync(ctx context.Context, client Client, scope string, full bool) (SyncStats, error) {
+	stats := SyncStats{}
+
+	formulas, err := client.ListFormulas(ctx, &proto.ListFormulasRequest{})
+	if err != nil {
+		return stats, fmt.Errorf("could not list formulas: %w", err)
+	}
+
+	bases, err := client.ListBases(ctx, &proto.ListBasesRequest{})
+	if err != nil {
+		return stats, fmt.Errorf("could not list bases: %w", err)
+	}
+
+	colorants, err := client.ListColorants(ctx, &proto.ListColorantsRequest{})
+	if err != nil {
+		return stats, fmt.Errorf("could not list colorants: %w", err)
+	}
+
+	formulaVersions, err := c.versions(scope, KindFormula)
+	if err != nil {
+		return stats, err
+	}
+
+	baseVersions, err := c.versions(scope, KindBase)
+	if err != nil {
+		return stats, err
+	}
+
+	colorantVersions, err := c.versions(scope, KindColorant)
+	if err != nil {
+		return stats, err
+	}
+
+	if !full {
+		full = changed(baseVersions, bases.Bases, func(base *proto.BaseMetadata) (string, int64) {
+			return base.Id, base.Version
+		}) || changed(colorantVersions, colorants.Colorants, func(colorant *proto.ColorantMetadata) (string, int64) {
+			return colorant.Id, colorant.Version
+		})
+	}
+
+	type fetched struct {
+		formula *proto.Formula
+		recipe  *proto.GetFormulaRecipeResponse
+	}
+
+	fetchedFormulas := []fetched{}
+	for _, metadata := range formulas.Formulas {
+		version, cached := formulaVersions[metadata.Id]
+		if !full && cached && version == metadata.Version {
+			continue
+		}
+
+		formula, err := client.GetFormula(ctx, &proto.GetFormulaRequest{Id: metadata.Id})
+		if err != nil {
+			return stats, fmt.Errorf("could not get formula %s: %w", metadata.Id, err)
+		}
+
+		recipe, err := client.GetFormulaRecipe(ctx, &proto.GetFormulaRecipeRequest{Id: metadata.Id})
+		if err != nil {
+			return stats, fmt.Errorf("could not get recipe of formula %s: %w", metadata.Id, err)
+		}
+
+		fetchedFormulas = append(fetchedFormulas, fetched{formula: formula.Formula, recipe: recipe})
+	}
+
+	tx, err := c.db.Beginx()
+	if err != nil {
+		return stats, fmt.Errorf("could not write cache: %w", err)
+	}
+	defer tx.Rollback() // nolint: errcheck
+
+	for _, item := range fetchedFormulas {
+		metadata := item.formula.Metadata
+
+		err = c.put(tx, scope, KindFormula, metadata.Id, metadata.Version, item.formula)
+		if err != nil {
+			return stats, err
+		}
+
+		err = c.put(tx, scope, KindRecipe, metadata.Id, metadata.Version, item.recipe)
+		if err != nil {
+			return stats, err
+		}
+	}
+
+	for _, base := range bases.Bases {
+		err = c.put(tx, scope, KindBase, base.Id, base.Version, base)
+		if err != nil {
+			return stats, err
+		}
+	}
+
+	for _, colorant := range colorants.Colorants {
+		err = c.put(tx, scope, KindColorant, colorant.Id, colorant.Version, colorant)
+		if err != nil {
+			return stats, err
+		}
+	}
+
+	removed := map[Kind]map[string]int64{
+		KindFormula:  formulaVersions,
+		KindBase:     baseVersions,
+		KindColorant: colorantVersions,
+	}
+	for _, formula := range formulas.Formulas {
+		delete(formulaVersions, formula.Id)
+	}
+	for _, base := range bases.Bases {
+		delete(baseVersions, base.Id)
+	}
+	for _, colorant := range colorants.Colorants {
+		delete(colorantVersions, colorant.Id)
+	}
+
+	for kind, ids := range removed {
+		for id := range ids {
+			err = c.delete(tx, scope, kind, id)
+			if err != nil {
+				return stats, err
+			}
+
+			if kind == KindFormula {
+				err = c.delete(tx, scope, KindRecipe, id)
+				if err != nil {
+					return stats, err
+				}
+			}
+
+			stats.Removed++
+		}
+	}
+
+	_, err = tx.Exec(`INSERT INTO snapshots (scope, synced) VALUES (?, ?)
+	ON CONFLICT (scope) DO UPDATE SET synced = excluded.synced`, scope, time.Now().UnixMilli())
+	if err != nil {
+		return stats, fmt.Errorf("could not write cache: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return stats, fmt.Errorf("could not write cache: %w", err)
+	}
+
+	stats.Formulas = len(formulas.Formulas)
+	stats.Bases = len(bases.Bases)
+	stats.Colorants = len(colorants.Colorants)
+	stats.Fetched = len(fetchedFormulas)
+
+	return stats, nil
+}
+
+// changed returns true if any of the items listed is new or has a different version than the one cached, or if any
+// cached item is no longer listed.
+func changed[T any](versions map[string]int64, items []T, key func(T) (string, int64)) bool {
+	if len(versions) != len(items) {
+		return true
+	}
+
+	for _, item := range items {
+		id, version := key(item)
+		cached, ok := versions[id]
+		if !ok || cached != version {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,118 @@
+package account
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var cmdAccountExport = &cobra.Command{
+	Use:   "export <id>",
+	Short: "Export an account to a portable archive",
+	Long: `Export an account to a portable archive.
+
+The archive is written as JSON Lines; one record per line. The first line is always a header describing the
+archive. The archive can be restored on this or any other Basecoat server with "basecoat account import".`,
+	Example: `$ basecoat account export FyrjxCQ -o FyrjxCQ.jsonl`,
+	RunE:    accountExport,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdAccountExport.Flags().StringP("output", "o", "", "Path to write the archive to; defaults to <id>.jsonl")
+	CmdAccount.AddCommand(cmdAccountExport)
+}
+
+func accountExport(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Exporting account", polyfmt.Pretty)
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if output == "" {
+		output = id + ".jsonl"
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	stream, err := client.ExportAccount(ctx, &proto.ExportAccountRequest{
+		Id: id,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not export account: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	file, err := os.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not create archive file: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	count := 0
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			_ = os.Remove(output)
+			cl.State.Fmt.Err(fmt.Sprintf("could not export account: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		line, err := protojson.Marshal(resp.Record)
+		if err != nil {
+			_ = os.Remove(output)
+			cl.State.Fmt.Err(fmt.Sprintf("could not encode archive record: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		_, _ = writer.Write(line)
+		_ = writer.WriteByte('\n')
+		count++
+	}
+
+	err = writer.Flush()
+	if err != nil {
+		_ = os.Remove(output)
+		cl.State.Fmt.Err(fmt.Sprintf("could not write archive file: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Exported account %s to %s (%d records)", id, output, count))
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -0,0 +1,159 @@
+package account
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var cmdAccountImport = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import an account from a portable archive",
+	Long: `Import an account from a portable archive created by "basecoat account export".
+
+The archive is imported into an already existing account; by default the account the archive was exported from.
+Entities keep the IDs they were exported with unless they conflict with an entity already present in the account,
+in which case the conflict policy decides what happens:
+
+  skip      - keep the existing entity and ignore the archived one.
+  overwrite - replace the existing entity with the archived one.
+  rename    - store the archived entity under a newly generated ID.
+
+The import is all or nothing; if any record fails to import no changes are made.`,
+	Example: `$ basecoat account import FyrjxCQ.jsonl
+$ basecoat account import FyrjxCQ.jsonl --account Qa1ufzL --on-conflict rename`,
+	RunE: accountImport,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdAccountImport.Flags().StringP("account", "a", "", "Account to import into; defaults to the account in the archive")
+	cmdAccountImport.Flags().String("on-conflict", "skip", "What to do when an entity already exists: skip, overwrite or rename")
+	CmdAccount.AddCommand(cmdAccountImport)
+}
+
+func accountImport(cmd *cobra.Command, args []string) error {
+	path := args[0]
+
+	cl.State.Fmt.Print("Importing account", polyfmt.Pretty)
+
+	account, err := cmd.Flags().GetString("account")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	onConflict, err := cmd.Flags().GetString("on-conflict")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	policy, ok := proto.ImportConflictPolicy_value[strings.ToUpper(onConflict)]
+	if !ok {
+		cl.State.Fmt.Err(fmt.Sprintf("unknown conflict policy %q; must be one of skip, overwrite or rename", onConflict))
+		cl.State.Fmt.Finish()
+		return fmt.Errorf("unknown conflict policy %q", onConflict)
+	}
+
+	records, err := readArchive(path)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read archive: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(records) == 0 || records[0].GetHeader() == nil {
+		cl.State.Fmt.Err("could not read archive: archive is missing its header")
+		cl.State.Fmt.Finish()
+		return fmt.Errorf("archive is missing its header")
+	}
+
+	if account == "" {
+		account = records[0].GetHeader().Account
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	stream, err := client.ImportAccount(ctx)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not import account: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	for i, record := range records {
+		request := &proto.ImportAccountRequest{Record: record}
+		if i == 0 {
+			request.Account = account
+			request.Policy = proto.ImportConflictPolicy(policy)
+		}
+
+		err = stream.Send(request)
+		if err != nil {
+			break
+		}
+	}
+
+	// Any error from sending is more accurately reported by the server's response.
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not import account: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Imported archive into account %s: %d created, %d overwritten, %d renamed, %d skipped",
+		account, resp.Created, resp.Overwritten, resp.Renamed, resp.Skipped))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+func readArchive(path string) ([]*proto.AccountArchiveRecord, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	records := []*proto.AccountArchiveRecord{}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+
+		record := &proto.AccountArchiveRecord{}
+		err := protojson.Unmarshal(scanner.Bytes(), record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, scanner.Err()
+}
@@ -26,6 +26,7 @@ A short listing of all currently registered accounts.`,
 }
 
 func init() {
+	cl.AddOutputFlags(cmdAccountList)
 	CmdAccount.AddCommand(cmdAccountList)
 }
 
@@ -50,6 +51,12 @@ func accountList(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(resp.Accounts, format.NewAccount))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
 	if len(resp.Accounts) == 0 {
 		cl.State.Fmt.Println("No accounts found")
 		cl.State.Fmt.Finish()
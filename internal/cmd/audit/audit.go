@@ -0,0 +1,196 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var CmdAudit = &cobra.Command{
+	Use:   "audit",
+	Short: "List changes made to the account",
+	Long: `List changes made to the account.
+
+Every change made to a formula, base, colorant, contact, contractor or job is recorded in the audit log along with
+who made it, when and from where. Events are listed newest first.`,
+	Example: `$ basecoat audit
+$ basecoat audit --kind formula --id 9A4E3BF
+$ basecoat audit --since 2022-06-01T00:00:00Z --until 2022-07-01T00:00:00Z`,
+	RunE: auditList,
+}
+
+func init() {
+	CmdAudit.Flags().StringP("kind", "k", "",
+		"Only list events for entities of this kind; one of formula, base, colorant, contact, contractor, job or account")
+	CmdAudit.Flags().StringP("id", "i", "", "Only list events for the entity with this id")
+	CmdAudit.Flags().String("since", "", "Only list events recorded at or after this time; RFC3339 format")
+	CmdAudit.Flags().String("until", "", "Only list events recorded before this time; RFC3339 format")
+	CmdAudit.Flags().IntP("limit", "l", 0, "Maximum number of events to list; defaults to the server maximum")
+	CmdAudit.Flags().Bool("changes", false, "Include the before and after state of each entity")
+	cl.AddOutputFlags(CmdAudit)
+}
+
+func auditList(cmd *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Retrieving audit events", polyfmt.Pretty)
+
+	request, err := parseFilter(cmd)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	changes, _ := cmd.Flags().GetBool("changes")
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListAuditEvents(ctx, request)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not list audit events: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(resp.Events, format.NewAuditEvent))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(resp.Events) == 0 {
+		cl.State.Fmt.Println("No audit events found")
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	data := [][]string{}
+	for _, event := range resp.Events {
+		row := []string{
+			format.UnixMilli(event.Created, "Unknown", cl.State.Config.Detail),
+			event.Actor,
+			methodName(event.Method),
+			format.NormalizeEnumValue(event.EntityKind.String(), "Unknown"),
+			event.EntityId,
+			event.SourceIp,
+		}
+
+		if changes {
+			row = append(row, event.Before, event.After)
+		}
+
+		data = append(data, row)
+	}
+
+	table := formatTable(data, changes, !cl.State.Config.NoColor)
+
+	cl.State.Fmt.Println(table)
+	cl.State.Fmt.Finish()
+
+	return nil
+}
+
+// parseFilter builds the audit event request from the command's flags.
+func parseFilter(cmd *cobra.Command) (*proto.ListAuditEventsRequest, error) {
+	request := &proto.ListAuditEventsRequest{}
+
+	kind, _ := cmd.Flags().GetString("kind")
+	if kind != "" {
+		value, ok := proto.EntityKind_value[strings.ToUpper(kind)]
+		if !ok || value == int32(proto.EntityKind_ENTITY_KIND_UNKNOWN) {
+			return nil, fmt.Errorf("unknown kind %q; must be one of formula, base, colorant, contact, contractor, job or account",
+				kind)
+		}
+		request.EntityKind = proto.EntityKind(value)
+	}
+
+	request.EntityId, _ = cmd.Flags().GetString("id")
+
+	since, _ := cmd.Flags().GetString("since")
+	if since != "" {
+		sinceTime, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse --since: %w", err)
+		}
+		request.Since = sinceTime.UnixMilli()
+	}
+
+	until, _ := cmd.Flags().GetString("until")
+	if until != "" {
+		untilTime, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse --until: %w", err)
+		}
+		request.Until = untilTime.UnixMilli()
+	}
+
+	limit, _ := cmd.Flags().GetInt("limit")
+	request.Limit = uint64(limit)
+
+	return request, nil
+}
+
+// methodName trims the service prefix from a full gRPC method name.
+func methodName(method string) string {
+	return method[strings.LastIndex(method, "/")+1:]
+}
+
+func formatTable(data [][]string, changes, color bool) string {
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	headers := []string{"Time", "Actor", "Method", "Kind", "ID", "Source"}
+	if changes {
+		headers = append(headers, "Before", "After")
+	}
+
+	table.SetHeader(headers)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(true)
+	table.SetBorder(false)
+	table.SetAutoFormatHeaders(false)
+	table.SetAutoWrapText(!changes)
+	table.SetRowSeparator("―")
+	table.SetRowLine(false)
+	table.SetColumnSeparator("")
+	table.SetCenterSeparator("")
+
+	if color {
+		headerColors := []tablewriter.Colors{}
+		columnColors := []tablewriter.Colors{}
+		for i := range headers {
+			headerColors = append(headerColors, tablewriter.Color(tablewriter.FgBlueColor))
+			if i == 4 {
+				columnColors = append(columnColors, tablewriter.Color(tablewriter.FgYellowColor))
+				continue
+			}
+			columnColors = append(columnColors, tablewriter.Color(0))
+		}
+		table.SetHeaderColor(headerColors...)
+		table.SetColumnColor(columnColors...)
+	}
+
+	table.AppendBulk(data)
+
+	table.Render()
+	return tableString.String()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
 	"github.com/clintjedwards/basecoat/proto"
 	"github.com/clintjedwards/polyfmt/v2"
@@ -13,19 +14,25 @@ import (
 )
 
 var cmdBaseCreate = &cobra.Command{
-	Use:     "create <label> <manufacturer>",
-	Short:   "Create a new base",
-	Long:    `Create a new base.`,
-	Example: `$ basecoat base create "Off White" "Benjamin Moore"`,
-	RunE:    baseCreate,
-	Args:    cobra.ExactArgs(2),
+	Use:   "create <label> <manufacturer>",
+	Short: "Create a new base",
+	Long:  `Create a new base.`,
+	Example: `$ basecoat base create "Off White" "Benjamin Moore"
+$ basecoat base create --file bases.jsonl`,
+	RunE: baseCreate,
+	Args: batch.Args(2),
 }
 
 func init() {
+	batch.AddFlags(cmdBaseCreate, "CreateBaseRequest")
 	CmdBase.AddCommand(cmdBaseCreate)
 }
 
-func baseCreate(_ *cobra.Command, args []string) error {
+func baseCreate(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return baseCreateBatch(cmd, path)
+	}
+
 	label := args[0]
 	manufacturer := args[1]
 
@@ -57,3 +64,45 @@ func baseCreate(_ *cobra.Command, args []string) error {
 	cl.State.Fmt.Finish()
 	return nil
 }
+
+// baseCreateBatch creates every base listed in a file with a single batch call.
+func baseCreateBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Creating bases", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.CreateBaseRequest { return &proto.CreateBaseRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchCreateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchCreateItem{Item: &proto.BatchCreateItem_Base{Base: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchCreate(ctx, &proto.BatchCreateRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not create bases: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("created", "base", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
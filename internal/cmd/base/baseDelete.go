@@ -3,8 +3,11 @@ package base
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
 	"github.com/clintjedwards/basecoat/proto"
 	"github.com/clintjedwards/polyfmt/v2"
 	"github.com/spf13/cobra"
@@ -12,23 +15,48 @@ import (
 )
 
 var cmdBaseDelete = &cobra.Command{
-	Use:     "delete <id>",
-	Short:   "Delete  an base",
-	Long:    `Delete an base.`,
-	Example: `$ basecoat base delete FyrjxCQ`,
-	RunE:    baseDelete,
-	Args:    cobra.ExactArgs(1),
+	Use:   "delete <id>",
+	Short: "Delete a base",
+	Long: `Delete a base.
+
+If any formulas still use the base they are listed and you are asked to confirm before the base is deleted. Those
+formulas lose the base until it is restored from the trash.`,
+	Example: `$ basecoat base delete FyrjxCQ
+$ basecoat base delete --file bases.jsonl`,
+	RunE: baseDelete,
+	Args: batch.Args(1),
 }
 
 func init() {
+	cmdBaseDelete.Flags().BoolP("yes", "y", false, "Do not ask for confirmation when formulas still use the base")
+	cmdBaseDelete.Flags().Bool("dry-run", false, "Only show which formulas would be affected; do not delete anything")
+	batch.AddFlags(cmdBaseDelete, "DeleteBaseRequest")
 	CmdBase.AddCommand(cmdBaseDelete)
 }
 
-func baseDelete(_ *cobra.Command, args []string) error {
+func baseDelete(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return baseDeleteBatch(cmd, path)
+	}
+
 	id := args[0]
 
 	cl.State.Fmt.Print("Deleting base", polyfmt.Pretty)
 
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
 	conn, err := cl.State.Connect()
 	if err != nil {
 		cl.State.Fmt.Err(err)
@@ -40,16 +68,95 @@ func baseDelete(_ *cobra.Command, args []string) error {
 
 	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
 	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	impact, err := client.DeleteBase(ctx, &proto.DeleteBaseRequest{
+		Id:     id,
+		DryRun: true,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete base: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(impact.Formulas) > 0 {
+		data := [][]string{}
+		for _, formula := range impact.Formulas {
+			data = append(data, []string{formula.Id, formula.Number, formula.Name})
+		}
+
+		cl.State.Fmt.Println(fmt.Sprintf("%d formula(s) use base %q:\n%s", len(impact.Formulas), id,
+			format.GenerateGenericTable(data, " ", 2)))
+	}
+
+	if dryRun {
+		cl.State.Fmt.Success(fmt.Sprintf("Dry run; deleting base %q would affect %d formula(s)", id, len(impact.Formulas)))
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	if len(impact.Formulas) > 0 && !yes {
+		answer := cl.State.Fmt.Question("Delete anyway? [y/N]: ")
+		if !strings.EqualFold(strings.TrimSpace(answer), "y") {
+			cl.State.Fmt.Warning("Delete cancelled")
+			cl.State.Fmt.Finish()
+			return nil
+		}
+	}
+
 	_, err = client.DeleteBase(ctx, &proto.DeleteBaseRequest{
-		Id: id,
+		Id:    id,
+		Force: len(impact.Formulas) > 0,
 	})
 	if err != nil {
-		cl.State.Fmt.Err(fmt.Sprintf("could not update base: %v", err))
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete base: %v", err))
 		cl.State.Fmt.Finish()
 		return err
 	}
 
-	cl.State.Fmt.Success(fmt.Sprintf("Updated base: %q", id))
+	cl.State.Fmt.Success(fmt.Sprintf("Moved base %q to trash; restore with 'basecoat trash restore base %s'", id, id))
 	cl.State.Fmt.Finish()
 	return nil
 }
+
+// baseDeleteBatch deletes every base listed in a file with a single batch call.
+func baseDeleteBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Deleting bases", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.DeleteBaseRequest { return &proto.DeleteBaseRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchDeleteItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchDeleteItem{Item: &proto.BatchDeleteItem_Base{Base: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchDelete(ctx, &proto.BatchDeleteRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete bases: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("deleted", "base", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
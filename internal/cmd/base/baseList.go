@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/clintjedwards/basecoat/internal/cache"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
 	"github.com/clintjedwards/basecoat/internal/cmd/format"
 	"github.com/clintjedwards/basecoat/proto"
@@ -20,44 +21,57 @@ var cmdBaseList = &cobra.Command{
 	Short: "List all bases",
 	Long: `List all bases.
 
-A short listing of all currently registered bases.`,
+A short listing of all currently registered bases. If the server can't be reached bases are listed from the
+offline cache instead.`,
 	Example: `$ basecoat base list`,
 	RunE:    baseList,
 }
 
 func init() {
+	cl.AddOutputFlags(cmdBaseList)
 	CmdBase.AddCommand(cmdBaseList)
 }
 
 func baseList(_ *cobra.Command, _ []string) error {
 	cl.State.Fmt.Print("Retrieving bases", polyfmt.Pretty)
 
-	conn, err := cl.State.Connect()
+	bases, err := listBases()
 	if err != nil {
-		cl.State.Fmt.Err(err)
-		cl.State.Fmt.Finish()
-		return err
+		snapshot, offline := cl.State.Offline(err)
+		if !offline {
+			cl.State.Fmt.Err(fmt.Sprintf("could not list bases: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		bases, err = snapshot.Bases()
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not list bases from offline copy: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
 	}
 
-	client := proto.NewBasecoatClient(conn)
-	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	versions := map[string]int64{}
+	for _, base := range bases {
+		versions[base.Id] = base.Version
+	}
+	cl.State.See(cache.KindBase, versions)
 
-	resp, err := client.ListBases(ctx, &proto.ListBasesRequest{})
-	if err != nil {
-		cl.State.Fmt.Err(fmt.Sprintf("could not list bases: %v", err))
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(bases, format.NewBase))
 		cl.State.Fmt.Finish()
 		return err
 	}
 
-	if len(resp.Bases) == 0 {
+	if len(bases) == 0 {
 		cl.State.Fmt.Println("No bases found")
 		cl.State.Fmt.Finish()
 		return err
 	}
 
 	data := [][]string{}
-	for _, base := range resp.Bases {
+	for _, base := range bases {
 		data = append(data, []string{
 			base.Id,
 			base.Manufacturer,
@@ -74,6 +88,25 @@ func baseList(_ *cobra.Command, _ []string) error {
 	return nil
 }
 
+// listBases retrieves every base from the server.
+func listBases() ([]*proto.BaseMetadata, error) {
+	conn, err := cl.State.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListBases(ctx, &proto.ListBasesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Bases, nil
+}
+
 func formatTable(data [][]string, color bool) string {
 	tableString := &strings.Builder{}
 	table := tablewriter.NewWriter(tableString)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
 	"github.com/clintjedwards/basecoat/proto"
 	"github.com/clintjedwards/polyfmt/v2"
@@ -12,21 +14,28 @@ import (
 )
 
 var cmdBaseUpdate = &cobra.Command{
-	Use:     "update <id>",
-	Short:   "Update an base",
-	Long:    `Update an base.`,
-	Example: `$ basecoat base update FyrjxCQ`,
-	RunE:    baseUpdate,
-	Args:    cobra.ExactArgs(1),
+	Use:   "update <id>",
+	Short: "Update an base",
+	Long:  `Update an base.`,
+	Example: `$ basecoat base update FyrjxCQ
+$ basecoat base update --file bases.jsonl`,
+	RunE: baseUpdate,
+	Args: batch.Args(1),
 }
 
 func init() {
 	cmdBaseUpdate.Flags().StringP("label", "l", "", "Human readable base name")
 	cmdBaseUpdate.Flags().StringP("manufacturer", "m", "", "Manufacturer of the base")
+	batch.AddFlags(cmdBaseUpdate, "UpdateBaseRequest")
+	cl.AddVersionFlag(cmdBaseUpdate)
 	CmdBase.AddCommand(cmdBaseUpdate)
 }
 
 func baseUpdate(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return baseUpdateBatch(cmd, path)
+	}
+
 	id := args[0]
 
 	cl.State.Fmt.Print("Updating base", polyfmt.Pretty)
@@ -45,6 +54,13 @@ func baseUpdate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	version, err := cl.State.UpdateVersion(cmd, cache.KindBase, id)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
 	conn, err := cl.State.Connect()
 	if err != nil {
 		cl.State.Fmt.Err(err)
@@ -54,28 +70,86 @@ func baseUpdate(cmd *cobra.Command, args []string) error {
 
 	client := proto.NewBasecoatClient(conn)
 
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	// With no version shown before, the update is made against the current one.
+	if version == 0 {
+		current, err := client.GetBase(ctx, &proto.GetBaseRequest{Id: id})
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not retrieve base: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		version = current.Base.Metadata.Version
+	}
+
 	updateBaseRequest := &proto.UpdateBaseRequest{
-		Id: id,
+		Id:      id,
+		Version: version,
 	}
 
 	if cmd.Flags().Changed("label") {
-		updateBaseRequest.Label = label
+		updateBaseRequest.Label = &label
 	}
 
 	if cmd.Flags().Changed("manufacturer") {
-		updateBaseRequest.Manufacturer = manufacturer
+		updateBaseRequest.Manufacturer = &manufacturer
 	}
 
-	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
-	_, err = client.UpdateBase(ctx, updateBaseRequest)
+	resp, err := client.UpdateBase(ctx, updateBaseRequest)
 	if err != nil {
-		cl.State.Fmt.Err(fmt.Sprintf("could not update base: %v", err))
+		cl.State.Fmt.Err(cl.UpdateFailed(cache.KindBase, id, version, err))
 		cl.State.Fmt.Finish()
 		return err
 	}
 
+	cl.State.See(cache.KindBase, map[string]int64{id: resp.Base.Version})
+
 	cl.State.Fmt.Success(fmt.Sprintf("Updated base: %q", id))
 	cl.State.Fmt.Finish()
 	return nil
 }
+
+// baseUpdateBatch updates every base listed in a file with a single batch call.
+func baseUpdateBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Updating bases", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.UpdateBaseRequest { return &proto.UpdateBaseRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchUpdateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchUpdateItem{Item: &proto.BatchUpdateItem_Base{Base: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchUpdate(ctx, &proto.BatchUpdateRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not update bases: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("updated", "base", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
@@ -0,0 +1,132 @@
+// Package batch contains helpers shared by commands which can read many items from a file and apply them with a single
+// batch call.
+package batch
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	protoreflect "google.golang.org/protobuf/proto"
+)
+
+// AddFlags adds the flags used to read items from a file to a command. Request names the message each line of the
+// file holds.
+func AddFlags(cmd *cobra.Command, request string) {
+	cmd.Flags().StringP("file", "f", "",
+		fmt.Sprintf("Read items from a file of JSON lines, one %s per line; use - to read from stdin", request))
+	cmd.Flags().Bool("best-effort", false,
+		"When reading from a file skip items which fail instead of saving nothing at all")
+}
+
+// Args returns the positional argument check for a command which also accepts its items from a file. No arguments may
+// be given when reading from a file; otherwise exactly n are required.
+func Args(n int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if File(cmd) != "" {
+			return cobra.NoArgs(cmd, args)
+		}
+
+		return cobra.ExactArgs(n)(cmd, args)
+	}
+}
+
+// File returns the path given to the command's --file flag.
+func File(cmd *cobra.Command) string {
+	path, _ := cmd.Flags().GetString("file")
+	return path
+}
+
+// Mode returns the batch mode selected by the command's --best-effort flag.
+func Mode(cmd *cobra.Command) proto.BatchMode {
+	bestEffort, _ := cmd.Flags().GetBool("best-effort")
+	if bestEffort {
+		return proto.BatchMode_BEST_EFFORT
+	}
+
+	return proto.BatchMode_ATOMIC
+}
+
+// ReadItems reads one item per line from a file of JSON lines. A path of "-" reads from stdin. Blank lines are skipped.
+func ReadItems[T protoreflect.Message](path string, newItem func() T) ([]T, error) {
+	var reader io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	items := []T{}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+
+		item := newItem()
+		err := protojson.Unmarshal(scanner.Bytes(), item)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		items = append(items, item)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no items found in %s", path)
+	}
+
+	return items, nil
+}
+
+// Report prints the outcome of a batch and returns an error if any of its items failed. Verb and kind describe what
+// was done to each item; ex. "created" and "formula".
+func Report(verb, kind string, results []*proto.BatchResult, committed bool) error {
+	applied := 0
+	failed := 0
+
+	for _, result := range results {
+		if codes.Code(result.Code) == codes.OK {
+			applied++
+			continue
+		}
+
+		// Items which were rolled back or never attempted are summarized below rather than listed one by one.
+		if result.RolledBack {
+			continue
+		}
+
+		failed++
+		cl.State.Fmt.Err(fmt.Sprintf("item %d: %s", result.Index, result.Error))
+	}
+
+	if !committed {
+		cl.State.Fmt.Err(fmt.Sprintf("No %ss were %s; fix the items above or retry with --best-effort", kind, verb))
+		return fmt.Errorf("batch rolled back; %d items failed", failed)
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("%s %d of %d %ss", strings.ToUpper(verb[:1])+verb[1:], applied, len(results), kind))
+
+	if failed > 0 {
+		return fmt.Errorf("%d items failed", failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package cl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ErrUnreachable is returned by Connect when the server can't be reached.
+var ErrUnreachable = errors.New("server unreachable")
+
+// connectTimeout is how long Connect waits for the server before giving up.
+const connectTimeout = 5 * time.Second
+
+// cacheSyncTimeout bounds how long refreshing the offline cache can hold up the command which connected.
+const cacheSyncTimeout = 30 * time.Second
+
+// openCache opens the offline cache the first time it's needed.
+func (s *Harness) openCache() (*cache.Cache, error) {
+	if s.cache != nil {
+		return s.cache, nil
+	}
+
+	localCache, err := cache.Open(config.CachePath(), config.CacheKey)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache = localCache
+	return localCache, nil
+}
+
+// cacheScope names the snapshot of the server and account in use. The account comes from the profile if one is in
+// use and otherwise from the token.
+func (s *Harness) cacheScope() string {
+	account := config.TokenAccount(s.Config.Token)
+	if s.Profile != "" && s.Credentials != nil {
+		if profile, ok := s.Credentials.Profiles[s.Profile]; ok && profile.Account != "" {
+			account = profile.Account
+		}
+	}
+
+	return cache.Scope(s.Config.Host, account)
+}
+
+// SyncCache refreshes the offline cache from the server connected to; see cache.Cache.Sync.
+func (s *Harness) SyncCache(conn *grpc.ClientConn, full bool) (cache.SyncStats, error) {
+	if s.Config.DisableCache {
+		return cache.SyncStats{}, fmt.Errorf("the offline cache is disabled by disable_cache")
+	}
+
+	localCache, err := s.openCache()
+	if err != nil {
+		return cache.SyncStats{}, err
+	}
+
+	md := metadata.Pairs("Authorization", "Bearer "+s.Config.Token)
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), cacheSyncTimeout)
+	defer cancel()
+
+	return localCache.Sync(ctx, proto.NewBasecoatClient(conn), s.cacheScope(), full)
+}
+
+// syncCache refreshes the offline cache after connecting if it's due. It's a convenience which must never fail the
+// command that connected, so errors are ignored; `basecoat sync` reports them.
+func (s *Harness) syncCache(conn *grpc.ClientConn) {
+	if s.SkipCacheSync || s.Config.DisableCache {
+		return
+	}
+
+	localCache, err := s.openCache()
+	if err != nil {
+		return
+	}
+
+	synced, err := localCache.Synced(s.cacheScope())
+	if err == nil && time.Since(synced) < time.Duration(s.Config.CacheSyncInterval)*time.Second {
+		return
+	}
+
+	_, _ = s.SyncCache(conn, false)
+}
+
+// Unreachable returns true if err shows that the server couldn't be reached.
+func Unreachable(err error) bool {
+	return errors.Is(err, ErrUnreachable) || status.Code(err) == codes.Unavailable
+}
+
+// Snapshot returns the offline cache's copy of the account in use.
+func (s *Harness) Snapshot() (*cache.Snapshot, error) {
+	if s.Config.DisableCache {
+		return nil, fmt.Errorf("the offline cache is disabled by disable_cache")
+	}
+
+	localCache, err := s.openCache()
+	if err != nil {
+		return nil, err
+	}
+
+	return localCache.Snapshot(s.cacheScope())
+}
+
+// Offline returns the offline cache's copy of the account in use if err shows that the server couldn't be reached,
+// warning that what's shown may be out of date. It returns false if err is any other error or nothing is cached, in
+// which case the command should report err as usual.
+func (s *Harness) Offline(err error) (*cache.Snapshot, bool) {
+	if !Unreachable(err) {
+		return nil, false
+	}
+
+	snapshot, cacheErr := s.Snapshot()
+	if cacheErr != nil {
+		return nil, false
+	}
+
+	s.Fmt.Warning(fmt.Sprintf("could not reach %s; showing offline copy, stale as of %s", s.Config.Host,
+		format.UnixMilli(snapshot.Synced.UnixMilli(), "never", true)))
+
+	return snapshot, true
+}
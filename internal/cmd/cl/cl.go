@@ -3,12 +3,16 @@
 package cl
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"log"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
 	"github.com/clintjedwards/basecoat/internal/config"
 	"github.com/clintjedwards/polyfmt/v2"
 	"github.com/fatih/color"
@@ -24,6 +28,23 @@ type Harness struct {
 	Fmt            polyfmt.Formatter
 	Config         *config.CLI
 	ConfigFilePath string
+
+	// Credentials are the saved logins; nil if they couldn't be read.
+	Credentials *config.Credentials
+
+	// Profile is the name of the saved login in use; empty if none is.
+	Profile string
+
+	// Output is how get and list commands print what they retrieved; see AddOutputFlags. Template is the text of the
+	// template used with format.OutputTemplate.
+	Output   format.Output
+	Template string
+
+	// SkipCacheSync stops Connect from sending queued changes and refreshing the offline cache; set by commands which
+	// do so themselves.
+	SkipCacheSync bool
+
+	cache *cache.Cache
 }
 
 // State holds values that aid in the lifetime of a command.
@@ -46,11 +67,32 @@ func (s *Harness) Connect() (*grpc.ClientConn, error) {
 	}
 
 	opt = append(opt, grpc.WithTransportCredentials(credentials.NewTLS(tlsConf)))
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%s", host, port), opt...)
+	opt = append(opt, grpc.WithUnaryInterceptor(s.reloginInterceptor))
+
+	// The connection is made before returning, instead of on the first call, so that commands which can fall back to
+	// the offline cache find out the server can't be reached without waiting on a call; see Offline.
+	opt = append(opt, grpc.WithBlock(), grpc.FailOnNonTempDialError(true), grpc.WithReturnConnectionError())
+
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%s", host, port), opt...)
 	if err != nil {
-		return nil, fmt.Errorf("could not connect to server: %w", err)
+		return nil, fmt.Errorf("could not connect to server: %w: %v", ErrUnreachable, err)
 	}
 
+	// Commands read the token right after connecting, so an expired one is replaced now instead of after the first
+	// call is turned away.
+	if s.Profile != "" && s.Credentials.Profiles[s.Profile].Expired(time.Now()) {
+		err = s.relogin(conn)
+		if err != nil {
+			return nil, fmt.Errorf("token for profile %q has expired and could not log in again: %w", s.Profile, err)
+		}
+	}
+
+	s.replayChanges(conn)
+	s.syncCache(conn)
+
 	return conn, nil
 }
 
@@ -66,11 +108,16 @@ func InitState(cmd *cobra.Command) {
 	// and also make sure the command line inherits the proper variable chain(config file -> envvar -> flags)
 	State = &Harness{}
 
-	// This is a hack. Because the start command needs to use the --config global variable for its own purposes
-	// we tell it to skip parsing the as if its a CLI config and supply it with some defaults.
-	if cmd.Name() == "start" && cmd.Parent().Name() == "service" {
+	// This is a hack. Because the start, backup and restore commands need to use the --config global variable for
+	// their own purposes(it points at the service's configuration) we tell them to skip parsing it as if its a CLI
+	// config and supply them with some defaults.
+	if isServiceConfigCommand(cmd) {
 		State.Config = &config.CLI{
-			Format: "silent",
+			Format: "pretty",
+		}
+
+		if cmd.Name() == "start" {
+			State.Config.Format = "silent"
 		}
 	} else {
 		config, _ := cmd.Flags().GetString("config")
@@ -83,9 +130,29 @@ func InitState(cmd *cobra.Command) {
 		State.Config.Format = format
 	}
 
+	State.setOutput(cmd)
 	State.NewFormatter()
 
 	overlayGlobalFlags(cmd)
+
+	if !isServiceConfigCommand(cmd) {
+		State.loadProfile(cmd)
+	}
+}
+
+// isServiceConfigCommand returns true for commands which read the service's configuration file instead of the
+// command line's.
+func isServiceConfigCommand(cmd *cobra.Command) bool {
+	if !cmd.HasParent() || cmd.Parent().Name() != "service" {
+		return false
+	}
+
+	switch cmd.Name() {
+	case "start", "backup", "restore":
+		return true
+	default:
+		return false
+	}
 }
 
 // Flags are the last possible way to provide variables to the command line. For global variables we allow the user
@@ -114,10 +181,51 @@ func overlayGlobalFlags(cmd *cobra.Command) {
 	if host != "" {
 		State.Config.Host = host
 	}
+
+	profile, _ := cmd.Flags().GetString("profile")
+	if profile != "" {
+		State.Config.Profile = profile
+	}
+}
+
+// loadProfile reads the saved logins and applies the one in use. A profile's host and token take precedence over the
+// configuration file and environment so that switching profiles always switches servers; the --host flag still wins.
+func (s *Harness) loadProfile(cmd *cobra.Command) {
+	credentials, err := config.LoadCredentials(config.CredentialsPath())
+	if err != nil {
+		s.Fmt.Warning(fmt.Sprintf("could not read saved logins: %v", err))
+		return
+	}
+
+	s.Credentials = credentials
+
+	profile, name, ok := credentials.Profile(s.Config.Profile)
+	if !ok {
+		return
+	}
+
+	s.Profile = name
+	s.Config.Token = profile.Token
+
+	if host, _ := cmd.Flags().GetString("host"); host == "" {
+		s.Config.Host = profile.Host
+	}
 }
 
 func (s *Harness) NewFormatter() {
-	clifmt, err := polyfmt.NewFormatter(polyfmt.Mode(s.Config.Format), polyfmt.DefaultOptions())
+	if s.Structured() {
+		s.Fmt = &stderrFormatter{}
+		return
+	}
+
+	mode := polyfmt.Mode(s.Config.Format)
+
+	// YAML and CSV only apply to what get and list commands retrieve; other commands report in JSON instead.
+	if output := format.Output(s.Config.Format); output == format.OutputYAML || output == format.OutputCSV {
+		mode = polyfmt.JSON
+	}
+
+	clifmt, err := polyfmt.NewFormatter(mode, polyfmt.DefaultOptions())
 	if err != nil {
 		log.Fatal(err)
 	}
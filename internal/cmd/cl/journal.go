@@ -0,0 +1,96 @@
+package cl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// Queue keeps a change which couldn't be made because the server couldn't be reached, to be sent the next time a
+// command connects; see cache.Cache.Queue. SeenVersion is the version of the formula the change was made against; 0
+// if unknown.
+func (s *Harness) Queue(request protobuf.Message, seenVersion int64) (*cache.Change, error) {
+	if s.Config.DisableCache {
+		return nil, fmt.Errorf("changes can't be queued while the offline cache is disabled by disable_cache")
+	}
+
+	localCache, err := s.openCache()
+	if err != nil {
+		return nil, err
+	}
+
+	return localCache.Queue(s.cacheScope(), request, seenVersion)
+}
+
+// Changes returns the changes queued for the account in use.
+func (s *Harness) Changes() ([]*cache.Change, error) {
+	localCache, err := s.openCache()
+	if err != nil {
+		return nil, err
+	}
+
+	return localCache.Changes(s.cacheScope())
+}
+
+// ResolveChange resolves a queued change which conflicts with the server by either sending it anyway or discarding it.
+func (s *Harness) ResolveChange(id int64, retry bool) error {
+	localCache, err := s.openCache()
+	if err != nil {
+		return err
+	}
+
+	if retry {
+		return localCache.Retry(s.cacheScope(), id)
+	}
+
+	return localCache.Discard(s.cacheScope(), id)
+}
+
+// ReplayChanges sends the changes queued for the account in use to the server connected to; see cache.Cache.Replay.
+func (s *Harness) ReplayChanges(conn *grpc.ClientConn) (cache.ReplayStats, error) {
+	localCache, err := s.openCache()
+	if err != nil {
+		return cache.ReplayStats{}, err
+	}
+
+	md := metadata.Pairs("Authorization", "Bearer "+s.Config.Token)
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), cacheSyncTimeout)
+	defer cancel()
+
+	return localCache.Replay(ctx, proto.NewBasecoatClient(conn), s.cacheScope())
+}
+
+// replayChanges sends queued changes after connecting so that they're made before whatever the command does. Like
+// syncCache it never fails the command which connected, but it does say what happened to the changes.
+func (s *Harness) replayChanges(conn *grpc.ClientConn) {
+	if s.SkipCacheSync || s.Config.DisableCache {
+		return
+	}
+
+	localCache, err := s.openCache()
+	if err != nil {
+		return
+	}
+
+	queued, err := localCache.Queued(s.cacheScope())
+	if err != nil || queued == 0 {
+		return
+	}
+
+	stats, err := s.ReplayChanges(conn)
+	if stats.Sent > 0 {
+		s.Fmt.Println(fmt.Sprintf("Sent %d changes made while offline", stats.Sent))
+	}
+	if stats.Conflicts > 0 {
+		s.Fmt.Warning(fmt.Sprintf("%d changes made while offline conflict with the server; see 'basecoat queue list'",
+			stats.Conflicts))
+	}
+	if err != nil {
+		s.Fmt.Warning(fmt.Sprintf("could not send %d changes made while offline: %v", stats.Pending, err))
+	}
+}
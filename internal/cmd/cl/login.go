@@ -0,0 +1,109 @@
+package cl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/fatih/color"
+	"golang.org/x/term"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Password asks the user for a password without echoing it back. If standard input isn't a terminal a line is read
+// from it as is so that passwords can be piped in.
+func (s *Harness) Password(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return s.Fmt.Question(prompt), nil
+	}
+
+	// The spinner would draw over the prompt so it's stopped while the user types.
+	s.Fmt.Finish()
+	defer s.NewFormatter()
+
+	fmt.Printf("%s %s", color.MagentaString("?"), prompt)
+	password, err := term.ReadPassword(fd)
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+
+	return string(password), nil
+}
+
+// RequestToken asks the server for a new token for the account of the profile given and saves it under the profile's
+// name. The token is used for the rest of the command.
+func (s *Harness) RequestToken(conn *grpc.ClientConn, name string, profile *config.Profile, password string) error {
+	if s.Credentials == nil {
+		return fmt.Errorf("saved logins could not be read")
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	resp, err := client.CreateAPIToken(context.Background(), &proto.CreateAPITokenRequest{
+		Account:  profile.Account,
+		Password: password,
+		Duration: profile.Duration,
+	})
+	if err != nil {
+		return err
+	}
+
+	profile.Token = resp.Key
+	s.Credentials.Profiles[name] = profile
+	s.Config.Token = resp.Key
+
+	return s.Credentials.Save()
+}
+
+// relogin replaces the token of the profile in use. The saved password is used if there is one, otherwise the user is
+// asked for it.
+func (s *Harness) relogin(conn *grpc.ClientConn) error {
+	profile, ok := s.Credentials.Profiles[s.Profile]
+	if !ok {
+		return fmt.Errorf("profile %q not found", s.Profile)
+	}
+
+	password := profile.Password
+	if password == "" {
+		var err error
+		password, err = s.Password(fmt.Sprintf("Token for profile %q has expired; password for %s: ",
+			s.Profile, profile.Account))
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.RequestToken(conn, s.Profile, profile, password)
+}
+
+// reloginInterceptor logs in again and retries a call once if the server turned it away because the profile's token
+// expired. Connect already replaces tokens which have expired by the client's clock; this covers the server's clock
+// disagreeing.
+func (s *Harness) reloginInterceptor(ctx context.Context, method string, req, reply interface{},
+	conn *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	err := invoker(ctx, method, req, reply, conn, opts...)
+	if s.Profile == "" || method == proto.Basecoat_CreateAPIToken_FullMethodName ||
+		status.Code(err) != codes.Unauthenticated || !strings.Contains(status.Convert(err).Message(), "expired") {
+		return err
+	}
+
+	reloginErr := s.relogin(conn)
+	if reloginErr != nil {
+		s.Fmt.Warning(fmt.Sprintf("could not log in again: %v", reloginErr))
+		return err
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set("authorization", "Bearer "+s.Config.Token)
+
+	return invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, conn, opts...)
+}
@@ -0,0 +1,86 @@
+package cl
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+)
+
+// AddOutputFlags marks a get or list command as able to print what it retrieved in a structured output. Such commands
+// honor --format json, yaml and csv and gain a --template flag.
+func AddOutputFlags(cmd *cobra.Command) {
+	cmd.Flags().String("template", "",
+		"Go template executed for each record printed, ex. '{{.ID}} {{.Name}}'; see 'basecoat help output' for fields")
+}
+
+// outputCommand returns true if the command can print structured output.
+func outputCommand(cmd *cobra.Command) bool {
+	return cmd.Flags().Lookup("template") != nil
+}
+
+// setOutput picks how a get or list command prints what it retrieved. With any structured output nothing but the
+// records themselves is written to standard out so they can be piped elsewhere; errors go to standard error. See
+// NewFormatter.
+func (s *Harness) setOutput(cmd *cobra.Command) {
+	s.Output = format.OutputPretty
+
+	if !outputCommand(cmd) {
+		return
+	}
+
+	s.Template, _ = cmd.Flags().GetString("template")
+
+	switch {
+	case s.Template != "":
+		s.Output = format.OutputTemplate
+	case s.Config.Format == string(format.OutputJSON), s.Config.Format == string(format.OutputYAML),
+		s.Config.Format == string(format.OutputCSV):
+		s.Output = format.Output(s.Config.Format)
+	}
+}
+
+// Structured returns true if the command should print records with PrintRecords instead of tables.
+func (s *Harness) Structured() bool {
+	return s.Output.Structured()
+}
+
+// PrintRecords prints a single record or a list of them in the structured output chosen.
+func (s *Harness) PrintRecords(records any) error {
+	err := format.Write(os.Stdout, s.Output, s.Template, records)
+	if err != nil {
+		s.Fmt.Err(fmt.Sprintf("could not print output: %v", err))
+		return err
+	}
+
+	return nil
+}
+
+// stderrFormatter is used in place of polyfmt's formatters while structured output is printed. Progress and success
+// messages are dropped and errors and warnings are written to standard error, keeping standard out for the records.
+type stderrFormatter struct{}
+
+func (f *stderrFormatter) Print(msg any, filter ...polyfmt.Mode)   {}
+func (f *stderrFormatter) Println(msg any, filter ...polyfmt.Mode) {}
+func (f *stderrFormatter) Success(msg any, filter ...polyfmt.Mode) {}
+func (f *stderrFormatter) Debugln(msg any, filter ...polyfmt.Mode) {}
+func (f *stderrFormatter) Finish()                                 {}
+
+func (f *stderrFormatter) Err(msg any, filter ...polyfmt.Mode) {
+	fmt.Fprintf(os.Stderr, "x %v\n", msg)
+}
+
+func (f *stderrFormatter) Warning(msg any, filter ...polyfmt.Mode) {
+	fmt.Fprintf(os.Stderr, "! %v\n", msg)
+}
+
+func (f *stderrFormatter) Question(msg any, filter ...polyfmt.Mode) string {
+	fmt.Fprintf(os.Stderr, "? %v", msg)
+
+	var answer string
+	fmt.Fscanln(os.Stdin, &answer)
+	return strings.TrimSpace(answer)
+}
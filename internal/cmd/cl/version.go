@@ -0,0 +1,80 @@
+package cl
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Updates name the version of the record they were made against so that changes made by someone else in the meantime
+// are refused rather than silently overwritten. The version made against is the one last shown by a get or list
+// command, which is kept in the offline cache, unless it's given with --version.
+
+// AddVersionFlag marks an update command as made against a version of the record it changes; see UpdateVersion.
+func AddVersionFlag(cmd *cobra.Command) {
+	cmd.Flags().Int64("version", 0, "Version of the record the update is made against; defaults to the version last "+
+		"shown by get or list. The update is refused if the record has changed since.")
+}
+
+// See keeps the versions of the records shown to the user, keyed by id, so that updates made afterwards are made
+// against them. Like syncCache it must never fail the command showing the records, so errors are ignored.
+func (s *Harness) See(kind cache.Kind, versions map[string]int64) {
+	if s.Config.DisableCache {
+		return
+	}
+
+	localCache, err := s.openCache()
+	if err != nil {
+		return
+	}
+
+	_ = localCache.See(s.cacheScope(), kind, versions)
+}
+
+// UpdateVersion returns the version of a record an update is made against: the one given by --version or else the
+// one last shown to the user. It returns 0 if neither is known, in which case the update is made against the current
+// version.
+func (s *Harness) UpdateVersion(cmd *cobra.Command, kind cache.Kind, id string) (int64, error) {
+	if cmd.Flags().Changed("version") {
+		version, err := cmd.Flags().GetInt64("version")
+		if err != nil {
+			return 0, err
+		}
+		if version <= 0 {
+			return 0, fmt.Errorf("--version must be greater than 0")
+		}
+
+		return version, nil
+	}
+
+	if s.Config.DisableCache {
+		return 0, nil
+	}
+
+	localCache, err := s.openCache()
+	if err != nil {
+		return 0, nil
+	}
+
+	version, err := localCache.Seen(s.cacheScope(), kind, id)
+	if err != nil && !errors.Is(err, cache.ErrNotCached) {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+// UpdateFailed describes why an update of a record failed. An update refused because someone else changed the record
+// since the version it was made against is reported as a conflict.
+func UpdateFailed(kind cache.Kind, id string, version int64, err error) string {
+	if status.Code(err) == codes.Aborted {
+		return fmt.Sprintf("could not update %s %q; it conflicts with changes made by someone else since version "+
+			"%d. Show it again to review them, then retry the update", kind, id, version)
+	}
+
+	return fmt.Sprintf("could not update %s: %v", kind, err)
+}
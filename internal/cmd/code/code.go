@@ -0,0 +1,14 @@
+package code
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var CmdCode = &cobra.Command{
+	Use:   "code",
+	Short: "Look up the short codes printed on labels",
+	Long: `Look up the short codes printed on labels.
+
+Every formula, job and mix has a short code which never changes. Codes are printed under the QR code on can labels and
+lead to <external_url>/c/<code>, so a can scanned or read years later still finds its recipe.`,
+}
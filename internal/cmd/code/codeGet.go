@@ -0,0 +1,73 @@
+package code
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdCodeGet = &cobra.Command{
+	Use:     "get <formula|job|mix> <id>",
+	Short:   "Show the code of a formula, job or mix",
+	Example: `$ basecoat code get formula FyrjxCQ`,
+	RunE:    codeGet,
+	Args:    cobra.ExactArgs(2),
+}
+
+func init() {
+	cl.AddOutputFlags(cmdCodeGet)
+	CmdCode.AddCommand(cmdCodeGet)
+}
+
+func codeGet(_ *cobra.Command, args []string) error {
+	kind := strings.ToUpper(args[0])
+	id := args[1]
+
+	cl.State.Fmt.Print("Retrieving code", polyfmt.Pretty)
+
+	if kind != proto.EntityKind_FORMULA.String() && kind != proto.EntityKind_JOB.String() &&
+		kind != proto.EntityKind_MIX.String() {
+		err := fmt.Errorf("unknown kind %q; must be one of formula, job or mix", args[0])
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.GetCode(ctx, &proto.GetCodeRequest{
+		Kind: proto.EntityKind(proto.EntityKind_value[kind]),
+		Id:   id,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not retrieve code: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.NewCode(resp.Code))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Println(resp.Code.Code)
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -0,0 +1,67 @@
+package code
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdCodeResolve = &cobra.Command{
+	Use:   "resolve <code>",
+	Short: "Find the formula, job or mix a code belongs to",
+	Long: `Find the formula, job or mix a code belongs to.
+
+Codes can be typed the way they're printed; case, spaces and dashes are ignored.`,
+	Example: `$ basecoat code resolve 7KQ2-MXHP`,
+	RunE:    codeResolve,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	CmdCode.AddCommand(cmdCodeResolve)
+}
+
+func codeResolve(_ *cobra.Command, args []string) error {
+	cl.State.Fmt.Print("Resolving code", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ResolveCode(ctx, &proto.ResolveCodeRequest{Code: args[0]})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not resolve code: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	switch entity := resp.Entity.(type) {
+	case *proto.ResolveCodeResponse_Formula:
+		formula := entity.Formula.Metadata
+		name := formula.Name
+		if formula.Number != "" {
+			name = fmt.Sprintf("%s (%s)", name, formula.Number)
+		}
+		cl.State.Fmt.Println(fmt.Sprintf("Formula %s: %s", formula.Id, name))
+	case *proto.ResolveCodeResponse_Job:
+		cl.State.Fmt.Println(fmt.Sprintf("Job %s: %s", entity.Job.Id, entity.Job.Name))
+	case *proto.ResolveCodeResponse_Mix:
+		cl.State.Fmt.Println(fmt.Sprintf("Mix %s: %d x %s of formula %s", entity.Mix.Id, entity.Mix.Quantity,
+			entity.Mix.Container, entity.Mix.Formula))
+	}
+
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
 	"github.com/clintjedwards/basecoat/proto"
 	"github.com/clintjedwards/polyfmt/v2"
@@ -13,24 +14,41 @@ import (
 )
 
 var cmdColorantCreate = &cobra.Command{
-	Use:     "create <label> <manufacturer>",
-	Short:   "Create a new colorant",
-	Long:    `Create a new colorant.`,
-	Example: `$ basecoat colorant create "Off White" "Benjamin Moore"`,
-	RunE:    colorantCreate,
-	Args:    cobra.ExactArgs(2),
+	Use:   "create <label> <manufacturer>",
+	Short: "Create a new colorant",
+	Long: `Create a new colorant.
+
+The dispenser code is what tinting dispensers call the colorant; it is needed to export formulas to a dispenser.`,
+	Example: `$ basecoat colorant create "Off White" "Benjamin Moore"
+$ basecoat colorant create "Lamp Black" "Benjamin Moore" --dispenser-code B
+$ basecoat colorant create --file colorants.jsonl`,
+	RunE: colorantCreate,
+	Args: batch.Args(2),
 }
 
 func init() {
+	cmdColorantCreate.Flags().StringP("dispenser-code", "d", "", "Code tinting dispensers use for the colorant")
+	batch.AddFlags(cmdColorantCreate, "CreateColorantRequest")
 	CmdColorant.AddCommand(cmdColorantCreate)
 }
 
-func colorantCreate(_ *cobra.Command, args []string) error {
+func colorantCreate(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return colorantCreateBatch(cmd, path)
+	}
+
 	label := args[0]
 	manufacturer := args[1]
 
 	cl.State.Fmt.Print("Creating colorant", polyfmt.Pretty)
 
+	dispenserCode, err := cmd.Flags().GetString("dispenser-code")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
 	conn, err := cl.State.Connect()
 	if err != nil {
 		cl.State.Fmt.Err(err)
@@ -43,8 +61,9 @@ func colorantCreate(_ *cobra.Command, args []string) error {
 	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
 	ctx := metadata.NewOutgoingContext(context.Background(), md)
 	resp, err := client.CreateColorant(ctx, &proto.CreateColorantRequest{
-		Label:        label,
-		Manufacturer: manufacturer,
+		Label:         label,
+		Manufacturer:  manufacturer,
+		DispenserCode: dispenserCode,
 	})
 	if err != nil {
 		cl.State.Fmt.Err(fmt.Sprintf("could not create colorant: %v", err))
@@ -57,3 +76,45 @@ func colorantCreate(_ *cobra.Command, args []string) error {
 	cl.State.Fmt.Finish()
 	return nil
 }
+
+// colorantCreateBatch creates every colorant listed in a file with a single batch call.
+func colorantCreateBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Creating colorants", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.CreateColorantRequest { return &proto.CreateColorantRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchCreateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchCreateItem{Item: &proto.BatchCreateItem_Colorant{Colorant: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchCreate(ctx, &proto.BatchCreateRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not create colorants: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("created", "colorant", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
@@ -3,8 +3,11 @@ package colorant
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
 	"github.com/clintjedwards/basecoat/proto"
 	"github.com/clintjedwards/polyfmt/v2"
 	"github.com/spf13/cobra"
@@ -12,23 +15,48 @@ import (
 )
 
 var cmdColorantDelete = &cobra.Command{
-	Use:     "delete <id>",
-	Short:   "Delete  an colorant",
-	Long:    `Delete an colorant.`,
-	Example: `$ basecoat colorant delete FyrjxCQ`,
-	RunE:    colorantDelete,
-	Args:    cobra.ExactArgs(1),
+	Use:   "delete <id>",
+	Short: "Delete a colorant",
+	Long: `Delete a colorant.
+
+If any formulas still use the colorant they are listed and you are asked to confirm before the colorant is deleted. Those
+formulas lose the colorant until it is restored from the trash.`,
+	Example: `$ basecoat colorant delete FyrjxCQ
+$ basecoat colorant delete --file colorants.jsonl`,
+	RunE: colorantDelete,
+	Args: batch.Args(1),
 }
 
 func init() {
+	cmdColorantDelete.Flags().BoolP("yes", "y", false, "Do not ask for confirmation when formulas still use the colorant")
+	cmdColorantDelete.Flags().Bool("dry-run", false, "Only show which formulas would be affected; do not delete anything")
+	batch.AddFlags(cmdColorantDelete, "DeleteColorantRequest")
 	CmdColorant.AddCommand(cmdColorantDelete)
 }
 
-func colorantDelete(_ *cobra.Command, args []string) error {
+func colorantDelete(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return colorantDeleteBatch(cmd, path)
+	}
+
 	id := args[0]
 
 	cl.State.Fmt.Print("Deleting colorant", polyfmt.Pretty)
 
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
 	conn, err := cl.State.Connect()
 	if err != nil {
 		cl.State.Fmt.Err(err)
@@ -40,16 +68,95 @@ func colorantDelete(_ *cobra.Command, args []string) error {
 
 	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
 	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	impact, err := client.DeleteColorant(ctx, &proto.DeleteColorantRequest{
+		Id:     id,
+		DryRun: true,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete colorant: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(impact.Formulas) > 0 {
+		data := [][]string{}
+		for _, formula := range impact.Formulas {
+			data = append(data, []string{formula.Id, formula.Number, formula.Name})
+		}
+
+		cl.State.Fmt.Println(fmt.Sprintf("%d formula(s) use colorant %q:\n%s", len(impact.Formulas), id,
+			format.GenerateGenericTable(data, " ", 2)))
+	}
+
+	if dryRun {
+		cl.State.Fmt.Success(fmt.Sprintf("Dry run; deleting colorant %q would affect %d formula(s)", id, len(impact.Formulas)))
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	if len(impact.Formulas) > 0 && !yes {
+		answer := cl.State.Fmt.Question("Delete anyway? [y/N]: ")
+		if !strings.EqualFold(strings.TrimSpace(answer), "y") {
+			cl.State.Fmt.Warning("Delete cancelled")
+			cl.State.Fmt.Finish()
+			return nil
+		}
+	}
+
 	_, err = client.DeleteColorant(ctx, &proto.DeleteColorantRequest{
-		Id: id,
+		Id:    id,
+		Force: len(impact.Formulas) > 0,
 	})
 	if err != nil {
-		cl.State.Fmt.Err(fmt.Sprintf("could not update colorant: %v", err))
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete colorant: %v", err))
 		cl.State.Fmt.Finish()
 		return err
 	}
 
-	cl.State.Fmt.Success(fmt.Sprintf("Updated colorant: %q", id))
+	cl.State.Fmt.Success(fmt.Sprintf("Moved colorant %q to trash; restore with 'basecoat trash restore colorant %s'", id, id))
 	cl.State.Fmt.Finish()
 	return nil
 }
+
+// colorantDeleteBatch deletes every colorant listed in a file with a single batch call.
+func colorantDeleteBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Deleting colorants", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.DeleteColorantRequest { return &proto.DeleteColorantRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchDeleteItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchDeleteItem{Item: &proto.BatchDeleteItem_Colorant{Colorant: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchDelete(ctx, &proto.BatchDeleteRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete colorants: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("deleted", "colorant", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
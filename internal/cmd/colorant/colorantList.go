@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/clintjedwards/basecoat/internal/cache"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
 	"github.com/clintjedwards/basecoat/internal/cmd/format"
 	"github.com/clintjedwards/basecoat/proto"
@@ -20,48 +21,62 @@ var cmdColorantList = &cobra.Command{
 	Short: "List all colorants",
 	Long: `List all colorants.
 
-A short listing of all currently registered colorants.`,
+A short listing of all currently registered colorants. If the server can't be reached colorants are listed from the
+offline cache instead.`,
 	Example: `$ basecoat colorant list`,
 	RunE:    colorantList,
 }
 
 func init() {
+	cl.AddOutputFlags(cmdColorantList)
 	CmdColorant.AddCommand(cmdColorantList)
 }
 
 func colorantList(_ *cobra.Command, _ []string) error {
 	cl.State.Fmt.Print("Retrieving colorants", polyfmt.Pretty)
 
-	conn, err := cl.State.Connect()
+	colorants, err := listColorants()
 	if err != nil {
-		cl.State.Fmt.Err(err)
-		cl.State.Fmt.Finish()
-		return err
+		snapshot, offline := cl.State.Offline(err)
+		if !offline {
+			cl.State.Fmt.Err(fmt.Sprintf("could not list colorants: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		colorants, err = snapshot.Colorants()
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not list colorants from offline copy: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
 	}
 
-	client := proto.NewBasecoatClient(conn)
-	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	versions := map[string]int64{}
+	for _, colorant := range colorants {
+		versions[colorant.Id] = colorant.Version
+	}
+	cl.State.See(cache.KindColorant, versions)
 
-	resp, err := client.ListColorants(ctx, &proto.ListColorantsRequest{})
-	if err != nil {
-		cl.State.Fmt.Err(fmt.Sprintf("could not list colorants: %v", err))
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(colorants, format.NewColorant))
 		cl.State.Fmt.Finish()
 		return err
 	}
 
-	if len(resp.Colorants) == 0 {
+	if len(colorants) == 0 {
 		cl.State.Fmt.Println("No colorants found")
 		cl.State.Fmt.Finish()
 		return err
 	}
 
 	data := [][]string{}
-	for _, colorant := range resp.Colorants {
+	for _, colorant := range colorants {
 		data = append(data, []string{
 			colorant.Id,
 			colorant.Manufacturer,
 			colorant.Label,
+			colorant.DispenserCode,
 			format.UnixMilli(colorant.Created, "Never", cl.State.Config.Detail),
 		})
 	}
@@ -74,11 +89,30 @@ func colorantList(_ *cobra.Command, _ []string) error {
 	return nil
 }
 
+// listColorants retrieves every colorant from the server.
+func listColorants() ([]*proto.ColorantMetadata, error) {
+	conn, err := cl.State.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListColorants(ctx, &proto.ListColorantsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Colorants, nil
+}
+
 func formatTable(data [][]string, color bool) string {
 	tableString := &strings.Builder{}
 	table := tablewriter.NewWriter(tableString)
 
-	table.SetHeader([]string{"ID", "Manufacturer", "Label", "Created"})
+	table.SetHeader([]string{"ID", "Manufacturer", "Label", "Code", "Created"})
 	table.SetAlignment(tablewriter.ALIGN_LEFT)
 	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
 	table.SetHeaderLine(true)
@@ -95,12 +129,14 @@ func formatTable(data [][]string, color bool) string {
 			tablewriter.Color(tablewriter.FgBlueColor),
 			tablewriter.Color(tablewriter.FgBlueColor),
 			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
 		)
 		table.SetColumnColor(
 			tablewriter.Color(tablewriter.FgYellowColor),
 			tablewriter.Color(0),
 			tablewriter.Color(0),
 			tablewriter.Color(0),
+			tablewriter.Color(0),
 		)
 	}
 
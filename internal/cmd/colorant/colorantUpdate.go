@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
 	"github.com/clintjedwards/basecoat/proto"
 	"github.com/clintjedwards/polyfmt/v2"
@@ -12,21 +14,30 @@ import (
 )
 
 var cmdColorantUpdate = &cobra.Command{
-	Use:     "update <id>",
-	Short:   "Update an colorant",
-	Long:    `Update an colorant.`,
-	Example: `$ basecoat colorant update FyrjxCQ`,
-	RunE:    colorantUpdate,
-	Args:    cobra.ExactArgs(1),
+	Use:   "update <id>",
+	Short: "Update an colorant",
+	Long:  `Update an colorant.`,
+	Example: `$ basecoat colorant update FyrjxCQ
+$ basecoat colorant update FyrjxCQ --dispenser-code KX
+$ basecoat colorant update --file colorants.jsonl`,
+	RunE: colorantUpdate,
+	Args: batch.Args(1),
 }
 
 func init() {
 	cmdColorantUpdate.Flags().StringP("label", "l", "", "Human readable colorant name")
 	cmdColorantUpdate.Flags().StringP("manufacturer", "m", "", "Manufacturer of the colorant")
+	cmdColorantUpdate.Flags().StringP("dispenser-code", "d", "", "Code tinting dispensers use for the colorant")
+	batch.AddFlags(cmdColorantUpdate, "UpdateColorantRequest")
+	cl.AddVersionFlag(cmdColorantUpdate)
 	CmdColorant.AddCommand(cmdColorantUpdate)
 }
 
 func colorantUpdate(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return colorantUpdateBatch(cmd, path)
+	}
+
 	id := args[0]
 
 	cl.State.Fmt.Print("Updating colorant", polyfmt.Pretty)
@@ -45,6 +56,20 @@ func colorantUpdate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	dispenserCode, err := cmd.Flags().GetString("dispenser-code")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	version, err := cl.State.UpdateVersion(cmd, cache.KindColorant, id)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
 	conn, err := cl.State.Connect()
 	if err != nil {
 		cl.State.Fmt.Err(err)
@@ -54,28 +79,90 @@ func colorantUpdate(cmd *cobra.Command, args []string) error {
 
 	client := proto.NewBasecoatClient(conn)
 
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	// With no version shown before, the update is made against the current one.
+	if version == 0 {
+		current, err := client.GetColorant(ctx, &proto.GetColorantRequest{Id: id})
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not retrieve colorant: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		version = current.Colorant.Metadata.Version
+	}
+
 	updateColorantRequest := &proto.UpdateColorantRequest{
-		Id: id,
+		Id:      id,
+		Version: version,
 	}
 
 	if cmd.Flags().Changed("label") {
-		updateColorantRequest.Label = label
+		updateColorantRequest.Label = &label
 	}
 
 	if cmd.Flags().Changed("manufacturer") {
-		updateColorantRequest.Manufacturer = manufacturer
+		updateColorantRequest.Manufacturer = &manufacturer
 	}
 
-	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
-	_, err = client.UpdateColorant(ctx, updateColorantRequest)
+	if cmd.Flags().Changed("dispenser-code") {
+		updateColorantRequest.DispenserCode = &dispenserCode
+	}
+
+	resp, err := client.UpdateColorant(ctx, updateColorantRequest)
 	if err != nil {
-		cl.State.Fmt.Err(fmt.Sprintf("could not update colorant: %v", err))
+		cl.State.Fmt.Err(cl.UpdateFailed(cache.KindColorant, id, version, err))
 		cl.State.Fmt.Finish()
 		return err
 	}
 
+	cl.State.See(cache.KindColorant, map[string]int64{id: resp.Colorant.Version})
+
 	cl.State.Fmt.Success(fmt.Sprintf("Updated colorant: %q", id))
 	cl.State.Fmt.Finish()
 	return nil
 }
+
+// colorantUpdateBatch updates every colorant listed in a file with a single batch call.
+func colorantUpdateBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Updating colorants", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.UpdateColorantRequest { return &proto.UpdateColorantRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchUpdateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchUpdateItem{Item: &proto.BatchUpdateItem_Colorant{Colorant: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchUpdate(ctx, &proto.BatchUpdateRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not update colorants: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("updated", "colorant", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
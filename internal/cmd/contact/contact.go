@@ -0,0 +1,14 @@
+package contact
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var CmdContact = &cobra.Command{
+	Use:   "contact",
+	Short: "Manage contacts",
+	Long: `Manage contacts.
+
+Contacts are the people behind contractors and jobs; the person to call at a contracting company or the homeowner
+a job was done for.`,
+}
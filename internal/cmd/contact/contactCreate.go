@@ -0,0 +1,122 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdContactCreate = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a new contact",
+	Long:  `Create a new contact.`,
+	Example: `$ basecoat contact create "Jane Smith" --email jane@example.com --phone 555-0100
+$ basecoat contact create --file contacts.jsonl`,
+	RunE: contactCreate,
+	Args: batch.Args(1),
+}
+
+func init() {
+	cmdContactCreate.Flags().StringP("email", "e", "", "Email address of the contact")
+	cmdContactCreate.Flags().StringP("phone", "p", "", "Phone number of the contact")
+	batch.AddFlags(cmdContactCreate, "CreateContactRequest")
+	CmdContact.AddCommand(cmdContactCreate)
+}
+
+func contactCreate(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return contactCreateBatch(cmd, path)
+	}
+
+	name := args[0]
+
+	cl.State.Fmt.Print("Creating contact", polyfmt.Pretty)
+
+	email, err := cmd.Flags().GetString("email")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	phone, err := cmd.Flags().GetString("phone")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.CreateContact(ctx, &proto.CreateContactRequest{
+		Name:  name,
+		Email: email,
+		Phone: phone,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not create contact: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Created contact: [%s] %q", resp.Contact.Id, resp.Contact.Name))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+// contactCreateBatch creates every contact listed in a file with a single batch call.
+func contactCreateBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Creating contacts", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.CreateContactRequest { return &proto.CreateContactRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchCreateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchCreateItem{Item: &proto.BatchCreateItem_Contact{Contact: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchCreate(ctx, &proto.BatchCreateRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not create contacts: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("created", "contact", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
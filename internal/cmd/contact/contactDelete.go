@@ -0,0 +1,104 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdContactDelete = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a contact",
+	Long:  `Delete a contact.`,
+	Example: `$ basecoat contact delete 3kYbvTn
+$ basecoat contact delete --file contacts.jsonl`,
+	RunE: contactDelete,
+	Args: batch.Args(1),
+}
+
+func init() {
+	batch.AddFlags(cmdContactDelete, "DeleteContactRequest")
+	CmdContact.AddCommand(cmdContactDelete)
+}
+
+func contactDelete(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return contactDeleteBatch(cmd, path)
+	}
+
+	id := args[0]
+
+	cl.State.Fmt.Print("Deleting contact", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err = client.DeleteContact(ctx, &proto.DeleteContactRequest{
+		Id: id,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete contact: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Moved contact %q to trash; restore with 'basecoat trash restore contact %s'", id, id))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+// contactDeleteBatch deletes every contact listed in a file with a single batch call.
+func contactDeleteBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Deleting contacts", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.DeleteContactRequest { return &proto.DeleteContactRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchDeleteItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchDeleteItem{Item: &proto.BatchDeleteItem_Contact{Contact: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchDelete(ctx, &proto.BatchDeleteRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete contacts: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("deleted", "contact", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
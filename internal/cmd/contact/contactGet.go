@@ -0,0 +1,75 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdContactGet = &cobra.Command{
+	Use:     "get <id>",
+	Short:   "Show a single contact",
+	Example: `$ basecoat contact get 3kYbvTn`,
+	RunE:    contactGet,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	cl.AddOutputFlags(cmdContactGet)
+	CmdContact.AddCommand(cmdContactGet)
+}
+
+func contactGet(_ *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Retrieving contact", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.GetContact(ctx, &proto.GetContactRequest{Id: id})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not retrieve contact: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.See(cache.KindContact, map[string]int64{resp.Contact.Id: resp.Contact.Version})
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.NewContact(resp.Contact))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	contact := resp.Contact
+	data := [][]string{
+		{"ID", contact.Id},
+		{"Name", contact.Name},
+		{"Email", contact.Email},
+		{"Phone", contact.Phone},
+		{"Version", strconv.FormatInt(contact.Version, 10)},
+		{"Created", format.UnixMilli(contact.Created, "Never", cl.State.Config.Detail)},
+		{"Modified", format.UnixMilli(contact.Modified, "Never", cl.State.Config.Detail)},
+	}
+
+	cl.State.Fmt.Println(format.GenerateGenericTable(data, "", 1))
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -0,0 +1,125 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdContactList = &cobra.Command{
+	Use:     "list",
+	Short:   "List all contacts",
+	Example: `$ basecoat contact list`,
+	RunE:    contactList,
+}
+
+func init() {
+	cl.AddOutputFlags(cmdContactList)
+	CmdContact.AddCommand(cmdContactList)
+}
+
+func contactList(_ *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Retrieving contacts", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListContacts(ctx, &proto.ListContactsRequest{})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not list contacts: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	versions := map[string]int64{}
+	for _, contact := range resp.Contacts {
+		versions[contact.Id] = contact.Version
+	}
+	cl.State.See(cache.KindContact, versions)
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(resp.Contacts, format.NewContact))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(resp.Contacts) == 0 {
+		cl.State.Fmt.Println("No contacts found")
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	data := [][]string{}
+	for _, contact := range resp.Contacts {
+		data = append(data, []string{
+			contact.Id,
+			contact.Name,
+			contact.Email,
+			contact.Phone,
+			format.UnixMilli(contact.Created, "Never", cl.State.Config.Detail),
+		})
+	}
+
+	table := formatTable(data, !cl.State.Config.NoColor)
+
+	cl.State.Fmt.Println(table)
+	cl.State.Fmt.Finish()
+
+	return nil
+}
+
+func formatTable(data [][]string, color bool) string {
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"ID", "Name", "Email", "Phone", "Created"})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(true)
+	table.SetBorder(false)
+	table.SetAutoFormatHeaders(false)
+	table.SetRowSeparator("―")
+	table.SetRowLine(false)
+	table.SetColumnSeparator("")
+	table.SetCenterSeparator("")
+
+	if color {
+		table.SetHeaderColor(
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+		)
+		table.SetColumnColor(
+			tablewriter.Color(tablewriter.FgYellowColor),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+		)
+	}
+
+	table.AppendBulk(data)
+
+	table.Render()
+	return tableString.String()
+}
@@ -0,0 +1,167 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdContactUpdate = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a contact",
+	Long:  `Update a contact.`,
+	Example: `$ basecoat contact update 3kYbvTn --phone 555-0199
+$ basecoat contact update --file contacts.jsonl`,
+	RunE: contactUpdate,
+	Args: batch.Args(1),
+}
+
+func init() {
+	cmdContactUpdate.Flags().StringP("name", "n", "", "Name of the contact")
+	cmdContactUpdate.Flags().StringP("email", "e", "", "Email address of the contact")
+	cmdContactUpdate.Flags().StringP("phone", "p", "", "Phone number of the contact")
+	batch.AddFlags(cmdContactUpdate, "UpdateContactRequest")
+	cl.AddVersionFlag(cmdContactUpdate)
+	CmdContact.AddCommand(cmdContactUpdate)
+}
+
+func contactUpdate(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return contactUpdateBatch(cmd, path)
+	}
+
+	id := args[0]
+
+	cl.State.Fmt.Print("Updating contact", polyfmt.Pretty)
+
+	name, err := cmd.Flags().GetString("name")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	email, err := cmd.Flags().GetString("email")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	phone, err := cmd.Flags().GetString("phone")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	version, err := cl.State.UpdateVersion(cmd, cache.KindContact, id)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	// With no version shown before, the update is made against the current one.
+	if version == 0 {
+		current, err := client.GetContact(ctx, &proto.GetContactRequest{Id: id})
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not retrieve contact: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		version = current.Contact.Version
+	}
+
+	updateContactRequest := &proto.UpdateContactRequest{
+		Id:      id,
+		Version: version,
+	}
+
+	if cmd.Flags().Changed("name") {
+		updateContactRequest.Name = &name
+	}
+
+	if cmd.Flags().Changed("email") {
+		updateContactRequest.Email = &email
+	}
+
+	if cmd.Flags().Changed("phone") {
+		updateContactRequest.Phone = &phone
+	}
+
+	resp, err := client.UpdateContact(ctx, updateContactRequest)
+	if err != nil {
+		cl.State.Fmt.Err(cl.UpdateFailed(cache.KindContact, id, version, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.See(cache.KindContact, map[string]int64{id: resp.Contact.Version})
+
+	cl.State.Fmt.Success(fmt.Sprintf("Updated contact: %q", id))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+// contactUpdateBatch updates every contact listed in a file with a single batch call.
+func contactUpdateBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Updating contacts", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.UpdateContactRequest { return &proto.UpdateContactRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchUpdateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchUpdateItem{Item: &proto.BatchUpdateItem_Contact{Contact: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchUpdate(ctx, &proto.BatchUpdateRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not update contacts: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("updated", "contact", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
@@ -0,0 +1,13 @@
+package contractor
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var CmdContractor = &cobra.Command{
+	Use:   "contractor",
+	Short: "Manage contractors",
+	Long: `Manage contractors.
+
+Contractors are the companies who request work for a job site.`,
+}
@@ -0,0 +1,68 @@
+package contractor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdContractorCreate = &cobra.Command{
+	Use:   "create <company>",
+	Short: "Create a new contractor",
+	Long:  `Create a new contractor.`,
+	Example: `$ basecoat contractor create "Acme Painting"
+$ basecoat contractor create "Acme Painting" --contact 3kYbvTn`,
+	RunE: contractorCreate,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdContractorCreate.Flags().StringP("contact", "c", "", "ID of the contact to reach at the company")
+	CmdContractor.AddCommand(cmdContractorCreate)
+}
+
+func contractorCreate(cmd *cobra.Command, args []string) error {
+	company := args[0]
+
+	cl.State.Fmt.Print("Creating contractor", polyfmt.Pretty)
+
+	contact, err := cmd.Flags().GetString("contact")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	request := &proto.CreateContractorRequest{Company: company}
+	if cmd.Flags().Changed("contact") {
+		request.Contact = &contact
+	}
+
+	resp, err := client.CreateContractor(ctx, request)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not create contractor: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Created contractor: [%s] %q", resp.Contractor.Id, resp.Contractor.Company))
+	cl.State.Fmt.Finish()
+	return nil
+}
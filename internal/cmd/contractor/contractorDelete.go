@@ -0,0 +1,114 @@
+package contractor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdContractorDelete = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a contractor",
+	Long: `Delete a contractor.
+
+If any jobs still belong to the contractor they are listed and you are asked to confirm before the contractor is
+deleted. Those jobs lose their contractor until it is restored from the trash.`,
+	Example: `$ basecoat contractor delete Jb8qkTz`,
+	RunE:    contractorDelete,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdContractorDelete.Flags().BoolP("yes", "y", false, "Do not ask for confirmation when jobs still belong to the contractor")
+	cmdContractorDelete.Flags().Bool("dry-run", false, "Only show which jobs would be affected; do not delete anything")
+	CmdContractor.AddCommand(cmdContractorDelete)
+}
+
+func contractorDelete(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Deleting contractor", polyfmt.Pretty)
+
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	impact, err := client.DeleteContractor(ctx, &proto.DeleteContractorRequest{
+		Id:     id,
+		DryRun: true,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete contractor: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(impact.Jobs) > 0 {
+		data := [][]string{}
+		for _, job := range impact.Jobs {
+			data = append(data, []string{job.Id, job.Name})
+		}
+
+		cl.State.Fmt.Println(fmt.Sprintf("%d job(s) belong to contractor %q:\n%s", len(impact.Jobs), id,
+			format.GenerateGenericTable(data, " ", 2)))
+	}
+
+	if dryRun {
+		cl.State.Fmt.Success(fmt.Sprintf("Dry run; deleting contractor %q would affect %d job(s)", id, len(impact.Jobs)))
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	if len(impact.Jobs) > 0 && !yes {
+		answer := cl.State.Fmt.Question("Delete anyway? [y/N]: ")
+		if !strings.EqualFold(strings.TrimSpace(answer), "y") {
+			cl.State.Fmt.Warning("Delete cancelled")
+			cl.State.Fmt.Finish()
+			return nil
+		}
+	}
+
+	_, err = client.DeleteContractor(ctx, &proto.DeleteContractorRequest{
+		Id:    id,
+		Force: len(impact.Jobs) > 0,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete contractor: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Moved contractor %q to trash; restore with 'basecoat trash restore contractor %s'",
+		id, id))
+	cl.State.Fmt.Finish()
+	return nil
+}
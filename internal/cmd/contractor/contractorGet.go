@@ -0,0 +1,74 @@
+package contractor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdContractorGet = &cobra.Command{
+	Use:     "get <id>",
+	Short:   "Show a single contractor",
+	Example: `$ basecoat contractor get Jb8qkTz`,
+	RunE:    contractorGet,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	cl.AddOutputFlags(cmdContractorGet)
+	CmdContractor.AddCommand(cmdContractorGet)
+}
+
+func contractorGet(_ *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Retrieving contractor", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.GetContractor(ctx, &proto.GetContractorRequest{Id: id})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not retrieve contractor: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.See(cache.KindContractor, map[string]int64{resp.Contractor.Id: resp.Contractor.Version})
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.NewContractor(resp.Contractor))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	contractor := resp.Contractor
+	data := [][]string{
+		{"ID", contractor.Id},
+		{"Company", contractor.Company},
+		{"Contact", contractor.GetContact()},
+		{"Version", strconv.FormatInt(contractor.Version, 10)},
+		{"Created", format.UnixMilli(contractor.Created, "Never", cl.State.Config.Detail)},
+		{"Modified", format.UnixMilli(contractor.Modified, "Never", cl.State.Config.Detail)},
+	}
+
+	cl.State.Fmt.Println(format.GenerateGenericTable(data, "", 1))
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -0,0 +1,122 @@
+package contractor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdContractorList = &cobra.Command{
+	Use:     "list",
+	Short:   "List all contractors",
+	Example: `$ basecoat contractor list`,
+	RunE:    contractorList,
+}
+
+func init() {
+	cl.AddOutputFlags(cmdContractorList)
+	CmdContractor.AddCommand(cmdContractorList)
+}
+
+func contractorList(_ *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Retrieving contractors", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListContractors(ctx, &proto.ListContractorsRequest{})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not list contractors: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	versions := map[string]int64{}
+	for _, contractor := range resp.Contractors {
+		versions[contractor.Id] = contractor.Version
+	}
+	cl.State.See(cache.KindContractor, versions)
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(resp.Contractors, format.NewContractor))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(resp.Contractors) == 0 {
+		cl.State.Fmt.Println("No contractors found")
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	data := [][]string{}
+	for _, contractor := range resp.Contractors {
+		data = append(data, []string{
+			contractor.Id,
+			contractor.Company,
+			contractor.GetContact(),
+			format.UnixMilli(contractor.Created, "Never", cl.State.Config.Detail),
+		})
+	}
+
+	table := formatTable(data, !cl.State.Config.NoColor)
+
+	cl.State.Fmt.Println(table)
+	cl.State.Fmt.Finish()
+
+	return nil
+}
+
+func formatTable(data [][]string, color bool) string {
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"ID", "Company", "Contact", "Created"})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(true)
+	table.SetBorder(false)
+	table.SetAutoFormatHeaders(false)
+	table.SetRowSeparator("―")
+	table.SetRowLine(false)
+	table.SetColumnSeparator("")
+	table.SetCenterSeparator("")
+
+	if color {
+		table.SetHeaderColor(
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+		)
+		table.SetColumnColor(
+			tablewriter.Color(tablewriter.FgYellowColor),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+		)
+	}
+
+	table.AppendBulk(data)
+
+	table.Render()
+	return tableString.String()
+}
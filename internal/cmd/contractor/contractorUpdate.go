@@ -0,0 +1,106 @@
+package contractor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdContractorUpdate = &cobra.Command{
+	Use:     "update <id>",
+	Short:   "Update a contractor",
+	Long:    `Update a contractor.`,
+	Example: `$ basecoat contractor update Jb8qkTz --company "Acme Painting & Drywall"`,
+	RunE:    contractorUpdate,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdContractorUpdate.Flags().StringP("company", "m", "", "Name of the contracting company")
+	cmdContractorUpdate.Flags().StringP("contact", "c", "", "ID of the contact to reach at the company")
+	cl.AddVersionFlag(cmdContractorUpdate)
+	CmdContractor.AddCommand(cmdContractorUpdate)
+}
+
+func contractorUpdate(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Updating contractor", polyfmt.Pretty)
+
+	company, err := cmd.Flags().GetString("company")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	contact, err := cmd.Flags().GetString("contact")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	version, err := cl.State.UpdateVersion(cmd, cache.KindContractor, id)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	// With no version shown before, the update is made against the current one.
+	if version == 0 {
+		current, err := client.GetContractor(ctx, &proto.GetContractorRequest{Id: id})
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not retrieve contractor: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		version = current.Contractor.Version
+	}
+
+	updateContractorRequest := &proto.UpdateContractorRequest{
+		Id:      id,
+		Version: version,
+	}
+
+	if cmd.Flags().Changed("company") {
+		updateContractorRequest.Company = &company
+	}
+
+	if cmd.Flags().Changed("contact") {
+		updateContractorRequest.Contact = &contact
+	}
+
+	resp, err := client.UpdateContractor(ctx, updateContractorRequest)
+	if err != nil {
+		cl.State.Fmt.Err(cl.UpdateFailed(cache.KindContractor, id, version, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.See(cache.KindContractor, map[string]int64{id: resp.Contractor.Version})
+
+	cl.State.Fmt.Success(fmt.Sprintf("Updated contractor: %q", id))
+	cl.State.Fmt.Finish()
+	return nil
+}
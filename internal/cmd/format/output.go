@@ -0,0 +1,164 @@
+package format
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Output is how get and list commands print what they retrieved.
+type Output string
+
+const (
+	// OutputPretty prints tables meant for people; it's the only output which isn't stable between releases.
+	OutputPretty Output = "pretty"
+	// OutputJSON prints a single JSON document; an object for get commands and an array for list commands.
+	OutputJSON Output = "json"
+	// OutputYAML prints a single YAML document shaped the same as OutputJSON.
+	OutputYAML Output = "yaml"
+	// OutputCSV prints a header row of field names followed by a row per record. Lists are joined with ";".
+	OutputCSV Output = "csv"
+	// OutputTemplate executes a Go template once per record, each followed by a new line.
+	OutputTemplate Output = "template"
+)
+
+// Structured returns true for outputs meant to be read by programs rather than people.
+func (o Output) Structured() bool {
+	switch o {
+	case OutputJSON, OutputYAML, OutputCSV, OutputTemplate:
+		return true
+	default:
+		return false
+	}
+}
+
+// templateFuncs are the functions templates can call on top of the built in ones.
+var templateFuncs = template.FuncMap{
+	"join": strings.Join,
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// Write prints records in the output given. Records is either a single record, as printed by get commands, or a
+// slice of them, as printed by list commands. Records are structs of the kind found in schema.go; the text of a
+// template is only used with OutputTemplate.
+func Write(w io.Writer, output Output, text string, records any) error {
+	switch output {
+	case OutputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(records)
+	case OutputYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		err := encoder.Encode(records)
+		if err != nil {
+			return err
+		}
+		return encoder.Close()
+	case OutputCSV:
+		return writeCSV(w, records)
+	case OutputTemplate:
+		tmpl, err := template.New("output").Funcs(templateFuncs).Parse(text)
+		if err != nil {
+			return fmt.Errorf("could not parse template: %w", err)
+		}
+
+		for _, record := range recordList(records) {
+			err = tmpl.Execute(w, record.Interface())
+			if err != nil {
+				return fmt.Errorf("could not execute template: %w", err)
+			}
+			fmt.Fprintln(w)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown output %q", output)
+	}
+}
+
+// Fields returns the names of a record's fields as they appear in every structured output other than templates,
+// which use the Go field names.
+func Fields(record any) []string {
+	recordType := reflect.TypeOf(record)
+	for recordType.Kind() == reflect.Pointer || recordType.Kind() == reflect.Slice {
+		recordType = recordType.Elem()
+	}
+
+	fields := []string{}
+	for i := 0; i < recordType.NumField(); i++ {
+		name, _, _ := strings.Cut(recordType.Field(i).Tag.Get("json"), ",")
+		fields = append(fields, name)
+	}
+
+	return fields
+}
+
+// recordList returns the records given as a list, wrapping a single record in one.
+func recordList(records any) []reflect.Value {
+	value := reflect.ValueOf(records)
+	if value.Kind() != reflect.Slice {
+		return []reflect.Value{value}
+	}
+
+	list := []reflect.Value{}
+	for i := 0; i < value.Len(); i++ {
+		list = append(list, value.Index(i))
+	}
+
+	return list
+}
+
+func writeCSV(w io.Writer, records any) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write(Fields(records))
+	if err != nil {
+		return err
+	}
+
+	for _, record := range recordList(records) {
+		record = reflect.Indirect(record)
+
+		row := []string{}
+		for i := 0; i < record.NumField(); i++ {
+			row = append(row, csvValue(record.Field(i)))
+		}
+
+		err = writer.Write(row)
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func csvValue(value reflect.Value) string {
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	case reflect.Slice:
+		items := []string{}
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, csvValue(value.Index(i)))
+		}
+		return strings.Join(items, ";")
+	default:
+		return fmt.Sprint(value.Interface())
+	}
+}
@@ -0,0 +1,191 @@
+package format
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	extraWhite = Base{
+		ID:           "b1",
+		Label:        "Extra White",
+		Manufacturer: "Sherwin-Williams",
+		Created:      "2023-03-04T10:00:00Z",
+		Version:      2,
+	}
+	deepBase = Base{ID: "b2", Label: `Deep, "Tint" Base`, Manufacturer: "Benjamin Moore", Version: 1}
+)
+
+func TestWrite(t *testing.T) {
+	tests := map[string]struct {
+		output   Output
+		text     string
+		records  any
+		expected string
+	}{
+		"json single": {
+			output:  OutputJSON,
+			records: extraWhite,
+			expected: `{
+  "id": "b1",
+  "label": "Extra White",
+  "manufacturer": "Sherwin-Williams",
+  "created": "2023-03-04T10:00:00Z",
+  "version": 2
+}
+`,
+		},
+		"json list": {
+			output:  OutputJSON,
+			records: []Base{extraWhite, deepBase},
+			expected: `[
+  {
+    "id": "b1",
+    "label": "Extra White",
+    "manufacturer": "Sherwin-Williams",
+    "created": "2023-03-04T10:00:00Z",
+    "version": 2
+  },
+  {
+    "id": "b2",
+    "label": "Deep, \"Tint\" Base",
+    "manufacturer": "Benjamin Moore",
+    "created": "",
+    "version": 1
+  }
+]
+`,
+		},
+		"json empty list": {
+			output:   OutputJSON,
+			records:  []Base{},
+			expected: "[]\n",
+		},
+		"yaml single": {
+			output:  OutputYAML,
+			records: extraWhite,
+			expected: `id: b1
+label: Extra White
+manufacturer: Sherwin-Williams
+created: "2023-03-04T10:00:00Z"
+version: 2
+`,
+		},
+		"yaml list": {
+			output:  OutputYAML,
+			records: []Base{extraWhite, deepBase},
+			expected: `- id: b1
+  label: Extra White
+  manufacturer: Sherwin-Williams
+  created: "2023-03-04T10:00:00Z"
+  version: 2
+- id: b2
+  label: Deep, "Tint" Base
+  manufacturer: Benjamin Moore
+  created: ""
+  version: 1
+`,
+		},
+		"csv single": {
+			output:  OutputCSV,
+			records: extraWhite,
+			expected: `id,label,manufacturer,created,version
+b1,Extra White,Sherwin-Williams,2023-03-04T10:00:00Z,2
+`,
+		},
+		"csv list": {
+			output:  OutputCSV,
+			records: []Base{extraWhite, deepBase},
+			expected: `id,label,manufacturer,created,version
+b1,Extra White,Sherwin-Williams,2023-03-04T10:00:00Z,2
+b2,"Deep, ""Tint"" Base",Benjamin Moore,,1
+`,
+		},
+		"csv empty list": {
+			output:   OutputCSV,
+			records:  []Base{},
+			expected: "id,label,manufacturer,created,version\n",
+		},
+		"csv lists joined": {
+			output:  OutputCSV,
+			records: []FormulaDetail{{ID: "f1", Jobs: []string{"j1", "j2"}, Bases: []string{}, Version: 1}},
+			expected: `id,name,number,sheen,color,notes,jobs,container,bases,colorants,created,modified,version
+f1,,,,,,j1;j2,,,,,,1
+`,
+		},
+		"template single": {
+			output:   OutputTemplate,
+			text:     "{{.Label}} v{{.Version}}",
+			records:  extraWhite,
+			expected: "Extra White v2\n",
+		},
+		"template list": {
+			output:   OutputTemplate,
+			text:     "{{.ID}}\t{{.Manufacturer}}",
+			records:  []Base{extraWhite, deepBase},
+			expected: "b1\tSherwin-Williams\nb2\tBenjamin Moore\n",
+		},
+		"template functions": {
+			output:   OutputTemplate,
+			text:     `{{join .Jobs ","}} {{json .Bases}}`,
+			records:  FormulaDetail{Jobs: []string{"j1", "j2"}, Bases: []string{"Extra White 1 gal"}},
+			expected: "j1,j2 [\"Extra White 1 gal\"]\n",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Write(&buf, tc.output, tc.text, tc.records)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expected, buf.String()); diff != "" {
+				t.Errorf("unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteErrors(t *testing.T) {
+	tests := map[string]struct {
+		output Output
+		text   string
+	}{
+		"unknown output":     {output: "xml"},
+		"pretty output":      {output: OutputPretty},
+		"unparsable":         {output: OutputTemplate, text: "{{.Label"},
+		"missing field":      {output: OutputTemplate, text: "{{.Color}}"},
+		"unknown function":   {output: OutputTemplate, text: "{{upper .Label}}"},
+		"function arguments": {output: OutputTemplate, text: "{{join .Label}}"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Write(&buf, tc.output, tc.text, extraWhite)
+			if err == nil {
+				t.Errorf("expected an error; got output %q", buf.String())
+			}
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	expected := []string{"id", "label", "manufacturer", "created", "version"}
+
+	for name, record := range map[string]any{
+		"record":  extraWhite,
+		"pointer": &extraWhite,
+		"list":    []Base{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(expected, Fields(record)); diff != "" {
+				t.Errorf("unexpected fields (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
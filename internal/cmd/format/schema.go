@@ -0,0 +1,482 @@
+package format
+
+import (
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/proto"
+)
+
+// The records below are what get and list commands print with a structured output (see Output). Their fields are
+// part of the command line's interface: fields may be added in later releases but are never renamed or removed, so
+// scripts can depend on them.
+//
+// Times are RFC 3339 in UTC and empty if never set. Field names in JSON, YAML and CSV are the json tags; templates
+// use the Go field names, ex. {{.Name}}.
+
+// Account is an account as printed by `basecoat account list`.
+type Account struct {
+	ID       string `json:"id" yaml:"id"`
+	Name     string `json:"name" yaml:"name"`
+	State    string `json:"state" yaml:"state"` // "active" or "disabled".
+	Created  string `json:"created" yaml:"created"`
+	Modified string `json:"modified" yaml:"modified"`
+}
+
+func NewAccount(account *proto.Account) Account {
+	return Account{
+		ID:       account.Id,
+		Name:     account.Name,
+		State:    strings.ToLower(account.State.String()),
+		Created:  timestamp(account.Created),
+		Modified: timestamp(account.Modified),
+	}
+}
+
+// AuditEvent is a change recorded in the audit log as printed by `basecoat audit`.
+type AuditEvent struct {
+	ID         int64  `json:"id" yaml:"id"`
+	Actor      string `json:"actor" yaml:"actor"`
+	Method     string `json:"method" yaml:"method"` // The API call which made the change; ex. "UpdateFormula".
+	EntityKind string `json:"entity_kind" yaml:"entity_kind"`
+	EntityID   string `json:"entity_id" yaml:"entity_id"`
+	SourceIP   string `json:"source_ip" yaml:"source_ip"`
+	Before     string `json:"before" yaml:"before"` // The entity as JSON before the change; empty if it was created.
+	After      string `json:"after" yaml:"after"`   // The entity as JSON after the change; empty if it was deleted.
+	Created    string `json:"created" yaml:"created"`
+}
+
+func NewAuditEvent(event *proto.AuditEvent) AuditEvent {
+	return AuditEvent{
+		ID:         event.Id,
+		Actor:      event.Actor,
+		Method:     event.Method[strings.LastIndex(event.Method, "/")+1:],
+		EntityKind: entityKind(event.EntityKind),
+		EntityID:   event.EntityId,
+		SourceIP:   event.SourceIp,
+		Before:     event.Before,
+		After:      event.After,
+		Created:    timestamp(event.Created),
+	}
+}
+
+// Base is a base as printed by `basecoat base list`.
+type Base struct {
+	ID           string `json:"id" yaml:"id"`
+	Label        string `json:"label" yaml:"label"`
+	Manufacturer string `json:"manufacturer" yaml:"manufacturer"`
+	Created      string `json:"created" yaml:"created"`
+	Version      int64  `json:"version" yaml:"version"`
+}
+
+func NewBase(base *proto.BaseMetadata) Base {
+	return Base{
+		ID:           base.Id,
+		Label:        base.Label,
+		Manufacturer: base.Manufacturer,
+		Created:      timestamp(base.Created),
+		Version:      base.Version,
+	}
+}
+
+// Code is the short code of a formula, job or mix as printed by `basecoat code get`.
+type Code struct {
+	Code       string `json:"code" yaml:"code"`
+	EntityKind string `json:"entity_kind" yaml:"entity_kind"`
+	EntityID   string `json:"entity_id" yaml:"entity_id"`
+	Created    string `json:"created" yaml:"created"`
+}
+
+func NewCode(code *proto.Code) Code {
+	return Code{
+		Code:       code.Code,
+		EntityKind: entityKind(code.EntityKind),
+		EntityID:   code.EntityId,
+		Created:    timestamp(code.Created),
+	}
+}
+
+// Colorant is a colorant as printed by `basecoat colorant list`.
+type Colorant struct {
+	ID            string `json:"id" yaml:"id"`
+	Label         string `json:"label" yaml:"label"`
+	Manufacturer  string `json:"manufacturer" yaml:"manufacturer"`
+	DispenserCode string `json:"dispenser_code" yaml:"dispenser_code"`
+	Created       string `json:"created" yaml:"created"`
+	Version       int64  `json:"version" yaml:"version"`
+}
+
+func NewColorant(colorant *proto.ColorantMetadata) Colorant {
+	return Colorant{
+		ID:            colorant.Id,
+		Label:         colorant.Label,
+		Manufacturer:  colorant.Manufacturer,
+		DispenserCode: colorant.DispenserCode,
+		Created:       timestamp(colorant.Created),
+		Version:       colorant.Version,
+	}
+}
+
+// Contact is a contact as printed by `basecoat contact get` and `basecoat contact list`.
+type Contact struct {
+	ID       string `json:"id" yaml:"id"`
+	Name     string `json:"name" yaml:"name"`
+	Email    string `json:"email" yaml:"email"`
+	Phone    string `json:"phone" yaml:"phone"`
+	Created  string `json:"created" yaml:"created"`
+	Modified string `json:"modified" yaml:"modified"`
+	Version  int64  `json:"version" yaml:"version"`
+}
+
+func NewContact(contact *proto.Contact) Contact {
+	return Contact{
+		ID:       contact.Id,
+		Name:     contact.Name,
+		Email:    contact.Email,
+		Phone:    contact.Phone,
+		Created:  timestamp(contact.Created),
+		Modified: timestamp(contact.Modified),
+		Version:  contact.Version,
+	}
+}
+
+// Contractor is a contractor as printed by `basecoat contractor get` and `basecoat contractor list`.
+type Contractor struct {
+	ID       string `json:"id" yaml:"id"`
+	Company  string `json:"company" yaml:"company"`
+	Contact  string `json:"contact" yaml:"contact"` // The ID of the contractor's contact; empty if none.
+	Created  string `json:"created" yaml:"created"`
+	Modified string `json:"modified" yaml:"modified"`
+	Version  int64  `json:"version" yaml:"version"`
+}
+
+func NewContractor(contractor *proto.Contractor) Contractor {
+	return Contractor{
+		ID:       contractor.Id,
+		Company:  contractor.Company,
+		Contact:  contractor.GetContact(),
+		Created:  timestamp(contractor.Created),
+		Modified: timestamp(contractor.Modified),
+		Version:  contractor.Version,
+	}
+}
+
+// DeletedEntity is an entity waiting in the trash as printed by `basecoat trash list`.
+type DeletedEntity struct {
+	Kind    string `json:"kind" yaml:"kind"`
+	ID      string `json:"id" yaml:"id"`
+	Name    string `json:"name" yaml:"name"`
+	Deleted string `json:"deleted" yaml:"deleted"`
+}
+
+func NewDeletedEntity(entity *proto.DeletedEntity) DeletedEntity {
+	return DeletedEntity{
+		Kind:    entityKind(entity.Kind),
+		ID:      entity.Id,
+		Name:    entity.Name,
+		Deleted: timestamp(entity.Deleted),
+	}
+}
+
+// Event is a change made to the account as printed by `basecoat watch`.
+type Event struct {
+	Sequence   int64  `json:"sequence" yaml:"sequence"` // Watch with --after set to this to resume after the event.
+	Type       string `json:"type" yaml:"type"`         // "created", "updated" or "deleted".
+	EntityKind string `json:"entity_kind" yaml:"entity_kind"`
+	EntityID   string `json:"entity_id" yaml:"entity_id"`
+	Actor      string `json:"actor" yaml:"actor"`
+	Method     string `json:"method" yaml:"method"` // The API call which made the change; ex. "UpdateFormula".
+	Entity     string `json:"entity" yaml:"entity"` // The entity as JSON after the change, or before it if deleted.
+	Created    string `json:"created" yaml:"created"`
+}
+
+func NewEvent(event *proto.Event) Event {
+	return Event{
+		Sequence:   event.Sequence,
+		Type:       strings.ToLower(event.Type.String()),
+		EntityKind: entityKind(event.EntityKind),
+		EntityID:   event.EntityId,
+		Actor:      event.Actor,
+		Method:     event.Method[strings.LastIndex(event.Method, "/")+1:],
+		Entity:     event.Entity,
+		Created:    timestamp(event.Created),
+	}
+}
+
+// Formula is a formula as printed by `basecoat formula list`.
+type Formula struct {
+	ID       string `json:"id" yaml:"id"`
+	Name     string `json:"name" yaml:"name"`
+	Number   string `json:"number" yaml:"number"`
+	Sheen    string `json:"sheen" yaml:"sheen"`
+	Color    string `json:"color" yaml:"color"` // Hex RGB; ex. "#4A5A6B".
+	Notes    string `json:"notes" yaml:"notes"`
+	Created  string `json:"created" yaml:"created"`
+	Modified string `json:"modified" yaml:"modified"`
+	Version  int64  `json:"version" yaml:"version"`
+}
+
+func NewFormula(formula *proto.FormulaMetadata) Formula {
+	return Formula{
+		ID:       formula.Id,
+		Name:     formula.Name,
+		Number:   formula.Number,
+		Sheen:    formula.Sheen,
+		Color:    formula.Color,
+		Notes:    formula.Notes,
+		Created:  timestamp(formula.Created),
+		Modified: timestamp(formula.Modified),
+		Version:  formula.Version,
+	}
+}
+
+// FormulaDetail is a single formula along with what goes into a can of it as printed by `basecoat formula get`.
+// Ingredients are listed as "<label> <amount>"; ex. "Lamp Black 2Y24".
+type FormulaDetail struct {
+	ID        string   `json:"id" yaml:"id"`
+	Name      string   `json:"name" yaml:"name"`
+	Number    string   `json:"number" yaml:"number"`
+	Sheen     string   `json:"sheen" yaml:"sheen"`
+	Color     string   `json:"color" yaml:"color"`
+	Notes     string   `json:"notes" yaml:"notes"`
+	Jobs      []string `json:"jobs" yaml:"jobs"` // The IDs of the jobs the formula was used on.
+	Container string   `json:"container" yaml:"container"`
+	Bases     []string `json:"bases" yaml:"bases"`
+	Colorants []string `json:"colorants" yaml:"colorants"`
+	Created   string   `json:"created" yaml:"created"`
+	Modified  string   `json:"modified" yaml:"modified"`
+	Version   int64    `json:"version" yaml:"version"`
+}
+
+func NewFormulaDetail(formula *proto.Formula, recipe *proto.GetFormulaRecipeResponse) FormulaDetail {
+	jobs := formula.Jobs
+	if jobs == nil {
+		jobs = []string{}
+	}
+
+	return FormulaDetail{
+		ID:        formula.Metadata.Id,
+		Name:      formula.Metadata.Name,
+		Number:    formula.Metadata.Number,
+		Sheen:     formula.Metadata.Sheen,
+		Color:     formula.Metadata.Color,
+		Notes:     formula.Metadata.Notes,
+		Jobs:      jobs,
+		Container: recipe.Container,
+		Bases:     ingredients(recipe.Bases),
+		Colorants: ingredients(recipe.Colorants),
+		Created:   timestamp(formula.Metadata.Created),
+		Modified:  timestamp(formula.Metadata.Modified),
+		Version:   formula.Metadata.Version,
+	}
+}
+
+// Job is a job as printed by `basecoat job get` and `basecoat job list`. The address is flattened so that each of its
+// parts gets its own CSV column.
+type Job struct {
+	ID         string   `json:"id" yaml:"id"`
+	Name       string   `json:"name" yaml:"name"`
+	Contractor string   `json:"contractor" yaml:"contractor"` // The ID of the contractor doing the job.
+	Contact    string   `json:"contact" yaml:"contact"`       // The ID of the job's contact; empty if none.
+	Street     string   `json:"street" yaml:"street"`
+	Street2    string   `json:"street2" yaml:"street2"`
+	City       string   `json:"city" yaml:"city"`
+	State      string   `json:"state" yaml:"state"`
+	Zipcode    string   `json:"zipcode" yaml:"zipcode"`
+	Notes      string   `json:"notes" yaml:"notes"`
+	Formulas   []string `json:"formulas" yaml:"formulas"` // The IDs of the formulas used on the job.
+	Created    string   `json:"created" yaml:"created"`
+	Modified   string   `json:"modified" yaml:"modified"`
+	Version    int64    `json:"version" yaml:"version"`
+}
+
+func NewJob(job *proto.Job) Job {
+	address := job.Address
+	if address == nil {
+		address = &proto.Address{}
+	}
+
+	formulas := job.Formulas
+	if formulas == nil {
+		formulas = []string{}
+	}
+
+	return Job{
+		ID:         job.Id,
+		Name:       job.Name,
+		Contractor: job.Contractor,
+		Contact:    job.GetContact(),
+		Street:     address.Street,
+		Street2:    address.Street2,
+		City:       address.City,
+		State:      address.State,
+		Zipcode:    address.Zipcode,
+		Notes:      job.Notes,
+		Formulas:   formulas,
+		Created:    timestamp(job.Created),
+		Modified:   timestamp(job.Modified),
+		Version:    job.Version,
+	}
+}
+
+// Mix is a record of paint mixed as printed by `basecoat mix get` and `basecoat mix list`.
+type Mix struct {
+	ID        string `json:"id" yaml:"id"`
+	Formula   string `json:"formula" yaml:"formula"`
+	Job       string `json:"job" yaml:"job"` // Empty if the paint wasn't mixed for a job.
+	Container string `json:"container" yaml:"container"`
+	Quantity  int64  `json:"quantity" yaml:"quantity"`
+	Notes     string `json:"notes" yaml:"notes"`
+	Actor     string `json:"actor" yaml:"actor"`
+	Created   string `json:"created" yaml:"created"`
+}
+
+func NewMix(mix *proto.Mix) Mix {
+	return Mix{
+		ID:        mix.Id,
+		Formula:   mix.Formula,
+		Job:       mix.Job,
+		Container: mix.Container,
+		Quantity:  mix.Quantity,
+		Notes:     mix.Notes,
+		Actor:     mix.Actor,
+		Created:   timestamp(mix.Created),
+	}
+}
+
+// QueuedChange is a change made while the server couldn't be reached as printed by `basecoat queue list`.
+type QueuedChange struct {
+	ID     int64  `json:"id" yaml:"id"`
+	Key    string `json:"key" yaml:"key"`       // The idempotency key the change is sent with.
+	Change string `json:"change" yaml:"change"` // A few words describing the change; not meant to be parsed.
+	State  string `json:"state" yaml:"state"`   // "pending", "sending", "conflict" or "retry".
+	Reason string `json:"reason" yaml:"reason"` // Why the change conflicts with the server; empty unless it does.
+	Queued string `json:"queued" yaml:"queued"`
+}
+
+func NewQueuedChange(change *cache.Change) QueuedChange {
+	return QueuedChange{
+		ID:     change.ID,
+		Key:    change.Key,
+		Change: change.Summary(),
+		State:  string(change.State),
+		Reason: change.Reason,
+		Queued: timestamp(change.Created.UnixMilli()),
+	}
+}
+
+// Profile is a saved login as printed by `basecoat profile list`. Tokens and passwords are never printed.
+type Profile struct {
+	Name         string `json:"name" yaml:"name"`
+	Current      bool   `json:"current" yaml:"current"`
+	Host         string `json:"host" yaml:"host"`
+	Account      string `json:"account" yaml:"account"`
+	TokenExpires string `json:"token_expires" yaml:"token_expires"` // Empty if the token never expires.
+	Expired      bool   `json:"expired" yaml:"expired"`
+}
+
+func NewProfile(name string, profile *config.Profile, current bool) Profile {
+	record := Profile{
+		Name:    name,
+		Current: current,
+		Host:    profile.Host,
+		Account: profile.Account,
+		Expired: profile.Expired(time.Now()),
+	}
+
+	if expiry, ok := profile.Expiry(); ok {
+		record.TokenExpires = expiry.UTC().Format(time.RFC3339)
+	}
+
+	return record
+}
+
+// Webhook is a webhook as printed by `basecoat webhook list`. Secrets are never printed.
+type Webhook struct {
+	ID       string   `json:"id" yaml:"id"`
+	URL      string   `json:"url" yaml:"url"`
+	Events   []string `json:"events" yaml:"events"` // Event types subscribed to; ex. "job.updated".
+	Created  string   `json:"created" yaml:"created"`
+	Modified string   `json:"modified" yaml:"modified"`
+}
+
+func NewWebhook(webhook *proto.Webhook) Webhook {
+	return Webhook{
+		ID:       webhook.Id,
+		URL:      webhook.Url,
+		Events:   webhook.Events,
+		Created:  timestamp(webhook.Created),
+		Modified: timestamp(webhook.Modified),
+	}
+}
+
+// WebhookDelivery is a single event posted to a webhook as printed by `basecoat webhook deliveries`.
+type WebhookDelivery struct {
+	ID           int64  `json:"id" yaml:"id"`
+	Webhook      string `json:"webhook" yaml:"webhook"`
+	Event        string `json:"event" yaml:"event"`
+	Sequence     int64  `json:"sequence" yaml:"sequence"` // The audit event delivered; 0 for pings.
+	State        string `json:"state" yaml:"state"`       // "pending", "succeeded" or "failed".
+	Attempts     int64  `json:"attempts" yaml:"attempts"`
+	NextAttempt  string `json:"next_attempt" yaml:"next_attempt"`   // Only meaningful while pending.
+	ResponseCode int64  `json:"response_code" yaml:"response_code"` // HTTP status of the last attempt; 0 if none.
+	Error        string `json:"error" yaml:"error"`                 // Why the last attempt failed.
+	Payload      string `json:"payload" yaml:"payload"`             // The JSON body posted.
+	Created      string `json:"created" yaml:"created"`
+	Modified     string `json:"modified" yaml:"modified"`
+}
+
+func NewWebhookDelivery(delivery *proto.WebhookDelivery) WebhookDelivery {
+	return WebhookDelivery{
+		ID:           delivery.Id,
+		Webhook:      delivery.Webhook,
+		Event:        delivery.Event,
+		Sequence:     delivery.Sequence,
+		State:        strings.ToLower(delivery.State.String()),
+		Attempts:     delivery.Attempts,
+		NextAttempt:  timestamp(delivery.NextAttempt),
+		ResponseCode: delivery.ResponseCode,
+		Error:        delivery.Error,
+		Payload:      delivery.Payload,
+		Created:      timestamp(delivery.Created),
+		Modified:     timestamp(delivery.Modified),
+	}
+}
+
+// Records converts every item of a list response into its record.
+func Records[P any, R any](items []P, record func(P) R) []R {
+	records := []R{}
+	for _, item := range items {
+		records = append(records, record(item))
+	}
+
+	return records
+}
+
+func ingredients(recipe []*proto.RecipeIngredient) []string {
+	ingredients := []string{}
+	for _, ingredient := range recipe {
+		ingredients = append(ingredients, ingredient.Name+" "+ingredient.Amount)
+	}
+
+	return ingredients
+}
+
+// timestamp formats a time given in unix milliseconds as RFC 3339; empty if the time isn't set.
+func timestamp(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+
+	return time.UnixMilli(unix).UTC().Format(time.RFC3339)
+}
+
+func entityKind(kind proto.EntityKind) string {
+	if kind == proto.EntityKind_ENTITY_KIND_UNKNOWN {
+		return "unknown"
+	}
+
+	return strings.ToLower(kind.String())
+}
@@ -0,0 +1,164 @@
+package format
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/google/go-cmp/cmp"
+)
+
+// records holds one of every record printed by get and list commands.
+var records = map[string]any{
+	"account":          Account{},
+	"audit event":      AuditEvent{},
+	"base":             Base{},
+	"code":             Code{},
+	"colorant":         Colorant{},
+	"contact":          Contact{},
+	"contractor":       Contractor{},
+	"deleted entity":   DeletedEntity{},
+	"event":            Event{},
+	"formula":          Formula{},
+	"formula detail":   FormulaDetail{},
+	"job":              Job{},
+	"mix":              Mix{},
+	"queued change":    QueuedChange{},
+	"profile":          Profile{},
+	"webhook":          Webhook{},
+	"webhook delivery": WebhookDelivery{},
+}
+
+func TestRecordFields(t *testing.T) {
+	fieldName := regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+	for name, record := range records {
+		t.Run(name, func(t *testing.T) {
+			recordType := reflect.TypeOf(record)
+			for i := 0; i < recordType.NumField(); i++ {
+				field := recordType.Field(i)
+				jsonName := field.Tag.Get("json")
+
+				// Field names are shared by every structured output, so JSON, YAML and CSV must agree on them.
+				if !fieldName.MatchString(jsonName) || field.Tag.Get("yaml") != jsonName {
+					t.Errorf("field %s must have matching snake case json and yaml tags; found %q", field.Name, field.Tag)
+				}
+
+				// Anything else can't be written as a single CSV column.
+				switch field.Type.Kind() {
+				case reflect.String, reflect.Int64, reflect.Bool:
+				case reflect.Slice:
+					if field.Type.Elem().Kind() != reflect.String {
+						t.Errorf("field %s must be a list of strings; found %s", field.Name, field.Type)
+					}
+				default:
+					t.Errorf("field %s has a type which can't be written as CSV; found %s", field.Name, field.Type)
+				}
+			}
+
+			for _, output := range []Output{OutputJSON, OutputYAML, OutputCSV} {
+				var buf bytes.Buffer
+				err := Write(&buf, output, "", record)
+				if err != nil {
+					t.Errorf("could not write %s: %v", output, err)
+				}
+			}
+		})
+	}
+}
+
+func TestNewJob(t *testing.T) {
+	contact := "c1"
+
+	tests := map[string]struct {
+		job      *proto.Job
+		expected Job
+	}{
+		"full": {
+			job: &proto.Job{
+				Id:         "j1",
+				Name:       "Smith kitchen",
+				Contractor: "k1",
+				Contact:    &contact,
+				Address:    &proto.Address{Street: "12 Elm St", City: "Springfield", State: "IL", Zipcode: "62701"},
+				Formulas:   []string{"f1", "f2"},
+				Created:    1677924000000,
+				Version:    3,
+			},
+			expected: Job{
+				ID:         "j1",
+				Name:       "Smith kitchen",
+				Contractor: "k1",
+				Contact:    "c1",
+				Street:     "12 Elm St",
+				City:       "Springfield",
+				State:      "IL",
+				Zipcode:    "62701",
+				Formulas:   []string{"f1", "f2"},
+				Created:    "2023-03-04T10:00:00Z",
+				Version:    3,
+			},
+		},
+		// Unset lists are printed as empty lists rather than null and unset times as empty strings.
+		"minimal": {
+			job:      &proto.Job{Id: "j1"},
+			expected: Job{ID: "j1", Formulas: []string{}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expected, NewJob(tc.job)); diff != "" {
+				t.Errorf("unexpected record (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewFormulaDetail(t *testing.T) {
+	formula := &proto.Formula{Metadata: &proto.FormulaMetadata{Id: "f1", Name: "Sea Salt", Version: 2}}
+	recipe := &proto.GetFormulaRecipeResponse{
+		Container: "1 gal",
+		Bases:     []*proto.RecipeIngredient{{Name: "Extra White", Amount: "1 gal"}},
+		Colorants: []*proto.RecipeIngredient{{Name: "Lamp Black", Amount: "2Y24"}},
+	}
+
+	expected := FormulaDetail{
+		ID:        "f1",
+		Name:      "Sea Salt",
+		Jobs:      []string{},
+		Container: "1 gal",
+		Bases:     []string{"Extra White 1 gal"},
+		Colorants: []string{"Lamp Black 2Y24"},
+		Version:   2,
+	}
+
+	if diff := cmp.Diff(expected, NewFormulaDetail(formula, recipe)); diff != "" {
+		t.Errorf("unexpected record (-want +got):\n%s", diff)
+	}
+}
+
+func TestEnumFields(t *testing.T) {
+	tests := map[string]struct {
+		got      string
+		expected string
+	}{
+		"entity kind":         {got: NewCode(&proto.Code{EntityKind: proto.EntityKind_MIX}).EntityKind, expected: "mix"},
+		"unknown entity kind": {got: NewDeletedEntity(&proto.DeletedEntity{}).Kind, expected: "unknown"},
+		"account state":       {got: NewAccount(&proto.Account{State: proto.AccountState_ACTIVE}).State, expected: "active"},
+		"audit method": {
+			got:      NewAuditEvent(&proto.AuditEvent{Method: "/proto.Basecoat/UpdateFormula"}).Method,
+			expected: "UpdateFormula",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.got != tc.expected {
+				t.Errorf("expected %q; found %q", tc.expected, tc.got)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package formula
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/proto"
 	"github.com/spf13/cobra"
 )
 
@@ -9,3 +13,22 @@ var CmdFormula = &cobra.Command{
 	Short: "Manage formulas",
 	Long:  `Manage formulas`,
 }
+
+// parseIngredients parses ingredient flags in the format <id>:<amount>.
+func parseIngredients(kind string, raw []string) ([]*proto.FormulaIngredient, error) {
+	ingredients := []*proto.FormulaIngredient{}
+
+	for _, ingredient := range raw {
+		id, amount, found := strings.Cut(ingredient, ":")
+		if !found {
+			return nil, fmt.Errorf("could not parse %s %q; must be in format <id>:<amount>", kind, ingredient)
+		}
+
+		ingredients = append(ingredients, &proto.FormulaIngredient{
+			Id:     id,
+			Amount: amount,
+		})
+	}
+
+	return ingredients, nil
+}
@@ -3,8 +3,8 @@ package formula
 import (
 	"context"
 	"fmt"
-	"strings"
 
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
 	"github.com/clintjedwards/basecoat/proto"
 	"github.com/clintjedwards/polyfmt/v2"
@@ -14,23 +14,34 @@ import (
 )
 
 var cmdFormulaCreate = &cobra.Command{
-	Use:     "create <name>",
-	Short:   "Create a new formula",
-	Long:    `Create a new formula.`,
-	Example: `$ basecoat formula create "Formula Name"`,
-	RunE:    formulaCreate,
-	Args:    cobra.ExactArgs(1),
+	Use:   "create <name>",
+	Short: "Create a new formula",
+	Long: `Create a new formula.
+
+If the server can't be reached the formula is queued and created the next time a command connects; see
+'basecoat queue'. Formulas created from a file aren't queued.`,
+	Example: `$ basecoat formula create "Formula Name"
+$ basecoat formula create --file formulas.jsonl`,
+	RunE: formulaCreate,
+	Args: batch.Args(1),
 }
 
 func init() {
 	cmdFormulaCreate.Flags().StringP("number", "u", "", "Special formula number")
 	cmdFormulaCreate.Flags().StringP("notes", "o", "", "Notes about the formula")
+	cmdFormulaCreate.Flags().StringP("sheen", "s", "", "Finish the formula is mixed in; ex. \"Eggshell\"")
+	cmdFormulaCreate.Flags().String("color", "", "Hex RGB value shown as a swatch of the color; ex. \"#4A5A6B\"")
 	cmdFormulaCreate.Flags().StringArrayP("base", "b", []string{}, "Bases to add to the formula. The syntax is <id>:<amount>.")
 	cmdFormulaCreate.Flags().StringArrayP("colorant", "c", []string{}, "Colorants to add to the formula. The syntax is <id>:<amount>.")
+	batch.AddFlags(cmdFormulaCreate, "CreateFormulaRequest")
 	CmdFormula.AddCommand(cmdFormulaCreate)
 }
 
 func formulaCreate(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return formulaCreateBatch(cmd, path)
+	}
+
 	name := args[0]
 
 	number, err := cmd.Flags().GetString("number")
@@ -47,6 +58,20 @@ func formulaCreate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	sheen, err := cmd.Flags().GetString("sheen")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	color, err := cmd.Flags().GetString("color")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
 	basesRaw, err := cmd.Flags().GetStringArray("base")
 	if err != nil {
 		cl.State.Fmt.Err(err)
@@ -61,33 +86,72 @@ func formulaCreate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	bases := map[string]string{}
+	bases, err := parseIngredients("base", basesRaw)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	colorants, err := parseIngredients("colorant", colorantsRaw)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
 
-	for _, base := range basesRaw {
-		id, amount, found := strings.Cut(base, ":")
-		if !found {
-			cl.State.Fmt.Err(fmt.Sprintf("could not parse base; must be in format <id>:<amount> : %v", err))
-			cl.State.Fmt.Finish()
-			return err
+	cl.State.Fmt.Print("Creating formula", polyfmt.Pretty)
+
+	request := &proto.CreateFormulaRequest{
+		Name:      name,
+		Number:    number,
+		Notes:     notes,
+		Sheen:     sheen,
+		Color:     color,
+		Bases:     bases,
+		Colorants: colorants,
+	}
+
+	resp, err := createFormula(request)
+	if err != nil {
+		if cl.Unreachable(err) {
+			return queueFormula(request)
 		}
 
-		bases[id] = amount
+		cl.State.Fmt.Err(fmt.Sprintf("could not create formula: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Created formula: [%s] %q", resp.Formula.Id, resp.Formula.Name))
+
+	for _, base := range bases {
+		cl.State.Fmt.Success(fmt.Sprintf("Attached Base: %s of %s", base.Amount, base.Id))
 	}
 
-	colorants := map[string]string{}
+	for _, colorant := range colorants {
+		cl.State.Fmt.Success(fmt.Sprintf("Attached Colorant: %s of %s", colorant.Amount, colorant.Id))
+	}
 
-	for _, colorant := range colorantsRaw {
-		id, amount, found := strings.Cut(colorant, ":")
-		if !found {
-			cl.State.Fmt.Err(fmt.Sprintf("could not parse colorant; must be in format <id>:<amount> : %v", err))
-			cl.State.Fmt.Finish()
-			return err
-		}
+	cl.State.Fmt.Finish()
+	return nil
+}
 
-		colorants[id] = amount
+// formulaCreateBatch creates every formula listed in a file with a single batch call.
+func formulaCreateBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Creating formulas", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.CreateFormulaRequest { return &proto.CreateFormulaRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
 	}
 
-	cl.State.Fmt.Print("Creating formula", polyfmt.Pretty)
+	items := []*proto.BatchCreateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchCreateItem{Item: &proto.BatchCreateItem_Formula{Formula: request}})
+	}
 
 	conn, err := cl.State.Connect()
 	if err != nil {
@@ -100,48 +164,46 @@ func formulaCreate(cmd *cobra.Command, args []string) error {
 
 	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
 	ctx := metadata.NewOutgoingContext(context.Background(), md)
-	resp, err := client.CreateFormula(ctx, &proto.CreateFormulaRequest{
-		Name:   name,
-		Number: number,
-		Notes:  notes,
+	resp, err := client.BatchCreate(ctx, &proto.BatchCreateRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
 	})
 	if err != nil {
-		cl.State.Fmt.Err(fmt.Sprintf("could not create formula: %v", err))
+		cl.State.Fmt.Err(fmt.Sprintf("could not create formulas: %v", err))
 		cl.State.Fmt.Finish()
 		return err
 	}
 
-	cl.State.Fmt.Success(fmt.Sprintf("Created formula: [%s] %q", resp.Formula.Id, resp.Formula.Name))
+	err = batch.Report("created", "formula", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
 
-	for base, amount := range bases {
-		_, err := client.AssociateBaseWithFormula(ctx, &proto.AssociateBaseWithFormulaRequest{
-			Formula: resp.Formula.Id,
-			Base:    base,
-			Amount:  amount,
-		})
-		if err != nil {
-			cl.State.Fmt.Err(fmt.Sprintf("could not link base to formula: %v", err))
-			cl.State.Fmt.Finish()
-			return err
-		}
-		cl.State.Fmt.Success(fmt.Sprintf("Attached Base: %s of %s", amount, base))
-	}
-
-	for colorant, amount := range colorants {
-		_, err := client.AssociateColorantWithFormula(ctx, &proto.AssociateColorantWithFormulaRequest{
-			Formula:  resp.Formula.Id,
-			Colorant: colorant,
-			Amount:   amount,
-		})
-		if err != nil {
-			cl.State.Fmt.Err(fmt.Sprintf("could not link colorant to formula: %v", err))
-			cl.State.Fmt.Finish()
-			return err
-		}
+func createFormula(request *proto.CreateFormulaRequest) (*proto.CreateFormulaResponse, error) {
+	conn, err := cl.State.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	return client.CreateFormula(ctx, request)
+}
 
-		cl.State.Fmt.Success(fmt.Sprintf("Attached Colorant: %s of %s", amount, colorant))
+// queueFormula keeps a formula created while the server can't be reached to be sent once it can. It has no ID until
+// then, so mixes can't be recorded from it in the meantime.
+func queueFormula(request *proto.CreateFormulaRequest) error {
+	change, err := cl.State.Queue(request, 0)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not reach %s or queue formula: %v", cl.State.Config.Host, err))
+		cl.State.Fmt.Finish()
+		return err
 	}
 
+	cl.State.Fmt.Warning(fmt.Sprintf("could not reach %s; formula %q queued as change %d and will be created the "+
+		"next time it can be reached", cl.State.Config.Host, request.Name, change.ID))
 	cl.State.Fmt.Finish()
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
 	"github.com/clintjedwards/basecoat/proto"
 	"github.com/clintjedwards/polyfmt/v2"
@@ -12,19 +13,25 @@ import (
 )
 
 var cmdFormulaDelete = &cobra.Command{
-	Use:     "delete <id>",
-	Short:   "Delete  an formula",
-	Long:    `Delete an formula.`,
-	Example: `$ basecoat formula delete FyrjxCQ`,
-	RunE:    formulaDelete,
-	Args:    cobra.ExactArgs(1),
+	Use:   "delete <id>",
+	Short: "Delete  an formula",
+	Long:  `Delete an formula.`,
+	Example: `$ basecoat formula delete FyrjxCQ
+$ basecoat formula delete --file formulas.jsonl`,
+	RunE: formulaDelete,
+	Args: batch.Args(1),
 }
 
 func init() {
+	batch.AddFlags(cmdFormulaDelete, "DeleteFormulaRequest")
 	CmdFormula.AddCommand(cmdFormulaDelete)
 }
 
-func formulaDelete(_ *cobra.Command, args []string) error {
+func formulaDelete(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return formulaDeleteBatch(cmd, path)
+	}
+
 	id := args[0]
 
 	cl.State.Fmt.Print("Deleting formula", polyfmt.Pretty)
@@ -49,7 +56,49 @@ func formulaDelete(_ *cobra.Command, args []string) error {
 		return err
 	}
 
-	cl.State.Fmt.Success(fmt.Sprintf("Updated formula: %q", id))
+	cl.State.Fmt.Success(fmt.Sprintf("Moved formula %q to trash; restore with 'basecoat trash restore formula %s'", id, id))
 	cl.State.Fmt.Finish()
 	return nil
 }
+
+// formulaDeleteBatch deletes every formula listed in a file with a single batch call.
+func formulaDeleteBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Deleting formulas", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.DeleteFormulaRequest { return &proto.DeleteFormulaRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchDeleteItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchDeleteItem{Item: &proto.BatchDeleteItem_Formula{Formula: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchDelete(ctx, &proto.BatchDeleteRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete formulas: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("deleted", "formula", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
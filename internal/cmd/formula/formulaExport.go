@@ -0,0 +1,112 @@
+package formula
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdFormulaExport = &cobra.Command{
+	Use:   "export <id>",
+	Short: "Export a formula for a tinting dispenser",
+	Long: `Export a formula as a file a tinting dispenser can load.
+
+Colorant amounts are scaled from a gallon to the container size given and colorants are written using their
+dispenser codes; every colorant in the formula must have one. Set them with "basecoat colorant update <id>
+--dispenser-code <code>".
+
+Supported dispenser formats:
+` + dispenserFormats(),
+	Example: `$ basecoat formula export FyrjxCQ --dispenser fluidmanagement
+$ basecoat formula export FyrjxCQ --dispenser corob --container "1 qt" -o naval.csv`,
+	RunE: formulaExport,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdFormulaExport.Flags().StringP("dispenser", "d", "", "Dispenser file format to write")
+	cmdFormulaExport.Flags().StringP("container", "c", "1 gal", "Container size to scale the formula to; ex. \"1 qt\"")
+	cmdFormulaExport.Flags().StringP("output", "o", "",
+		"Path to write the file to; defaults to the formula number with the format's extension")
+	_ = cmdFormulaExport.MarkFlagRequired("dispenser")
+	CmdFormula.AddCommand(cmdFormulaExport)
+}
+
+func formulaExport(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Exporting formula", polyfmt.Pretty)
+
+	dispenserFormat, err := cmd.Flags().GetString("dispenser")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	container, err := cmd.Flags().GetString("container")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.ExportFormula(ctx, &proto.ExportFormulaRequest{
+		Id:        id,
+		Format:    dispenserFormat,
+		Container: container,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not export formula: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if output == "" {
+		output = resp.FileName
+	}
+
+	file, err := os.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not create export file: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(resp.Content)
+	if err != nil {
+		_ = os.Remove(output)
+		cl.State.Fmt.Err(fmt.Sprintf("could not write export file: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Exported formula %s for a %s can to %s", id, container, output))
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -0,0 +1,135 @@
+package formula
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/internal/dispenser"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdFormulaGet = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Show a single formula and its recipe",
+	Long: `Show a single formula and its recipe.
+
+Ingredient amounts are scaled to the container given. If the server can't be reached the formula is read from the
+offline cache instead.`,
+	Example: `$ basecoat formula get QH6vWSt
+$ basecoat formula get QH6vWSt --container "5 gal"`,
+	RunE: formulaGet,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdFormulaGet.Flags().StringP("container", "c", "1 gal", "Container size to scale the recipe to; ex. \"1 qt\"")
+	cl.AddOutputFlags(cmdFormulaGet)
+	CmdFormula.AddCommand(cmdFormulaGet)
+}
+
+func formulaGet(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Retrieving formula", polyfmt.Pretty)
+
+	containerRaw, err := cmd.Flags().GetString("container")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	container, err := dispenser.ParseContainer(containerRaw)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	formula, recipe, err := getFormula(id, container)
+	if err != nil {
+		snapshot, offline := cl.State.Offline(err)
+		if !offline {
+			cl.State.Fmt.Err(fmt.Sprintf("could not retrieve formula: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		formula, recipe, err = snapshot.Formula(id, container)
+		if errors.Is(err, cache.ErrNotCached) {
+			err = fmt.Errorf("formula %q is not in the offline copy", id)
+		}
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not retrieve formula from offline copy: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+	}
+
+	cl.State.See(cache.KindFormula, map[string]int64{formula.Metadata.Id: formula.Metadata.Version})
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.NewFormulaDetail(formula, recipe))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	data := [][]string{
+		{"ID", formula.Metadata.Id},
+		{"Name", formula.Metadata.Name},
+		{"Number", formula.Metadata.Number},
+		{"Sheen", formula.Metadata.Sheen},
+		{"Color", formula.Metadata.Color},
+		{"Notes", formula.Metadata.Notes},
+		{"Jobs", strings.Join(formula.Jobs, ", ")},
+		{"Version", strconv.FormatInt(formula.Metadata.Version, 10)},
+		{"Created", format.UnixMilli(formula.Metadata.Created, "Never", cl.State.Config.Detail)},
+		{"Modified", format.UnixMilli(formula.Metadata.Modified, "Never", cl.State.Config.Detail)},
+	}
+
+	ingredients := [][]string{}
+	for _, base := range recipe.Bases {
+		ingredients = append(ingredients, []string{"Base", base.Name, base.Manufacturer, base.Amount})
+	}
+	for _, colorant := range recipe.Colorants {
+		ingredients = append(ingredients, []string{"Colorant", colorant.Name, colorant.Manufacturer, colorant.Amount})
+	}
+
+	cl.State.Fmt.Println(format.GenerateGenericTable(data, "", 1))
+	cl.State.Fmt.Println(fmt.Sprintf("Recipe for %s:\n%s", recipe.Container, format.GenerateGenericTable(ingredients, "", 1)))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+// getFormula retrieves a formula and its recipe for the container given from the server.
+func getFormula(id string, container dispenser.Container) (*proto.Formula, *proto.GetFormulaRecipeResponse, error) {
+	conn, err := cl.State.Connect()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	formulaResp, err := client.GetFormula(ctx, &proto.GetFormulaRequest{Id: id})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recipeResp, err := client.GetFormulaRecipe(ctx, &proto.GetFormulaRecipeRequest{Id: id, Container: container.String()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return formulaResp.Formula, recipeResp, nil
+}
@@ -0,0 +1,451 @@
+package formula
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/knadh/koanf/parsers/hcl"
+	"github.com/knadh/koanf/providers/file"
+	"github.com/knadh/koanf/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdFormulaImport = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import formulas from a spreadsheet",
+	Long: `Import formulas from a spreadsheet such as a formula book exported from tinting machine software.
+
+The spreadsheet must be saved as CSV with a header row. A mapping file describes which columns hold which parts of
+each formula; every row becomes one formula:
+
+  name      = "Formula Name"  # required
+  number    = "Formula #"
+  notes     = "Comments"
+  delimiter = ","             # use "\t" for tab separated files
+
+  # Used for any base or colorant without a manufacturer column, or whose manufacturer cell is empty.
+  default_manufacturer = "Benjamin Moore"
+
+  base {
+    label        = "Base"
+    manufacturer = "Base Brand"
+    amount       = "Base Qty"
+  }
+
+  # Repeat for as many colorant columns as the spreadsheet has; empty cells are ignored.
+  colorant {
+    label  = "Colorant 1"
+    amount = "Amount 1"
+  }
+
+Bases and colorants are matched to those already in the account by manufacturer and label; missing ones are created.
+Rows whose formula number is already in use are skipped as duplicates.
+
+Formula files exported by tinting dispensers are imported with --dispenser instead of a mapping file. Colorant codes
+are matched to colorants by dispenser code, falling back to label, and bases to bases with the same label; use --code
+and --base to map any others. Every base and colorant must already exist. Colorant amounts are scaled to a gallon.
+
+Supported dispenser formats:
+` + dispenserFormats() + `
+A report of what the import would do is always shown first and you are asked to confirm before anything is saved.`,
+	Example: `$ basecoat formula import book.csv --mapping mapping.hcl
+$ basecoat formula import book.csv --mapping mapping.hcl --dry-run
+$ basecoat formula import export.xml --dispenser fluidmanagement --code KX=9xE1ddS --base "Extra White=2Jdx9Vd"`,
+	RunE: formulaImport,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdFormulaImport.Flags().StringP("mapping", "m", "", "Mapping file describing the spreadsheet's columns")
+	cmdFormulaImport.Flags().Bool("dry-run", false, "Only show what the import would do; do not save anything")
+	cmdFormulaImport.Flags().BoolP("yes", "y", false, "Do not ask for confirmation before saving")
+	cmdFormulaImport.Flags().StringP("dispenser", "d", "",
+		"Read a formula file exported by a tinting dispenser in this format instead of a spreadsheet")
+	cmdFormulaImport.Flags().StringArray("code", []string{},
+		"Map a dispenser colorant code to a colorant. The syntax is <code>=<colorant id>.")
+	cmdFormulaImport.Flags().StringArray("base", []string{},
+		"Map a dispenser base name to a base. The syntax is <name>=<base id>.")
+	cmdFormulaImport.MarkFlagsMutuallyExclusive("mapping", "dispenser")
+	CmdFormula.AddCommand(cmdFormulaImport)
+}
+
+// importMapping describes which columns of a spreadsheet hold which parts of a formula. Columns are named by their
+// header.
+type importMapping struct {
+	Name                string              `koanf:"name"`
+	Number              string              `koanf:"number"`
+	Notes               string              `koanf:"notes"`
+	Delimiter           string              `koanf:"delimiter"`
+	DefaultManufacturer string              `koanf:"default_manufacturer"`
+	Bases               []ingredientMapping `koanf:"base"`
+	Colorants           []ingredientMapping `koanf:"colorant"`
+}
+
+type ingredientMapping struct {
+	Label        string `koanf:"label"`
+	Manufacturer string `koanf:"manufacturer"`
+	Amount       string `koanf:"amount"`
+}
+
+func formulaImport(cmd *cobra.Command, args []string) error {
+	path := args[0]
+
+	cl.State.Fmt.Print("Importing formulas", polyfmt.Pretty)
+
+	mappingPath, err := cmd.Flags().GetString("mapping")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	yes, err := cmd.Flags().GetBool("yes")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	dispenserFormat, err := cmd.Flags().GetString("dispenser")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if dispenserFormat != "" {
+		return formulaImportDispenser(cmd, path, dispenserFormat, dryRun, yes)
+	}
+
+	if mappingPath == "" {
+		cl.State.Fmt.Err("a mapping file is required to import a spreadsheet; see --help")
+		cl.State.Fmt.Finish()
+		return errors.New("mapping file required")
+	}
+
+	mapping, err := readImportMapping(mappingPath)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read mapping: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	records, err := readFormulaBook(path, mapping)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	report, err := sendFormulaImport(ctx, client, records, true)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not import formulas: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	printImportReport(report)
+
+	summary := fmt.Sprintf("%d formula(s), %d base(s) and %d colorant(s); %d row(s) skipped",
+		len(report.FormulasCreated), len(report.BasesCreated), len(report.ColorantsCreated), len(report.Skipped))
+
+	if dryRun {
+		cl.State.Fmt.Success("Dry run; importing would create " + summary)
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	if len(report.FormulasCreated) == 0 {
+		cl.State.Fmt.Warning("Nothing to import; " + summary)
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	if !yes {
+		answer := cl.State.Fmt.Question(fmt.Sprintf("Create %s? [y/N]: ", summary))
+		if !strings.EqualFold(strings.TrimSpace(answer), "y") {
+			cl.State.Fmt.Warning("Import cancelled")
+			cl.State.Fmt.Finish()
+			return nil
+		}
+	}
+
+	report, err = sendFormulaImport(ctx, client, records, false)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not import formulas: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Imported %d formula(s), %d base(s) and %d colorant(s); %d row(s) skipped",
+		len(report.FormulasCreated), len(report.BasesCreated), len(report.ColorantsCreated), len(report.Skipped)))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+func readImportMapping(path string) (*importMapping, error) {
+	parser := koanf.New(".")
+
+	err := parser.Load(file.Provider(path), hcl.Parser(true))
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := &importMapping{Delimiter: ","}
+	err = parser.Unmarshal("", mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Name == "" {
+		return nil, errors.New("name column required")
+	}
+
+	if mapping.Delimiter == `\t` {
+		mapping.Delimiter = "\t"
+	}
+
+	if len([]rune(mapping.Delimiter)) != 1 {
+		return nil, fmt.Errorf("delimiter must be a single character; got %q", mapping.Delimiter)
+	}
+
+	return mapping, nil
+}
+
+// readFormulaBook reads every row of a spreadsheet into a formula record using the mapping given.
+func readFormulaBook(path string, mapping *importMapping) ([]*proto.ImportFormulaRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.Comma = []rune(mapping.Delimiter)[0]
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read header row: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	// column returns a function reading the named column out of a row. Unmapped columns always read as empty.
+	column := func(name string) (func(row []string) string, error) {
+		if name == "" {
+			return func([]string) string { return "" }, nil
+		}
+
+		index, present := columns[name]
+		if !present {
+			return nil, fmt.Errorf("column %q not found in header row", name)
+		}
+
+		return func(row []string) string {
+			if index >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[index])
+		}, nil
+	}
+
+	nameColumn, err := column(mapping.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	numberColumn, err := column(mapping.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	notesColumn, err := column(mapping.Notes)
+	if err != nil {
+		return nil, err
+	}
+
+	baseColumns, err := ingredientColumns(column, mapping.Bases, mapping.DefaultManufacturer)
+	if err != nil {
+		return nil, err
+	}
+
+	colorantColumns, err := ingredientColumns(column, mapping.Colorants, mapping.DefaultManufacturer)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []*proto.ImportFormulaRecord{}
+
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+
+		records = append(records, &proto.ImportFormulaRecord{
+			Row:       uint32(line),
+			Name:      nameColumn(row),
+			Number:    numberColumn(row),
+			Notes:     notesColumn(row),
+			Bases:     baseColumns(row),
+			Colorants: colorantColumns(row),
+		})
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("no rows found")
+	}
+
+	return records, nil
+}
+
+// ingredientColumns returns a function reading every mapped base or colorant out of a row. Ingredients whose label
+// and amount are both empty are left out so that rows may use fewer colorants than the spreadsheet has columns for.
+func ingredientColumns(column func(string) (func([]string) string, error), mappings []ingredientMapping,
+	defaultManufacturer string,
+) (func(row []string) []*proto.ImportIngredient, error) {
+	type reader struct {
+		label, manufacturer, amount func([]string) string
+	}
+
+	readers := []reader{}
+	for _, mapping := range mappings {
+		label, err := column(mapping.Label)
+		if err != nil {
+			return nil, err
+		}
+
+		manufacturer, err := column(mapping.Manufacturer)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := column(mapping.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		readers = append(readers, reader{label, manufacturer, amount})
+	}
+
+	return func(row []string) []*proto.ImportIngredient {
+		ingredients := []*proto.ImportIngredient{}
+		for _, reader := range readers {
+			label := reader.label(row)
+			amount := reader.amount(row)
+			if label == "" && amount == "" {
+				continue
+			}
+
+			manufacturer := reader.manufacturer(row)
+			if manufacturer == "" {
+				manufacturer = defaultManufacturer
+			}
+
+			ingredients = append(ingredients, &proto.ImportIngredient{
+				Label:        label,
+				Manufacturer: manufacturer,
+				Amount:       amount,
+			})
+		}
+
+		return ingredients
+	}, nil
+}
+
+func sendFormulaImport(ctx context.Context, client proto.BasecoatClient, records []*proto.ImportFormulaRecord,
+	dryRun bool,
+) (*proto.ImportFormulasResponse, error) {
+	stream, err := client.ImportFormulas(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, record := range records {
+		request := &proto.ImportFormulasRequest{Record: record}
+		if i == 0 {
+			request.DryRun = dryRun
+		}
+
+		err = stream.Send(request)
+		if err != nil {
+			break
+		}
+	}
+
+	// Any error from sending is more accurately reported by the server's response.
+	return stream.CloseAndRecv()
+}
+
+func printImportReport(report *proto.ImportFormulasResponse) {
+	if len(report.BasesCreated) > 0 {
+		data := [][]string{}
+		for _, base := range report.BasesCreated {
+			data = append(data, []string{base.Manufacturer, base.Label})
+		}
+
+		cl.State.Fmt.Println(fmt.Sprintf("%d new base(s):\n%s", len(report.BasesCreated),
+			format.GenerateGenericTable(data, " ", 2)))
+	}
+
+	if len(report.ColorantsCreated) > 0 {
+		data := [][]string{}
+		for _, colorant := range report.ColorantsCreated {
+			data = append(data, []string{colorant.Manufacturer, colorant.Label})
+		}
+
+		cl.State.Fmt.Println(fmt.Sprintf("%d new colorant(s):\n%s", len(report.ColorantsCreated),
+			format.GenerateGenericTable(data, " ", 2)))
+	}
+
+	if len(report.Skipped) > 0 {
+		data := [][]string{}
+		for _, skipped := range report.Skipped {
+			data = append(data, []string{fmt.Sprintf("row %d", skipped.Row), skipped.Number, skipped.Reason})
+		}
+
+		cl.State.Fmt.Println(fmt.Sprintf("%d row(s) skipped:\n%s", len(report.Skipped),
+			format.GenerateGenericTable(data, " ", 2)))
+	}
+}
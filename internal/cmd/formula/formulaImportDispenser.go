@@ -0,0 +1,189 @@
+package formula
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/internal/dispenser"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+// dispenserFormats lists the supported dispenser formats for the import command's help.
+func dispenserFormats() string {
+	formats := ""
+	for _, name := range dispenser.Names() {
+		format, _ := dispenser.Get(name)
+		formats += fmt.Sprintf("  %-16s %s\n", name, format.Description())
+	}
+
+	return formats
+}
+
+// formulaImportDispenser imports a formula file exported by a tinting dispenser. Formulas are created with a single
+// atomic batch so either the whole file is imported or none of it is.
+func formulaImportDispenser(cmd *cobra.Command, path, formatName string, dryRun, yes bool) error {
+	dispenserFormat, err := dispenser.Get(formatName)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+	defer file.Close()
+
+	recipes, err := dispenserFormat.Read(file)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(recipes) == 0 {
+		cl.State.Fmt.Warning(fmt.Sprintf("No formulas found in %s", path))
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	catalog, err := dispenserCatalog(ctx, cmd, client)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	requests, err := catalog.Resolve(recipes)
+	if err != nil {
+		var resolveErr *dispenser.ResolveError
+		if errors.As(err, &resolveErr) {
+			cl.State.Fmt.Err(fmt.Sprintf("could not match every base and colorant in %s; %v; "+
+				"map them with --base and --code", path, err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	data := [][]string{}
+	for i, request := range requests {
+		data = append(data, []string{request.Number, request.Name, recipes[i].Base,
+			fmt.Sprintf("%d colorant(s)", len(request.Colorants))})
+	}
+
+	cl.State.Fmt.Println(fmt.Sprintf("%d formula(s) found in %s:\n%s", len(requests), path,
+		format.GenerateGenericTable(data, " ", 2)))
+
+	if dryRun {
+		cl.State.Fmt.Success(fmt.Sprintf("Dry run; importing would create %d formula(s)", len(requests)))
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	if !yes {
+		answer := cl.State.Fmt.Question(fmt.Sprintf("Create %d formula(s)? [y/N]: ", len(requests)))
+		if !strings.EqualFold(strings.TrimSpace(answer), "y") {
+			cl.State.Fmt.Warning("Import cancelled")
+			cl.State.Fmt.Finish()
+			return nil
+		}
+	}
+
+	items := []*proto.BatchCreateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchCreateItem{Item: &proto.BatchCreateItem_Formula{Formula: request}})
+	}
+
+	resp, err := client.BatchCreate(ctx, &proto.BatchCreateRequest{
+		Mode:  proto.BatchMode_ATOMIC,
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not import formulas: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("created", "formula", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
+
+// dispenserCatalog matches dispenser names to the account's bases and colorants. Bases are matched by label and
+// colorants by their dispenser code, or by label if they have none; mappings given on the command line take
+// precedence.
+func dispenserCatalog(ctx context.Context, cmd *cobra.Command, client proto.BasecoatClient) (*dispenser.Catalog, error) {
+	catalog := dispenser.NewCatalog()
+
+	bases, err := client.ListBases(ctx, &proto.ListBasesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list bases: %w", err)
+	}
+
+	for _, base := range bases.Bases {
+		catalog.AddBase(base.Label, base.Id)
+	}
+
+	colorants, err := client.ListColorants(ctx, &proto.ListColorantsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list colorants: %w", err)
+	}
+
+	for _, colorant := range colorants.Colorants {
+		catalog.AddColorant(colorant.Label, colorant.Id)
+	}
+
+	// Dispenser codes are added after labels so that they win should one colorant's label be another's code.
+	for _, colorant := range colorants.Colorants {
+		if colorant.DispenserCode != "" {
+			catalog.AddColorant(colorant.DispenserCode, colorant.Id)
+		}
+	}
+
+	baseMappings, _ := cmd.Flags().GetStringArray("base")
+	for _, mapping := range baseMappings {
+		name, id, found := strings.Cut(mapping, "=")
+		if !found {
+			return nil, fmt.Errorf("could not parse base mapping %q; must be in format <name>=<base id>", mapping)
+		}
+		catalog.AddBase(name, id)
+	}
+
+	codeMappings, _ := cmd.Flags().GetStringArray("code")
+	for _, mapping := range codeMappings {
+		code, id, found := strings.Cut(mapping, "=")
+		if !found {
+			return nil, fmt.Errorf("could not parse colorant code mapping %q; must be in format <code>=<colorant id>",
+				mapping)
+		}
+		catalog.AddColorant(code, id)
+	}
+
+	return catalog, nil
+}
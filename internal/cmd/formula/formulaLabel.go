@@ -0,0 +1,133 @@
+package formula
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdFormulaLabel = &cobra.Command{
+	Use:   "label <id>",
+	Short: "Print a can label for a formula",
+	Long: `Print a can label for a formula.
+
+The label shows the formula's name and number, its base, the colorant breakdown, the job and customer it was mixed
+for, the date and a QR code linking back to the formula. Labels are rendered as PDF for laser printers or ZPL for
+thermal label printers; both are sized for 4x3 inch labels.
+
+If the formula belongs to more than one job, name the job the paint was mixed for with --job. Colorant amounts are
+scaled from a gallon to the container given with --container.
+
+Labels for a recorded mix, named with --mix, carry the mix's code instead of the formula's and take their job and
+container from the mix.`,
+	Example: `$ basecoat formula label FyrjxCQ
+$ basecoat formula label FyrjxCQ --type zpl --container "1 qt" -o label.zpl && lp -d zebra -o raw label.zpl
+$ basecoat formula label FyrjxCQ --mix 3pQv7Yd`,
+	RunE: formulaLabel,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdFormulaLabel.Flags().StringP("type", "t", "pdf",
+		"Label type; 'pdf' for laser printers or 'zpl' for thermal printers")
+	cmdFormulaLabel.Flags().StringP("job", "j", "", "Job the paint was mixed for")
+	cmdFormulaLabel.Flags().StringP("container", "c", "1 gal", "Container size the paint was mixed in; ex. \"1 qt\"")
+	cmdFormulaLabel.Flags().String("mix", "", "Recorded mix the can was filled by")
+	cmdFormulaLabel.Flags().StringP("output", "o", "", "Path to write the label to; defaults to <id>-label.<type>")
+	CmdFormula.AddCommand(cmdFormulaLabel)
+}
+
+func formulaLabel(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Rendering label", polyfmt.Pretty)
+
+	labelType, err := cmd.Flags().GetString("type")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	format, present := proto.LabelFormat_value[strings.ToUpper(labelType)]
+	if !present {
+		err := fmt.Errorf("unknown label type %q; must be 'pdf' or 'zpl'", labelType)
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	job, err := cmd.Flags().GetString("job")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	container, err := cmd.Flags().GetString("container")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	mix, err := cmd.Flags().GetString("mix")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.RenderLabel(ctx, &proto.RenderLabelRequest{
+		Id:        id,
+		Format:    proto.LabelFormat(format),
+		Job:       job,
+		Container: container,
+		Mix:       mix,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not render label: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if output == "" {
+		output = resp.FileName
+	}
+
+	err = os.WriteFile(output, resp.Content, 0o644)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not write label file: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Saved label for formula %s to %s", id, output))
+	cl.State.Fmt.Finish()
+	return nil
+}
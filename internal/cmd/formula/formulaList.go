@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/clintjedwards/basecoat/internal/cache"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
 	"github.com/clintjedwards/basecoat/internal/cmd/format"
 	"github.com/clintjedwards/basecoat/proto"
@@ -20,13 +21,15 @@ var cmdFormulaList = &cobra.Command{
 	Short: "List all formulas",
 	Long: `List all formulas.
 
-A short listing of all currently registered formulas.`,
+A short listing of all currently registered formulas. If the server can't be reached formulas are listed from the
+offline cache instead, where the filter only matches the text of a formula's name, number or notes.`,
 	Example: `$ basecoat formula list`,
 	RunE:    formulaList,
 }
 
 func init() {
 	cmdFormulaList.Flags().StringP("filter", "f", "", "Fuzzy search for formulas")
+	cl.AddOutputFlags(cmdFormulaList)
 	CmdFormula.AddCommand(cmdFormulaList)
 }
 
@@ -40,34 +43,43 @@ func formulaList(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	conn, err := cl.State.Connect()
+	formulas, err := listFormulas(query)
 	if err != nil {
-		cl.State.Fmt.Err(err)
-		cl.State.Fmt.Finish()
-		return err
+		snapshot, offline := cl.State.Offline(err)
+		if !offline {
+			cl.State.Fmt.Err(fmt.Sprintf("could not list formulas: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		formulas, err = snapshot.Formulas(query)
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not list formulas from offline copy: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
 	}
 
-	client := proto.NewBasecoatClient(conn)
-	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	versions := map[string]int64{}
+	for _, formula := range formulas {
+		versions[formula.Id] = formula.Version
+	}
+	cl.State.See(cache.KindFormula, versions)
 
-	resp, err := client.ListFormulas(ctx, &proto.ListFormulasRequest{
-		Filter: query,
-	})
-	if err != nil {
-		cl.State.Fmt.Err(fmt.Sprintf("could not list formulas: %v", err))
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(formulas, format.NewFormula))
 		cl.State.Fmt.Finish()
 		return err
 	}
 
-	if len(resp.Formulas) == 0 {
+	if len(formulas) == 0 {
 		cl.State.Fmt.Println("No formulas found")
 		cl.State.Fmt.Finish()
 		return err
 	}
 
 	data := [][]string{}
-	for _, formula := range resp.Formulas {
+	for _, formula := range formulas {
 		data = append(data, []string{
 			formula.Id,
 			formula.Name,
@@ -84,6 +96,27 @@ func formulaList(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+// listFormulas retrieves the formulas matching the filter given from the server.
+func listFormulas(filter string) ([]*proto.FormulaMetadata, error) {
+	conn, err := cl.State.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListFormulas(ctx, &proto.ListFormulasRequest{
+		Filter: filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Formulas, nil
+}
+
 func formatTable(data [][]string, color bool) string {
 	tableString := &strings.Builder{}
 	table := tablewriter.NewWriter(tableString)
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
 	"github.com/clintjedwards/basecoat/proto"
 	"github.com/clintjedwards/polyfmt/v2"
@@ -12,22 +14,41 @@ import (
 )
 
 var cmdFormulaUpdate = &cobra.Command{
-	Use:     "update <id>",
-	Short:   "Update an formula",
-	Long:    `Update an formula.`,
-	Example: `$ basecoat formula update FyrjxCQ`,
-	RunE:    formulaUpdate,
-	Args:    cobra.ExactArgs(1),
+	Use:   "update <id>",
+	Short: "Update an formula",
+	Long:  `Update an formula.`,
+	Example: `$ basecoat formula update FyrjxCQ --name "Sea Salt"
+$ basecoat formula update FyrjxCQ --base 2Jdx9Vd:1gal
+$ basecoat formula update FyrjxCQ --replace-colorants --colorant hUPwpq2:2oz --colorant 9xE1ddS:1oz
+$ basecoat formula update --file formulas.jsonl`,
+	RunE: formulaUpdate,
+	Args: batch.Args(1),
 }
 
 func init() {
 	cmdFormulaUpdate.Flags().StringP("name", "n", "", "Human readable formula name")
 	cmdFormulaUpdate.Flags().StringP("number", "u", "", "Specialized formula number")
 	cmdFormulaUpdate.Flags().StringP("notes", "o", "", "Notes about a specific formula")
+	cmdFormulaUpdate.Flags().StringP("sheen", "s", "", "Finish the formula is mixed in; ex. \"Eggshell\"")
+	cmdFormulaUpdate.Flags().String("color", "", "Hex RGB value shown as a swatch of the color; ex. \"#4A5A6B\"")
+	cmdFormulaUpdate.Flags().StringArrayP("base", "b", []string{},
+		"Bases to add to the formula or change the amount of. The syntax is <id>:<amount>.")
+	cmdFormulaUpdate.Flags().StringArrayP("colorant", "c", []string{},
+		"Colorants to add to the formula or change the amount of. The syntax is <id>:<amount>.")
+	cmdFormulaUpdate.Flags().Bool("replace-bases", false,
+		"Replace all of the formula's bases with those given; with no --base flags all bases are removed")
+	cmdFormulaUpdate.Flags().Bool("replace-colorants", false,
+		"Replace all of the formula's colorants with those given; with no --colorant flags all colorants are removed")
+	batch.AddFlags(cmdFormulaUpdate, "UpdateFormulaRequest")
+	cl.AddVersionFlag(cmdFormulaUpdate)
 	CmdFormula.AddCommand(cmdFormulaUpdate)
 }
 
 func formulaUpdate(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return formulaUpdateBatch(cmd, path)
+	}
+
 	id := args[0]
 
 	cl.State.Fmt.Print("Updating formula", polyfmt.Pretty)
@@ -46,7 +67,59 @@ func formulaUpdate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	notes, err := cmd.Flags().GetString("number")
+	notes, err := cmd.Flags().GetString("notes")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	sheen, err := cmd.Flags().GetString("sheen")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	color, err := cmd.Flags().GetString("color")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	basesRaw, err := cmd.Flags().GetStringArray("base")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	bases, err := parseIngredients("base", basesRaw)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	colorantsRaw, err := cmd.Flags().GetStringArray("colorant")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	colorants, err := parseIngredients("colorant", colorantsRaw)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	replaceBases, _ := cmd.Flags().GetBool("replace-bases")
+	replaceColorants, _ := cmd.Flags().GetBool("replace-colorants")
+
+	version, err := cl.State.UpdateVersion(cmd, cache.KindFormula, id)
 	if err != nil {
 		cl.State.Fmt.Err(err)
 		cl.State.Fmt.Finish()
@@ -62,32 +135,102 @@ func formulaUpdate(cmd *cobra.Command, args []string) error {
 
 	client := proto.NewBasecoatClient(conn)
 
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	// With no version shown before, the update is made against the current one.
+	if version == 0 {
+		current, err := client.GetFormula(ctx, &proto.GetFormulaRequest{Id: id})
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not retrieve formula: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		version = current.Formula.Metadata.Version
+	}
+
 	updateFormulaRequest := &proto.UpdateFormulaRequest{
-		Id: id,
+		Id:               id,
+		Version:          version,
+		Bases:            bases,
+		Colorants:        colorants,
+		ReplaceBases:     replaceBases,
+		ReplaceColorants: replaceColorants,
 	}
 
 	if cmd.Flags().Changed("name") {
-		updateFormulaRequest.Name = name
+		updateFormulaRequest.Name = &name
 	}
 
 	if cmd.Flags().Changed("number") {
-		updateFormulaRequest.Number = number
+		updateFormulaRequest.Number = &number
 	}
 
 	if cmd.Flags().Changed("notes") {
-		updateFormulaRequest.Notes = notes
+		updateFormulaRequest.Notes = &notes
 	}
 
-	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
-	ctx := metadata.NewOutgoingContext(context.Background(), md)
-	_, err = client.UpdateFormula(ctx, updateFormulaRequest)
+	if cmd.Flags().Changed("sheen") {
+		updateFormulaRequest.Sheen = &sheen
+	}
+
+	if cmd.Flags().Changed("color") {
+		updateFormulaRequest.Color = &color
+	}
+
+	resp, err := client.UpdateFormula(ctx, updateFormulaRequest)
 	if err != nil {
-		cl.State.Fmt.Err(fmt.Sprintf("could not update formula: %v", err))
+		cl.State.Fmt.Err(cl.UpdateFailed(cache.KindFormula, id, version, err))
 		cl.State.Fmt.Finish()
 		return err
 	}
 
+	cl.State.See(cache.KindFormula, map[string]int64{id: resp.Formula.Version})
+
 	cl.State.Fmt.Success(fmt.Sprintf("Updated formula: %q", id))
 	cl.State.Fmt.Finish()
 	return nil
 }
+
+// formulaUpdateBatch updates every formula listed in a file with a single batch call.
+func formulaUpdateBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Updating formulas", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.UpdateFormulaRequest { return &proto.UpdateFormulaRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchUpdateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchUpdateItem{Item: &proto.BatchUpdateItem_Formula{Formula: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchUpdate(ctx, &proto.BatchUpdateRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not update formulas: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("updated", "formula", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
@@ -0,0 +1,81 @@
+package job
+
+import (
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/spf13/cobra"
+)
+
+var CmdJob = &cobra.Command{
+	Use:   "job",
+	Short: "Manage jobs",
+	Long: `Manage jobs.
+
+Jobs are job sites formulas were mixed for. Each job can belong to a contractor and name a contact; usually the
+homeowner.`,
+}
+
+// addressFlags are the flags which make up a job's address, keyed by flag name.
+var addressFlags = []struct{ name, usage string }{
+	{"street", "Street address of the job site"},
+	{"street2", "Second line of the job site's street address"},
+	{"city", "City of the job site"},
+	{"state", "State of the job site"},
+	{"zipcode", "Zip code of the job site"},
+}
+
+// addAddressFlags adds the flags which make up a job's address to a command.
+func addAddressFlags(cmd *cobra.Command) {
+	for _, flag := range addressFlags {
+		cmd.Flags().String(flag.name, "", flag.usage)
+	}
+}
+
+// parseAddress applies the address flags given to a command on top of an existing address; only flags which were set
+// are changed. It reports whether any flag was set.
+func parseAddress(cmd *cobra.Command, address *proto.Address) (changed bool) {
+	fields := map[string]*string{
+		"street":  &address.Street,
+		"street2": &address.Street2,
+		"city":    &address.City,
+		"state":   &address.State,
+		"zipcode": &address.Zipcode,
+	}
+
+	for name, field := range fields {
+		if !cmd.Flags().Changed(name) {
+			continue
+		}
+
+		*field, _ = cmd.Flags().GetString(name)
+		changed = true
+	}
+
+	return changed
+}
+
+// formatAddress returns an address on a single line; empty parts are left off.
+func formatAddress(address *proto.Address) string {
+	if address == nil {
+		return ""
+	}
+
+	line := ""
+	for _, part := range []string{address.Street, address.Street2, address.City, address.State} {
+		if part == "" {
+			continue
+		}
+		if line != "" {
+			line += ", "
+		}
+		line += part
+	}
+
+	if address.Zipcode != "" {
+		if line != "" {
+			line += " "
+		}
+		line += address.Zipcode
+	}
+
+	return line
+}
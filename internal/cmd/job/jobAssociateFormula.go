@@ -0,0 +1,106 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdJobAssociateFormula = &cobra.Command{
+	Use:   "associate-formula <job> <formula>",
+	Short: "Record that a formula was used on a job",
+	Long: `Record that a formula was used on a job.
+
+Formulas associated with a job show up in 'basecoat job get' and in the job's report, along with the area of the
+job they were used in.`,
+	Example: `$ basecoat job associate-formula 9A4E3BF a3R6kUc --area "Kitchen walls"`,
+	RunE:    jobAssociateFormula,
+	Args:    cobra.ExactArgs(2),
+}
+
+var cmdJobDisassociateFormula = &cobra.Command{
+	Use:     "disassociate-formula <job> <formula>",
+	Short:   "Remove a formula from a job",
+	Example: `$ basecoat job disassociate-formula 9A4E3BF a3R6kUc`,
+	RunE:    jobDisassociateFormula,
+	Args:    cobra.ExactArgs(2),
+}
+
+func init() {
+	cmdJobAssociateFormula.Flags().StringP("area", "a", "", "Where on the job the formula was used; ex. \"Kitchen walls\"")
+	CmdJob.AddCommand(cmdJobAssociateFormula)
+	CmdJob.AddCommand(cmdJobDisassociateFormula)
+}
+
+func jobAssociateFormula(cmd *cobra.Command, args []string) error {
+	job, formula := args[0], args[1]
+
+	area, err := cmd.Flags().GetString("area")
+	if err != nil {
+		return err
+	}
+
+	cl.State.Fmt.Print("Associating formula with job", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err = client.AssociateFormulaWithJob(ctx, &proto.AssociateFormulaWithJobRequest{
+		Job:     job,
+		Formula: formula,
+		Area:    area,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not associate formula with job: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Associated formula %q with job %q", formula, job))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+func jobDisassociateFormula(_ *cobra.Command, args []string) error {
+	job, formula := args[0], args[1]
+
+	cl.State.Fmt.Print("Removing formula from job", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err = client.DisassociateFormulaFromJob(ctx, &proto.DisassociateFormulaFromJobRequest{
+		Job:     job,
+		Formula: formula,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not remove formula from job: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Removed formula %q from job %q", formula, job))
+	cl.State.Fmt.Finish()
+	return nil
+}
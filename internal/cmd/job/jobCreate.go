@@ -0,0 +1,143 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdJobCreate = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a new job",
+	Long:  `Create a new job.`,
+	Example: `$ basecoat job create "Smith kitchen" --contractor Jb8qkTz --contact 3kYbvTn \
+    --street "12 Elm St" --city Springfield --state IL --zipcode 62701
+$ basecoat job create --file jobs.jsonl`,
+	RunE: jobCreate,
+	Args: batch.Args(1),
+}
+
+func init() {
+	cmdJobCreate.Flags().String("contractor", "", "ID of the contractor the job is for; required")
+	cmdJobCreate.Flags().StringP("contact", "c", "", "ID of the contact for the job; usually the homeowner")
+	cmdJobCreate.Flags().StringP("notes", "o", "", "Notes about the job")
+	addAddressFlags(cmdJobCreate)
+	batch.AddFlags(cmdJobCreate, "CreateJobRequest")
+	CmdJob.AddCommand(cmdJobCreate)
+}
+
+func jobCreate(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return jobCreateBatch(cmd, path)
+	}
+
+	name := args[0]
+
+	cl.State.Fmt.Print("Creating job", polyfmt.Pretty)
+
+	contractor, err := cmd.Flags().GetString("contractor")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	contact, err := cmd.Flags().GetString("contact")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	notes, err := cmd.Flags().GetString("notes")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	request := &proto.CreateJobRequest{
+		Name:         name,
+		Notes:        notes,
+		ContractorId: contractor,
+	}
+
+	if cmd.Flags().Changed("contact") {
+		request.ContactId = &contact
+	}
+
+	address := &proto.Address{}
+	if parseAddress(cmd, address) {
+		request.Address = address
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.CreateJob(ctx, request)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not create job: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Created job: [%s] %q", resp.Job.Id, resp.Job.Name))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+// jobCreateBatch creates every job listed in a file with a single batch call.
+func jobCreateBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Creating jobs", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.CreateJobRequest { return &proto.CreateJobRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchCreateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchCreateItem{Item: &proto.BatchCreateItem_Job{Job: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchCreate(ctx, &proto.BatchCreateRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not create jobs: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("created", "job", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
@@ -0,0 +1,104 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdJobDelete = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a job",
+	Long:  `Delete a job.`,
+	Example: `$ basecoat job delete 9A4E3BF
+$ basecoat job delete --file jobs.jsonl`,
+	RunE: jobDelete,
+	Args: batch.Args(1),
+}
+
+func init() {
+	batch.AddFlags(cmdJobDelete, "DeleteJobRequest")
+	CmdJob.AddCommand(cmdJobDelete)
+}
+
+func jobDelete(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return jobDeleteBatch(cmd, path)
+	}
+
+	id := args[0]
+
+	cl.State.Fmt.Print("Deleting job", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err = client.DeleteJob(ctx, &proto.DeleteJobRequest{
+		Id: id,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete job: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Moved job %q to trash; restore with 'basecoat trash restore job %s'", id, id))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+// jobDeleteBatch deletes every job listed in a file with a single batch call.
+func jobDeleteBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Deleting jobs", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.DeleteJobRequest { return &proto.DeleteJobRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchDeleteItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchDeleteItem{Item: &proto.BatchDeleteItem_Job{Job: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchDelete(ctx, &proto.BatchDeleteRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete jobs: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("deleted", "job", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
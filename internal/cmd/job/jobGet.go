@@ -0,0 +1,79 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdJobGet = &cobra.Command{
+	Use:     "get <id>",
+	Short:   "Show a single job and the formulas used on it",
+	Example: `$ basecoat job get 9A4E3BF`,
+	RunE:    jobGet,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	cl.AddOutputFlags(cmdJobGet)
+	CmdJob.AddCommand(cmdJobGet)
+}
+
+func jobGet(_ *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Retrieving job", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.GetJob(ctx, &proto.GetJobRequest{Id: id})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not retrieve job: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.See(cache.KindJob, map[string]int64{resp.Job.Id: resp.Job.Version})
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.NewJob(resp.Job))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	job := resp.Job
+	data := [][]string{
+		{"ID", job.Id},
+		{"Name", job.Name},
+		{"Address", formatAddress(job.Address)},
+		{"Contractor", job.Contractor},
+		{"Contact", job.GetContact()},
+		{"Notes", job.Notes},
+		{"Formulas", strings.Join(job.Formulas, ", ")},
+		{"Version", strconv.FormatInt(job.Version, 10)},
+		{"Created", format.UnixMilli(job.Created, "Never", cl.State.Config.Detail)},
+		{"Modified", format.UnixMilli(job.Modified, "Never", cl.State.Config.Detail)},
+	}
+
+	cl.State.Fmt.Println(format.GenerateGenericTable(data, "", 1))
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -0,0 +1,139 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdJobList = &cobra.Command{
+	Use:   "list",
+	Short: "List all jobs",
+	Long: `List all jobs.
+
+A short listing of all currently registered jobs.`,
+	Example: `$ basecoat job list
+$ basecoat job list --filter smith`,
+	RunE: jobList,
+}
+
+func init() {
+	cmdJobList.Flags().StringP("filter", "f", "", "Fuzzy search for jobs")
+	cl.AddOutputFlags(cmdJobList)
+	CmdJob.AddCommand(cmdJobList)
+}
+
+func jobList(cmd *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Retrieving jobs", polyfmt.Pretty)
+
+	query, err := cmd.Flags().GetString("filter")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListJobs(ctx, &proto.ListJobsRequest{
+		Filter: query,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not list jobs: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	versions := map[string]int64{}
+	for _, job := range resp.Jobs {
+		versions[job.Id] = job.Version
+	}
+	cl.State.See(cache.KindJob, versions)
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(resp.Jobs, format.NewJob))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(resp.Jobs) == 0 {
+		cl.State.Fmt.Println("No jobs found")
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	data := [][]string{}
+	for _, job := range resp.Jobs {
+		data = append(data, []string{
+			job.Id,
+			job.Name,
+			formatAddress(job.Address),
+			job.Contractor,
+			format.UnixMilli(job.Created, "Never", cl.State.Config.Detail),
+		})
+	}
+
+	table := formatTable(data, !cl.State.Config.NoColor)
+
+	cl.State.Fmt.Println(table)
+	cl.State.Fmt.Finish()
+
+	return nil
+}
+
+func formatTable(data [][]string, color bool) string {
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"ID", "Name", "Address", "Contractor", "Created"})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(true)
+	table.SetBorder(false)
+	table.SetAutoFormatHeaders(false)
+	table.SetRowSeparator("―")
+	table.SetRowLine(false)
+	table.SetColumnSeparator("")
+	table.SetCenterSeparator("")
+
+	if color {
+		table.SetHeaderColor(
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+		)
+		table.SetColumnColor(
+			tablewriter.Color(tablewriter.FgYellowColor),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+		)
+	}
+
+	table.AppendBulk(data)
+
+	table.Render()
+	return tableString.String()
+}
@@ -0,0 +1,109 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdJobReport = &cobra.Command{
+	Use:   "report <id>",
+	Short: "Generate a customer report for a job",
+	Long: `Generate a customer report for a job.
+
+The report lists every formula used on the job with its manufacturer, base, sheen, colorant amounts and a swatch
+of the color so that the customer can buy or mix the same paint again for touch-ups. Each formula's code is printed
+too so it can be looked up later.
+
+Reports are rendered as PDF or HTML. Without --type the format is picked from the output file's extension and
+defaults to PDF.`,
+	Example: `$ basecoat job report 9A4E3BF -o report.pdf
+$ basecoat job report 9A4E3BF --type html`,
+	RunE: jobReport,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdJobReport.Flags().StringP("type", "t", "pdf", "Report type; 'pdf' or 'html'")
+	cmdJobReport.Flags().StringP("output", "o", "", "Path to write the report to; defaults to <id>-report.<type>")
+	CmdJob.AddCommand(cmdJobReport)
+}
+
+func jobReport(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Generating job report", polyfmt.Pretty)
+
+	reportType, err := cmd.Flags().GetString("type")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	output, err := cmd.Flags().GetString("output")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if !cmd.Flags().Changed("type") {
+		switch strings.ToLower(filepath.Ext(output)) {
+		case ".html", ".htm":
+			reportType = "html"
+		}
+	}
+
+	format, present := proto.GenerateJobReportRequest_Format_value[strings.ToUpper(reportType)]
+	if !present {
+		err := fmt.Errorf("unknown report type %q; must be 'pdf' or 'html'", reportType)
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.GenerateJobReport(ctx, &proto.GenerateJobReportRequest{
+		Id:     id,
+		Format: proto.GenerateJobReportRequest_Format(format),
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not generate job report: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if output == "" {
+		output = resp.FileName
+	}
+
+	err = os.WriteFile(output, resp.Content, 0o644)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not write report file: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Saved report for job %s to %s", id, output))
+	cl.State.Fmt.Finish()
+	return nil
+}
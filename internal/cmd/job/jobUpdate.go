@@ -0,0 +1,179 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/batch"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdJobUpdate = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a job",
+	Long:  `Update a job.`,
+	Example: `$ basecoat job update 9A4E3BF --street "14 Elm St"
+$ basecoat job update --file jobs.jsonl`,
+	RunE: jobUpdate,
+	Args: batch.Args(1),
+}
+
+func init() {
+	cmdJobUpdate.Flags().StringP("name", "n", "", "Name of the job")
+	cmdJobUpdate.Flags().StringP("contact", "c", "", "ID of the contact for the job; usually the homeowner")
+	cmdJobUpdate.Flags().StringP("notes", "o", "", "Notes about the job")
+	addAddressFlags(cmdJobUpdate)
+	batch.AddFlags(cmdJobUpdate, "UpdateJobRequest")
+	cl.AddVersionFlag(cmdJobUpdate)
+	CmdJob.AddCommand(cmdJobUpdate)
+}
+
+func jobUpdate(cmd *cobra.Command, args []string) error {
+	if path := batch.File(cmd); path != "" {
+		return jobUpdateBatch(cmd, path)
+	}
+
+	id := args[0]
+
+	cl.State.Fmt.Print("Updating job", polyfmt.Pretty)
+
+	name, err := cmd.Flags().GetString("name")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	contact, err := cmd.Flags().GetString("contact")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	notes, err := cmd.Flags().GetString("notes")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	version, err := cl.State.UpdateVersion(cmd, cache.KindJob, id)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	current, err := client.GetJob(ctx, &proto.GetJobRequest{Id: id})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not retrieve job: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	// With no version shown before, the update is made against the current one.
+	if version == 0 {
+		version = current.Job.Version
+	}
+
+	updateJobRequest := &proto.UpdateJobRequest{
+		Id:      id,
+		Version: version,
+	}
+
+	if cmd.Flags().Changed("name") {
+		updateJobRequest.Name = &name
+	}
+
+	if cmd.Flags().Changed("contact") {
+		updateJobRequest.ContactId = &contact
+	}
+
+	if cmd.Flags().Changed("notes") {
+		updateJobRequest.Notes = &notes
+	}
+
+	// An address given in an update replaces the job's whole address, so the flags given are applied on top of the
+	// current one.
+	address := current.Job.Address
+	if address == nil {
+		address = &proto.Address{}
+	}
+
+	if parseAddress(cmd, address) {
+		updateJobRequest.Address = address
+	}
+
+	resp, err := client.UpdateJob(ctx, updateJobRequest)
+	if err != nil {
+		cl.State.Fmt.Err(cl.UpdateFailed(cache.KindJob, id, version, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.See(cache.KindJob, map[string]int64{id: resp.Job.Version})
+
+	cl.State.Fmt.Success(fmt.Sprintf("Updated job: %q", id))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+// jobUpdateBatch updates every job listed in a file with a single batch call.
+func jobUpdateBatch(cmd *cobra.Command, path string) error {
+	cl.State.Fmt.Print("Updating jobs", polyfmt.Pretty)
+
+	requests, err := batch.ReadItems(path, func() *proto.UpdateJobRequest { return &proto.UpdateJobRequest{} })
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not read %s: %v", path, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	items := []*proto.BatchUpdateItem{}
+	for _, request := range requests {
+		items = append(items, &proto.BatchUpdateItem{Item: &proto.BatchUpdateItem_Job{Job: request}})
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	resp, err := client.BatchUpdate(ctx, &proto.BatchUpdateRequest{
+		Mode:  batch.Mode(cmd),
+		Items: items,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not update jobs: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = batch.Report("updated", "job", resp.Results, resp.Committed)
+	cl.State.Fmt.Finish()
+	return err
+}
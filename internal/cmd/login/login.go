@@ -0,0 +1,120 @@
+package login
+
+import (
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+)
+
+var CmdLogin = &cobra.Command{
+	Use:   "login <account>",
+	Short: "Log in to a Basecoat server",
+	Long: `Log in to a Basecoat server.
+
+Asks for the account's password, requests a token and saves both as a profile; the profile is then used for every
+command until another one is picked with 'basecoat profile use'. Profiles make it easy to switch between servers, such
+as those of different shops.
+
+When the token expires you're asked for the password again. Pass --save-password to have a new token requested with
+the saved password instead; this needs BASECOAT_CLI_CREDENTIALS_KEY to be set, as the key file kept next to saved
+logins doesn't protect them from anyone who can read the home directory.
+
+Saved logins are kept encrypted in ~/.config/basecoat/credentials; see 'basecoat profile --help'.`,
+	Example: `$ basecoat login shop --host basecoat.example.com
+$ basecoat login shop --host basecoat.other.example.com --profile other-shop
+$ BASECOAT_CLI_CREDENTIALS_KEY=... basecoat login shop --save-password`,
+	RunE: login,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	CmdLogin.Flags().Int64P("duration", "d", 86400, "How long each token lasts in seconds")
+	CmdLogin.Flags().Bool("save-password", false,
+		"Save the password to replace expired tokens with; requires BASECOAT_CLI_CREDENTIALS_KEY")
+}
+
+func login(cmd *cobra.Command, args []string) error {
+	account := args[0]
+
+	cl.State.Fmt.Print("Logging in", polyfmt.Pretty)
+
+	duration, err := cmd.Flags().GetInt64("duration")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	savePassword, err := cmd.Flags().GetBool("save-password")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if savePassword && !config.PassphraseSet() {
+		err := fmt.Errorf("--save-password requires BASECOAT_CLI_CREDENTIALS_KEY to be set to a passphrase")
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if cl.State.Credentials == nil {
+		err := fmt.Errorf("saved logins could not be read; fix or remove %s", config.CredentialsPath())
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	// Logging in again replaces the profile in use unless another is named.
+	name := cl.State.Profile
+	if cl.State.Config.Profile != "" {
+		name = cl.State.Config.Profile
+	}
+	if name == "" {
+		name = config.DefaultProfile
+	}
+
+	password, err := cl.State.Password("Password: ")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	profile := &config.Profile{
+		Host:     cl.State.Config.Host,
+		Account:  account,
+		Duration: duration,
+	}
+
+	if savePassword {
+		profile.Password = password
+	}
+
+	// The old token of the profile being replaced doesn't matter; it shouldn't be refreshed while connecting.
+	cl.State.Profile = ""
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Credentials.Current = name
+
+	err = cl.State.RequestToken(conn, name, profile, password)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not log in: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Logged in to %s as %q; saved as profile %q", profile.Host, account, name))
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -0,0 +1,14 @@
+package mix
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var CmdMix = &cobra.Command{
+	Use:   "mix",
+	Short: "Record and review paint mixed from formulas",
+	Long: `Record and review paint mixed from formulas.
+
+A mix is a record of paint mixed at the counter; which formula, how many cans of what size, for which job and who
+mixed it. Mixes can't be changed once recorded.`,
+}
@@ -0,0 +1,73 @@
+package mix
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdMixGet = &cobra.Command{
+	Use:     "get <id>",
+	Short:   "Show a single mix",
+	Example: `$ basecoat mix get 8Hq2mVd`,
+	RunE:    mixGet,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	cl.AddOutputFlags(cmdMixGet)
+	CmdMix.AddCommand(cmdMixGet)
+}
+
+func mixGet(_ *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Retrieving mix", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.GetMix(ctx, &proto.GetMixRequest{Id: id})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not retrieve mix: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.NewMix(resp.Mix))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	mix := resp.Mix
+	data := [][]string{
+		{"ID", mix.Id},
+		{"Formula", mix.Formula},
+		{"Job", mix.Job},
+		{"Container", mix.Container},
+		{"Quantity", strconv.FormatInt(mix.Quantity, 10)},
+		{"Notes", mix.Notes},
+		{"Mixed By", mix.Actor},
+		{"Mixed", format.UnixMilli(mix.Created, "Never", cl.State.Config.Detail)},
+	}
+
+	cl.State.Fmt.Println(format.GenerateGenericTable(data, "", 1))
+	cl.State.Fmt.Finish()
+	return nil
+}
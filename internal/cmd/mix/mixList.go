@@ -0,0 +1,149 @@
+package mix
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdMixList = &cobra.Command{
+	Use:   "list",
+	Short: "List mixes, most recent first",
+	Example: `$ basecoat mix list
+$ basecoat mix list --formula FyrjxCQ
+$ basecoat mix list --job 5tb4Xz1`,
+	RunE: mixList,
+}
+
+func init() {
+	cmdMixList.Flags().StringP("formula", "f", "", "Only list mixes of this formula")
+	cmdMixList.Flags().StringP("job", "j", "", "Only list mixes for this job")
+	cmdMixList.Flags().Int64P("limit", "l", 0, "Maximum number of mixes to list")
+	cl.AddOutputFlags(cmdMixList)
+	CmdMix.AddCommand(cmdMixList)
+}
+
+func mixList(cmd *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Retrieving mixes", polyfmt.Pretty)
+
+	formula, err := cmd.Flags().GetString("formula")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	job, err := cmd.Flags().GetString("job")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	limit, err := cmd.Flags().GetInt64("limit")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListMixes(ctx, &proto.ListMixesRequest{
+		Formula: formula,
+		Job:     job,
+		Limit:   limit,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not list mixes: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(resp.Mixes, format.NewMix))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(resp.Mixes) == 0 {
+		cl.State.Fmt.Println("No mixes found")
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	data := [][]string{}
+	for _, mix := range resp.Mixes {
+		data = append(data, []string{
+			mix.Id,
+			mix.Formula,
+			mix.Job,
+			fmt.Sprintf("%s x %s", strconv.FormatInt(mix.Quantity, 10), mix.Container),
+			format.UnixMilli(mix.Created, "Never", cl.State.Config.Detail),
+		})
+	}
+
+	table := formatTable(data, !cl.State.Config.NoColor)
+
+	cl.State.Fmt.Println(table)
+	cl.State.Fmt.Finish()
+
+	return nil
+}
+
+func formatTable(data [][]string, color bool) string {
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"ID", "Formula", "Job", "Cans", "Mixed"})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(true)
+	table.SetBorder(false)
+	table.SetAutoFormatHeaders(false)
+	table.SetRowSeparator("―")
+	table.SetRowLine(false)
+	table.SetColumnSeparator("")
+	table.SetCenterSeparator("")
+
+	if color {
+		table.SetHeaderColor(
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+		)
+		table.SetColumnColor(
+			tablewriter.Color(tablewriter.FgYellowColor),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+		)
+	}
+
+	table.AppendBulk(data)
+
+	table.Render()
+	return tableString.String()
+}
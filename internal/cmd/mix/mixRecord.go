@@ -0,0 +1,127 @@
+package mix
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/dispenser"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdMixRecord = &cobra.Command{
+	Use:   "record <formula>",
+	Short: "Record paint mixed from a formula",
+	Long: `Record paint mixed from a formula.
+
+If the server can't be reached the mix is queued and recorded the next time a command connects; see 'basecoat queue'.`,
+	Example: `$ basecoat mix record FyrjxCQ
+$ basecoat mix record FyrjxCQ --container "1 qt" --quantity 3 --job 5tb4Xz1 --notes "Trim, second coat"`,
+	RunE: mixRecord,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdMixRecord.Flags().StringP("job", "j", "", "Job the paint was mixed for")
+	cmdMixRecord.Flags().StringP("container", "c", "1 gal", "Size of each can; ex. \"1 qt\"")
+	cmdMixRecord.Flags().Int64P("quantity", "q", 1, "Number of cans mixed")
+	cmdMixRecord.Flags().StringP("notes", "n", "", "Notes about the mix")
+	CmdMix.AddCommand(cmdMixRecord)
+}
+
+func mixRecord(cmd *cobra.Command, args []string) error {
+	formula := args[0]
+
+	cl.State.Fmt.Print("Recording mix", polyfmt.Pretty)
+
+	job, err := cmd.Flags().GetString("job")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	container, err := cmd.Flags().GetString("container")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	quantity, err := cmd.Flags().GetInt64("quantity")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	notes, err := cmd.Flags().GetString("notes")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	request := &proto.RecordMixRequest{
+		Formula:   formula,
+		Job:       job,
+		Container: container,
+		Quantity:  quantity,
+		Notes:     notes,
+	}
+
+	resp, err := recordMix(request)
+	if err != nil {
+		if cl.Unreachable(err) {
+			return queueMix(request)
+		}
+
+		cl.State.Fmt.Err(fmt.Sprintf("could not record mix: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Recorded mix: [%s] %d x %s of formula %s",
+		resp.Mix.Id, resp.Mix.Quantity, resp.Mix.Container, resp.Mix.Formula))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+func recordMix(request *proto.RecordMixRequest) (*proto.RecordMixResponse, error) {
+	conn, err := cl.State.Connect()
+	if err != nil {
+		return nil, err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	return client.RecordMix(ctx, request)
+}
+
+// queueMix keeps a mix recorded while the server can't be reached to be sent once it can. The version of the formula
+// in the offline copy is kept with it so that a mix made from a recipe which has since changed is caught.
+func queueMix(request *proto.RecordMixRequest) error {
+	var seenVersion int64
+	if snapshot, err := cl.State.Snapshot(); err == nil {
+		if formula, _, err := snapshot.Formula(request.Formula, dispenser.Gallon); err == nil {
+			seenVersion = formula.Metadata.Version
+		}
+	}
+
+	change, err := cl.State.Queue(request, seenVersion)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not reach %s or queue mix: %v", cl.State.Config.Host, err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Warning(fmt.Sprintf("could not reach %s; mix queued as change %d and will be recorded the next "+
+		"time it can be reached", cl.State.Config.Host, change.ID))
+	cl.State.Fmt.Finish()
+	return nil
+}
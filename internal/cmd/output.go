@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/spf13/cobra"
+)
+
+// outputSchemas are the records printed by get and list commands in the order they're listed by 'basecoat help
+// output'.
+var outputSchemas = []struct {
+	name   string
+	record any
+}{
+	{"account", format.Account{}},
+	{"audit event", format.AuditEvent{}},
+	{"base", format.Base{}},
+	{"code", format.Code{}},
+	{"colorant", format.Colorant{}},
+	{"contact", format.Contact{}},
+	{"contractor", format.Contractor{}},
+	{"formula", format.Formula{}},
+	{"formula get", format.FormulaDetail{}},
+	{"job", format.Job{}},
+	{"mix", format.Mix{}},
+	{"profile", format.Profile{}},
+	{"queue", format.QueuedChange{}},
+	{"trash", format.DeletedEntity{}},
+	{"watch", format.Event{}},
+	{"webhook", format.Webhook{}},
+	{"webhook deliveries", format.WebhookDelivery{}},
+}
+
+// cmdOutput is a help topic rather than a command; it's shown by 'basecoat help output'.
+var cmdOutput = &cobra.Command{
+	Use:   "output",
+	Short: "Printing get and list commands as JSON, YAML, CSV or with a template",
+	Long: `Printing get and list commands as JSON, YAML, CSV or with a template.
+
+Every get and list command can print what it retrieved for other programs to read:
+
+  --format json      A single JSON document; an object for get commands and an array for list commands.
+  --format yaml      A single YAML document shaped the same as JSON.
+  --format csv       A header row of field names followed by a row per record. Lists are joined with ";".
+  --template <text>  A Go template (https://pkg.go.dev/text/template) executed once per record, each followed by a
+                     new line. Fields are named as in Go; the names below in CamelCase, ex. {{.DispenserCode}}
+                     or {{.SourceIP}}. The functions 'join' and 'json' can be called.
+
+Only the records are written to standard out; errors are written to standard error. Times are RFC 3339 in UTC and
+empty if never set. Fields may be added in later releases but are never renamed or removed.
+
+` + outputFields(),
+	Example: `$ basecoat formula list --format csv > formulas.csv
+$ basecoat job get 5tb4Xz1 --format json | jq -r .formulas[]
+$ basecoat colorant list --template '{{.DispenserCode}}	{{.Label}}'`,
+}
+
+func init() {
+	RootCmd.AddCommand(cmdOutput)
+}
+
+// outputFields lists the fields of every record.
+func outputFields() string {
+	fields := &strings.Builder{}
+	fmt.Fprintln(fields, "Fields:")
+
+	for _, schema := range outputSchemas {
+		fmt.Fprintf(fields, "  %-12s %s\n", schema.name, strings.Join(format.Fields(schema.record), ", "))
+	}
+
+	return strings.TrimSuffix(fields.String(), "\n")
+}
@@ -0,0 +1,19 @@
+package profile
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var CmdProfile = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage saved logins",
+	Long: `Manage saved logins.
+
+Each profile is a server along with the account and token used to talk to it; they're created with 'basecoat login'.
+The profile in use supplies the host and token for every command; use --profile or the BASECOAT_CLI_PROFILE
+environment variable to pick another for a single command.
+
+Profiles are kept in ~/.config/basecoat/credentials, encrypted with a key kept next to it in credentials.key. Set
+BASECOAT_CLI_CREDENTIALS_KEY to encrypt them with a passphrase instead; anyone who can read both files can read the
+saved tokens and passwords. BASECOAT_CLI_CREDENTIALS_PATH moves the file elsewhere.`,
+}
@@ -0,0 +1,58 @@
+package profile
+
+import (
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var cmdProfileDelete = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Remove a saved login",
+	Long: `Remove a saved login.
+
+The profile's token and password are forgotten. The token itself stays valid until it expires.`,
+	Example: `$ basecoat profile delete other-shop`,
+	RunE:    profileDelete,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	CmdProfile.AddCommand(cmdProfileDelete)
+}
+
+func profileDelete(_ *cobra.Command, args []string) error {
+	name := args[0]
+
+	if cl.State.Credentials == nil {
+		err := fmt.Errorf("saved logins could not be read; fix or remove %s", config.CredentialsPath())
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if _, ok := cl.State.Credentials.Profiles[name]; !ok {
+		err := fmt.Errorf("profile %q not found", name)
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	delete(cl.State.Credentials.Profiles, name)
+	if cl.State.Credentials.Current == name {
+		cl.State.Credentials.Current = ""
+	}
+
+	err := cl.State.Credentials.Save()
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not save profiles: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Deleted profile %q", name))
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -0,0 +1,121 @@
+package profile
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var cmdProfileList = &cobra.Command{
+	Use:   "list",
+	Short: "List all saved logins",
+	Long: `List all saved logins.
+
+The profile in use is marked with an asterisk.`,
+	Example: `$ basecoat profile list`,
+	RunE:    profileList,
+}
+
+func init() {
+	cl.AddOutputFlags(cmdProfileList)
+	CmdProfile.AddCommand(cmdProfileList)
+}
+
+func profileList(_ *cobra.Command, _ []string) error {
+	if cl.State.Credentials == nil {
+		err := fmt.Errorf("saved logins could not be read; fix or remove %s", config.CredentialsPath())
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	names := cl.State.Credentials.Names()
+
+	if cl.State.Structured() {
+		records := []format.Profile{}
+		for _, name := range names {
+			records = append(records, format.NewProfile(name, cl.State.Credentials.Profiles[name], name == cl.State.Profile))
+		}
+
+		err := cl.State.PrintRecords(records)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(names) == 0 {
+		cl.State.Fmt.Println("No profiles found; create one with 'basecoat login'")
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	data := [][]string{}
+	for _, name := range names {
+		profile := cl.State.Credentials.Profiles[name]
+
+		current := ""
+		if name == cl.State.Profile {
+			current = "*"
+		}
+
+		expires := "Never"
+		if expiry, ok := profile.Expiry(); ok {
+			expires = format.UnixMilli(expiry.UnixMilli(), "Never", cl.State.Config.Detail)
+		}
+		if profile.Expired(time.Now()) {
+			expires = "Expired"
+		}
+
+		data = append(data, []string{current, name, profile.Host, profile.Account, expires})
+	}
+
+	table := formatTable(data, !cl.State.Config.NoColor)
+
+	cl.State.Fmt.Println(table)
+	cl.State.Fmt.Finish()
+
+	return nil
+}
+
+func formatTable(data [][]string, color bool) string {
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"", "Name", "Host", "Account", "Token Expires"})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(true)
+	table.SetBorder(false)
+	table.SetAutoFormatHeaders(false)
+	table.SetRowSeparator("―")
+	table.SetRowLine(false)
+	table.SetColumnSeparator("")
+	table.SetCenterSeparator("")
+
+	if color {
+		table.SetHeaderColor(
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+		)
+		table.SetColumnColor(
+			tablewriter.Color(tablewriter.FgGreenColor),
+			tablewriter.Color(tablewriter.FgYellowColor),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+		)
+	}
+
+	table.AppendBulk(data)
+
+	table.Render()
+	return tableString.String()
+}
@@ -0,0 +1,56 @@
+package profile
+
+import (
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var cmdProfileUse = &cobra.Command{
+	Use:   "use <name>",
+	Short: "Switch to another saved login",
+	Long: `Switch to another saved login.
+
+Every command from now on talks to the profile's server using its account.`,
+	Example: `$ basecoat profile use other-shop`,
+	RunE:    profileUse,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	CmdProfile.AddCommand(cmdProfileUse)
+}
+
+func profileUse(_ *cobra.Command, args []string) error {
+	name := args[0]
+
+	if cl.State.Credentials == nil {
+		err := fmt.Errorf("saved logins could not be read; fix or remove %s", config.CredentialsPath())
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	profile, ok := cl.State.Credentials.Profiles[name]
+	if !ok {
+		err := fmt.Errorf("profile %q not found; create it with 'basecoat login <account> --profile %s'", name, name)
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Credentials.Current = name
+
+	err := cl.State.Credentials.Save()
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not save profiles: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Using profile %q; %s as %q", name, profile.Host, profile.Account))
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -0,0 +1,20 @@
+package queue
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var CmdQueue = &cobra.Command{
+	Use:   "queue",
+	Short: "Manage changes made while offline",
+	Long: `Manage changes made while offline.
+
+Mixes recorded and formulas created while the server can't be reached are queued on this machine and sent the next
+time a command connects, or when 'basecoat sync' is run. Each is sent with an idempotency key so that a change which
+reached the server before the connection dropped again isn't made twice.
+
+Before a change is sent it's checked against the server: a mix recorded from a formula which was changed or deleted
+on the server since it was last synced, or a formula created on the server in the meantime with the same name and
+number, conflicts with it. Changes the server turns away conflict with it as well. Conflicts are held back until they
+are resolved with 'basecoat queue resolve'; the changes queued after them are still sent.`,
+}
@@ -0,0 +1,110 @@
+package queue
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/polyfmt/v2"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+)
+
+var cmdQueueList = &cobra.Command{
+	Use:   "list",
+	Short: "List changes waiting to be sent",
+	Long: `List changes waiting to be sent.
+
+Lists the changes queued for the account in use in the order they were made, along with why those which conflict with
+the server do.`,
+	Example: `$ basecoat queue list`,
+	RunE:    queueList,
+	Args:    cobra.NoArgs,
+}
+
+func init() {
+	cl.AddOutputFlags(cmdQueueList)
+	CmdQueue.AddCommand(cmdQueueList)
+}
+
+func queueList(_ *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Retrieving queued changes", polyfmt.Pretty)
+
+	changes, err := cl.State.Changes()
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not list queued changes: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(changes, format.NewQueuedChange))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(changes) == 0 {
+		cl.State.Fmt.Println("No changes queued")
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	data := [][]string{}
+	for _, change := range changes {
+		data = append(data, []string{
+			strconv.FormatInt(change.ID, 10),
+			change.Summary(),
+			string(change.State),
+			change.Reason,
+			format.UnixMilli(change.Created.UnixMilli(), "Never", cl.State.Config.Detail),
+		})
+	}
+
+	table := formatTable(data, !cl.State.Config.NoColor)
+
+	cl.State.Fmt.Println(table)
+	cl.State.Fmt.Finish()
+
+	return nil
+}
+
+func formatTable(data [][]string, color bool) string {
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"ID", "Change", "State", "Conflict", "Queued"})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(true)
+	table.SetBorder(false)
+	table.SetAutoFormatHeaders(false)
+	table.SetRowSeparator("―")
+	table.SetRowLine(false)
+	table.SetColumnSeparator("")
+	table.SetCenterSeparator("")
+
+	if color {
+		table.SetHeaderColor(
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+		)
+		table.SetColumnColor(
+			tablewriter.Color(tablewriter.FgYellowColor),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+		)
+	}
+
+	table.AppendBulk(data)
+
+	table.Render()
+	return tableString.String()
+}
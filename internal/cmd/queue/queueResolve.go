@@ -0,0 +1,83 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/clintjedwards/basecoat/internal/cache"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+)
+
+var cmdQueueResolve = &cobra.Command{
+	Use:   "resolve <id>",
+	Short: "Resolve a change which conflicts with the server",
+	Long: `Resolve a change which conflicts with the server.
+
+Either send the change anyway with --retry, ex. once a mix recorded from a formula changed since has been checked
+against the new recipe, or drop it with --discard. Changes sent anyway are sent the next time a command connects.`,
+	Example: `$ basecoat queue resolve 3 --retry
+$ basecoat queue resolve 4 --discard`,
+	RunE: queueResolve,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdQueueResolve.Flags().Bool("retry", false, "Send the change anyway")
+	cmdQueueResolve.Flags().Bool("discard", false, "Drop the change without sending it")
+	cmdQueueResolve.MarkFlagsMutuallyExclusive("retry", "discard")
+	CmdQueue.AddCommand(cmdQueueResolve)
+}
+
+func queueResolve(cmd *cobra.Command, args []string) error {
+	cl.State.Fmt.Print("Resolving change", polyfmt.Pretty)
+
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		err = fmt.Errorf("could not parse change id %q; see 'basecoat queue list'", args[0])
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	retry, err := cmd.Flags().GetBool("retry")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	discard, err := cmd.Flags().GetBool("discard")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if !retry && !discard {
+		err = fmt.Errorf("one of --retry or --discard is required")
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = cl.State.ResolveChange(id, retry)
+	if err != nil {
+		if errors.Is(err, cache.ErrNotCached) {
+			err = fmt.Errorf("change %d not found; see 'basecoat queue list'", id)
+		}
+		cl.State.Fmt.Err(fmt.Sprintf("could not resolve change: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if retry {
+		cl.State.Fmt.Success(fmt.Sprintf("Change %d will be sent the next time the server can be reached", id))
+	} else {
+		cl.State.Fmt.Success(fmt.Sprintf("Discarded change %d", id))
+	}
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -5,11 +5,25 @@ import (
 	"strings"
 
 	"github.com/clintjedwards/basecoat/internal/cmd/account"
+	"github.com/clintjedwards/basecoat/internal/cmd/audit"
 	"github.com/clintjedwards/basecoat/internal/cmd/base"
 	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/code"
 	"github.com/clintjedwards/basecoat/internal/cmd/colorant"
+	"github.com/clintjedwards/basecoat/internal/cmd/contact"
+	"github.com/clintjedwards/basecoat/internal/cmd/contractor"
 	"github.com/clintjedwards/basecoat/internal/cmd/formula"
+	"github.com/clintjedwards/basecoat/internal/cmd/job"
+	"github.com/clintjedwards/basecoat/internal/cmd/login"
+	"github.com/clintjedwards/basecoat/internal/cmd/mix"
+	"github.com/clintjedwards/basecoat/internal/cmd/profile"
+	"github.com/clintjedwards/basecoat/internal/cmd/queue"
 	"github.com/clintjedwards/basecoat/internal/cmd/service"
+	"github.com/clintjedwards/basecoat/internal/cmd/sync"
+	"github.com/clintjedwards/basecoat/internal/cmd/trash"
+	"github.com/clintjedwards/basecoat/internal/cmd/tui"
+	"github.com/clintjedwards/basecoat/internal/cmd/watch"
+	"github.com/clintjedwards/basecoat/internal/cmd/webhook"
 	"github.com/spf13/cobra"
 )
 
@@ -32,12 +46,28 @@ func init() {
 	RootCmd.AddCommand(formula.CmdFormula)
 	RootCmd.AddCommand(base.CmdBase)
 	RootCmd.AddCommand(colorant.CmdColorant)
+	RootCmd.AddCommand(job.CmdJob)
+	RootCmd.AddCommand(contractor.CmdContractor)
+	RootCmd.AddCommand(contact.CmdContact)
+	RootCmd.AddCommand(mix.CmdMix)
+	RootCmd.AddCommand(trash.CmdTrash)
+	RootCmd.AddCommand(audit.CmdAudit)
+	RootCmd.AddCommand(code.CmdCode)
+	RootCmd.AddCommand(login.CmdLogin)
+	RootCmd.AddCommand(profile.CmdProfile)
+	RootCmd.AddCommand(sync.CmdSync)
+	RootCmd.AddCommand(queue.CmdQueue)
+	RootCmd.AddCommand(tui.CmdTUI)
+	RootCmd.AddCommand(watch.CmdWatch)
+	RootCmd.AddCommand(webhook.CmdWebhook)
 
 	RootCmd.PersistentFlags().String("config", "", "configuration file path")
 	RootCmd.PersistentFlags().Bool("detail", false, "show extra detail for some commands (ex. Exact time instead of humanized)")
-	RootCmd.PersistentFlags().String("format", "", "output format; accepted values are 'pretty', 'json', 'silent'")
+	RootCmd.PersistentFlags().String("format", "",
+		"output format; accepted values are 'pretty', 'json', 'yaml', 'csv', 'silent'; see 'basecoat help output'")
 	RootCmd.PersistentFlags().Bool("no-color", false, "disable color output")
 	RootCmd.PersistentFlags().String("host", "", "specify the URL of the server to communicate to")
+	RootCmd.PersistentFlags().String("profile", "", "saved login to use; see 'basecoat profile'")
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
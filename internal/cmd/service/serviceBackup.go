@@ -0,0 +1,95 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+)
+
+var cmdServiceBackup = &cobra.Command{
+	Use:   "backup",
+	Short: "Take a backup of the Basecoat database",
+	Long: `Take a backup of the Basecoat database.
+
+The backup is taken online and is safe to run while the Basecoat service is running. This command must be run on the
+same machine as the service and reads the service's configuration to find the database and backup directory.
+
+The backup is verified for integrity after it is written. The database's schema is never changed; if it doesn't match
+the version of basecoat taking the backup, no backup is taken.`,
+	Example: `$ basecoat service backup
+$ basecoat service backup -o /mnt/backups/basecoat.db`,
+	RunE: serviceBackup,
+}
+
+func init() {
+	cmdServiceBackup.Flags().StringP("output", "o", "",
+		"Path to write the backup to; defaults to a timestamped file inside the configured backup directory")
+	CmdService.AddCommand(cmdServiceBackup)
+}
+
+func serviceBackup(cmd *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Taking backup", polyfmt.Pretty)
+
+	configPath, _ := cmd.Flags().GetString("config")
+	conf, err := config.InitAPIConfig(configPath, true, false)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not load service configuration: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if storage.Engine(conf.Server.StorageEngine) == storage.EnginePostgres {
+		err := errors.New("backups are only supported for sqlite; back up postgres with pg_dump instead")
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	output, _ := cmd.Flags().GetString("output")
+	if output == "" {
+		err := os.MkdirAll(conf.Backup.Path, 0o700)
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not create backup directory: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		output = filepath.Join(conf.Backup.Path, storage.BackupName(time.Now()))
+	}
+
+	// The database is left exactly as the service has it; a backup taken by a newer binary must not migrate it.
+	db, err := storage.Open(storage.EngineSQLite, conf.Server.StoragePath, conf.Server.StorageResultsLimit)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not open database: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+	defer db.Close()
+
+	err = db.Backup(output)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not take backup: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	err = storage.VerifyIntegrity(output)
+	if err != nil {
+		_ = os.Remove(output)
+		cl.State.Fmt.Err(fmt.Sprintf("backup failed verification and was removed: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Backup written and verified: %s", output))
+	cl.State.Fmt.Finish()
+	return nil
+}
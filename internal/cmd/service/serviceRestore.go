@@ -0,0 +1,145 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+)
+
+var cmdServiceRestore = &cobra.Command{
+	Use:   "restore [backup]",
+	Short: "Restore the Basecoat database from a backup",
+	Long: `Restore the Basecoat database from a backup.
+
+The Basecoat service must be stopped before running this command.
+
+When a backup file is not given the database is restored to as recently as possible from the configured backup and
+write-ahead log directories. The --at flag instead restores the database as it was at the given time: from shipped
+write-ahead log the database can be restored to within the shipping interval of it, from backups alone to the most
+recent backup taken at or before it.
+
+The backup is verified for integrity before it replaces the current database. The replaced database is kept next to
+the restored one with a ".pre-restore" suffix.`,
+	Example: `$ basecoat service restore
+$ basecoat service restore /var/lib/basecoat/backups/basecoat-20230101T000000Z.db
+$ basecoat service restore --at 2023-01-01T12:00:00Z`,
+	RunE: serviceRestore,
+	Args: cobra.MaximumNArgs(1),
+}
+
+func init() {
+	cmdServiceRestore.Flags().String("at", "", "Restore the database as it was at this time (RFC3339)")
+	cmdServiceRestore.Flags().BoolP("yes", "y", false, "Do not ask for confirmation before replacing the database")
+	CmdService.AddCommand(cmdServiceRestore)
+}
+
+func serviceRestore(cmd *cobra.Command, args []string) error {
+	cl.State.Fmt.Print("Restoring database", polyfmt.Pretty)
+
+	configPath, _ := cmd.Flags().GetString("config")
+	conf, err := config.InitAPIConfig(configPath, true, false)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not load service configuration: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if storage.Engine(conf.Server.StorageEngine) == storage.EnginePostgres {
+		err := errors.New("restores are only supported for sqlite; restore postgres with pg_restore instead")
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	at := time.Now()
+
+	atRaw, _ := cmd.Flags().GetString("at")
+	if atRaw != "" {
+		at, err = time.Parse(time.RFC3339, atRaw)
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not parse --at; must be in RFC3339 format: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+	}
+
+	var source string
+	var generation *storage.WALGeneration
+	if len(args) == 1 {
+		source = args[0]
+	} else {
+		source, generation, err = findRestoreSource(conf.Backup, at)
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not find backup: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+	}
+
+	description := fmt.Sprintf("backup %q", source)
+	if generation != nil {
+		description = fmt.Sprintf("write-ahead log %q as of %s", generation.Path,
+			generation.Restored().Format(time.RFC3339))
+	}
+
+	yes, _ := cmd.Flags().GetBool("yes")
+	if !yes {
+		answer := cl.State.Fmt.Question(fmt.Sprintf("Replace database %q with %s? [y/N]: ",
+			conf.Server.StoragePath, description))
+		if !strings.EqualFold(strings.TrimSpace(answer), "y") {
+			cl.State.Fmt.Warning("Restore cancelled")
+			cl.State.Fmt.Finish()
+			return nil
+		}
+	}
+
+	if generation != nil {
+		err = storage.RestoreWAL(*generation, conf.Server.StoragePath)
+	} else {
+		err = storage.Restore(source, conf.Server.StoragePath)
+	}
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not restore database: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Restored %s from %s", conf.Server.StoragePath, description))
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+// findRestoreSource returns whichever of the backups and shipped write-ahead log restores the database to the latest
+// point at or before the time given; a backup's path or a write-ahead log generation.
+func findRestoreSource(config *config.Backup, at time.Time) (string, *storage.WALGeneration, error) {
+	backup, err := storage.FindBackup(config.Path, at)
+	if err != nil && !errors.Is(err, storage.ErrEntityNotFound) && !errors.Is(err, os.ErrNotExist) {
+		return "", nil, err
+	}
+	backupFound := err == nil
+
+	if config.WALPath != "" {
+		generation, err := storage.FindWALGeneration(config.WALPath, at)
+		if err != nil && !errors.Is(err, storage.ErrEntityNotFound) && !errors.Is(err, os.ErrNotExist) {
+			return "", nil, err
+		}
+
+		if err == nil && (!backupFound || !generation.Restored().Before(backup.Taken)) {
+			return "", &generation, nil
+		}
+	}
+
+	if !backupFound {
+		return "", nil, fmt.Errorf("no backup found in %q taken at or before %s", config.Path, at.Format(time.RFC3339))
+	}
+
+	return backup.Path, nil, nil
+}
@@ -0,0 +1,69 @@
+package sync
+
+import (
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+)
+
+var CmdSync = &cobra.Command{
+	Use:   "sync",
+	Short: "Send changes made while offline and refresh the offline copy",
+	Long: `Send changes made while offline and refresh the offline copy of formulas, bases and colorants.
+
+The command line keeps a copy of the account's formulas, bases and colorants so that 'formula get', 'formula list',
+'base list' and 'colorant list' still work when the server can't be reached. The copy is refreshed whenever a command
+connects, at most once every cache_sync_interval seconds, but only fetches the recipes of formulas which changed;
+sync fetches every one of them. Run it before heading somewhere without a signal.
+
+Changes queued while offline are sent first; see 'basecoat queue'.
+
+The copy is kept encrypted in ~/.config/basecoat/cache.db, or BASECOAT_CLI_CACHE_PATH if set, with the same key as
+saved logins; see 'basecoat profile --help'. Set disable_cache to stop keeping it.`,
+	Example: `$ basecoat sync`,
+	RunE:    sync,
+	Args:    cobra.NoArgs,
+}
+
+func sync(_ *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Syncing offline copy", polyfmt.Pretty)
+
+	// Connecting would otherwise send changes and refresh the copy on its own before doing so below.
+	cl.State.SkipCacheSync = true
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	replayed, err := cl.State.ReplayChanges(conn)
+	if replayed.Sent > 0 {
+		cl.State.Fmt.Success(fmt.Sprintf("Sent %d changes made while offline", replayed.Sent))
+	}
+	if replayed.Conflicts > 0 {
+		cl.State.Fmt.Warning(fmt.Sprintf("%d changes made while offline conflict with the server; see "+
+			"'basecoat queue list'", replayed.Conflicts))
+	}
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not send changes made while offline: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	stats, err := cl.State.SyncCache(conn, true)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not sync offline copy: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Synced %d formulas, %d bases and %d colorants to %s", stats.Formulas,
+		stats.Bases, stats.Colorants, config.CachePath()))
+	cl.State.Fmt.Finish()
+	return nil
+}
@@ -0,0 +1,14 @@
+package trash
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var CmdTrash = &cobra.Command{
+	Use:   "trash",
+	Short: "Manage deleted entities",
+	Long: `Manage deleted entities.
+
+Deleted formulas, bases, colorants, contacts, contractors and jobs are kept in the trash for a configurable amount of
+time before being permanently removed. While in the trash they can be restored.`,
+}
@@ -0,0 +1,151 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdTrashList = &cobra.Command{
+	Use:   "list",
+	Short: "List all deleted entities",
+	Long: `List all deleted entities.
+
+Lists everything currently in the trash; most recently deleted first.`,
+	Example: `$ basecoat trash list
+$ basecoat trash list --kind colorant`,
+	RunE: trashList,
+}
+
+func init() {
+	cmdTrashList.Flags().StringP("kind", "k", "",
+		"Only list entities of this kind; one of formula, base, colorant, contact, contractor or job")
+	cl.AddOutputFlags(cmdTrashList)
+	CmdTrash.AddCommand(cmdTrashList)
+}
+
+func trashList(cmd *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Retrieving deleted entities", polyfmt.Pretty)
+
+	kindStr, err := cmd.Flags().GetString("kind")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	kind := proto.EntityKind_ENTITY_KIND_UNKNOWN
+	if kindStr != "" {
+		kind, err = parseKind(kindStr)
+		if err != nil {
+			cl.State.Fmt.Err(err)
+			cl.State.Fmt.Finish()
+			return err
+		}
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListDeleted(ctx, &proto.ListDeletedRequest{
+		Kind: kind,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not list deleted entities: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(resp.Entities, format.NewDeletedEntity))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(resp.Entities) == 0 {
+		cl.State.Fmt.Println("Trash is empty")
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	data := [][]string{}
+	for _, entity := range resp.Entities {
+		data = append(data, []string{
+			entity.Id,
+			format.NormalizeEnumValue(entity.Kind.String(), "Unknown"),
+			entity.Name,
+			format.UnixMilli(entity.Deleted, "Never", cl.State.Config.Detail),
+		})
+	}
+
+	table := formatTable(data, !cl.State.Config.NoColor)
+
+	cl.State.Fmt.Println(table)
+	cl.State.Fmt.Finish()
+
+	return nil
+}
+
+// parseKind converts a user supplied entity kind into its proto representation.
+func parseKind(kind string) (proto.EntityKind, error) {
+	value, ok := proto.EntityKind_value[strings.ToUpper(kind)]
+	if !ok || value == int32(proto.EntityKind_ENTITY_KIND_UNKNOWN) {
+		return proto.EntityKind_ENTITY_KIND_UNKNOWN,
+			fmt.Errorf("unknown kind %q; must be one of formula, base, colorant, contact, contractor or job", kind)
+	}
+
+	return proto.EntityKind(value), nil
+}
+
+func formatTable(data [][]string, color bool) string {
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"ID", "Kind", "Name", "Deleted"})
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(true)
+	table.SetBorder(false)
+	table.SetAutoFormatHeaders(false)
+	table.SetRowSeparator("―")
+	table.SetRowLine(false)
+	table.SetColumnSeparator("")
+	table.SetCenterSeparator("")
+
+	if color {
+		table.SetHeaderColor(
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+			tablewriter.Color(tablewriter.FgBlueColor),
+		)
+		table.SetColumnColor(
+			tablewriter.Color(tablewriter.FgYellowColor),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+			tablewriter.Color(0),
+		)
+	}
+
+	table.AppendBulk(data)
+
+	table.Render()
+	return tableString.String()
+}
@@ -0,0 +1,66 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdTrashRestore = &cobra.Command{
+	Use:   "restore <kind> <id>",
+	Short: "Restore a deleted entity",
+	Long: `Restore a deleted entity from the trash.
+
+Kind is one of formula, base, colorant, contact, contractor or job. The entity is restored along with all of the
+associations it had when it was deleted.`,
+	Example: `$ basecoat trash restore colorant FyrjxCQ`,
+	RunE:    trashRestore,
+	Args:    cobra.ExactArgs(2),
+}
+
+func init() {
+	CmdTrash.AddCommand(cmdTrashRestore)
+}
+
+func trashRestore(_ *cobra.Command, args []string) error {
+	id := args[1]
+
+	cl.State.Fmt.Print("Restoring entity", polyfmt.Pretty)
+
+	kind, err := parseKind(args[0])
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	_, err = client.Undelete(ctx, &proto.UndeleteRequest{
+		Kind: kind,
+		Id:   id,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not restore %s: %v", args[0], err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Restored %s: %q", args[0], id))
+	cl.State.Fmt.Finish()
+	return nil
+}
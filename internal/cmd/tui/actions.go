@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestTimeout bounds every call made to the server so that a slow network can't hang the interface.
+const requestTimeout = 10 * time.Second
+
+// searchDelay is how long typing has to pause before formulas are searched for.
+const searchDelay = 200 * time.Millisecond
+
+type (
+	// searchTickMsg fires once typing pauses; seq tells stale ticks apart from the latest one.
+	searchTickMsg struct{ seq int }
+
+	searchResultMsg struct {
+		seq      int
+		formulas []*proto.FormulaMetadata
+		err      error
+	}
+
+	recipeMsg struct {
+		formula   string
+		container string
+		recipe    *proto.GetFormulaRecipeResponse
+		err       error
+	}
+
+	mixRecordedMsg struct {
+		mix *proto.Mix
+		err error
+	}
+
+	labelPrintedMsg struct {
+		// where describes what became of the label; ex. "Sent label to zebra".
+		where string
+		err   error
+	}
+)
+
+// requestContext returns a context carrying the token in use which times out after requestTimeout.
+func requestContext() (context.Context, context.CancelFunc) {
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	return context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), requestTimeout)
+}
+
+func waitForTyping(seq int) tea.Cmd {
+	return tea.Tick(searchDelay, func(time.Time) tea.Msg {
+		return searchTickMsg{seq: seq}
+	})
+}
+
+func searchFormulas(client proto.BasecoatClient, seq int, filter string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := requestContext()
+		defer cancel()
+
+		resp, err := client.ListFormulas(ctx, &proto.ListFormulasRequest{Filter: filter})
+		if err != nil {
+			return searchResultMsg{seq: seq, err: err}
+		}
+
+		return searchResultMsg{seq: seq, formulas: resp.Formulas}
+	}
+}
+
+func fetchRecipe(client proto.BasecoatClient, formula, container string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := requestContext()
+		defer cancel()
+
+		resp, err := client.GetFormulaRecipe(ctx, &proto.GetFormulaRecipeRequest{Id: formula, Container: container})
+		return recipeMsg{formula: formula, container: container, recipe: resp, err: err}
+	}
+}
+
+func recordMix(client proto.BasecoatClient, formula, job, container string, quantity int64) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := requestContext()
+		defer cancel()
+
+		resp, err := client.RecordMix(ctx, &proto.RecordMixRequest{
+			Formula:   formula,
+			Job:       job,
+			Container: container,
+			Quantity:  quantity,
+		})
+		if err != nil {
+			return mixRecordedMsg{err: err}
+		}
+
+		return mixRecordedMsg{mix: resp.Mix}
+	}
+}
+
+// printLabel renders a label and hands it to lp. If lp isn't installed the label is saved to the current directory
+// instead so it can be printed by hand. Labels of a recorded mix, named by its id, carry the mix's code.
+func printLabel(client proto.BasecoatClient, settings settings, formula, mix, container string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := requestContext()
+		defer cancel()
+
+		resp, err := client.RenderLabel(ctx, &proto.RenderLabelRequest{
+			Id:        formula,
+			Format:    settings.labelFormat,
+			Job:       settings.job,
+			Container: container,
+			Mix:       mix,
+		})
+		if err != nil {
+			return labelPrintedMsg{err: err}
+		}
+
+		if _, err := exec.LookPath("lp"); err != nil {
+			err = os.WriteFile(resp.FileName, resp.Content, 0o644)
+			if err != nil {
+				return labelPrintedMsg{err: fmt.Errorf("could not write label file: %w", err)}
+			}
+
+			return labelPrintedMsg{where: "Saved label to " + resp.FileName}
+		}
+
+		args := []string{}
+		destination := "default printer"
+		if settings.printer != "" {
+			args = append(args, "-d", settings.printer)
+			destination = settings.printer
+		}
+
+		// Thermal printers take ZPL as is; without raw the print system would try to convert it.
+		if settings.labelFormat == proto.LabelFormat_ZPL {
+			args = append(args, "-o", "raw")
+		}
+
+		lp := exec.Command("lp", args...)
+		lp.Stdin = bytes.NewReader(resp.Content)
+		output, err := lp.CombinedOutput()
+		if err != nil {
+			return labelPrintedMsg{err: fmt.Errorf("lp failed: %v: %s", err, bytes.TrimSpace(output))}
+		}
+
+		return labelPrintedMsg{where: "Sent label to " + destination}
+	}
+}
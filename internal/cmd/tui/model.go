@@ -0,0 +1,436 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+)
+
+// containerSizes are the sizes offered at the counter, smallest first.
+var containerSizes = []string{"1 pt", "1 qt", "1 gal", "5 gal"}
+
+// defaultContainerSize is the index of a gallon in containerSizes.
+const defaultContainerSize = 2
+
+// settings are the choices made on the command line which hold for the whole session.
+type settings struct {
+	job         string
+	printer     string
+	labelFormat proto.LabelFormat
+}
+
+type focus int
+
+const (
+	focusSearch focus = iota
+	focusList
+)
+
+type model struct {
+	client   proto.BasecoatClient
+	settings settings
+
+	search    textinput.Model
+	searchSeq int
+	searching bool
+	focus     focus
+
+	formulas []*proto.FormulaMetadata
+	cursor   int
+
+	size     int
+	quantity int64
+
+	// lastMix is the last mix recorded; its label is printed in place of the formula's while it's of the formula and
+	// container picked.
+	lastMix *proto.Mix
+
+	// recipe is the breakdown of the formula under the cursor for the container size picked; nil while it's loading.
+	recipe    *proto.GetFormulaRecipeResponse
+	recipeErr error
+
+	status    string
+	statusErr bool
+
+	width  int
+	height int
+}
+
+func newModel(client proto.BasecoatClient, settings settings) model {
+	search := textinput.New()
+	search.Placeholder = "name, number or notes"
+	search.Prompt = "Search: "
+	search.Focus()
+
+	return model{
+		client:    client,
+		settings:  settings,
+		search:    search,
+		searching: true,
+		focus:     focusSearch,
+		size:      defaultContainerSize,
+		quantity:  1,
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(textinput.Blink, searchFormulas(m.client, m.searchSeq, ""))
+}
+
+// selected returns the formula under the cursor; nil if there are none.
+func (m model) selected() *proto.FormulaMetadata {
+	if m.cursor < 0 || m.cursor >= len(m.formulas) {
+		return nil
+	}
+
+	return m.formulas[m.cursor]
+}
+
+func (m model) container() string {
+	return containerSizes[m.size]
+}
+
+// loadRecipe clears the breakdown shown and fetches the one for the formula under the cursor.
+func (m *model) loadRecipe() tea.Cmd {
+	m.recipe = nil
+	m.recipeErr = nil
+
+	formula := m.selected()
+	if formula == nil {
+		return nil
+	}
+
+	return fetchRecipe(m.client, formula.Id, m.container())
+}
+
+func (m *model) setStatus(status string, isErr bool) {
+	m.status = status
+	m.statusErr = isErr
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case searchTickMsg:
+		if msg.seq != m.searchSeq {
+			return m, nil
+		}
+		return m, searchFormulas(m.client, msg.seq, strings.TrimSpace(m.search.Value()))
+
+	case searchResultMsg:
+		// Results of a search the user has since typed past are thrown away.
+		if msg.seq != m.searchSeq {
+			return m, nil
+		}
+
+		m.searching = false
+		if msg.err != nil {
+			m.setStatus(fmt.Sprintf("could not search formulas: %v", msg.err), true)
+			return m, nil
+		}
+
+		m.formulas = msg.formulas
+		m.cursor = 0
+		return m, m.loadRecipe()
+
+	case recipeMsg:
+		formula := m.selected()
+		if formula == nil || formula.Id != msg.formula || m.container() != msg.container {
+			return m, nil
+		}
+
+		m.recipe = msg.recipe
+		m.recipeErr = msg.err
+		return m, nil
+
+	case mixRecordedMsg:
+		if msg.err != nil {
+			m.setStatus(fmt.Sprintf("could not record mix: %v", msg.err), true)
+			return m, nil
+		}
+
+		m.lastMix = msg.mix
+		m.setStatus(fmt.Sprintf("Recorded mix %s: %d x %s", msg.mix.Id, msg.mix.Quantity, msg.mix.Container), false)
+		return m, nil
+
+	case labelPrintedMsg:
+		if msg.err != nil {
+			m.setStatus(fmt.Sprintf("could not print label: %v", msg.err), true)
+			return m, nil
+		}
+
+		m.setStatus(msg.where, false)
+		return m, nil
+
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+
+		if m.focus == focusSearch {
+			return m.updateSearch(msg)
+		}
+
+		return m.updateList(msg)
+	}
+
+	var cmd tea.Cmd
+	m.search, cmd = m.search.Update(msg)
+	return m, cmd
+}
+
+// updateSearch handles keys typed while the search box has focus. The search runs once typing pauses rather than on
+// every key.
+func (m model) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter, tea.KeyEsc, tea.KeyTab, tea.KeyDown:
+		m.focus = focusList
+		m.search.Blur()
+		return m, nil
+	}
+
+	previous := m.search.Value()
+
+	var cmd tea.Cmd
+	m.search, cmd = m.search.Update(msg)
+	if m.search.Value() == previous {
+		return m, cmd
+	}
+
+	m.searchSeq++
+	m.searching = true
+	return m, tea.Batch(cmd, waitForTyping(m.searchSeq))
+}
+
+func (m model) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		return m, tea.Quit
+
+	case "/", "tab":
+		m.focus = focusSearch
+		return m, m.search.Focus()
+
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+			return m, m.loadRecipe()
+		}
+
+	case "down", "j":
+		if m.cursor < len(m.formulas)-1 {
+			m.cursor++
+			return m, m.loadRecipe()
+		}
+
+	case "s":
+		m.size = (m.size + 1) % len(containerSizes)
+		return m, m.loadRecipe()
+
+	case "S":
+		m.size = (m.size + len(containerSizes) - 1) % len(containerSizes)
+		return m, m.loadRecipe()
+
+	case "+", "=":
+		m.quantity++
+
+	case "-":
+		if m.quantity > 1 {
+			m.quantity--
+		}
+
+	case "m":
+		formula := m.selected()
+		if formula == nil {
+			return m, nil
+		}
+
+		m.setStatus(fmt.Sprintf("Recording mix of %s...", formula.Name), false)
+		return m, recordMix(m.client, formula.Id, m.settings.job, m.container(), m.quantity)
+
+	case "p":
+		formula := m.selected()
+		if formula == nil {
+			return m, nil
+		}
+
+		mix := ""
+		if m.lastMix != nil && m.lastMix.Formula == formula.Id && m.lastMix.Container == m.container() {
+			mix = m.lastMix.Id
+		}
+
+		m.setStatus(fmt.Sprintf("Printing label for %s...", formula.Name), false)
+		return m, printLabel(m.client, m.settings, formula.Id, mix, m.container())
+	}
+
+	return m, nil
+}
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12"))
+	selectedStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("11"))
+	faintStyle    = lipgloss.NewStyle().Faint(true)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	successStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	paneStyle     = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 1)
+)
+
+func (m model) View() string {
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	// Two border and two padding columns per pane.
+	listWidth := m.width/3 - 4
+	detailWidth := m.width - listWidth - 8
+	paneHeight := m.height - 6
+
+	list := paneStyle.Width(listWidth).Height(paneHeight).Render(m.listView(listWidth, paneHeight))
+	detail := paneStyle.Width(detailWidth).Height(paneHeight).Render(m.detailView())
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		m.search.View(),
+		lipgloss.JoinHorizontal(lipgloss.Top, list, detail),
+		m.statusView(),
+		m.helpView(),
+	)
+}
+
+func (m model) listView(width, height int) string {
+	if m.searching && len(m.formulas) == 0 {
+		return faintStyle.Render("Searching...")
+	}
+
+	if len(m.formulas) == 0 {
+		return faintStyle.Render("No formulas found")
+	}
+
+	// The list scrolls so that the cursor is always in view.
+	start := 0
+	if m.cursor >= height {
+		start = m.cursor - height + 1
+	}
+
+	lines := []string{}
+	for i := start; i < len(m.formulas) && i < start+height; i++ {
+		formula := m.formulas[i]
+
+		line := formula.Name
+		if formula.Number != "" {
+			line = fmt.Sprintf("%s (%s)", formula.Name, formula.Number)
+		}
+		line = truncate(line, width-4)
+
+		if i == m.cursor {
+			lines = append(lines, selectedStyle.Render("> "+line))
+			continue
+		}
+		lines = append(lines, "  "+line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (m model) detailView() string {
+	formula := m.selected()
+	if formula == nil {
+		return faintStyle.Render("Pick a formula to see what goes into it")
+	}
+
+	data := [][]string{
+		{"ID", formula.Id},
+		{"Number", formula.Number},
+		{"Sheen", formula.Sheen},
+		{"Modified", format.UnixMilli(formula.Modified, "Never", cl.State.Config.Detail)},
+	}
+	if m.settings.job != "" {
+		data = append(data, []string{"Job", m.settings.job})
+	}
+
+	title := titleStyle.Render(formula.Name)
+	if formula.Color != "" {
+		title = lipgloss.NewStyle().Background(lipgloss.Color(formula.Color)).Render("      ") + " " + title
+	}
+
+	sections := []string{
+		title,
+		strings.TrimSuffix(format.GenerateGenericTable(data, "", 1), "\n"),
+	}
+
+	switch {
+	case m.recipeErr != nil:
+		sections = append(sections, errorStyle.Render(fmt.Sprintf("could not load recipe: %v", m.recipeErr)))
+	case m.recipe == nil:
+		sections = append(sections, faintStyle.Render("Loading recipe..."))
+	default:
+		sections = append(sections,
+			titleStyle.Render(fmt.Sprintf("Recipe for %d x %s", m.quantity, m.recipe.Container)),
+			ingredientTable("Bases", m.recipe.Bases),
+			ingredientTable("Colorants", m.recipe.Colorants),
+		)
+	}
+
+	if formula.Notes != "" {
+		sections = append(sections, titleStyle.Render("Notes"), formula.Notes)
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+func ingredientTable(title string, ingredients []*proto.RecipeIngredient) string {
+	if len(ingredients) == 0 {
+		return title + "\n" + faintStyle.Render("  None")
+	}
+
+	data := [][]string{}
+	for _, ingredient := range ingredients {
+		name := ingredient.Name
+		if ingredient.Manufacturer != "" {
+			name = fmt.Sprintf("%s (%s)", ingredient.Name, ingredient.Manufacturer)
+		}
+		data = append(data, []string{name, ingredient.Amount})
+	}
+
+	return title + "\n" + strings.TrimSuffix(format.GenerateGenericTable(data, "", 3), "\n")
+}
+
+func (m model) statusView() string {
+	switch {
+	case m.status == "":
+		return ""
+	case m.statusErr:
+		return errorStyle.Render(m.status)
+	default:
+		return successStyle.Render(m.status)
+	}
+}
+
+func (m model) helpView() string {
+	if m.focus == focusSearch {
+		return faintStyle.Render("enter: pick a formula • ctrl+c: quit")
+	}
+
+	return faintStyle.Render(fmt.Sprintf(
+		"↑/↓: pick • s/S: size (%s) • +/-: cans (%d) • m: record mix • p: print label • /: search • q: quit",
+		m.container(), m.quantity))
+}
+
+// truncate shortens a line to the width given, marking that it was cut.
+func truncate(line string, width int) string {
+	runes := []rune(line)
+	if width < 1 || len(runes) <= width {
+		return line
+	}
+
+	return string(runes[:width-1]) + "…"
+}
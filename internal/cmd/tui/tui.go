@@ -0,0 +1,96 @@
+// Package tui is a full-screen terminal interface for the mixing counter; search formulas as you type, see what goes
+// into a can of any size, then record the mix and print its label with a single key.
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/spf13/cobra"
+)
+
+var CmdTUI = &cobra.Command{
+	Use:   "tui",
+	Short: "Full-screen terminal interface for the mixing counter",
+	Long: `Full-screen terminal interface for the mixing counter.
+
+Type to search formulas by name, number or notes; the formula under the cursor shows its base and colorant
+breakdown scaled to the container size picked. Mixes are recorded and labels printed with a single key.
+
+Labels are sent to the system's default printer with lp, or the printer named with --printer. If lp isn't
+installed they're saved to the current directory instead.
+
+Keys:
+  /          search formulas
+  up/down    pick a formula
+  s, S       next/previous container size
+  +, -       more/fewer cans
+  m          record a mix of the formula picked
+  p          print a label for the formula picked; once its mix is recorded the label carries the mix's code
+  q, ctrl+c  quit`,
+	Example: `$ basecoat tui
+$ basecoat tui --job 5tb4Xz1 --printer zebra --label-type zpl`,
+	RunE: runTUI,
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	CmdTUI.Flags().StringP("job", "j", "", "Job mixes are recorded and labels printed for")
+	CmdTUI.Flags().String("printer", "", "Printer labels are sent to; defaults to the system's default printer")
+	CmdTUI.Flags().StringP("label-type", "t", "pdf",
+		"Label type; 'pdf' for laser printers or 'zpl' for thermal printers")
+}
+
+func runTUI(cmd *cobra.Command, _ []string) error {
+	job, err := cmd.Flags().GetString("job")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	printer, err := cmd.Flags().GetString("printer")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	labelType, err := cmd.Flags().GetString("label-type")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	labelFormat, present := proto.LabelFormat_value[strings.ToUpper(labelType)]
+	if !present {
+		err := fmt.Errorf("unknown label type %q; must be 'pdf' or 'zpl'", labelType)
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+	defer conn.Close()
+
+	// The interface takes over the whole terminal; the spinner would draw over it.
+	cl.State.Fmt.Finish()
+
+	program := tea.NewProgram(newModel(proto.NewBasecoatClient(conn), settings{
+		job:         job,
+		printer:     printer,
+		labelFormat: proto.LabelFormat(labelFormat),
+	}), tea.WithAltScreen())
+
+	_, err = program.Run()
+	return err
+}
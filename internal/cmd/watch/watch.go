@@ -0,0 +1,178 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxReconnectDelay caps how long watch waits between attempts to reconnect to the server.
+const maxReconnectDelay = 30 * time.Second
+
+var CmdWatch = &cobra.Command{
+	Use:   "watch",
+	Short: "Print changes made to the account as they happen",
+	Long: `Print changes made to the account as they happen.
+
+Every formula, base, colorant, contact, contractor, job and mix created, updated or deleted is printed until the
+command is stopped. If the connection to the server drops, watch reconnects and picks up where it left off without
+missing a change.
+
+Each change has a sequence number; pass the last one seen to --after to also print the changes made since then, for
+example after the command was stopped. The same changes are kept in the audit log; see 'basecoat audit'.
+
+With --format json or yaml a document is printed per change. CSV isn't supported.`,
+	Example: `$ basecoat watch
+$ basecoat watch --kind formula --kind mix
+$ basecoat watch --after 1042 --format json`,
+	RunE: watch,
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	CmdWatch.Flags().Int64("after", 0, "Also print changes made after the change with this sequence number")
+	CmdWatch.Flags().StringSliceP("kind", "k", nil,
+		"Only print changes to entities of this kind; one of formula, base, colorant, contact, contractor, job, mix or "+
+			"account. Can be given more than once")
+	cl.AddOutputFlags(CmdWatch)
+}
+
+func watch(cmd *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Watching for changes", polyfmt.Pretty)
+
+	request, err := parseRequest(cmd)
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if cl.State.Output == format.OutputCSV {
+		err = fmt.Errorf("csv output isn't supported by watch; use json, yaml or a template")
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	// Watching from now on is pinned to the latest change so that reconnecting doesn't skip what was made in between.
+	if request.After == 0 {
+		resp, err := client.ListAuditEvents(ctx, &proto.ListAuditEventsRequest{Limit: 1})
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not watch for changes: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+
+		if len(resp.Events) > 0 {
+			request.After = resp.Events[0].Id
+		}
+	}
+
+	cl.State.Fmt.Finish()
+
+	delay := time.Second
+	for {
+		received, err := watchEvents(ctx, client, request)
+		if received {
+			delay = time.Second
+		}
+
+		if status.Code(err) != codes.Unavailable && !errors.Is(err, io.EOF) {
+			cl.State.Fmt.Err(fmt.Sprintf("could not watch for changes: %v", err))
+			return err
+		}
+
+		// The connection dropped or the server is restarting; the same request resumes after the last change printed.
+		cl.State.Fmt.Warning(fmt.Sprintf("lost connection to server; reconnecting in %s", delay))
+		time.Sleep(delay)
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// watchEvents prints changes until the stream ends, moving the request along so that retrying it resumes after the
+// last change printed. It returns true if any change was received.
+func watchEvents(ctx context.Context, client proto.BasecoatClient, request *proto.WatchEventsRequest) (bool, error) {
+	stream, err := client.WatchEvents(ctx, request, grpc.WaitForReady(true))
+	if err != nil {
+		return false, err
+	}
+
+	received := false
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+
+		err = printEvent(resp.Event)
+		if err != nil {
+			return received, err
+		}
+
+		request.After = resp.Event.Sequence
+	}
+}
+
+func printEvent(event *proto.Event) error {
+	if cl.State.Structured() {
+		return cl.State.PrintRecords(format.NewEvent(event))
+	}
+
+	cl.State.Fmt.Println(fmt.Sprintf("%s  #%d  %s %s %s by %s (%s)",
+		format.UnixMilli(event.Created, "Unknown", cl.State.Config.Detail),
+		event.Sequence,
+		format.NormalizeEnumValue(event.EntityKind.String(), "Unknown"),
+		event.EntityId,
+		strings.ToLower(event.Type.String()),
+		event.Actor,
+		event.Method[strings.LastIndex(event.Method, "/")+1:],
+	))
+	return nil
+}
+
+// parseRequest builds the watch request from the command's flags.
+func parseRequest(cmd *cobra.Command) (*proto.WatchEventsRequest, error) {
+	request := &proto.WatchEventsRequest{}
+
+	request.After, _ = cmd.Flags().GetInt64("after")
+
+	kinds, _ := cmd.Flags().GetStringSlice("kind")
+	for _, kind := range kinds {
+		value, ok := proto.EntityKind_value[strings.ToUpper(kind)]
+		if !ok || value == int32(proto.EntityKind_ENTITY_KIND_UNKNOWN) {
+			return nil, fmt.Errorf("unknown kind %q; must be one of formula, base, colorant, contact, contractor, job, "+
+				"mix or account", kind)
+		}
+		request.EntityKinds = append(request.EntityKinds, proto.EntityKind(value))
+	}
+
+	return request, nil
+}
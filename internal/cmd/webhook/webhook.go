@@ -0,0 +1,26 @@
+package webhook
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var CmdWebhook = &cobra.Command{
+	Use:   "webhook",
+	Short: "Manage webhooks",
+	Long: `Manage webhooks.
+
+Webhooks post the account's changes to a URL as they happen. A webhook subscribes to event types made of the kind of
+entity and what happened to it; for example "job.updated" or "mix.created". The kinds are formula, base, colorant,
+contact, contractor, job, account and mix; each can be created, updated or deleted.
+
+Every delivery is a JSON document posted with these headers:
+
+  X-Basecoat-Event      The event type; "ping" for test deliveries.
+  X-Basecoat-Delivery   The ID of the delivery; the same for every attempt at it.
+  X-Basecoat-Timestamp  When the attempt was made in epoch seconds.
+  X-Basecoat-Signature  "sha256=" followed by the hex encoded HMAC-SHA256 of the timestamp, a period and the body,
+                        keyed with the webhook's secret.
+
+A delivery succeeds when the webhook answers with a 2xx status. Failed deliveries are retried with exponential backoff
+and every attempt is kept in the webhook's delivery log; see 'basecoat webhook deliveries'.`,
+}
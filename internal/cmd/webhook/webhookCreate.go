@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdWebhookCreate = &cobra.Command{
+	Use:   "create <url>",
+	Short: "Create a new webhook",
+	Long: `Create a new webhook.
+
+The secret deliveries are signed with is generated unless given and is only ever shown once; keep it somewhere safe.`,
+	Example: `$ basecoat webhook create https://example.com/hooks/basecoat --event job.updated --event mix.created
+$ basecoat webhook create https://example.com/hooks/basecoat -e formula.created,formula.updated --secret shh`,
+	RunE: webhookCreate,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdWebhookCreate.Flags().StringSliceP("event", "e", nil,
+		"Event type to deliver; ex. job.updated. Can be given more than once")
+	cmdWebhookCreate.Flags().StringP("secret", "s", "", "Secret to sign deliveries with; generated if not given")
+	_ = cmdWebhookCreate.MarkFlagRequired("event")
+	CmdWebhook.AddCommand(cmdWebhookCreate)
+}
+
+func webhookCreate(cmd *cobra.Command, args []string) error {
+	url := args[0]
+
+	cl.State.Fmt.Print("Creating webhook", polyfmt.Pretty)
+
+	events, err := cmd.Flags().GetStringSlice("event")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	secret, err := cmd.Flags().GetString("secret")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.CreateWebhook(ctx, &proto.CreateWebhookRequest{
+		Url:    url,
+		Events: events,
+		Secret: secret,
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not create webhook: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Created webhook: [%s] %q", resp.Webhook.Id, resp.Webhook.Url))
+	if secret == "" {
+		cl.State.Fmt.Println(fmt.Sprintf("Secret: %s\nThis is the only time the secret is shown.", resp.Secret))
+	}
+	cl.State.Fmt.Finish()
+	return nil
+}
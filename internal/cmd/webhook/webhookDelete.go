@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdWebhookDelete = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a webhook",
+	Long: `Delete a webhook.
+
+The webhook's delivery log is deleted with it and deliveries not yet made are dropped. Webhooks aren't moved to the
+trash and can't be restored.`,
+	Example: `$ basecoat webhook delete 8cRk2Lp`,
+	RunE:    webhookDelete,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	CmdWebhook.AddCommand(cmdWebhookDelete)
+}
+
+func webhookDelete(_ *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Deleting webhook", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	_, err = client.DeleteWebhook(ctx, &proto.DeleteWebhookRequest{Id: id})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not delete webhook: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Deleted webhook: %q", id))
+	cl.State.Fmt.Finish()
+	return nil
+}
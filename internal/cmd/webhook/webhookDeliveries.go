@@ -0,0 +1,125 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdWebhookDeliveries = &cobra.Command{
+	Use:   "deliveries <id>",
+	Short: "List a webhook's deliveries",
+	Long: `List a webhook's deliveries; newest first.
+
+Pending deliveries are retried at the time shown under Next Attempt. Failed deliveries have run out of attempts or
+were test deliveries, which are never retried; the error of the last attempt is shown for both.`,
+	Example: `$ basecoat webhook deliveries 8cRk2Lp
+$ basecoat webhook deliveries 8cRk2Lp --limit 5 --format json`,
+	RunE: webhookDeliveries,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdWebhookDeliveries.Flags().UintP("limit", "l", 20, "Maximum number of deliveries to list")
+	cmdWebhookDeliveries.Flags().Uint("offset", 0, "Number of deliveries to skip")
+	cl.AddOutputFlags(cmdWebhookDeliveries)
+	CmdWebhook.AddCommand(cmdWebhookDeliveries)
+}
+
+func webhookDeliveries(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Retrieving webhook deliveries", polyfmt.Pretty)
+
+	limit, err := cmd.Flags().GetUint("limit")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	offset, err := cmd.Flags().GetUint("offset")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListWebhookDeliveries(ctx, &proto.ListWebhookDeliveriesRequest{
+		Webhook: id,
+		Offset:  uint64(offset),
+		Limit:   uint64(limit),
+	})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not list webhook deliveries: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(resp.Deliveries, format.NewWebhookDelivery))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(resp.Deliveries) == 0 {
+		cl.State.Fmt.Println("No deliveries found")
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	data := [][]string{}
+	for _, delivery := range resp.Deliveries {
+		nextAttempt := ""
+		if delivery.State == proto.WebhookDeliveryState_PENDING {
+			nextAttempt = format.UnixMilli(delivery.NextAttempt, "Now", cl.State.Config.Detail)
+		}
+
+		data = append(data, []string{
+			strconv.FormatInt(delivery.Id, 10),
+			delivery.Event,
+			strings.ToLower(delivery.State.String()),
+			strconv.FormatInt(delivery.Attempts, 10),
+			responseCode(delivery.ResponseCode),
+			nextAttempt,
+			delivery.Error,
+			format.UnixMilli(delivery.Created, "Never", cl.State.Config.Detail),
+		})
+	}
+
+	table := formatTable([]string{"ID", "Event", "State", "Attempts", "Response", "Next Attempt", "Error", "Created"},
+		data, !cl.State.Config.NoColor)
+
+	cl.State.Fmt.Println(table)
+	cl.State.Fmt.Finish()
+
+	return nil
+}
+
+// responseCode formats the HTTP status of an attempt; blank if the webhook never responded.
+func responseCode(code int64) string {
+	if code == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(code, 10)
+}
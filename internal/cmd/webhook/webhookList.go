@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/internal/cmd/format"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdWebhookList = &cobra.Command{
+	Use:     "list",
+	Short:   "List all webhooks",
+	Example: `$ basecoat webhook list`,
+	RunE:    webhookList,
+}
+
+func init() {
+	cl.AddOutputFlags(cmdWebhookList)
+	CmdWebhook.AddCommand(cmdWebhookList)
+}
+
+func webhookList(_ *cobra.Command, _ []string) error {
+	cl.State.Fmt.Print("Retrieving webhooks", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.ListWebhooks(ctx, &proto.ListWebhooksRequest{})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not list webhooks: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if cl.State.Structured() {
+		err = cl.State.PrintRecords(format.Records(resp.Webhooks, format.NewWebhook))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	if len(resp.Webhooks) == 0 {
+		cl.State.Fmt.Println("No webhooks found")
+		cl.State.Fmt.Finish()
+		return nil
+	}
+
+	data := [][]string{}
+	for _, webhook := range resp.Webhooks {
+		data = append(data, []string{
+			webhook.Id,
+			webhook.Url,
+			strings.Join(webhook.Events, ", "),
+			format.UnixMilli(webhook.Created, "Never", cl.State.Config.Detail),
+		})
+	}
+
+	table := formatTable([]string{"ID", "URL", "Events", "Created"}, data, !cl.State.Config.NoColor)
+
+	cl.State.Fmt.Println(table)
+	cl.State.Fmt.Finish()
+
+	return nil
+}
+
+func formatTable(header []string, data [][]string, color bool) string {
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader(header)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetHeaderLine(true)
+	table.SetBorder(false)
+	table.SetAutoFormatHeaders(false)
+	table.SetAutoWrapText(false)
+	table.SetRowSeparator("―")
+	table.SetRowLine(false)
+	table.SetColumnSeparator("")
+	table.SetCenterSeparator("")
+
+	if color {
+		headerColors := []tablewriter.Colors{}
+		columnColors := []tablewriter.Colors{tablewriter.Color(tablewriter.FgYellowColor)}
+		for i := range header {
+			headerColors = append(headerColors, tablewriter.Color(tablewriter.FgBlueColor))
+			if i > 0 {
+				columnColors = append(columnColors, tablewriter.Color(0))
+			}
+		}
+
+		table.SetHeaderColor(headerColors...)
+		table.SetColumnColor(columnColors...)
+	}
+
+	table.AppendBulk(data)
+
+	table.Render()
+	return tableString.String()
+}
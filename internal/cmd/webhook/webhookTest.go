@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdWebhookTest = &cobra.Command{
+	Use:   "test <id>",
+	Short: "Send a test delivery to a webhook",
+	Long: `Send a test delivery to a webhook.
+
+A "ping" event is posted to the webhook right away, signed like any other delivery, and the outcome is printed. Test
+deliveries are added to the webhook's delivery log but are never retried.`,
+	Example: `$ basecoat webhook test 8cRk2Lp`,
+	RunE:    webhookTest,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	CmdWebhook.AddCommand(cmdWebhookTest)
+}
+
+func webhookTest(_ *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Sending test delivery", polyfmt.Pretty)
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	resp, err := client.TestWebhook(ctx, &proto.TestWebhookRequest{Id: id})
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not test webhook: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	delivery := resp.Delivery
+	if delivery.State != proto.WebhookDeliveryState_SUCCEEDED {
+		err = fmt.Errorf("test delivery %d failed: %s", delivery.Id, delivery.Error)
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Test delivery %d succeeded; webhook responded with %d", delivery.Id,
+		delivery.ResponseCode))
+	cl.State.Fmt.Finish()
+	return nil
+}
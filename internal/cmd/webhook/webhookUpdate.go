@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/clintjedwards/basecoat/internal/cmd/cl"
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/clintjedwards/polyfmt/v2"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/metadata"
+)
+
+var cmdWebhookUpdate = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Update a webhook",
+	Long: `Update a webhook.
+
+Events given replace the ones the webhook subscribes to. Deliveries already queued are still made.`,
+	Example: `$ basecoat webhook update 8cRk2Lp --url https://example.com/hooks/new
+$ basecoat webhook update 8cRk2Lp --event job.created --event job.updated
+$ basecoat webhook update 8cRk2Lp --rotate-secret`,
+	RunE: webhookUpdate,
+	Args: cobra.ExactArgs(1),
+}
+
+func init() {
+	cmdWebhookUpdate.Flags().StringP("url", "u", "", "URL deliveries are posted to")
+	cmdWebhookUpdate.Flags().StringSliceP("event", "e", nil,
+		"Event type to deliver; ex. job.updated. Can be given more than once")
+	cmdWebhookUpdate.Flags().StringP("secret", "s", "", "Secret to sign deliveries with")
+	cmdWebhookUpdate.Flags().Bool("rotate-secret", false, "Sign deliveries with a newly generated secret")
+	cmdWebhookUpdate.MarkFlagsMutuallyExclusive("secret", "rotate-secret")
+	CmdWebhook.AddCommand(cmdWebhookUpdate)
+}
+
+func webhookUpdate(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	cl.State.Fmt.Print("Updating webhook", polyfmt.Pretty)
+
+	url, err := cmd.Flags().GetString("url")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	events, err := cmd.Flags().GetStringSlice("event")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	secret, err := cmd.Flags().GetString("secret")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	rotateSecret, err := cmd.Flags().GetBool("rotate-secret")
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	conn, err := cl.State.Connect()
+	if err != nil {
+		cl.State.Fmt.Err(err)
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	client := proto.NewBasecoatClient(conn)
+
+	md := metadata.Pairs("Authorization", "Bearer "+cl.State.Config.Token)
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+
+	updateWebhookRequest := &proto.UpdateWebhookRequest{
+		Id:     id,
+		Events: events,
+	}
+
+	if cmd.Flags().Changed("url") {
+		updateWebhookRequest.Url = &url
+	}
+
+	if cmd.Flags().Changed("secret") {
+		updateWebhookRequest.Secret = &secret
+	}
+
+	if rotateSecret {
+		secret, err = newSecret()
+		if err != nil {
+			cl.State.Fmt.Err(fmt.Sprintf("could not generate secret: %v", err))
+			cl.State.Fmt.Finish()
+			return err
+		}
+		updateWebhookRequest.Secret = &secret
+	}
+
+	_, err = client.UpdateWebhook(ctx, updateWebhookRequest)
+	if err != nil {
+		cl.State.Fmt.Err(fmt.Sprintf("could not update webhook: %v", err))
+		cl.State.Fmt.Finish()
+		return err
+	}
+
+	cl.State.Fmt.Success(fmt.Sprintf("Updated webhook: %q", id))
+	if rotateSecret {
+		cl.State.Fmt.Println(fmt.Sprintf("Secret: %s\nThis is the only time the secret is shown.", secret))
+	}
+	cl.State.Fmt.Finish()
+	return nil
+}
+
+// newSecret returns a random secret the same way the server generates one for webhooks created without a secret.
+func newSecret() (string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
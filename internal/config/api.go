@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"sort"
 	"strings"
@@ -25,10 +26,14 @@ type API struct {
 	SearchIndexRebuildTime int64  `koanf:"search_index_rebuild_time"` // how often the search index rebuilds; in seconds
 	EncryptionKey          string `koanf:"encryption_key"`            // secret key used to encrypt api tokens
 
+	Backup      *Backup      `koanf:"backup"`
 	Frontend    *Frontend    `koanf:"frontend"`
 	Development *Development `koanf:"development"`
+	Idempotency *Idempotency `koanf:"idempotency"`
 	Metrics     *Metrics     `koanf:"metrics"`
 	Server      *Server      `koanf:"server"`
+	Trash       *Trash       `koanf:"trash"`
+	Webhooks    *Webhooks    `koanf:"webhooks"`
 }
 
 func DefaultAPIConfig() *API {
@@ -39,10 +44,14 @@ func DefaultAPIConfig() *API {
 		SearchIndexRebuildTime: 600,
 		EncryptionKey:          "testtoken",
 
+		Backup:      DefaultBackupConfig(),
 		Development: DefaultDevelopmentConfig(),
 		Frontend:    DefaultFrontendConfig(),
+		Idempotency: DefaultIdempotencyConfig(),
 		Metrics:     DefaultMetricsConfig(),
 		Server:      DefaultServerConfig(),
+		Trash:       DefaultTrashConfig(),
+		Webhooks:    DefaultWebhooksConfig(),
 	}
 }
 
@@ -76,15 +85,34 @@ type Server struct {
 	// URL for the server to bind to. Ex: localhost:8080
 	Host string `koanf:"host"`
 
+	// URL users reach Basecoat at; used for links printed on can labels. Ex: https://basecoat.example.com
+	// Defaults to https://<host>.
+	ExternalURL string `koanf:"external_url"`
+
+	// Whether the pages codes printed on labels lead to can be viewed without logging in. Codes can't be guessed so
+	// anyone able to open the page is holding the label; turn off to require an API token.
+	PublicCodes bool `koanf:"public_codes"`
+
 	// How long the GRPC service should wait on in-progress connections before hard closing everything out.
 	ShutdownTimeout time.Duration `koanf:"shutdown_timeout"`
 
-	// Path to Basecoat's sqlite database.
+	// Database engine Basecoat stores its data in; either "sqlite" or "postgres". Sqlite keeps everything in a single
+	// local file while postgres allows several Basecoat services to share one database server.
+	StorageEngine string `koanf:"storage_engine"`
+
+	// Path to Basecoat's sqlite database. Only used by the sqlite engine.
 	StoragePath string `koanf:"storage_path"`
 
+	// Connection string for Basecoat's postgres database. Ex: postgres://basecoat@db.example.com/basecoat
+	// Only used by the postgres engine; requires postgres 15 or later.
+	StorageDSN string `koanf:"storage_dsn"`
+
 	// The total amount of results the database will attempt to pass back when a limit is not explicitly given.
 	StorageResultsLimit int `koanf:"storage_results_limit"`
 
+	// The most items a single batch create, update or delete call may contain.
+	BatchSizeLimit int `koanf:"batch_size_limit"`
+
 	TLSCertPath string `koanf:"tls_cert_path"`
 	TLSKeyPath  string `koanf:"tls_key_path"`
 }
@@ -94,9 +122,128 @@ type Server struct {
 func DefaultServerConfig() *Server {
 	return &Server{
 		Host:                "localhost:8080",
+		PublicCodes:         true,
 		ShutdownTimeout:     mustParseDuration("15s"),
+		StorageEngine:       "sqlite",
 		StoragePath:         "/tmp/basecoat.db",
 		StorageResultsLimit: 200,
+		BatchSizeLimit:      1000,
+	}
+}
+
+// Backup represents settings for scheduled online backups of Basecoat's database.
+type Backup struct {
+	Enable bool `koanf:"enable"`
+
+	// Directory that backups are written to and restored from.
+	Path string `koanf:"path"`
+
+	// How often a new backup is taken.
+	Interval time.Duration `koanf:"interval"`
+
+	// The number of most recent backups to keep; older backups are removed after each new backup.
+	// Set to 0 to keep all backups regardless of count.
+	RetentionCount int `koanf:"retention_count"`
+
+	// Backups older than this are removed after each new backup. Set to 0 to disable age based removal. Shipped
+	// write-ahead log is kept for as long.
+	RetentionPeriod time.Duration `koanf:"retention_period"`
+
+	// Directory the database's write-ahead log is shipped to so that it can be restored to any point in time rather
+	// than only to when a backup was taken. Leave empty to turn off shipping.
+	WALPath string `koanf:"wal_path"`
+
+	// How often writes are shipped to the write-ahead log directory; the database can be restored to points in time
+	// this far apart.
+	WALInterval time.Duration `koanf:"wal_interval"`
+}
+
+// DefaultBackupConfig returns a pre-populated configuration struct that is used as the base for super imposing user
+// configuration settings.
+func DefaultBackupConfig() *Backup {
+	return &Backup{
+		Enable:          false,
+		Path:            "/var/lib/basecoat/backups",
+		Interval:        mustParseDuration("1h"),
+		RetentionCount:  48,
+		RetentionPeriod: mustParseDuration("720h"),
+		WALPath:         "/var/lib/basecoat/wal",
+		WALInterval:     mustParseDuration("10s"),
+	}
+}
+
+// Trash represents settings for entities which have been deleted but not yet permanently removed.
+type Trash struct {
+	// How long a deleted entity can be restored for before it is permanently removed.
+	// Set to 0 to keep deleted entities forever.
+	Retention time.Duration `koanf:"retention"`
+
+	// How often the trash is checked for entities which have outlived the retention period.
+	PurgeInterval time.Duration `koanf:"purge_interval"`
+}
+
+// DefaultTrashConfig returns a pre-populated configuration struct that is used as the base for super imposing user
+// configuration settings.
+func DefaultTrashConfig() *Trash {
+	return &Trash{
+		Retention:     mustParseDuration("720h"),
+		PurgeInterval: mustParseDuration("1h"),
+	}
+}
+
+// Idempotency represents settings for the idempotency keys create and associate calls can be made with.
+type Idempotency struct {
+	// How long the response to a call made with an idempotency key is kept; a call retried with the same key within
+	// this window is answered with it instead of being made again. Set to 0 to ignore idempotency keys.
+	Window time.Duration `koanf:"window"`
+
+	// How often keys which have outlived the window are removed.
+	PurgeInterval time.Duration `koanf:"purge_interval"`
+}
+
+// DefaultIdempotencyConfig returns a pre-populated configuration struct that is used as the base for super imposing
+// user configuration settings.
+func DefaultIdempotencyConfig() *Idempotency {
+	return &Idempotency{
+		Window:        mustParseDuration("24h"),
+		PurgeInterval: mustParseDuration("1h"),
+	}
+}
+
+// Webhooks represents settings for posting account events to webhooks.
+type Webhooks struct {
+	// How long to wait for a webhook to answer a single delivery.
+	Timeout time.Duration `koanf:"timeout"`
+
+	// How many times a delivery is attempted before giving up on it.
+	MaxAttempts int `koanf:"max_attempts"`
+
+	// How long to wait before retrying a delivery which failed; doubled after every attempt up to MaxBackoff.
+	Backoff    time.Duration `koanf:"backoff"`
+	MaxBackoff time.Duration `koanf:"max_backoff"`
+
+	// How often to check for deliveries which are due.
+	PollInterval time.Duration `koanf:"poll_interval"`
+
+	// How long deliveries are kept in a webhook's delivery log once they succeed or are given up on. Set to 0 to keep
+	// them forever.
+	Retention time.Duration `koanf:"retention"`
+
+	// How often deliveries which have outlived the retention period are removed.
+	PurgeInterval time.Duration `koanf:"purge_interval"`
+}
+
+// DefaultWebhooksConfig returns a pre-populated configuration struct that is used as the base for super imposing
+// user configuration settings.
+func DefaultWebhooksConfig() *Webhooks {
+	return &Webhooks{
+		Timeout:       mustParseDuration("10s"),
+		MaxAttempts:   8,
+		Backoff:       mustParseDuration("30s"),
+		MaxBackoff:    mustParseDuration("1h"),
+		PollInterval:  mustParseDuration("2s"),
+		Retention:     mustParseDuration("720h"),
+		PurgeInterval: mustParseDuration("1h"),
 	}
 }
 
@@ -181,15 +328,63 @@ func InitAPIConfig(userDefinedPath string, loadDefaults bool, devMode bool) (*AP
 		return nil, err
 	}
 
+	err = config.validate()
+	if err != nil {
+		return nil, err
+	}
+
 	return config, nil
 }
 
+// validate checks the settings which would otherwise only fail once the service is running. The intervals background
+// chores run on must be positive; a zero interval would have them run in a busy loop.
+func (c *API) validate() error {
+	intervals := map[string]time.Duration{}
+
+	if c.Backup != nil && c.Backup.Enable {
+		intervals["backup.interval"] = c.Backup.Interval
+		if c.Backup.WALPath != "" {
+			intervals["backup.wal_interval"] = c.Backup.WALInterval
+		}
+	}
+	if c.Trash != nil && c.Trash.Retention > 0 {
+		intervals["trash.purge_interval"] = c.Trash.PurgeInterval
+	}
+	if c.Idempotency != nil && c.Idempotency.Window > 0 {
+		intervals["idempotency.purge_interval"] = c.Idempotency.PurgeInterval
+	}
+	if c.Webhooks != nil {
+		intervals["webhooks.poll_interval"] = c.Webhooks.PollInterval
+		if c.Webhooks.Retention > 0 {
+			intervals["webhooks.purge_interval"] = c.Webhooks.PurgeInterval
+		}
+	}
+
+	names := []string{}
+	for name, interval := range intervals {
+		if interval <= 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	if len(names) > 0 {
+		return fmt.Errorf("%s must be greater than 0", strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
 func GetAPIEnvVars() []string {
 	api := API{
+		Backup:      &Backup{},
 		Frontend:    &Frontend{},
 		Metrics:     &Metrics{},
 		Server:      &Server{},
 		Development: &Development{},
+		Idempotency: &Idempotency{},
+		Trash:       &Trash{},
+		Webhooks:    &Webhooks{},
 	}
 	fields := structs.Fields(api)
 
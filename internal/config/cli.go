@@ -20,6 +20,17 @@ type CLI struct {
 	Host      string `koanf:"host"`
 	NoColor   bool   `koanf:"no_color"`
 	Token     string `koanf:"token"`
+
+	// Profile is the saved login to use; see Credentials. If empty the profile last logged in to or picked with
+	// `basecoat profile use` is used.
+	Profile string `koanf:"profile"`
+
+	// CacheSyncInterval is the least number of seconds between refreshes of the offline cache; see cache.Cache. The
+	// cache is refreshed on every successful connection if zero.
+	CacheSyncInterval int64 `koanf:"cache_sync_interval"`
+
+	// DisableCache stops formulas, bases and colorants from being copied to the offline cache.
+	DisableCache bool `koanf:"disable_cache"`
 }
 
 // DefaultCLIConfig returns a pre-populated configuration struct that is used as the base for super imposing user configuration
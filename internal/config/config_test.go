@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/fatih/structs"
 )
@@ -10,11 +11,39 @@ import (
 // pointers with zero values.
 func TestGetEnvvarsFromStruct(t *testing.T) {
 	api := API{
+		Backup:      &Backup{},
 		Frontend:    &Frontend{},
 		Development: &Development{},
+		Idempotency: &Idempotency{},
 		Metrics:     &Metrics{},
 		Server:      &Server{},
+		Trash:       &Trash{},
+		Webhooks:    &Webhooks{},
 	}
 	fields := structs.Fields(api)
 	getEnvVarsFromStruct("BASECOAT_", fields)
 }
+
+func TestValidateIntervals(t *testing.T) {
+	config := DefaultAPIConfig()
+	err := config.validate()
+	if err != nil {
+		t.Fatalf("expected default config to be valid; found %v", err)
+	}
+
+	config.Trash.PurgeInterval = 0
+	config.Webhooks.PollInterval = -time.Second
+	err = config.validate()
+	if err == nil || err.Error() != "trash.purge_interval, webhooks.poll_interval must be greater than 0" {
+		t.Errorf("unexpected error for zero intervals: %v", err)
+	}
+
+	// Intervals of chores which are turned off don't matter.
+	config = DefaultAPIConfig()
+	config.Trash.Retention = 0
+	config.Trash.PurgeInterval = 0
+	err = config.validate()
+	if err != nil {
+		t.Errorf("expected interval of disabled trash purge to be ignored; found %v", err)
+	}
+}
@@ -0,0 +1,336 @@
+package config
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// credentialsMagic starts every credentials file so that other files aren't mistaken for one.
+var credentialsMagic = []byte("BCRED1")
+
+const (
+	credentialsSaltSize = 16
+	credentialsKeySize  = 32
+
+	// DefaultProfile is the name of the profile used when none is given.
+	DefaultProfile = "default"
+)
+
+// Profile is a single saved login; the server to talk to and the account and token to use with it.
+type Profile struct {
+	Host    string `json:"host"`
+	Account string `json:"account"`
+	Token   string `json:"token"`
+
+	// Password is kept so that an expired token can be replaced without asking the user again; empty unless the user
+	// chose to save it. Passwords are only saved in credentials encrypted with a passphrase; see Credentials.
+	Password string `json:"password,omitempty"`
+
+	// Duration is how long tokens requested for the profile are valid for in seconds.
+	Duration int64 `json:"duration"`
+}
+
+// Expiry returns when the profile's token expires. The token's signature is not checked; that's up to the server. ok
+// is false if the token can't be read or never expires.
+func (p *Profile) Expiry() (expiry time.Time, ok bool) {
+	claims, ok := readTokenClaims(p.Token)
+	if !ok || claims.Expiry == 0 {
+		return time.Time{}, false
+	}
+
+	return time.Unix(claims.Expiry, 0), true
+}
+
+// Expired returns true if the profile has no token or its token expires before the time given.
+func (p *Profile) Expired(now time.Time) bool {
+	if p.Token == "" {
+		return true
+	}
+
+	expiry, ok := p.Expiry()
+	if !ok {
+		return false
+	}
+
+	return now.After(expiry)
+}
+
+// tokenClaims are the parts of an API token the command line reads.
+type tokenClaims struct {
+	Account string `json:"account"`
+	Expiry  int64  `json:"expiry"`
+}
+
+// readTokenClaims reads the claims of an API token without checking its signature. ok is false if the token isn't a
+// JWT.
+func readTokenClaims(token string) (claims tokenClaims, ok bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return tokenClaims{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return tokenClaims{}, false
+	}
+
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return tokenClaims{}, false
+	}
+
+	return claims, true
+}
+
+// TokenAccount returns the account an API token was issued for; empty if the token can't be read, as with admin
+// tokens.
+func TokenAccount(token string) string {
+	claims, _ := readTokenClaims(token)
+	return claims.Account
+}
+
+// Credentials are the profiles saved by `basecoat login`.
+//
+// They're kept in a file encrypted with AES-GCM. The key is derived from the passphrase in the
+// BASECOAT_CLI_CREDENTIALS_KEY environment variable if it's set and otherwise read from a key file kept next to the
+// credentials file, which is generated the first time credentials are saved. A key file only protects the credentials
+// when the two files are kept apart, such as when the credentials file is synced between machines or backed up on its
+// own; setting a passphrase protects them from anyone who can read the home directory.
+type Credentials struct {
+	// Current is the name of the profile used when none is given.
+	Current  string              `json:"current"`
+	Profiles map[string]*Profile `json:"profiles"`
+
+	path string
+}
+
+// CredentialsPath returns where credentials are kept; the BASECOAT_CLI_CREDENTIALS_PATH environment variable if set,
+// otherwise inside the user's config directory.
+func CredentialsPath() string {
+	if path := os.Getenv("BASECOAT_CLI_CREDENTIALS_PATH"); path != "" {
+		return path
+	}
+
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".config", "basecoat", "credentials")
+}
+
+// LoadCredentials reads the credentials kept at the path given. No profiles are returned if the file doesn't exist
+// yet.
+func LoadCredentials(path string) (*Credentials, error) {
+	credentials := &Credentials{
+		Profiles: map[string]*Profile{},
+		path:     path,
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return credentials, nil
+		}
+
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(contents, credentialsMagic) ||
+		len(contents) < len(credentialsMagic)+credentialsSaltSize {
+		return nil, fmt.Errorf("%s is not a credentials file", path)
+	}
+
+	contents = contents[len(credentialsMagic):]
+	salt, sealed := contents[:credentialsSaltSize], contents[credentialsSaltSize:]
+
+	gcm, err := credentialsCipher(path, salt, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("%s is not a credentials file", path)
+	}
+
+	plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], credentialsMagic)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt %s; was BASECOAT_CLI_CREDENTIALS_KEY or its key file changed?", path)
+	}
+
+	err = json.Unmarshal(plaintext, credentials)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", path, err)
+	}
+
+	if credentials.Profiles == nil {
+		credentials.Profiles = map[string]*Profile{}
+	}
+
+	return credentials, nil
+}
+
+// Save encrypts the credentials and writes them back to the file they were read from. Only the user can read the
+// file. Passwords are left out unless the credentials are encrypted with a passphrase.
+func (c *Credentials) Save() error {
+	err := os.MkdirAll(filepath.Dir(c.path), 0o700)
+	if err != nil {
+		return err
+	}
+
+	if !PassphraseSet() {
+		for _, profile := range c.Profiles {
+			profile.Password = ""
+		}
+	}
+
+	plaintext, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	salt := make([]byte, credentialsSaltSize)
+	_, err = rand.Read(salt)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := credentialsCipher(c.path, salt, true)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return err
+	}
+
+	contents := append([]byte{}, credentialsMagic...)
+	contents = append(contents, salt...)
+	contents = append(contents, nonce...)
+	contents = gcm.Seal(contents, nonce, plaintext, credentialsMagic)
+
+	// The file is written next to the old one and moved over it so that an interrupted save can't lose every profile.
+	tmp := c.path + ".tmp"
+	err = os.WriteFile(tmp, contents, 0o600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, c.path)
+}
+
+// Profile returns the profile with the name given, or the current profile if name is empty. ok is false if there is
+// no such profile.
+func (c *Credentials) Profile(name string) (profile *Profile, resolved string, ok bool) {
+	if name == "" {
+		name = c.Current
+	}
+
+	profile, ok = c.Profiles[name]
+	return profile, name, ok
+}
+
+// Names returns the names of all profiles in alphabetical order.
+func (c *Credentials) Names() []string {
+	names := []string{}
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// credentialsCipher returns the cipher credentials are encrypted with. The key file is only created if create is true.
+func credentialsCipher(path string, salt []byte, create bool) (cipher.AEAD, error) {
+	key, err := secretKey(path+".key", salt, create)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// PassphraseSet returns true if local secrets are encrypted with the passphrase in the BASECOAT_CLI_CREDENTIALS_KEY
+// environment variable rather than with a key file.
+func PassphraseSet() bool {
+	return os.Getenv("BASECOAT_CLI_CREDENTIALS_KEY") != ""
+}
+
+// secretKey returns the key local secrets are encrypted with; derived from the passphrase in the
+// BASECOAT_CLI_CREDENTIALS_KEY environment variable if it's set and otherwise read from the key file given.
+func secretKey(keyPath string, salt []byte, create bool) ([]byte, error) {
+	if passphrase := os.Getenv("BASECOAT_CLI_CREDENTIALS_KEY"); passphrase != "" {
+		return scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, credentialsKeySize)
+	}
+
+	return credentialsKeyFile(keyPath, create)
+}
+
+// CacheKey returns the key the offline cache is encrypted with. It's the same key saved credentials are encrypted
+// with, so setting BASECOAT_CLI_CREDENTIALS_KEY protects both; the key file is created if there isn't one yet.
+func CacheKey(salt []byte) ([]byte, error) {
+	return secretKey(CredentialsPath()+".key", salt, true)
+}
+
+// CachePath returns where the offline cache is kept; the BASECOAT_CLI_CACHE_PATH environment variable if set,
+// otherwise inside the user's config directory.
+func CachePath() string {
+	if path := os.Getenv("BASECOAT_CLI_CACHE_PATH"); path != "" {
+		return path
+	}
+
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".config", "basecoat", "cache.db")
+}
+
+// credentialsKeyFile reads the key kept at the path given, generating a new one first if create is true and there
+// isn't one yet.
+func credentialsKeyFile(path string, create bool) ([]byte, error) {
+	key, err := os.ReadFile(path)
+	if err == nil {
+		if len(key) != credentialsKeySize {
+			return nil, fmt.Errorf("key file %s is corrupt; expected %d bytes", path, credentialsKeySize)
+		}
+
+		return key, nil
+	}
+
+	if !errors.Is(err, os.ErrNotExist) || !create {
+		return nil, fmt.Errorf("could not read key file %s: %w", path, err)
+	}
+
+	key = make([]byte, credentialsKeySize)
+	_, err = rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(path, key, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
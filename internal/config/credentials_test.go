@@ -0,0 +1,170 @@
+package config
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// testToken returns an unsigned token carrying the expiry given; the CLI never checks signatures.
+func testToken(expiry int64) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"account":"shop","expiry":%d}`, expiry)))
+	return "eyJhbGciOiJIUzI1NiJ9." + payload + ".c2ln"
+}
+
+func testCredentials(t *testing.T) *Credentials {
+	t.Helper()
+
+	credentials, err := LoadCredentials(filepath.Join(t.TempDir(), "credentials"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	credentials.Current = "shop"
+	credentials.Profiles["shop"] = &Profile{
+		Host:     "basecoat.example.com:443",
+		Account:  "shop",
+		Token:    testToken(1700000000),
+		Password: "hunter22",
+		Duration: 86400,
+	}
+
+	return credentials
+}
+
+func TestCredentialsRoundTrip(t *testing.T) {
+	t.Setenv("BASECOAT_CLI_CREDENTIALS_KEY", "")
+
+	want := testCredentials(t)
+	err := want.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := LoadCredentials(want.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Passwords are only saved with a passphrase; a key file kept next to them wouldn't protect them.
+	if want.Profiles["shop"].Password != "" {
+		t.Errorf("expected password to be dropped without a passphrase")
+	}
+
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(Credentials{})); diff != "" {
+		t.Errorf("unexpected credentials (-want +got):\n%s", diff)
+	}
+
+	// Nothing saved should be readable without the key.
+	contents, err := os.ReadFile(want.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"hunter22", "basecoat.example.com", want.Profiles["shop"].Token} {
+		if bytes.Contains(contents, []byte(secret)) {
+			t.Errorf("found %q in the credentials file", secret)
+		}
+	}
+
+	for _, path := range []string{want.path, want.path + ".key"} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if info.Mode().Perm() != 0o600 {
+			t.Errorf("expected %s to be readable only by its owner; found %v", path, info.Mode().Perm())
+		}
+	}
+}
+
+func TestCredentialsPassphrase(t *testing.T) {
+	t.Setenv("BASECOAT_CLI_CREDENTIALS_KEY", "correct horse")
+
+	credentials := testCredentials(t)
+	err := credentials.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// No key file is needed when a passphrase is given.
+	if _, err := os.Stat(credentials.path + ".key"); !os.IsNotExist(err) {
+		t.Errorf("expected no key file to be created; %v", err)
+	}
+
+	loaded, err := LoadCredentials(credentials.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.Profiles["shop"].Password != "hunter22" {
+		t.Errorf("expected password to be saved with a passphrase; found %q", loaded.Profiles["shop"].Password)
+	}
+
+	t.Setenv("BASECOAT_CLI_CREDENTIALS_KEY", "battery staple")
+
+	_, err = LoadCredentials(credentials.path)
+	if err == nil {
+		t.Error("expected credentials not to load with the wrong passphrase")
+	}
+}
+
+func TestLoadCredentialsMissing(t *testing.T) {
+	credentials, err := LoadCredentials(filepath.Join(t.TempDir(), "credentials"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(credentials.Profiles) != 0 {
+		t.Errorf("expected no profiles; found %d", len(credentials.Profiles))
+	}
+
+	if _, _, ok := credentials.Profile(""); ok {
+		t.Error("expected no current profile")
+	}
+}
+
+func TestLoadCredentialsNotCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(path, []byte(`host = "localhost:8080"`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = LoadCredentials(path)
+	if err == nil {
+		t.Error("expected a file which isn't a credentials file to be rejected")
+	}
+}
+
+func TestProfileExpired(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	tests := map[string]struct {
+		token   string
+		expired bool
+	}{
+		"valid":         {testToken(now.Unix() + 60), false},
+		"expired":       {testToken(now.Unix() - 60), true},
+		"never expires": {testToken(0), false},
+		"no token":      {"", true},
+		"not a jwt":     {"abc", false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			profile := Profile{Token: tc.token}
+			if expired := profile.Expired(now); expired != tc.expired {
+				t.Errorf("expected expired to be %t; found %t", tc.expired, expired)
+			}
+		})
+	}
+}
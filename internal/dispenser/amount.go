@@ -0,0 +1,211 @@
+package dispenser
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// unitsPerOunce is the resolution amounts are kept at. Dispensers in the US measure colorant in fluid ounces plus a
+// fraction of an ounce; 384 is divisible by every fraction in common use (32nds, 48ths, 64ths, 96ths and 128ths) so
+// converting between them is exact.
+const unitsPerOunce = 384
+
+// unitsPerY is the number of units in a "Y"; one 48th of a fluid ounce.
+const unitsPerY = unitsPerOunce / 48
+
+// Amount is a quantity of colorant in 1/384ths of a fluid ounce.
+type Amount int64
+
+// Ounces returns the amount in fluid ounces.
+func (a Amount) Ounces() float64 {
+	return float64(a) / unitsPerOunce
+}
+
+// AmountFromOunces converts fluid ounces into an amount, rounding to the nearest unit.
+func AmountFromOunces(ounces float64) Amount {
+	return Amount(math.Round(ounces * unitsPerOunce))
+}
+
+// String returns the amount in the form counter staff read off a formula label; whole ounces and 48ths of an ounce,
+// ex. "1oz 12/48". Amounts which are not a whole number of 48ths are given in decimal ounces instead.
+func (a Amount) String() string {
+	if a%unitsPerY != 0 {
+		return strconv.FormatFloat(a.Ounces(), 'f', -1, 64) + "oz"
+	}
+
+	ounces := a / unitsPerOunce
+	ys := (a % unitsPerOunce) / unitsPerY
+
+	switch {
+	case ys == 0:
+		return fmt.Sprintf("%doz", ounces)
+	case ounces == 0:
+		return fmt.Sprintf("%d/48", ys)
+	default:
+		return fmt.Sprintf("%doz %d/48", ounces, ys)
+	}
+}
+
+// Y returns the amount in the Y notation dispensers use; whole ounces and 48ths of an ounce separated by a "Y", ex.
+// "1Y12". Amounts which are not a whole number of 48ths have a fractional count of 48ths, ex. "0Y2.5".
+func (a Amount) Y() string {
+	ys := strconv.FormatFloat(float64(a%unitsPerOunce)/unitsPerY, 'f', -1, 64)
+	return fmt.Sprintf("%dY%s", a/unitsPerOunce, ys)
+}
+
+// ParseAmount reads an amount written in any of the forms used by dispensers and formula books:
+//
+//	1oz 12/48  whole ounces and a fraction of an ounce; any denominator dividing 384 is accepted
+//	12/48      a fraction of an ounce on its own
+//	1Y12       whole ounces and 48ths, written with a "Y" in between
+//	12Y        48ths of an ounce
+//	1.25oz     decimal ounces; the unit may be left off
+//
+// The unit may be separated from the ounces by a space, ex. "1.5 oz" or "2 oz 12/48".
+func ParseAmount(s string) (Amount, error) {
+	value := strings.ToLower(strings.Join(strings.Fields(s), " "))
+	if value == "" {
+		return 0, fmt.Errorf("amount is empty")
+	}
+	value = strings.ReplaceAll(value, " oz", "oz")
+
+	if ounces, ys, found := strings.Cut(value, "y"); found {
+		return parseYAmount(s, ounces, ys)
+	}
+
+	var total Amount
+	for _, part := range strings.Fields(value) {
+		if numerator, denominator, found := strings.Cut(part, "/"); found {
+			fraction, err := parseFraction(numerator, denominator)
+			if err != nil {
+				return 0, fmt.Errorf("could not parse amount %q: %w", s, err)
+			}
+			total += fraction
+			continue
+		}
+
+		ounces, err := strconv.ParseFloat(strings.TrimSuffix(part, "oz"), 64)
+		if err != nil || ounces < 0 {
+			return 0, fmt.Errorf("could not parse amount %q; expected ounces such as 1.5oz or 1oz 12/48", s)
+		}
+		total += AmountFromOunces(ounces)
+	}
+
+	return total, nil
+}
+
+func parseYAmount(original, ounces, ys string) (Amount, error) {
+	var total Amount
+
+	ounces = strings.TrimSpace(ounces)
+	if ounces != "" {
+		whole, err := strconv.ParseUint(ounces, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse amount %q; expected whole ounces before the Y", original)
+		}
+		total += Amount(whole) * unitsPerOunce
+	}
+
+	ys = strings.TrimSpace(ys)
+	if ys != "" {
+		count, err := strconv.ParseFloat(ys, 64)
+		if err != nil || count < 0 {
+			return 0, fmt.Errorf("could not parse amount %q; expected 48ths after the Y", original)
+		}
+		total += Amount(math.Round(count * unitsPerY))
+	}
+
+	// "12Y" is 12 48ths rather than 12 ounces; the number before a trailing Y is the count of Ys.
+	if ys == "" && ounces != "" {
+		total = total / unitsPerOunce * unitsPerY
+	}
+
+	return total, nil
+}
+
+func parseFraction(numerator, denominator string) (Amount, error) {
+	top, err := strconv.ParseFloat(numerator, 64)
+	if err != nil || top < 0 {
+		return 0, fmt.Errorf("invalid numerator %q", numerator)
+	}
+
+	bottom, err := strconv.ParseUint(denominator, 10, 32)
+	if err != nil || bottom == 0 || unitsPerOunce%bottom != 0 {
+		return 0, fmt.Errorf("invalid denominator %q; must divide %d", denominator, unitsPerOunce)
+	}
+
+	return Amount(math.Round(top * float64(unitsPerOunce/bottom))), nil
+}
+
+// Scale returns the amount needed to tint a container of a different size. Amounts are rounded to the nearest unit.
+func (a Amount) Scale(from, to Container) Amount {
+	return Amount(math.Round(float64(a) * to.Ounces() / from.Ounces()))
+}
+
+// Container is the size of a can of paint in fluid ounces.
+type Container float64
+
+// Gallon is the container size formula amounts are stored for.
+const Gallon Container = 128
+
+var containerUnits = map[string]float64{
+	"gal":    128,
+	"gallon": 128,
+	"qt":     32,
+	"quart":  32,
+	"pt":     16,
+	"pint":   16,
+	"oz":     1,
+	"l":      33.814,
+	"liter":  33.814,
+	"litre":  33.814,
+}
+
+// Ounces returns the size of the container in fluid ounces.
+func (c Container) Ounces() float64 {
+	return float64(c)
+}
+
+// String returns the container size in the largest unit which divides it evenly, ex. "1 gal" or "2 qt".
+func (c Container) String() string {
+	for _, unit := range []string{"gal", "qt", "pt"} {
+		quantity := c.Ounces() / containerUnits[unit]
+		if quantity == math.Trunc(quantity) {
+			return strconv.FormatFloat(quantity, 'f', -1, 64) + " " + unit
+		}
+	}
+
+	return strconv.FormatFloat(c.Ounces(), 'f', -1, 64) + " oz"
+}
+
+// ParseContainer reads a container size such as "1 gal", "qt", "5gal" or "3.78L". A missing quantity means one of
+// the unit.
+func ParseContainer(s string) (Container, error) {
+	value := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), " ", ""))
+
+	split := strings.IndexFunc(value, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if split < 0 {
+		return 0, fmt.Errorf("could not parse container size %q; unit missing", s)
+	}
+
+	quantity := 1.0
+	if split > 0 {
+		var err error
+		quantity, err = strconv.ParseFloat(value[:split], 64)
+		if err != nil || quantity <= 0 {
+			return 0, fmt.Errorf("could not parse container size %q", s)
+		}
+	}
+
+	unit := strings.TrimSuffix(value[split:], "s")
+	ounces, known := containerUnits[unit]
+	if !known {
+		return 0, fmt.Errorf("could not parse container size %q; unknown unit %q", s, value[split:])
+	}
+
+	return Container(quantity * ounces), nil
+}
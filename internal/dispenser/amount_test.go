@@ -0,0 +1,150 @@
+package dispenser
+
+import (
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  Amount
+	}{
+		"y with ounces":            {input: "1Y12", want: 384 + 12*8},
+		"y alone":                  {input: "12Y", want: 12 * 8},
+		"zero ounces with y":       {input: "0Y12", want: 12 * 8},
+		"ounces and fraction":      {input: "1oz 12/48", want: 384 + 12*8},
+		"fraction alone":           {input: "3/96", want: 12},
+		"decimal ounces":           {input: "1.25oz", want: 480},
+		"bare decimal":             {input: "0.5", want: 192},
+		"fractional ys":            {input: "0Y0.5", want: 4},
+		"spaced unit":              {input: "1.5 oz", want: 576},
+		"spaced unit and fraction": {input: "2 oz 12/48", want: 2*384 + 12*8},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseAmount(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tc.want {
+				t.Errorf("ParseAmount(%q) = %d; want %d", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseAmountInvalid(t *testing.T) {
+	for _, input := range []string{"", "lots", "1/7", "-1oz", "1.5Y2", "oz", "1 oz oz"} {
+		_, err := ParseAmount(input)
+		if err == nil {
+			t.Errorf("ParseAmount(%q) expected an error", input)
+		}
+	}
+}
+
+func TestAmountString(t *testing.T) {
+	tests := map[Amount]string{
+		0:            "0oz",
+		384:          "1oz",
+		12 * 8:       "12/48",
+		384 + 12*8:   "1oz 12/48",
+		4:            "0.010416666666666666oz",
+		2*384 + 47*8: "2oz 47/48",
+	}
+
+	for amount, want := range tests {
+		if got := amount.String(); got != want {
+			t.Errorf("Amount(%d).String() = %q; want %q", amount, got, want)
+		}
+
+		// Everything written out must be readable again.
+		parsed, err := ParseAmount(amount.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed != amount {
+			t.Errorf("ParseAmount(%q) = %d; want %d", amount.String(), parsed, amount)
+		}
+	}
+}
+
+func TestAmountY(t *testing.T) {
+	tests := map[Amount]string{
+		0:          "0Y0",
+		12 * 8:     "0Y12",
+		384 + 3*8:  "1Y3",
+		4:          "0Y0.5",
+		3*384 + 20: "3Y2.5",
+	}
+
+	for amount, want := range tests {
+		if got := amount.Y(); got != want {
+			t.Errorf("Amount(%d).Y() = %q; want %q", amount, got, want)
+		}
+
+		parsed, err := ParseAmount(amount.Y())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed != amount {
+			t.Errorf("ParseAmount(%q) = %d; want %d", amount.Y(), parsed, amount)
+		}
+	}
+}
+
+func TestParseContainer(t *testing.T) {
+	tests := map[string]Container{
+		"1 gal":  128,
+		"5gal":   640,
+		"qt":     32,
+		"2 Qts":  64,
+		"1 pint": 16,
+	}
+
+	for input, want := range tests {
+		got, err := ParseContainer(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != want {
+			t.Errorf("ParseContainer(%q) = %v; want %v", input, got, want)
+		}
+	}
+
+	_, err := ParseContainer("1 bucket")
+	if err == nil {
+		t.Errorf("expected unknown unit to fail")
+	}
+}
+
+func TestContainerString(t *testing.T) {
+	tests := map[Container]string{
+		128: "1 gal",
+		640: "5 gal",
+		32:  "1 qt",
+		96:  "3 qt",
+		16:  "1 pt",
+		8:   "8 oz",
+	}
+
+	for container, want := range tests {
+		if got := container.String(); got != want {
+			t.Errorf("Container(%v).String() = %q; want %q", float64(container), got, want)
+		}
+	}
+}
+
+func TestScale(t *testing.T) {
+	quart := Amount(12 * 8)
+
+	if got := quart.Scale(32, Gallon); got != 48*8 {
+		t.Errorf("scaling a quart's 12/48 to a gallon = %s; want 1oz", got)
+	}
+
+	if got := quart.Scale(Gallon, 32); got != 3*8 {
+		t.Errorf("scaling a gallon's 12/48 to a quart = %s; want 3/48", got)
+	}
+}
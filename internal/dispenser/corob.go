@@ -0,0 +1,151 @@
+package dispenser
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// corob reads the semicolon separated formula files exported by Corob style dispensers. The first row is a header;
+// the fixed columns are followed by pairs of colorant code and quantity columns, as many as the formula needs:
+//
+//	CODE;NAME;BASE;CAN;NOTES;CNT1;QTY1;CNT2;QTY2;CNT3;QTY3
+//	SW 6204;Sea Salt;Extra White;1 gal;Exterior satin;B;12;L;51;;
+//
+// Quantities are in 48ths of a fluid ounce and may be fractional.
+type corob struct{}
+
+// corobFixedColumns is the number of columns before the first colorant.
+const corobFixedColumns = 5
+
+func (corob) Name() string {
+	return "corob"
+}
+
+func (corob) Description() string {
+	return "semicolon separated formula file exported by Corob style dispensers; quantities in 48ths of an ounce"
+}
+
+func (corob) Extension() string {
+	return "csv"
+}
+
+func (corob) Read(r io.Reader) ([]Recipe, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = ';'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read header row: %w", err)
+	}
+
+	if len(header) < corobFixedColumns || !strings.EqualFold(strings.TrimSpace(header[0]), "CODE") {
+		return nil, fmt.Errorf("header row does not look like a corob export; expected it to start with CODE;NAME;BASE;CAN;NOTES")
+	}
+
+	recipes := []Recipe{}
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+
+		if len(row) < corobFixedColumns {
+			return nil, fmt.Errorf("line %d: expected at least %d columns; found %d", line, corobFixedColumns, len(row))
+		}
+
+		recipe := Recipe{
+			Number: strings.TrimSpace(row[0]),
+			Name:   strings.TrimSpace(row[1]),
+			Base:   strings.TrimSpace(row[2]),
+			Notes:  strings.TrimSpace(row[4]),
+		}
+
+		if strings.TrimSpace(row[3]) != "" {
+			recipe.Container, err = ParseContainer(row[3])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+
+		if (len(row)-corobFixedColumns)%2 != 0 && strings.TrimSpace(row[len(row)-1]) != "" {
+			return nil, fmt.Errorf("line %d: colorant %q has no quantity", line, strings.TrimSpace(row[len(row)-1]))
+		}
+
+		for i := corobFixedColumns; i+1 < len(row); i += 2 {
+			code := strings.TrimSpace(row[i])
+			quantity := strings.TrimSpace(row[i+1])
+			if code == "" && quantity == "" {
+				continue
+			}
+
+			ys, err := strconv.ParseFloat(quantity, 64)
+			if err != nil || ys < 0 {
+				return nil, fmt.Errorf("line %d: could not parse quantity %q for colorant %q", line, quantity, code)
+			}
+
+			recipe.Colorants = append(recipe.Colorants, Dose{Code: code, Amount: AmountFromOunces(ys / 48)})
+		}
+
+		recipes = append(recipes, recipe)
+	}
+
+	return recipes, nil
+}
+
+func (corob) Write(w io.Writer, recipes []Recipe) error {
+	colorantColumns := 1
+	for _, recipe := range recipes {
+		if len(recipe.Colorants) > colorantColumns {
+			colorantColumns = len(recipe.Colorants)
+		}
+	}
+
+	header := []string{"CODE", "NAME", "BASE", "CAN", "NOTES"}
+	for i := 1; i <= colorantColumns; i++ {
+		header = append(header, fmt.Sprintf("CNT%d", i), fmt.Sprintf("QTY%d", i))
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = ';'
+
+	err := writer.Write(header)
+	if err != nil {
+		return err
+	}
+
+	for _, recipe := range recipes {
+		can := ""
+		if recipe.Container != 0 {
+			can = recipe.Container.String()
+		}
+
+		row := []string{recipe.Number, recipe.Name, recipe.Base, can, recipe.Notes}
+		for _, dose := range recipe.Colorants {
+			row = append(row, dose.Code, strconv.FormatFloat(float64(dose.Amount)/unitsPerY, 'f', -1, 64))
+		}
+
+		for len(row) < len(header) {
+			row = append(row, "")
+		}
+
+		err := writer.Write(row)
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,61 @@
+package dispenser
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCorobRead(t *testing.T) {
+	file, err := os.Open("testdata/corob.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	recipes, err := corob{}.Read(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(fixtureRecipes, recipes); diff != "" {
+		t.Errorf("unexpected recipes (-want +got):\n%s", diff)
+	}
+}
+
+func TestCorobReadInvalid(t *testing.T) {
+	tests := map[string]string{
+		"wrong header":     "NAME;CODE\nSea Salt;SW 6204\n",
+		"bad quantity":     "CODE;NAME;BASE;CAN;NOTES;CNT1;QTY1\nSW 6204;Sea Salt;Extra White;1 gal;;B;lots\n",
+		"missing quantity": "CODE;NAME;BASE;CAN;NOTES;CNT1\nSW 6204;Sea Salt;Extra White;1 gal;;B\n",
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := corob{}.Read(strings.NewReader(input))
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestCorobWriteRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	err := corob{}.Write(&buf, fixtureRecipes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recipes, err := corob{}.Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(fixtureRecipes, recipes); diff != "" {
+		t.Errorf("unexpected recipes (-want +got):\n%s", diff)
+	}
+}
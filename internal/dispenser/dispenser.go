@@ -0,0 +1,186 @@
+// Package dispenser reads and writes the formula files used by automated tinting dispensers.
+//
+// Every dispenser vendor has its own export format but they all describe the same thing: a named formula made by
+// dispensing colorants, identified by short codes, into a can of base. Each supported format is an adapter which turns
+// its files into Recipes and back; recipes are matched against the bases and colorants of a Basecoat account.
+package dispenser
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/clintjedwards/basecoat/proto"
+)
+
+// A Recipe is a single formula as it appears in a dispenser's formula file.
+type Recipe struct {
+	Name      string
+	Number    string
+	Notes     string
+	Base      string    // The name of the base the colorants are dispensed into.
+	Container Container // The size of the can the amounts are for; a gallon if unknown.
+	Colorants []Dose
+}
+
+// A Dose is an amount of a single colorant.
+type Dose struct {
+	Code   string // The dispenser's code for the colorant; ex. "B" or "KX".
+	Amount Amount
+}
+
+// Format is a dispenser formula file format.
+type Format interface {
+	// Name is what the format is called on the command line.
+	Name() string
+
+	// Description briefly explains what the format is and where it comes from.
+	Description() string
+
+	// Extension is the file extension formula files of this format are saved with, without the leading dot.
+	Extension() string
+
+	// Read parses every recipe in a formula file.
+	Read(r io.Reader) ([]Recipe, error)
+
+	// Write writes recipes as a formula file the dispenser can load.
+	Write(w io.Writer, recipes []Recipe) error
+}
+
+var formats = map[string]Format{}
+
+func register(format Format) {
+	formats[format.Name()] = format
+}
+
+func init() {
+	register(fluidManagement{})
+	register(corob{})
+}
+
+// Get returns the format with the name given.
+func Get(name string) (Format, error) {
+	format, present := formats[strings.ToLower(name)]
+	if !present {
+		return nil, fmt.Errorf("unknown dispenser format %q; must be one of %s", name, strings.Join(Names(), ", "))
+	}
+
+	return format, nil
+}
+
+// Names returns the names of all supported formats in alphabetical order.
+func Names() []string {
+	names := []string{}
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Catalog maps the names dispenser files use for bases and colorants onto the IDs of those entities in Basecoat.
+// Lookups ignore case and surrounding whitespace.
+type Catalog struct {
+	bases     map[string]string
+	colorants map[string]string
+}
+
+func NewCatalog() *Catalog {
+	return &Catalog{
+		bases:     map[string]string{},
+		colorants: map[string]string{},
+	}
+}
+
+func catalogKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// AddBase records that bases called name in dispenser files are the Basecoat base with the ID given.
+func (c *Catalog) AddBase(name, id string) {
+	c.bases[catalogKey(name)] = id
+}
+
+// AddColorant records that the colorant code given refers to the Basecoat colorant with the ID given.
+func (c *Catalog) AddColorant(code, id string) {
+	c.colorants[catalogKey(code)] = id
+}
+
+// ResolveError lists every base and colorant code found in a formula file which the catalog has no entry for.
+type ResolveError struct {
+	Bases     []string
+	Colorants []string
+}
+
+func (e *ResolveError) Error() string {
+	problems := []string{}
+	if len(e.Bases) > 0 {
+		problems = append(problems, fmt.Sprintf("unknown bases: %s", strings.Join(e.Bases, ", ")))
+	}
+	if len(e.Colorants) > 0 {
+		problems = append(problems, fmt.Sprintf("unknown colorant codes: %s", strings.Join(e.Colorants, ", ")))
+	}
+
+	return strings.Join(problems, "; ")
+}
+
+// Resolve turns recipes into formula create requests. Colorant amounts are scaled to a gallon, the container size
+// Basecoat formulas are kept for. If any base or colorant code can't be found in the catalog a ResolveError listing
+// all of them is returned.
+func (c *Catalog) Resolve(recipes []Recipe) ([]*proto.CreateFormulaRequest, error) {
+	missingBases := map[string]struct{}{}
+	missingColorants := map[string]struct{}{}
+	requests := []*proto.CreateFormulaRequest{}
+
+	for _, recipe := range recipes {
+		request := &proto.CreateFormulaRequest{
+			Name:   recipe.Name,
+			Number: recipe.Number,
+			Notes:  recipe.Notes,
+		}
+
+		if recipe.Base != "" {
+			id, present := c.bases[catalogKey(recipe.Base)]
+			if !present {
+				missingBases[recipe.Base] = struct{}{}
+			}
+			request.Bases = append(request.Bases, &proto.FormulaIngredient{Id: id, Amount: "1 gal"})
+		}
+
+		container := recipe.Container
+		if container == 0 {
+			container = Gallon
+		}
+
+		for _, dose := range recipe.Colorants {
+			id, present := c.colorants[catalogKey(dose.Code)]
+			if !present {
+				missingColorants[dose.Code] = struct{}{}
+			}
+			request.Colorants = append(request.Colorants, &proto.FormulaIngredient{
+				Id:     id,
+				Amount: dose.Amount.Scale(container, Gallon).String(),
+			})
+		}
+
+		requests = append(requests, request)
+	}
+
+	if len(missingBases) > 0 || len(missingColorants) > 0 {
+		return nil, &ResolveError{Bases: sortedKeys(missingBases), Colorants: sortedKeys(missingColorants)}
+	}
+
+	return requests, nil
+}
+
+func sortedKeys(set map[string]struct{}) []string {
+	keys := []string{}
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,88 @@
+package dispenser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/clintjedwards/basecoat/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestGet(t *testing.T) {
+	for _, name := range Names() {
+		format, err := Get(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if format.Name() != name {
+			t.Errorf("Get(%q) returned format %q", name, format.Name())
+		}
+	}
+
+	_, err := Get("nonexistent")
+	if err == nil {
+		t.Errorf("expected unknown format to fail")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.AddBase("extra white", "base1")
+	catalog.AddBase("Deep Base", "base2")
+	catalog.AddColorant("B", "black")
+	catalog.AddColorant("l", "yellow")
+	catalog.AddColorant("KX", "white")
+	catalog.AddColorant("E", "umber")
+
+	requests, err := catalog.Resolve(fixtureRecipes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*proto.CreateFormulaRequest{
+		{
+			Name:   "Sea Salt",
+			Number: "SW 6204",
+			Notes:  "Exterior satin",
+			Bases:  []*proto.FormulaIngredient{{Id: "base1", Amount: "1 gal"}},
+			Colorants: []*proto.FormulaIngredient{
+				{Id: "black", Amount: "12/48"},
+				{Id: "yellow", Amount: "1oz 3/48"},
+			},
+		},
+		{
+			// Amounts for a quart are scaled up to a gallon.
+			Name:   "Naval",
+			Number: "SW 6244",
+			Bases:  []*proto.FormulaIngredient{{Id: "base2", Amount: "1 gal"}},
+			Colorants: []*proto.FormulaIngredient{
+				{Id: "white", Amount: "8/48"},
+				{Id: "umber", Amount: "6oz"},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, requests, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected requests (-want +got):\n%s", diff)
+	}
+}
+
+func TestResolveMissing(t *testing.T) {
+	catalog := NewCatalog()
+	catalog.AddBase("Extra White", "base1")
+	catalog.AddColorant("B", "black")
+
+	_, err := catalog.Resolve(fixtureRecipes)
+
+	var resolveErr *ResolveError
+	if !errors.As(err, &resolveErr) {
+		t.Fatalf("expected a ResolveError; got %v", err)
+	}
+
+	want := &ResolveError{Bases: []string{"Deep Base"}, Colorants: []string{"E", "KX", "L"}}
+	if diff := cmp.Diff(want, resolveErr); diff != "" {
+		t.Errorf("unexpected missing entries (-want +got):\n%s", diff)
+	}
+}
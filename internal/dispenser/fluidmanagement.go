@@ -0,0 +1,131 @@
+package dispenser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// fluidManagement reads the XML formula databases exported by Fluid Management style dispensers:
+//
+//	<FormulaExport>
+//	  <Formula>
+//	    <Name>Sea Salt</Name>
+//	    <Code>SW 6204</Code>
+//	    <Comment>Exterior satin</Comment>
+//	    <Base>Extra White</Base>
+//	    <CanSize>1 gal</CanSize>
+//	    <Colorant Code="B" Amount="0Y12"/>
+//	    <Colorant Code="L" Amount="1Y3"/>
+//	  </Formula>
+//	</FormulaExport>
+//
+// Amounts are given in ounces and 48ths using Y notation; see ParseAmount.
+type fluidManagement struct{}
+
+type fluidManagementExport struct {
+	XMLName  xml.Name                 `xml:"FormulaExport"`
+	Formulas []fluidManagementFormula `xml:"Formula"`
+}
+
+type fluidManagementFormula struct {
+	Name      string                    `xml:"Name"`
+	Code      string                    `xml:"Code"`
+	Comment   string                    `xml:"Comment,omitempty"`
+	Base      string                    `xml:"Base"`
+	CanSize   string                    `xml:"CanSize,omitempty"`
+	Colorants []fluidManagementColorant `xml:"Colorant"`
+}
+
+type fluidManagementColorant struct {
+	Code   string `xml:"Code,attr"`
+	Amount string `xml:"Amount,attr"`
+}
+
+func (fluidManagement) Name() string {
+	return "fluidmanagement"
+}
+
+func (fluidManagement) Description() string {
+	return "XML formula database exported by Fluid Management style dispensers; amounts in ounces and Y (48ths)"
+}
+
+func (fluidManagement) Extension() string {
+	return "xml"
+}
+
+func (fluidManagement) Read(r io.Reader) ([]Recipe, error) {
+	var export fluidManagementExport
+	err := xml.NewDecoder(r).Decode(&export)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse formula export: %w", err)
+	}
+
+	recipes := []Recipe{}
+	for i, formula := range export.Formulas {
+		recipe := Recipe{
+			Name:   strings.TrimSpace(formula.Name),
+			Number: strings.TrimSpace(formula.Code),
+			Notes:  strings.TrimSpace(formula.Comment),
+			Base:   strings.TrimSpace(formula.Base),
+		}
+
+		if strings.TrimSpace(formula.CanSize) != "" {
+			recipe.Container, err = ParseContainer(formula.CanSize)
+			if err != nil {
+				return nil, fmt.Errorf("formula %d (%s): %w", i+1, recipe.Name, err)
+			}
+		}
+
+		for _, colorant := range formula.Colorants {
+			amount, err := ParseAmount(colorant.Amount)
+			if err != nil {
+				return nil, fmt.Errorf("formula %d (%s): %w", i+1, recipe.Name, err)
+			}
+
+			recipe.Colorants = append(recipe.Colorants, Dose{Code: strings.TrimSpace(colorant.Code), Amount: amount})
+		}
+
+		recipes = append(recipes, recipe)
+	}
+
+	return recipes, nil
+}
+
+func (fluidManagement) Write(w io.Writer, recipes []Recipe) error {
+	export := fluidManagementExport{}
+	for _, recipe := range recipes {
+		formula := fluidManagementFormula{
+			Name:    recipe.Name,
+			Code:    recipe.Number,
+			Comment: recipe.Notes,
+			Base:    recipe.Base,
+		}
+
+		if recipe.Container != 0 {
+			formula.CanSize = recipe.Container.String()
+		}
+
+		for _, dose := range recipe.Colorants {
+			formula.Colorants = append(formula.Colorants, fluidManagementColorant{Code: dose.Code, Amount: dose.Amount.Y()})
+		}
+
+		export.Formulas = append(export.Formulas, formula)
+	}
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	err = encoder.Encode(export)
+	if err != nil {
+		return fmt.Errorf("could not write formula export: %w", err)
+	}
+
+	_, err = io.WriteString(w, "\n")
+	return err
+}
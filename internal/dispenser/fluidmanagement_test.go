@@ -0,0 +1,77 @@
+package dispenser
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fixtureRecipes are the recipes held by every format's fixture file in testdata.
+var fixtureRecipes = []Recipe{
+	{
+		Name:      "Sea Salt",
+		Number:    "SW 6204",
+		Notes:     "Exterior satin",
+		Base:      "Extra White",
+		Container: Gallon,
+		Colorants: []Dose{
+			{Code: "B", Amount: 12 * unitsPerY},
+			{Code: "L", Amount: unitsPerOunce + 3*unitsPerY},
+		},
+	},
+	{
+		Name:      "Naval",
+		Number:    "SW 6244",
+		Base:      "Deep Base",
+		Container: 32,
+		Colorants: []Dose{
+			{Code: "KX", Amount: 2 * unitsPerY},
+			{Code: "E", Amount: unitsPerOunce + 24*unitsPerY},
+		},
+	},
+}
+
+func TestFluidManagementRead(t *testing.T) {
+	file, err := os.Open("testdata/fluidmanagement.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	recipes, err := fluidManagement{}.Read(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(fixtureRecipes, recipes); diff != "" {
+		t.Errorf("unexpected recipes (-want +got):\n%s", diff)
+	}
+}
+
+func TestFluidManagementReadInvalidAmount(t *testing.T) {
+	_, err := fluidManagement{}.Read(strings.NewReader(`<FormulaExport><Formula><Name>Bad</Name>
+		<Colorant Code="B" Amount="lots"/></Formula></FormulaExport>`))
+	if err == nil {
+		t.Fatal("expected an invalid amount to fail")
+	}
+}
+
+func TestFluidManagementWriteRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	err := fluidManagement{}.Write(&buf, fixtureRecipes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recipes, err := fluidManagement{}.Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(fixtureRecipes, recipes); diff != "" {
+		t.Errorf("unexpected recipes (-want +got):\n%s", diff)
+	}
+}
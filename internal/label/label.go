@@ -0,0 +1,130 @@
+// Package label renders the labels stuck to the lid of a can once it has been mixed.
+//
+// A label carries everything needed to identify and remix the paint in the can: the formula, the base and
+// colorants that went into it, who it was mixed for and a QR code linking back to the formula in Basecoat
+// along with its short code. Labels
+// are rendered as PDF for office laser printers or ZPL for thermal label printers.
+package label
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Label is everything printed on a single can label.
+type Label struct {
+	Name      string
+	Number    string
+	Base      string
+	Container string // The size of the can the amounts are for; ex. "1 gal".
+	Colorants []Colorant
+	Job       string
+	Customer  string
+	Mixed     time.Time
+	Link      string // Encoded into the label's QR code; left off if empty.
+	Code      string // Printed under the QR code so the label can be looked up by hand when the code won't scan.
+}
+
+// Colorant is a single line of a label's colorant breakdown.
+type Colorant struct {
+	Name   string
+	Amount string
+}
+
+// maxColorantLines is the most colorant lines which fit on a label. Formulas with more colorants list as many as fit
+// followed by a count of the rest.
+const maxColorantLines = 8
+
+// colorantLines returns the colorant breakdown as it fits on a label.
+func (l *Label) colorantLines() []string {
+	lines := []string{}
+	for i, colorant := range l.Colorants {
+		if i == maxColorantLines-1 && len(l.Colorants) > maxColorantLines {
+			lines = append(lines, fmt.Sprintf("... and %d more", len(l.Colorants)-i))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%s  %s", colorant.Amount, colorant.Name))
+	}
+
+	return lines
+}
+
+// title returns the formula's name and number as printed across the top of the label.
+func (l *Label) title() string {
+	if l.Number == "" {
+		return l.Name
+	}
+
+	return fmt.Sprintf("%s (%s)", l.Name, l.Number)
+}
+
+// details returns the lines printed under the title; empty fields are left off.
+func (l *Label) details() []string {
+	details := []string{}
+
+	base := l.Base
+	if base != "" && l.Container != "" {
+		base = fmt.Sprintf("%s; %s", base, l.Container)
+	}
+
+	for _, detail := range []struct{ name, value string }{
+		{"Base", base},
+		{"Job", l.Job},
+		{"Customer", l.Customer},
+	} {
+		if detail.value != "" {
+			details = append(details, fmt.Sprintf("%s: %s", detail.name, detail.value))
+		}
+	}
+
+	if !l.Mixed.IsZero() {
+		details = append(details, "Mixed: "+l.Mixed.Format("Jan 2, 2006"))
+	}
+
+	return details
+}
+
+// Format is a label output format.
+type Format string
+
+const (
+	// PDF renders a label as a single 4x3 inch page for laser printers.
+	PDF Format = "pdf"
+
+	// ZPL renders a label as a Zebra Programming Language script for 203 dpi thermal printers loaded with 4x3 inch
+	// labels.
+	ZPL Format = "zpl"
+)
+
+// ParseFormat returns the format with the name given.
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(name))); format {
+	case PDF, ZPL:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown label format %q; must be one of %s, %s", name, PDF, ZPL)
+	}
+}
+
+// ContentType returns the MIME type of labels rendered in the format.
+func (f Format) ContentType() string {
+	if f == ZPL {
+		return "application/vnd.zebra-zpl"
+	}
+
+	return "application/pdf"
+}
+
+// Render writes a label in the format given.
+func Render(w io.Writer, format Format, label Label) error {
+	switch format {
+	case PDF:
+		return renderPDF(w, label)
+	case ZPL:
+		return renderZPL(w, label)
+	default:
+		return fmt.Errorf("unknown label format %q", format)
+	}
+}
@@ -0,0 +1,78 @@
+package label
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fixtureLabel is a label with every field filled in.
+var fixtureLabel = Label{
+	Name:      "Sea Salt",
+	Number:    "SW 6204",
+	Base:      "Extra White",
+	Container: "1 gal",
+	Colorants: []Colorant{
+		{Name: "Lamp Black", Amount: "12/48"},
+		{Name: "Yellow Oxide", Amount: "1oz 3/48"},
+	},
+	Job:      "Smith kitchen",
+	Customer: "Acme Painting",
+	Mixed:    time.Date(2023, time.March, 4, 10, 0, 0, 0, time.UTC),
+	Link:     "https://basecoat.example.com/c/7KQ2MXHP",
+	Code:     "7KQ2-MXHP",
+}
+
+func TestDetails(t *testing.T) {
+	want := []string{
+		"Base: Extra White; 1 gal",
+		"Job: Smith kitchen",
+		"Customer: Acme Painting",
+		"Mixed: Mar 4, 2023",
+	}
+
+	if diff := cmp.Diff(want, fixtureLabel.details()); diff != "" {
+		t.Errorf("unexpected details (-want +got):\n%s", diff)
+	}
+
+	// Empty fields are left off entirely.
+	label := Label{Name: "Sea Salt", Base: "Extra White"}
+	if diff := cmp.Diff([]string{"Base: Extra White"}, label.details()); diff != "" {
+		t.Errorf("unexpected details (-want +got):\n%s", diff)
+	}
+}
+
+func TestColorantLines(t *testing.T) {
+	label := Label{}
+	for i := 0; i < maxColorantLines+2; i++ {
+		label.Colorants = append(label.Colorants, Colorant{Name: "Lamp Black", Amount: "1oz"})
+	}
+
+	lines := label.colorantLines()
+	if len(lines) != maxColorantLines {
+		t.Fatalf("expected %d lines; found %d", maxColorantLines, len(lines))
+	}
+
+	if last := lines[len(lines)-1]; last != "... and 3 more" {
+		t.Errorf("expected the last line to count the colorants left off; found %q", last)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for input, want := range map[string]Format{"pdf": PDF, "ZPL": ZPL, " pdf ": PDF} {
+		got, err := ParseFormat(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got != want {
+			t.Errorf("ParseFormat(%q) = %q; want %q", input, got, want)
+		}
+	}
+
+	_, err := ParseFormat("png")
+	if err == nil {
+		t.Errorf("expected unknown format to fail")
+	}
+}
@@ -0,0 +1,90 @@
+package label
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/skip2/go-qrcode"
+)
+
+// Label dimensions and layout in inches.
+const (
+	pdfWidth   = 4.0
+	pdfHeight  = 3.0
+	pdfMargin  = 0.15
+	pdfQRSize  = 1.1
+	pdfCode    = 0.16 // Height of the code printed under the QR code.
+	pdfLineGap = 0.02
+)
+
+func renderPDF(w io.Writer, label Label) error {
+	pdf := gofpdf.NewCustom(&gofpdf.InitType{
+		UnitStr: "in",
+		Size:    gofpdf.SizeType{Wd: pdfWidth, Ht: pdfHeight},
+	})
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetTitle(label.title(), true)
+	pdf.SetCreator("Basecoat", true)
+	if !label.Mixed.IsZero() {
+		pdf.SetCreationDate(label.Mixed)
+	}
+	pdf.AddPage()
+
+	// The core fonts only cover latin characters; translate so accented names still print correctly.
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	// Text to the left of the QR code is narrower than the rest of the label.
+	textWidth := pdfWidth - 2*pdfMargin
+	if label.Link != "" {
+		textWidth -= pdfQRSize + pdfMargin
+
+		png, err := qrcode.Encode(label.Link, qrcode.Medium, 256)
+		if err != nil {
+			return fmt.Errorf("could not generate QR code: %w", err)
+		}
+
+		options := gofpdf.ImageOptions{ImageType: "PNG"}
+		pdf.RegisterImageOptionsReader("qr", options, bytes.NewReader(png))
+		pdf.ImageOptions("qr", pdfWidth-pdfMargin-pdfQRSize, pdfMargin, pdfQRSize, pdfQRSize, false, options, 0, "")
+
+		if label.Code != "" {
+			pdf.SetFont("Courier", "B", 10)
+			pdf.SetXY(pdfWidth-pdfMargin-pdfQRSize, pdfMargin+pdfQRSize)
+			pdf.CellFormat(pdfQRSize, pdfCode, label.Code, "", 0, "C", false, 0, "")
+			pdf.SetXY(pdfMargin, pdfMargin)
+		}
+	}
+
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.MultiCell(textWidth, 0.22, translate(label.title()), "", "L", false)
+	pdf.Ln(pdfLineGap)
+
+	pdf.SetFont("Helvetica", "", 9)
+	for _, detail := range label.details() {
+		pdf.CellFormat(textWidth, 0.16, translate(detail), "", 1, "L", false, 0, "")
+	}
+
+	// The colorant breakdown always starts below the QR code.
+	y := pdfMargin + pdfQRSize + pdfLineGap
+	if label.Code != "" {
+		y += pdfCode
+	}
+	if label.Link != "" && pdf.GetY() < y {
+		pdf.SetY(y)
+	}
+
+	pdf.Ln(pdfLineGap)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(0, 0.18, "Colorants", "B", 1, "L", false, 0, "")
+	pdf.Ln(pdfLineGap)
+
+	pdf.SetFont("Courier", "", 9)
+	for _, line := range label.colorantLines() {
+		pdf.CellFormat(0, 0.15, translate(line), "", 1, "L", false, 0, "")
+	}
+
+	return pdf.Output(w)
+}
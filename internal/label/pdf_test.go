@@ -0,0 +1,25 @@
+package label
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRenderPDF(t *testing.T) {
+	for name, label := range map[string]Label{
+		"full":    fixtureLabel,
+		"minimal": {Name: "Sea Salt"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Render(&buf, PDF, label)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")) {
+				t.Errorf("expected output to be a PDF document")
+			}
+		})
+	}
+}
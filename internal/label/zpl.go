@@ -0,0 +1,86 @@
+package label
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Label dimensions and layout in dots; 4x3 inches at 203 dpi.
+const (
+	zplWidth  = 812
+	zplHeight = 609
+	zplMargin = 30
+)
+
+// zplEscaper hex escapes the characters ZPL treats as commands so that they print as text. Fields are written with
+// ^FH so that \XX is read as a hex encoded byte.
+var zplEscaper = strings.NewReplacer(`\`, `\5C`, `^`, `\5E`, `~`, `\7E`)
+
+// zplQRSize is the room left for the QR code in the top right corner of the label.
+const zplQRSize = 190
+
+// zplText writes a single line of text at the position given. Text is kept within width; anything longer is cut off by
+// the printer.
+func zplText(b *strings.Builder, x, y, width, height int, text string) {
+	fmt.Fprintf(b, "^FO%d,%d^A0N,%d,%d^FB%d,1,0,L^FH\\^FD%s^FS\n", x, y, height, height, width,
+		zplEscaper.Replace(text))
+}
+
+// zplCentered writes a single line of text centered within width.
+func zplCentered(b *strings.Builder, x, y, width, height int, text string) {
+	fmt.Fprintf(b, "^FO%d,%d^A0N,%d,%d^FB%d,1,0,C^FH\\^FD%s^FS\n", x, y, height, height, width,
+		zplEscaper.Replace(text))
+}
+
+func renderZPL(w io.Writer, label Label) error {
+	var b strings.Builder
+
+	// ^CI28 reads field data as UTF-8.
+	fmt.Fprintf(&b, "^XA\n^CI28\n^PW%d\n^LL%d\n", zplWidth, zplHeight)
+
+	// Text beside the QR code is narrower than the rest of the label.
+	width := zplWidth - 2*zplMargin
+	headerWidth := width
+	if label.Link != "" {
+		headerWidth -= zplQRSize
+	}
+
+	y := zplMargin
+	zplText(&b, zplMargin, y, headerWidth, 40, label.title())
+	y += 55
+
+	for _, detail := range label.details() {
+		zplText(&b, zplMargin, y, headerWidth, 26, detail)
+		y += 32
+	}
+
+	if label.Link != "" {
+		// ^BQ adds a quiet zone above the code so it sits slightly higher than the text. Field data starts with the
+		// error correction level followed by the input mode; M is medium correction and A picks the mode automatically.
+		fmt.Fprintf(&b, "^FO%d,%d^BQN,2,5^FH\\^FDMA,%s^FS\n", zplWidth-zplMargin-zplQRSize, zplMargin-20,
+			zplEscaper.Replace(label.Link))
+
+		bottom := zplMargin + zplQRSize + 10
+		if label.Code != "" {
+			zplCentered(&b, zplWidth-zplMargin-zplQRSize, zplMargin+zplQRSize-10, zplQRSize, 24, label.Code)
+			bottom += 24
+		}
+		if y < bottom {
+			y = bottom
+		}
+	}
+
+	fmt.Fprintf(&b, "^FO%d,%d^GB%d,2,2^FS\n", zplMargin, y, width)
+	y += 12
+
+	for _, line := range label.colorantLines() {
+		zplText(&b, zplMargin, y, width, 26, line)
+		y += 30
+	}
+
+	b.WriteString("^XZ\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
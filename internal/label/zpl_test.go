@@ -0,0 +1,48 @@
+package label
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRenderZPL(t *testing.T) {
+	var buf bytes.Buffer
+	err := Render(&buf, ZPL, fixtureLabel)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zpl := buf.String()
+	if !strings.HasPrefix(zpl, "^XA") || !strings.HasSuffix(zpl, "^XZ\n") {
+		t.Fatalf("expected a single label format; found:\n%s", zpl)
+	}
+
+	for _, want := range []string{
+		"^FDSea Salt (SW 6204)^FS",
+		"^FDCustomer: Acme Painting^FS",
+		"^FD12/48  Lamp Black^FS",
+		"^BQN,2,5^FH\\^FDMA,https://basecoat.example.com/c/7KQ2MXHP^FS",
+		"^FB190,1,0,C^FH\\^FD7KQ2-MXHP^FS",
+	} {
+		if !strings.Contains(zpl, want) {
+			t.Errorf("expected label to contain %q; found:\n%s", want, zpl)
+		}
+	}
+}
+
+func TestRenderZPLEscapesText(t *testing.T) {
+	var buf bytes.Buffer
+	err := Render(&buf, ZPL, Label{Name: `Blue^XZ~JA\`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `^FDBlue\5EXZ\7EJA\5C^FS`; !strings.Contains(buf.String(), want) {
+		t.Errorf("expected name to be escaped as %q; found:\n%s", want, buf.String())
+	}
+
+	if strings.Count(buf.String(), "^XZ") != 1 {
+		t.Errorf("expected text not to end the label early")
+	}
+}
@@ -0,0 +1,126 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/storage"
+	proto "github.com/clintjedwards/basecoat/proto"
+)
+
+// An AuditEvent is a record of a single change made to an account's data; who made it, how and what changed.
+type AuditEvent struct {
+	ID         int64      `json:"id"`
+	Account    string     `json:"account"`     // Account the changed entity belongs to.
+	Actor      string     `json:"actor"`       // API token ID that made the change or "admin".
+	Method     string     `json:"method"`      // Full gRPC method name that made the change.
+	EntityKind EntityKind `json:"entity_kind"` // The kind of entity that was changed.
+	EntityID   string     `json:"entity_id"`
+	Before     *string    `json:"before"`    // JSON encoded entity before the change; nil if it did not exist.
+	After      *string    `json:"after"`     // JSON encoded entity after the change; nil if it no longer exists.
+	SourceIP   string     `json:"source_ip"` // Address the change was requested from.
+	Created    int64      `json:"created"`   // The creation time in epoch milli.
+}
+
+func NewAuditEvent(account, actor, method string, kind EntityKind, id, sourceIP string) *AuditEvent {
+	return &AuditEvent{
+		Account:    account,
+		Actor:      actor,
+		Method:     method,
+		EntityKind: kind,
+		EntityID:   id,
+		SourceIP:   sourceIP,
+		Created:    time.Now().UnixMilli(),
+	}
+}
+
+func (a *AuditEvent) ToProto() *proto.AuditEvent {
+	event := &proto.AuditEvent{
+		Id:         a.ID,
+		Account:    a.Account,
+		Actor:      a.Actor,
+		Method:     a.Method,
+		EntityKind: proto.EntityKind(proto.EntityKind_value[string(a.EntityKind)]),
+		EntityId:   a.EntityID,
+		SourceIp:   a.SourceIP,
+		Created:    a.Created,
+	}
+
+	if a.Before != nil {
+		event.Before = *a.Before
+	}
+
+	if a.After != nil {
+		event.After = *a.After
+	}
+
+	return event
+}
+
+// ToEventProto returns the event as it's sent to watchers of the account.
+func (a *AuditEvent) ToEventProto() *proto.Event {
+	event := &proto.Event{
+		Sequence:   a.ID,
+		Type:       a.eventType(),
+		EntityKind: proto.EntityKind(proto.EntityKind_value[string(a.EntityKind)]),
+		EntityId:   a.EntityID,
+		Actor:      a.Actor,
+		Method:     a.Method,
+		Created:    a.Created,
+	}
+
+	switch {
+	case a.After != nil:
+		event.Entity = *a.After
+	case a.Before != nil:
+		event.Entity = *a.Before
+	}
+
+	return event
+}
+
+// eventType works out what kind of change the event records. Associations and account imports are recorded against the
+// entity they change with only one side of the change, so they'd otherwise pass for creates and deletes.
+func (a *AuditEvent) eventType() proto.EventType {
+	method := a.Method[strings.LastIndex(a.Method, "/")+1:]
+
+	switch {
+	case strings.HasPrefix(method, "Associate"), strings.HasPrefix(method, "Disassociate"),
+		method == "ImportAccount":
+		return proto.EventType_UPDATED
+	case a.Before == nil:
+		return proto.EventType_CREATED
+	case a.After == nil:
+		return proto.EventType_DELETED
+	default:
+		return proto.EventType_UPDATED
+	}
+}
+
+func (a *AuditEvent) ToStorage() *storage.AuditEvent {
+	return &storage.AuditEvent{
+		ID:         a.ID,
+		Account:    a.Account,
+		Actor:      a.Actor,
+		Method:     a.Method,
+		EntityKind: string(a.EntityKind),
+		EntityID:   a.EntityID,
+		Before:     a.Before,
+		After:      a.After,
+		SourceIP:   a.SourceIP,
+		Created:    a.Created,
+	}
+}
+
+func (a *AuditEvent) FromStorage(s *storage.AuditEvent) {
+	a.ID = s.ID
+	a.Account = s.Account
+	a.Actor = s.Actor
+	a.Method = s.Method
+	a.EntityKind = EntityKind(s.EntityKind)
+	a.EntityID = s.EntityID
+	a.Before = s.Before
+	a.After = s.After
+	a.SourceIP = s.SourceIP
+	a.Created = s.Created
+}
@@ -27,6 +27,7 @@ type BaseMetadata struct {
 	Label        string `json:"label"`        // Humanized name; great for reading from UIs.
 	Manufacturer string `json:"manufacturer"` // Company name who created the base.
 	Created      int64  `json:"created"`      // The creation time in epoch milli.
+	Version      int64  `json:"version"`      // Incremented on every change; guards against conflicting updates.
 }
 
 func NewBaseMetadata(account, label, manufacturer string) *BaseMetadata {
@@ -36,6 +37,7 @@ func NewBaseMetadata(account, label, manufacturer string) *BaseMetadata {
 		Label:        label,
 		Manufacturer: manufacturer,
 		Created:      time.Now().UnixMilli(),
+		Version:      1,
 	}
 
 	return newBaseMetadata
@@ -48,6 +50,7 @@ func (b *BaseMetadata) ToProto() *proto.BaseMetadata {
 		Label:        b.Label,
 		Manufacturer: b.Manufacturer,
 		Created:      b.Created,
+		Version:      b.Version,
 	}
 }
 
@@ -58,6 +61,7 @@ func (b *BaseMetadata) ToStorage() *storage.Base {
 		Label:        b.Label,
 		Manufacturer: b.Manufacturer,
 		Created:      b.Created,
+		Version:      b.Version,
 	}
 }
 
@@ -67,6 +71,16 @@ func (b *BaseMetadata) FromStorage(s *storage.Base) {
 	b.Label = s.Label
 	b.Manufacturer = s.Manufacturer
 	b.Created = s.Created
+	b.Version = s.Version
+}
+
+func (b *BaseMetadata) FromProto(p *proto.BaseMetadata) {
+	b.Account = p.Account
+	b.ID = p.Id
+	b.Label = p.Label
+	b.Manufacturer = p.Manufacturer
+	b.Created = p.Created
+	b.Version = p.Version
 }
 
 // A base is the starting paint mix before significant color is added.
@@ -108,3 +122,9 @@ func (fb *FormulaBase) FromStorage(s *storage.FormulaBase) {
 	fb.Base = s.Base
 	fb.Amount = s.Amount
 }
+
+func (fb *FormulaBase) FromProto(p *proto.FormulaBase) {
+	fb.Formula = p.Formula
+	fb.Base = p.Base
+	fb.Amount = p.Amount
+}
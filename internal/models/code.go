@@ -0,0 +1,85 @@
+package models
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/storage"
+	proto "github.com/clintjedwards/basecoat/proto"
+)
+
+// codeAlphabet leaves out characters which are easily mistaken for one another when read off a worn label; 0 and O,
+// 1, I and L, and U and V.
+const codeAlphabet = "23456789ABCDEFGHJKMNPQRSTWXYZ"
+
+// codeLength gives over 500 billion possible codes; enough that they can't be guessed.
+const codeLength = 8
+
+// A Code is a short, stable code printed on labels which identifies a formula, job or mix. Codes never change once
+// given out so that scanning a label years later still finds what it was printed for.
+type Code struct {
+	Code       string     `json:"code"`
+	Account    string     `json:"account"`
+	EntityKind EntityKind `json:"entity_kind"`
+	EntityID   string     `json:"entity_id"`
+	Created    int64      `json:"created"` // The creation time in epoch milli.
+}
+
+func NewCode(account string, kind EntityKind, id string) *Code {
+	code := make([]byte, codeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(codeAlphabet))))
+		if err != nil {
+			panic(err)
+		}
+		code[i] = codeAlphabet[n.Int64()]
+	}
+
+	return &Code{
+		Code:       string(code),
+		Account:    account,
+		EntityKind: kind,
+		EntityID:   id,
+		Created:    time.Now().UnixMilli(),
+	}
+}
+
+// NormalizeCode cleans up a code typed in by hand; case, spaces and dashes are ignored.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(code)))
+}
+
+// Display returns the code split into two halves as it is printed for people to read; ex. "7KQ2-MXHP".
+func (c *Code) Display() string {
+	half := len(c.Code) / 2
+	return c.Code[:half] + "-" + c.Code[half:]
+}
+
+func (c *Code) ToProto() *proto.Code {
+	return &proto.Code{
+		Code:       c.Code,
+		EntityKind: proto.EntityKind(proto.EntityKind_value[string(c.EntityKind)]),
+		EntityId:   c.EntityID,
+		Created:    c.Created,
+	}
+}
+
+func (c *Code) ToStorage() *storage.Code {
+	return &storage.Code{
+		Code:       c.Code,
+		Account:    c.Account,
+		EntityKind: string(c.EntityKind),
+		EntityID:   c.EntityID,
+		Created:    c.Created,
+	}
+}
+
+func (c *Code) FromStorage(s *storage.Code) {
+	c.Code = s.Code
+	c.Account = s.Account
+	c.EntityKind = EntityKind(s.EntityKind)
+	c.EntityID = s.EntityID
+	c.Created = s.Created
+}
@@ -27,6 +27,10 @@ type ColorantMetadata struct {
 	Label        string `json:"label"`        // Humanized name; great for reading from UIs.
 	Manufacturer string `json:"manufacturer"` // Company name who created the colorant.
 	Created      int64  `json:"created"`      // The creation time in epoch milli.
+	Version      int64  `json:"version"`      // Incremented on every change; guards against conflicting updates.
+
+	// The code tinting dispensers know the colorant by; used when exporting formulas to a dispenser.
+	DispenserCode string `json:"dispenser_code"`
 }
 
 func NewColorantMetadata(account, label, manufacturer string) *ColorantMetadata {
@@ -36,6 +40,7 @@ func NewColorantMetadata(account, label, manufacturer string) *ColorantMetadata
 		Label:        label,
 		Manufacturer: manufacturer,
 		Created:      time.Now().UnixMilli(),
+		Version:      1,
 	}
 
 	return newColorantMetadata
@@ -43,21 +48,25 @@ func NewColorantMetadata(account, label, manufacturer string) *ColorantMetadata
 
 func (b *ColorantMetadata) ToProto() *proto.ColorantMetadata {
 	return &proto.ColorantMetadata{
-		Account:      b.Account,
-		Id:           b.ID,
-		Label:        b.Label,
-		Manufacturer: b.Manufacturer,
-		Created:      b.Created,
+		Account:       b.Account,
+		Id:            b.ID,
+		Label:         b.Label,
+		Manufacturer:  b.Manufacturer,
+		Created:       b.Created,
+		Version:       b.Version,
+		DispenserCode: b.DispenserCode,
 	}
 }
 
 func (b *ColorantMetadata) ToStorage() *storage.Colorant {
 	return &storage.Colorant{
-		Account:      b.Account,
-		ID:           b.ID,
-		Label:        b.Label,
-		Manufacturer: b.Manufacturer,
-		Created:      b.Created,
+		Account:       b.Account,
+		ID:            b.ID,
+		Label:         b.Label,
+		Manufacturer:  b.Manufacturer,
+		Created:       b.Created,
+		Version:       b.Version,
+		DispenserCode: b.DispenserCode,
 	}
 }
 
@@ -67,6 +76,18 @@ func (b *ColorantMetadata) FromStorage(s *storage.Colorant) {
 	b.Label = s.Label
 	b.Manufacturer = s.Manufacturer
 	b.Created = s.Created
+	b.Version = s.Version
+	b.DispenserCode = s.DispenserCode
+}
+
+func (b *ColorantMetadata) FromProto(p *proto.ColorantMetadata) {
+	b.Account = p.Account
+	b.ID = p.Id
+	b.Label = p.Label
+	b.Manufacturer = p.Manufacturer
+	b.Created = p.Created
+	b.Version = p.Version
+	b.DispenserCode = p.DispenserCode
 }
 
 // A colorant is the pigment which is mixed in to give a base a specific color.
@@ -108,3 +129,9 @@ func (fb *FormulaColorant) FromStorage(s *storage.FormulaColorant) {
 	fb.Colorant = s.Colorant
 	fb.Amount = s.Amount
 }
+
+func (fb *FormulaColorant) FromProto(p *proto.FormulaColorant) {
+	fb.Formula = p.Formula
+	fb.Colorant = p.Colorant
+	fb.Amount = p.Amount
+}
@@ -17,6 +17,7 @@ type Contact struct {
 	Phone    string `json:"phone"`
 	Created  int64  `json:"created"`
 	Modified int64  `json:"modified"`
+	Version  int64  `json:"version"` // Incremented on every change; guards against conflicting updates.
 }
 
 func NewContact(account, name string) *Contact {
@@ -28,6 +29,7 @@ func NewContact(account, name string) *Contact {
 		Phone:    "",
 		Created:  time.Now().UnixMilli(),
 		Modified: 0,
+		Version:  1,
 	}
 
 	return NewContact
@@ -42,6 +44,7 @@ func (c *Contact) ToProto() *proto.Contact {
 		Phone:    c.Phone,
 		Created:  c.Created,
 		Modified: c.Modified,
+		Version:  c.Version,
 	}
 }
 
@@ -54,6 +57,7 @@ func (c *Contact) ToStorage() *storage.Contact {
 		Phone:    c.Phone,
 		Created:  c.Created,
 		Modified: c.Modified,
+		Version:  c.Version,
 	}
 }
 
@@ -65,4 +69,16 @@ func (c *Contact) FromStorage(s *storage.Contact) {
 	c.Phone = s.Phone
 	c.Created = s.Created
 	c.Modified = s.Modified
+	c.Version = s.Version
+}
+
+func (c *Contact) FromProto(p *proto.Contact) {
+	c.Account = p.Account
+	c.ID = p.Id
+	c.Name = p.Name
+	c.Email = p.Email
+	c.Phone = p.Phone
+	c.Created = p.Created
+	c.Modified = p.Modified
+	c.Version = p.Version
 }
@@ -17,6 +17,7 @@ type Contractor struct {
 	Contact  *string `json:"contact"`  // contact ID
 	Created  int64   `json:"created"`  // The creation time in epoch milli.
 	Modified int64   `json:"modified"` // The modified time in epoch milli;
+	Version  int64   `json:"version"`  // Incremented on every change; guards against conflicting updates.
 }
 
 func NewContractor(account, company string) *Contractor {
@@ -27,6 +28,7 @@ func NewContractor(account, company string) *Contractor {
 		Contact:  nil,
 		Created:  time.Now().UnixMilli(),
 		Modified: 0,
+		Version:  1,
 	}
 
 	return newContractor
@@ -40,6 +42,7 @@ func (f *Contractor) ToProto() *proto.Contractor {
 		Contact:  f.Contact,
 		Created:  f.Created,
 		Modified: f.Modified,
+		Version:  f.Version,
 	}
 }
 
@@ -51,6 +54,7 @@ func (f *Contractor) ToStorage() *storage.Contractor {
 		Contact:  f.Contact,
 		Created:  f.Created,
 		Modified: f.Modified,
+		Version:  f.Version,
 	}
 }
 
@@ -61,4 +65,15 @@ func (f *Contractor) FromStorage(s *storage.Contractor) {
 	f.Contact = s.Contact
 	f.Created = s.Created
 	f.Modified = s.Modified
+	f.Version = s.Version
+}
+
+func (f *Contractor) FromProto(p *proto.Contractor) {
+	f.Account = p.Account
+	f.ID = p.Id
+	f.Company = p.Company
+	f.Contact = p.Contact
+	f.Created = p.Created
+	f.Modified = p.Modified
+	f.Version = p.Version
 }
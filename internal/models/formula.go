@@ -33,8 +33,11 @@ type FormulaMetadata struct {
 	Name     string `json:"name"`    // Humanized name; great for reading from UIs.
 	Number   string `json:"number"`  // Some formulas have specific reference numbers from their manufacturers
 	Notes    string `json:"notes"`
+	Sheen    string `json:"sheen"`    // The finish the formula is mixed in; ex. "Eggshell".
+	Color    string `json:"color"`    // Hex RGB value used as a swatch of the color; ex. "#4A5A6B".
 	Created  int64  `json:"created"`  // The creation time in epoch milli.
 	Modified int64  `json:"modified"` // The modified time in epoch milli;
+	Version  int64  `json:"version"`  // Incremented on every change; guards against conflicting updates.
 }
 
 func NewFormulaMetadata(account, name string) *FormulaMetadata {
@@ -46,6 +49,7 @@ func NewFormulaMetadata(account, name string) *FormulaMetadata {
 		Notes:    "",
 		Created:  time.Now().UnixMilli(),
 		Modified: 0,
+		Version:  1,
 	}
 
 	return NewFormulaMetadata
@@ -58,8 +62,11 @@ func (f *FormulaMetadata) ToProto() *proto.FormulaMetadata {
 		Name:     f.Name,
 		Number:   f.Number,
 		Notes:    f.Notes,
+		Sheen:    f.Sheen,
+		Color:    f.Color,
 		Created:  f.Created,
 		Modified: f.Modified,
+		Version:  f.Version,
 	}
 }
 
@@ -70,8 +77,11 @@ func (f *FormulaMetadata) ToStorage() *storage.Formula {
 		Name:     f.Name,
 		Number:   f.Number,
 		Notes:    f.Notes,
+		Sheen:    f.Sheen,
+		Color:    f.Color,
 		Created:  f.Created,
 		Modified: f.Modified,
+		Version:  f.Version,
 	}
 }
 
@@ -81,6 +91,22 @@ func (f *FormulaMetadata) FromStorage(s *storage.Formula) {
 	f.Name = s.Name
 	f.Number = s.Number
 	f.Notes = s.Notes
+	f.Sheen = s.Sheen
+	f.Color = s.Color
 	f.Created = s.Created
 	f.Modified = s.Modified
+	f.Version = s.Version
+}
+
+func (f *FormulaMetadata) FromProto(p *proto.FormulaMetadata) {
+	f.Account = p.Account
+	f.ID = p.Id
+	f.Name = p.Name
+	f.Number = p.Number
+	f.Notes = p.Notes
+	f.Sheen = p.Sheen
+	f.Color = p.Color
+	f.Created = p.Created
+	f.Modified = p.Modified
+	f.Version = p.Version
 }
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/clintjedwards/basecoat/internal/storage"
@@ -36,6 +37,18 @@ func (a *Address) FromProto(pa *proto.Address) {
 	a.Zipcode = pa.Zipcode
 }
 
+// String returns the address on a single line; ex. "12 Elm St, Springfield, IL 62701". Empty parts are left off.
+func (a *Address) String() string {
+	parts := []string{}
+	for _, part := range []string{a.Street, a.Street2, a.City, strings.TrimSpace(a.State + " " + a.Zipcode)} {
+		if strings.TrimSpace(part) != "" {
+			parts = append(parts, strings.TrimSpace(part))
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
 func (a *Address) ToJSON() string {
 	jsonAddress, err := json.Marshal(a)
 	if err != nil {
@@ -57,6 +70,7 @@ type Job struct {
 	Contact    *string `json:"contact"`
 	Created    int64   `json:"created"`  // The creation time in epoch milli.
 	Modified   int64   `json:"modified"` // The modified time in epoch milli;
+	Version    int64   `json:"version"`  // Incremented on every change; guards against conflicting updates.
 }
 
 func NewJob(account, contractor, name string) *Job {
@@ -70,6 +84,7 @@ func NewJob(account, contractor, name string) *Job {
 		Contact:    nil,
 		Created:    time.Now().UnixMilli(),
 		Modified:   0,
+		Version:    1,
 	}
 
 	return NewJob
@@ -86,6 +101,7 @@ func (f *Job) ToProto() *proto.Job {
 		Contact:    f.Contact,
 		Created:    f.Created,
 		Modified:   f.Modified,
+		Version:    f.Version,
 	}
 }
 
@@ -100,6 +116,7 @@ func (f *Job) ToStorage() *storage.Job {
 		Contact:    f.Contact,
 		Created:    f.Created,
 		Modified:   f.Modified,
+		Version:    f.Version,
 	}
 }
 
@@ -119,4 +136,58 @@ func (f *Job) FromStorage(s *storage.Job) {
 	f.Contact = s.Contact
 	f.Created = s.Created
 	f.Modified = s.Modified
+	f.Version = s.Version
+}
+
+func (f *Job) FromProto(p *proto.Job) {
+	address := Address{}
+	if p.Address != nil {
+		address.FromProto(p.Address)
+	}
+
+	f.Account = p.Account
+	f.ID = p.Id
+	f.Name = p.Name
+	f.Address = address
+	f.Notes = p.Notes
+	f.Contractor = p.Contractor
+	f.Contact = p.Contact
+	f.Created = p.Created
+	f.Modified = p.Modified
+	f.Version = p.Version
+}
+
+// A FormulaJob is metadata about a formula and job relationship.
+type FormulaJob struct {
+	Formula string `json:"formula"` // Unique ID for formula.
+	Job     string `json:"job"`     // Unique ID for job.
+	Area    string `json:"area"`    // Where on the job the formula was used; ex. "Kitchen walls".
+}
+
+func (fj *FormulaJob) ToProto() *proto.FormulaJob {
+	return &proto.FormulaJob{
+		Formula: fj.Formula,
+		Job:     fj.Job,
+		Area:    fj.Area,
+	}
+}
+
+func (fj *FormulaJob) ToStorage() *storage.FormulaJob {
+	return &storage.FormulaJob{
+		Formula: fj.Formula,
+		Job:     fj.Job,
+		Area:    fj.Area,
+	}
+}
+
+func (fj *FormulaJob) FromStorage(s *storage.FormulaJob) {
+	fj.Formula = s.Formula
+	fj.Job = s.Job
+	fj.Area = s.Area
+}
+
+func (fj *FormulaJob) FromProto(p *proto.FormulaJob) {
+	fj.Formula = p.Formula
+	fj.Job = p.Job
+	fj.Area = p.Area
 }
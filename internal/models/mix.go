@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/storage"
+	proto "github.com/clintjedwards/basecoat/proto"
+	"github.com/lithammer/shortuuid/v4"
+)
+
+// A Mix is a record of paint mixed from a formula; how many cans of which size, for which job and by whom.
+type Mix struct {
+	Account   string `json:"account"`
+	ID        string `json:"id"`
+	Formula   string `json:"formula"`
+	Job       string `json:"job"`       // Empty if the paint wasn't mixed for a job.
+	Container string `json:"container"` // The size of each can; ex. "1 gal".
+	Quantity  int64  `json:"quantity"`
+	Notes     string `json:"notes"`
+	Actor     string `json:"actor"` // The ID of the API token used to record the mix.
+	Created   int64  `json:"created"`
+}
+
+func NewMix(account, formula, job, container string, quantity int64, actor string) *Mix {
+	return &Mix{
+		Account:   account,
+		ID:        shortuuid.New()[0:7],
+		Formula:   formula,
+		Job:       job,
+		Container: container,
+		Quantity:  quantity,
+		Actor:     actor,
+		Created:   time.Now().UnixMilli(),
+	}
+}
+
+func (m *Mix) ToProto() *proto.Mix {
+	return &proto.Mix{
+		Account:   m.Account,
+		Id:        m.ID,
+		Formula:   m.Formula,
+		Job:       m.Job,
+		Container: m.Container,
+		Quantity:  m.Quantity,
+		Notes:     m.Notes,
+		Actor:     m.Actor,
+		Created:   m.Created,
+	}
+}
+
+func (m *Mix) ToStorage() *storage.Mix {
+	return &storage.Mix{
+		Account:   m.Account,
+		ID:        m.ID,
+		Formula:   m.Formula,
+		Job:       m.Job,
+		Container: m.Container,
+		Quantity:  m.Quantity,
+		Notes:     m.Notes,
+		Actor:     m.Actor,
+		Created:   m.Created,
+	}
+}
+
+func (m *Mix) FromStorage(s *storage.Mix) {
+	m.Account = s.Account
+	m.ID = s.ID
+	m.Formula = s.Formula
+	m.Job = s.Job
+	m.Container = s.Container
+	m.Quantity = s.Quantity
+	m.Notes = s.Notes
+	m.Actor = s.Actor
+	m.Created = s.Created
+}
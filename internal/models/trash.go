@@ -0,0 +1,48 @@
+package models
+
+import (
+	"github.com/clintjedwards/basecoat/internal/storage"
+	proto "github.com/clintjedwards/basecoat/proto"
+)
+
+type EntityKind string
+
+const (
+	EntityKindUnknown    EntityKind = "ENTITY_KIND_UNKNOWN"
+	EntityKindFormula    EntityKind = "FORMULA"
+	EntityKindBase       EntityKind = "BASE"
+	EntityKindColorant   EntityKind = "COLORANT"
+	EntityKindContact    EntityKind = "CONTACT"
+	EntityKindContractor EntityKind = "CONTRACTOR"
+	EntityKindJob        EntityKind = "JOB"
+	EntityKindAccount    EntityKind = "ACCOUNT"
+	EntityKindMix        EntityKind = "MIX"
+)
+
+// A DeletedEntity is a formula, base, etc. that has been moved to the trash. Deleted entities are hidden everywhere
+// else but can be restored until they are purged.
+type DeletedEntity struct {
+	Kind    EntityKind `json:"kind"`
+	Account string     `json:"account"`
+	ID      string     `json:"id"`
+	Name    string     `json:"name"`    // The entity's human readable name; label for bases and colorants.
+	Deleted int64      `json:"deleted"` // The deletion time in epoch milli.
+}
+
+func (d *DeletedEntity) ToProto() *proto.DeletedEntity {
+	return &proto.DeletedEntity{
+		Kind:    proto.EntityKind(proto.EntityKind_value[string(d.Kind)]),
+		Account: d.Account,
+		Id:      d.ID,
+		Name:    d.Name,
+		Deleted: d.Deleted,
+	}
+}
+
+func (d *DeletedEntity) FromStorage(s *storage.DeletedEntity) {
+	d.Kind = EntityKind(s.Kind)
+	d.Account = s.Account
+	d.ID = s.ID
+	d.Name = s.Name
+	d.Deleted = s.Deleted
+}
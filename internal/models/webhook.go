@@ -0,0 +1,242 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/storage"
+	proto "github.com/clintjedwards/basecoat/proto"
+	"github.com/lithammer/shortuuid/v4"
+)
+
+type WebhookDeliveryState string
+
+const (
+	WebhookDeliveryStateUnknown   WebhookDeliveryState = "UNKNOWN"
+	WebhookDeliveryStatePending   WebhookDeliveryState = "PENDING"
+	WebhookDeliveryStateSucceeded WebhookDeliveryState = "SUCCEEDED"
+	WebhookDeliveryStateFailed    WebhookDeliveryState = "FAILED"
+)
+
+// WebhookEventPing is the event type of test deliveries; webhooks don't need to subscribe to it.
+const WebhookEventPing = "ping"
+
+// A Webhook posts an account's events of the types it subscribes to to a URL. Every delivery is signed with its
+// secret so the receiver can tell it came from Basecoat.
+type Webhook struct {
+	Account  string   `json:"account"`
+	ID       string   `json:"id"`
+	URL      string   `json:"url"`
+	Events   []string `json:"events"` // Event types subscribed to; see WebhookEventType.
+	Secret   string   `json:"-"`
+	Created  int64    `json:"created"`
+	Modified int64    `json:"modified"`
+}
+
+func NewWebhook(account, url string, events []string, secret string) *Webhook {
+	return &Webhook{
+		Account:  account,
+		ID:       shortuuid.New()[0:7],
+		URL:      url,
+		Events:   events,
+		Secret:   secret,
+		Created:  time.Now().UnixMilli(),
+		Modified: 0,
+	}
+}
+
+// NewWebhookSecret returns a random secret for webhooks created without one.
+func NewWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
+
+// WebhookEventType names the type of an event as webhooks subscribe to it; the kind of entity and what happened to
+// it, ex. "job.updated".
+func WebhookEventType(kind proto.EntityKind, eventType proto.EventType) string {
+	return strings.ToLower(kind.String()) + "." + strings.ToLower(eventType.String())
+}
+
+// WebhookEventTypes returns every event type a webhook can subscribe to.
+func WebhookEventTypes() []string {
+	types := []string{}
+	for kind := proto.EntityKind_FORMULA; kind <= proto.EntityKind_MIX; kind++ {
+		for eventType := proto.EventType_CREATED; eventType <= proto.EventType_DELETED; eventType++ {
+			types = append(types, WebhookEventType(kind, eventType))
+		}
+	}
+
+	return types
+}
+
+// Subscribes returns true if the webhook subscribes to events of the type given.
+func (w *Webhook) Subscribes(eventType string) bool {
+	for _, event := range w.Events {
+		if event == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w *Webhook) ToProto() *proto.Webhook {
+	return &proto.Webhook{
+		Account:  w.Account,
+		Id:       w.ID,
+		Url:      w.URL,
+		Events:   w.Events,
+		Created:  w.Created,
+		Modified: w.Modified,
+	}
+}
+
+func (w *Webhook) ToStorage() *storage.Webhook {
+	return &storage.Webhook{
+		Account:  w.Account,
+		ID:       w.ID,
+		URL:      w.URL,
+		Events:   strings.Join(w.Events, ","),
+		Secret:   w.Secret,
+		Created:  w.Created,
+		Modified: w.Modified,
+	}
+}
+
+func (w *Webhook) FromStorage(s *storage.Webhook) {
+	w.Account = s.Account
+	w.ID = s.ID
+	w.URL = s.URL
+	w.Events = strings.Split(s.Events, ",")
+	w.Secret = s.Secret
+	w.Created = s.Created
+	w.Modified = s.Modified
+}
+
+// WebhookPayload is the JSON body posted to a webhook for each event.
+type WebhookPayload struct {
+	Type       string          `json:"type"` // The event type; ex. "job.updated" or "ping".
+	Account    string          `json:"account"`
+	Sequence   int64           `json:"sequence"` // Same as the id of the event in the audit log; 0 for pings.
+	EntityKind string          `json:"entity_kind,omitempty"`
+	EntityID   string          `json:"entity_id,omitempty"`
+	Actor      string          `json:"actor,omitempty"`
+	Method     string          `json:"method,omitempty"` // The API call which made the change; ex. "UpdateJob".
+	Entity     json.RawMessage `json:"entity,omitempty"` // The entity after the change, or before it if deleted.
+	Created    int64           `json:"created"`          // When the change was made in epoch milli.
+}
+
+// NewWebhookPayload describes an audit event for webhooks.
+func NewWebhookPayload(event *AuditEvent) WebhookPayload {
+	protoEvent := event.ToEventProto()
+
+	payload := WebhookPayload{
+		Type:       WebhookEventType(protoEvent.EntityKind, protoEvent.Type),
+		Account:    event.Account,
+		Sequence:   event.ID,
+		EntityKind: strings.ToLower(protoEvent.EntityKind.String()),
+		EntityID:   event.EntityID,
+		Actor:      event.Actor,
+		Method:     event.Method[strings.LastIndex(event.Method, "/")+1:],
+		Created:    event.Created,
+	}
+
+	if protoEvent.Entity != "" {
+		payload.Entity = json.RawMessage(protoEvent.Entity)
+	}
+
+	return payload
+}
+
+// A WebhookDelivery is a single event posted to a webhook. Deliveries which fail are retried with exponential backoff
+// until they succeed or run out of attempts and are then kept as the webhook's delivery log.
+type WebhookDelivery struct {
+	ID           int64                `json:"id"`
+	Account      string               `json:"account"`
+	Webhook      string               `json:"webhook"`
+	Event        string               `json:"event"`    // The event type; ex. "job.updated".
+	Sequence     int64                `json:"sequence"` // The audit event delivered; 0 for pings.
+	Payload      string               `json:"payload"`  // The JSON body posted.
+	State        WebhookDeliveryState `json:"state"`
+	Attempts     int64                `json:"attempts"`
+	NextAttempt  int64                `json:"next_attempt"`  // When the delivery is next attempted in epoch milli.
+	ResponseCode int64                `json:"response_code"` // HTTP status of the last attempt; 0 if there was none.
+	Error        string               `json:"error"`         // Why the last attempt failed.
+	Created      int64                `json:"created"`
+	Modified     int64                `json:"modified"`
+}
+
+func NewWebhookDelivery(account, webhook, event string, sequence int64, payload string) *WebhookDelivery {
+	now := time.Now().UnixMilli()
+
+	return &WebhookDelivery{
+		Account:     account,
+		Webhook:     webhook,
+		Event:       event,
+		Sequence:    sequence,
+		Payload:     payload,
+		State:       WebhookDeliveryStatePending,
+		NextAttempt: now,
+		Created:     now,
+		Modified:    now,
+	}
+}
+
+func (d *WebhookDelivery) ToProto() *proto.WebhookDelivery {
+	return &proto.WebhookDelivery{
+		Id:           d.ID,
+		Webhook:      d.Webhook,
+		Event:        d.Event,
+		Sequence:     d.Sequence,
+		Payload:      d.Payload,
+		State:        proto.WebhookDeliveryState(proto.WebhookDeliveryState_value[string(d.State)]),
+		Attempts:     d.Attempts,
+		NextAttempt:  d.NextAttempt,
+		ResponseCode: d.ResponseCode,
+		Error:        d.Error,
+		Created:      d.Created,
+		Modified:     d.Modified,
+	}
+}
+
+func (d *WebhookDelivery) ToStorage() *storage.WebhookDelivery {
+	return &storage.WebhookDelivery{
+		ID:           d.ID,
+		Account:      d.Account,
+		Webhook:      d.Webhook,
+		Event:        d.Event,
+		Sequence:     d.Sequence,
+		Payload:      d.Payload,
+		State:        string(d.State),
+		Attempts:     d.Attempts,
+		NextAttempt:  d.NextAttempt,
+		ResponseCode: d.ResponseCode,
+		Error:        d.Error,
+		Created:      d.Created,
+		Modified:     d.Modified,
+	}
+}
+
+func (d *WebhookDelivery) FromStorage(s *storage.WebhookDelivery) {
+	d.ID = s.ID
+	d.Account = s.Account
+	d.Webhook = s.Webhook
+	d.Event = s.Event
+	d.Sequence = s.Sequence
+	d.Payload = s.Payload
+	d.State = WebhookDeliveryState(s.State)
+	d.Attempts = s.Attempts
+	d.NextAttempt = s.NextAttempt
+	d.ResponseCode = s.ResponseCode
+	d.Error = s.Error
+	d.Created = s.Created
+	d.Modified = s.Modified
+}
@@ -0,0 +1,81 @@
+package report
+
+import (
+	"html/template"
+	"io"
+)
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"colorants": colorantLine,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Job}}</title>
+<style>
+body { font-family: sans-serif; max-width: 48em; margin: 2em auto; padding: 0 1em; color: #222; }
+h2 { border-bottom: 1px solid #ccc; padding-bottom: 0.25em; }
+.details p { margin: 0.2em 0; }
+.formula { display: flex; gap: 1.25em; margin-bottom: 1.5em; break-inside: avoid; }
+.swatch { flex: none; width: 6em; height: 6em; border: 1px solid #aaa; }
+.empty { display: flex; align-items: center; justify-content: center; font-size: 0.8em; color: #888; }
+.formula h3 { margin: 0 0 0.3em 0; }
+.formula p { margin: 0.15em 0; }
+</style>
+</head>
+<body>
+<h1>{{.Job}}</h1>
+<div class="details">
+{{range .Details}}<p>{{.}}</p>
+{{end}}{{with .Notes}}<p><em>{{.}}</em></p>
+{{end}}</div>
+<h2>Paint used</h2>
+{{range .Formulas}}<div class="formula">
+{{if .Color}}<div class="swatch" style="background-color: {{.Color}}"></div>{{else}}<div class="swatch empty">No swatch</div>{{end}}
+<div>
+<h3>{{.Title}}</h3>
+{{range .Details}}<p>{{.}}</p>
+{{end}}{{with colorants .Colorants}}<p>Colorants per gallon: {{.}}</p>
+{{end}}{{with .Link}}<p><a href="{{.}}">{{.}}</a></p>
+{{end}}</div>
+</div>
+{{else}}<p>No formulas were recorded for this job.</p>
+{{end}}</body>
+</html>
+`))
+
+// htmlFormula adds the computed fields the template needs to a formula.
+type htmlFormula struct {
+	Formula
+	Title   string
+	Details []string
+}
+
+func renderHTML(w io.Writer, report Report) error {
+	formulas := []htmlFormula{}
+	for _, formula := range report.Formulas {
+		// Colors which can't be read are left off rather than handed to the browser.
+		if _, _, _, ok := formula.rgb(); !ok {
+			formula.Color = ""
+		}
+
+		formulas = append(formulas, htmlFormula{
+			Formula: formula,
+			Title:   formula.title(),
+			Details: formula.details(),
+		})
+	}
+
+	return htmlTemplate.Execute(w, struct {
+		Job      string
+		Details  []string
+		Notes    string
+		Formulas []htmlFormula
+	}{
+		Job:      report.Job,
+		Details:  report.details(),
+		Notes:    report.Notes,
+		Formulas: formulas,
+	})
+}
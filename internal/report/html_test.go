@@ -0,0 +1,51 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRenderHTML(t *testing.T) {
+	var buf bytes.Buffer
+	err := Render(&buf, HTML, fixtureReport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	page := buf.String()
+	for _, want := range []string{
+		"<h1>Smith kitchen</h1>",
+		"<p>Address: 12 Elm St, Springfield, IL 62701</p>",
+		"<h3>Sea Salt (SW 6204)</h3>",
+		`style="background-color: #CDD2CA"`,
+		"<p>Area: Kitchen walls</p>",
+		"<p>Sheen: Eggshell</p>",
+		"<p>Colorants per gallon: 12/48 Lamp Black, 1oz 3/48 Yellow Oxide</p>",
+		`<a href="https://basecoat.example.com/c/7KQ2MXHP">`,
+		`<div class="swatch empty">No swatch</div>`,
+	} {
+		if !strings.Contains(page, want) {
+			t.Errorf("expected report to contain %q; found:\n%s", want, page)
+		}
+	}
+}
+
+func TestRenderHTMLEscapesText(t *testing.T) {
+	var buf bytes.Buffer
+	err := Render(&buf, HTML, Report{
+		Job:      "<script>alert(1)</script>",
+		Formulas: []Formula{{Name: "Blue", Color: "red; background-image: url(x)"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(buf.String(), "<script>") {
+		t.Errorf("expected job name to be escaped; found:\n%s", buf.String())
+	}
+
+	if strings.Contains(buf.String(), "url(x)") {
+		t.Errorf("expected an unreadable color to be left off; found:\n%s", buf.String())
+	}
+}
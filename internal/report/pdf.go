@@ -0,0 +1,131 @@
+package report
+
+import (
+	"io"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// Report layout in inches; letter paper.
+const (
+	pdfWidth      = 8.5
+	pdfHeight     = 11.0
+	pdfMargin     = 0.75
+	pdfSwatchSize = 1.0
+	pdfLine       = 0.2
+)
+
+func renderPDF(w io.Writer, report Report) error {
+	return newPDF(report).Output(w)
+}
+
+// newPDF lays out a report as a PDF document ready to be written.
+func newPDF(report Report) *gofpdf.Fpdf {
+	pdf := gofpdf.New("P", "in", "Letter", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+	pdf.SetTitle(report.Job, true)
+	pdf.SetCreator("Basecoat", true)
+	if !report.Generated.IsZero() {
+		pdf.SetCreationDate(report.Generated)
+	}
+	pdf.AddPage()
+
+	// The core fonts only cover latin characters; translate so accented names still print correctly.
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+	width := pdfWidth - 2*pdfMargin
+
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.MultiCell(width, 0.3, translate(report.Job), "", "L", false)
+	pdf.Ln(0.05)
+
+	pdf.SetFont("Helvetica", "", 10)
+	for _, detail := range report.details() {
+		pdf.MultiCell(width, pdfLine, translate(detail), "", "L", false)
+	}
+
+	if report.Notes != "" {
+		pdf.Ln(0.05)
+		pdf.SetFont("Helvetica", "I", 10)
+		pdf.MultiCell(width, pdfLine, translate(report.Notes), "", "L", false)
+	}
+
+	pdf.Ln(0.1)
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(width, 0.25, "Paint used", "B", 1, "L", false, 0, "")
+	pdf.Ln(0.1)
+
+	if len(report.Formulas) == 0 {
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.CellFormat(width, pdfLine, "No formulas were recorded for this job.", "", 1, "L", false, 0, "")
+	}
+
+	// Text sits to the right of the swatch.
+	textX := pdfMargin + pdfSwatchSize + 0.2
+	textWidth := width - pdfSwatchSize - 0.2
+
+	for _, formula := range report.Formulas {
+		details := formula.details()
+		colorants := colorantLine(formula.Colorants)
+
+		// Keep each formula on a single page.
+		height := 0.25 + float64(len(details))*pdfLine
+		if colorants != "" {
+			height += 2 * pdfLine
+		}
+		if height < pdfSwatchSize {
+			height = pdfSwatchSize
+		}
+		if pdf.GetY()+height > pdfHeight-pdfMargin {
+			pdf.AddPage()
+		}
+
+		top := pdf.GetY()
+
+		if r, g, b, ok := formula.rgb(); ok {
+			pdf.SetFillColor(r, g, b)
+			pdf.SetDrawColor(160, 160, 160)
+			pdf.Rect(pdfMargin, top, pdfSwatchSize, pdfSwatchSize, "FD")
+		} else {
+			pdf.SetDrawColor(160, 160, 160)
+			pdf.Rect(pdfMargin, top, pdfSwatchSize, pdfSwatchSize, "D")
+			pdf.SetFont("Helvetica", "", 8)
+			pdf.SetXY(pdfMargin, top+pdfSwatchSize/2-0.07)
+			pdf.CellFormat(pdfSwatchSize, 0.14, "No swatch", "", 0, "C", false, 0, "")
+		}
+
+		pdf.SetXY(textX, top)
+		pdf.SetFont("Helvetica", "B", 12)
+		pdf.MultiCell(textWidth, 0.25, translate(formula.title()), "", "L", false)
+
+		pdf.SetFont("Helvetica", "", 10)
+		for _, detail := range details {
+			pdf.SetX(textX)
+			pdf.MultiCell(textWidth, pdfLine, translate(detail), "", "L", false)
+		}
+
+		if colorants != "" {
+			pdf.SetX(textX)
+			pdf.SetFont("Helvetica", "", 9)
+			pdf.MultiCell(textWidth, pdfLine, translate("Colorants per gallon: "+colorants), "", "L", false)
+		}
+
+		if y := top + pdfSwatchSize; pdf.GetY() < y {
+			pdf.SetY(y)
+		}
+		pdf.Ln(0.25)
+	}
+
+	return pdf
+}
+
+// colorantLine returns a formula's colorants as a single line of text.
+func colorantLine(colorants []Colorant) string {
+	parts := []string{}
+	for _, colorant := range colorants {
+		parts = append(parts, colorant.Amount+" "+colorant.Name)
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,89 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// pdfText renders a report without compressing its pages so that the text drawn on them can be searched for. It
+// returns the document and the number of pages it has.
+func pdfText(t *testing.T, report Report) (string, int) {
+	t.Helper()
+
+	pdf := newPDF(report)
+	pdf.SetCompression(false)
+
+	var buf bytes.Buffer
+	err := pdf.Output(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.String(), pdf.PageNo()
+}
+
+func TestRenderPDF(t *testing.T) {
+	var buf bytes.Buffer
+	err := Render(&buf, PDF, fixtureReport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-")) {
+		t.Errorf("expected output to be a PDF document")
+	}
+
+	document, pages := pdfText(t, fixtureReport)
+	if pages != 1 {
+		t.Errorf("expected report to fit on 1 page; found %d", pages)
+	}
+
+	for _, want := range []string{
+		"(Smith kitchen)Tj",
+		"(Address: 12 Elm St, Springfield, IL 62701)Tj",
+		"(Two coats throughout.)Tj",
+		"(Sea Salt \\(SW 6204\\))Tj",
+		"(Area: Kitchen walls)Tj",
+		"(Sheen: Eggshell)Tj",
+		"(Colorants per gallon: 12/48 Lamp Black, 1oz 3/48 Yellow Oxide)Tj",
+		"(Pure White)Tj",
+		"(No swatch)Tj",
+		// The swatch of #CDD2CA.
+		"0.804 0.824 0.792 rg",
+	} {
+		if !strings.Contains(document, want) {
+			t.Errorf("expected report to contain %q", want)
+		}
+	}
+}
+
+func TestRenderPDFLayout(t *testing.T) {
+	many := Report{Job: "Whole house"}
+	for i := 0; i < 20; i++ {
+		many.Formulas = append(many.Formulas, fixtureReport.Formulas[0])
+	}
+
+	tests := map[string]struct {
+		report Report
+		pages  int
+		want   string
+	}{
+		"no formulas":      {report: Report{Job: "Smith kitchen"}, pages: 1, want: "(No formulas were recorded for this job.)Tj"},
+		"many formulas":    {report: many, pages: 4, want: "(Area: Kitchen walls)Tj"},
+		"accented letters": {report: Report{Job: "Café Rouge"}, pages: 1, want: "(Caf\xe9 Rouge)Tj"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			document, pages := pdfText(t, tc.report)
+			if pages != tc.pages {
+				t.Errorf("expected %d pages; found %d", tc.pages, pages)
+			}
+
+			if !strings.Contains(document, tc.want) {
+				t.Errorf("expected report to contain %q", tc.want)
+			}
+		})
+	}
+}
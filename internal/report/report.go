@@ -0,0 +1,153 @@
+// Package report renders the job reports handed to customers once a job is finished.
+//
+// A report lists every formula used on a job along with what's needed to buy or mix the same paint again years later
+// for touch-ups: the manufacturer, base, sheen, colorant amounts and a swatch of the color. Each formula's short code
+// is printed too so that it can be looked up in Basecoat. Reports are rendered as PDF for printing or emailing and as
+// HTML for viewing in a browser.
+package report
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Report is everything printed in a single job report.
+type Report struct {
+	Job        string
+	Address    string // A single line; ex. "12 Elm St, Springfield, IL 62701".
+	Customer   string
+	Contractor string // Who did the work and how to reach them.
+	Notes      string
+	Code       string // The job's code; left off if empty.
+	Generated  time.Time
+	Formulas   []Formula
+}
+
+// Formula is a single formula used on a job.
+type Formula struct {
+	Name         string
+	Number       string
+	Area         string // Where on the job the formula was used; ex. "Kitchen trim".
+	Manufacturer string
+	Base         string
+	Sheen        string
+	Color        string // Hex RGB value shown as a swatch; ex. "#4A5A6B". No swatch is shown if empty.
+	Colorants    []Colorant
+	Code         string // The formula's code; left off if empty.
+	Link         string // Where the formula's code leads; left off if empty.
+}
+
+// Colorant is a single colorant of a formula and its amount per gallon.
+type Colorant struct {
+	Name   string
+	Amount string
+}
+
+// title returns the formula's name and number as printed at the top of its section.
+func (f *Formula) title() string {
+	if f.Number == "" {
+		return f.Name
+	}
+
+	return fmt.Sprintf("%s (%s)", f.Name, f.Number)
+}
+
+// details returns the lines printed under a formula's title; empty fields are left off.
+func (f *Formula) details() []string {
+	details := []string{}
+	for _, detail := range []struct{ name, value string }{
+		{"Area", f.Area},
+		{"Manufacturer", f.Manufacturer},
+		{"Base", f.Base},
+		{"Sheen", f.Sheen},
+		{"Code", f.Code},
+	} {
+		if detail.value != "" {
+			details = append(details, fmt.Sprintf("%s: %s", detail.name, detail.value))
+		}
+	}
+
+	return details
+}
+
+// rgb returns the red, green and blue components of the formula's color; ok is false if the formula has no color or
+// it can't be read.
+func (f *Formula) rgb() (r, g, b int, ok bool) {
+	color := strings.TrimPrefix(f.Color, "#")
+	if len(color) != 6 {
+		return 0, 0, 0, false
+	}
+
+	value, err := strconv.ParseUint(color, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+
+	return int(value >> 16 & 0xFF), int(value >> 8 & 0xFF), int(value & 0xFF), true
+}
+
+// details returns the lines printed under the report's title; empty fields are left off.
+func (r *Report) details() []string {
+	details := []string{}
+	for _, detail := range []struct{ name, value string }{
+		{"Address", r.Address},
+		{"Customer", r.Customer},
+		{"Contractor", r.Contractor},
+		{"Job code", r.Code},
+	} {
+		if detail.value != "" {
+			details = append(details, fmt.Sprintf("%s: %s", detail.name, detail.value))
+		}
+	}
+
+	if !r.Generated.IsZero() {
+		details = append(details, "Prepared: "+r.Generated.Format("Jan 2, 2006"))
+	}
+
+	return details
+}
+
+// Format is a report output format.
+type Format string
+
+const (
+	// PDF renders a report as a letter sized document.
+	PDF Format = "pdf"
+
+	// HTML renders a report as a single self-contained page.
+	HTML Format = "html"
+)
+
+// ParseFormat returns the format with the name given.
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(name))); format {
+	case PDF, HTML:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown report format %q; must be one of %s, %s", name, PDF, HTML)
+	}
+}
+
+// ContentType returns the MIME type of reports rendered in the format.
+func (f Format) ContentType() string {
+	if f == HTML {
+		return "text/html; charset=utf-8"
+	}
+
+	return "application/pdf"
+}
+
+// Render writes a report in the format given.
+func Render(w io.Writer, format Format, report Report) error {
+	switch format {
+	case PDF:
+		return renderPDF(w, report)
+	case HTML:
+		return renderHTML(w, report)
+	default:
+		return fmt.Errorf("unknown report format %q", format)
+	}
+}
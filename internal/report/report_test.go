@@ -0,0 +1,95 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// fixtureReport is a report with every field filled in.
+var fixtureReport = Report{
+	Job:        "Smith kitchen",
+	Address:    "12 Elm St, Springfield, IL 62701",
+	Customer:   "Jane Smith",
+	Contractor: "Acme Painting; Bob Jones, 555-0100",
+	Notes:      "Two coats throughout.",
+	Code:       "HTPG-P829",
+	Generated:  time.Date(2023, time.March, 4, 10, 0, 0, 0, time.UTC),
+	Formulas: []Formula{
+		{
+			Name:         "Sea Salt",
+			Number:       "SW 6204",
+			Area:         "Kitchen walls",
+			Manufacturer: "Sherwin-Williams",
+			Base:         "Extra White",
+			Sheen:        "Eggshell",
+			Color:        "#CDD2CA",
+			Colorants: []Colorant{
+				{Name: "Lamp Black", Amount: "12/48"},
+				{Name: "Yellow Oxide", Amount: "1oz 3/48"},
+			},
+			Code: "7KQ2-MXHP",
+			Link: "https://basecoat.example.com/c/7KQ2MXHP",
+		},
+		{Name: "Pure White"},
+	},
+}
+
+func TestFormulaDetails(t *testing.T) {
+	want := []string{
+		"Area: Kitchen walls",
+		"Manufacturer: Sherwin-Williams",
+		"Base: Extra White",
+		"Sheen: Eggshell",
+		"Code: 7KQ2-MXHP",
+	}
+
+	if diff := cmp.Diff(want, fixtureReport.Formulas[0].details()); diff != "" {
+		t.Errorf("unexpected details (-want +got):\n%s", diff)
+	}
+
+	// Empty fields are left off entirely.
+	if diff := cmp.Diff([]string{}, fixtureReport.Formulas[1].details()); diff != "" {
+		t.Errorf("unexpected details (-want +got):\n%s", diff)
+	}
+}
+
+func TestRGB(t *testing.T) {
+	tests := map[string]struct {
+		color   string
+		r, g, b int
+		ok      bool
+	}{
+		"hex":       {"#CDD2CA", 205, 210, 202, true},
+		"no prefix": {"4a5a6b", 74, 90, 107, true},
+		"empty":     {"", 0, 0, 0, false},
+		"short":     {"#FFF", 0, 0, 0, false},
+		"not hex":   {"#GGGGGG", 0, 0, 0, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			formula := Formula{Color: tc.color}
+			r, g, b, ok := formula.rgb()
+			if r != tc.r || g != tc.g || b != tc.b || ok != tc.ok {
+				t.Errorf("expected (%d, %d, %d, %t); found (%d, %d, %d, %t)", tc.r, tc.g, tc.b, tc.ok, r, g, b, ok)
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat(" HTML ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if format != HTML {
+		t.Errorf("expected %q; found %q", HTML, format)
+	}
+
+	if _, err := ParseFormat("docx"); err == nil {
+		t.Errorf("expected an unknown format to be rejected")
+	}
+}
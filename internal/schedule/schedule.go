@@ -0,0 +1,18 @@
+// Package schedule runs the service's background chores, like backups and purges, on a fixed interval.
+package schedule
+
+import "time"
+
+// Every calls fn straight away and then once every interval, forever. A call which runs for longer than the interval
+// delays the next one rather than overlapping it. It should be run in a goroutine.
+//
+// The interval must be positive; intervals are checked when the configuration is loaded.
+func Every(interval time.Duration, fn func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn()
+		<-ticker.C
+	}
+}
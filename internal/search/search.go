@@ -45,7 +45,7 @@ func (si *Search) BuildIndex(store storage.DB) {
 	// TODO: Log how long it took to build the index in prometheus
 	start := time.Now()
 
-	accounts, err := store.ListAccounts(si.store.DB, 0, 0)
+	accounts, err := store.ListAccounts(si.store, 0, 0)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to query database for accounts")
 	}
@@ -64,7 +64,7 @@ func (si *Search) UpdateFormulaIndex(account string, formulaID string) {
 		si.formulaIndex[account] = createNewIndex()
 	}
 
-	formula, err := si.store.GetFormula(si.store.DB, account, formulaID)
+	formula, err := si.store.GetFormula(si.store, account, formulaID)
 	if err != nil {
 		log.Error().Err(err).Str("account", account).Str("formula_id", formulaID).Msg("could not get formula from database")
 	}
@@ -82,14 +82,14 @@ func (si *Search) UpdateJobIndex(account string, jobID string) {
 		si.jobIndex[account] = createNewIndex()
 	}
 
-	job, err := si.store.GetJob(si.store.DB, account, jobID)
+	job, err := si.store.GetJob(si.store, account, jobID)
 	if err != nil {
 		log.Error().Err(err).Str("account", account).Str("job", jobID).Msg("could not get job from database")
 	}
 
 	contractor := storage.Contractor{}
 	if job.Contractor != "" {
-		contractor, err = si.store.GetContractor(si.store.DB, account, job.Contractor)
+		contractor, err = si.store.GetContractor(si.store, account, job.Contractor)
 		if err != nil {
 			log.Error().Err(err).Str("account", account).Str("job", jobID).Msg("could not get contractor from database")
 		}
@@ -109,7 +109,11 @@ func (si *Search) UpdateJobIndex(account string, jobID string) {
 
 // DeleteFormulaIndex updates an already loaded formula index
 func (si *Search) DeleteFormulaIndex(account string, formulaID string) {
-	index := si.formulaIndex[account]
+	index, ok := si.formulaIndex[account]
+	if !ok {
+		return
+	}
+
 	err := index.Delete(formulaID)
 	if err != nil {
 		log.Error().Err(err).Str("account", account).Str("formula", formulaID).Msg("failed to remove formula from index")
@@ -118,7 +122,11 @@ func (si *Search) DeleteFormulaIndex(account string, formulaID string) {
 
 // DeleteJobIndex updates an already loaded job index
 func (si *Search) DeleteJobIndex(account string, jobID string) {
-	index := si.jobIndex[account]
+	index, ok := si.jobIndex[account]
+	if !ok {
+		return
+	}
+
 	err := index.Delete(jobID)
 	if err != nil {
 		log.Error().Err(err).Str("account", account).Str("formula", jobID).Msg("failed to remove job from index")
@@ -149,7 +157,7 @@ func (si *Search) populateIndex(account string) {
 	si.newAccountIndex(account)
 
 	// Index all formulas
-	formulas, err := si.store.ListFormulas(si.store.DB, account, 0, 0)
+	formulas, err := si.store.ListFormulas(si.store, account, 0, 0)
 	if err != nil {
 		log.Error().Err(err).Str("account", account).Msg("failed to query database for formulas")
 	}
@@ -162,13 +170,13 @@ func (si *Search) populateIndex(account string) {
 	}
 
 	// Index all jobs
-	jobs, err := si.store.ListJobs(si.store.DB, account, 0, 0)
+	jobs, err := si.store.ListJobs(si.store, account, 0, 0)
 	if err != nil {
 		log.Error().Err(err).Str("account", account).Msg("failed to query database for jobs")
 	}
 
 	for _, job := range jobs {
-		contractor, err := si.store.GetContractor(si.store.DB, account, job.Contractor)
+		contractor, err := si.store.GetContractor(si.store, account, job.Contractor)
 		if err != nil {
 			log.Error().Err(err).Str("account", account).Msg("failed to query database for contractor")
 		}
@@ -29,7 +29,7 @@ var testInfo = struct {
 func setup() {
 	databasePath := fmt.Sprintf("/tmp/basecoat%s.db", shortuuid.New()[0:7])
 
-	storage, err := storage.New(databasePath, 100)
+	storage, err := storage.New(storage.EngineSQLite, databasePath, 100)
 	if err != nil {
 		log.Fatal().Err(err).Msg("could not init storage")
 	}
@@ -48,7 +48,7 @@ func setup() {
 }
 
 func populateDB() {
-	err := testInfo.storage.InsertAccount(testInfo.storage.DB, &storage.Account{
+	err := testInfo.storage.InsertAccount(testInfo.storage, &storage.Account{
 		ID:   "test_account",
 		Name: "test_account",
 	})
@@ -56,7 +56,7 @@ func populateDB() {
 		panic(err)
 	}
 
-	err = testInfo.storage.InsertContractor(testInfo.storage.DB, &storage.Contractor{
+	err = testInfo.storage.InsertContractor(testInfo.storage, &storage.Contractor{
 		Account: "test_account",
 		ID:      "test_contractor",
 		Company: "test_contractor",
@@ -65,7 +65,7 @@ func populateDB() {
 		panic(err)
 	}
 
-	err = testInfo.storage.InsertFormula(testInfo.storage.DB, &storage.Formula{
+	err = testInfo.storage.InsertFormula(testInfo.storage, &storage.Formula{
 		Account: "test_account",
 		ID:      "formula_1",
 		Name:    "testunique",
@@ -73,7 +73,7 @@ func populateDB() {
 	if err != nil {
 		panic(err)
 	}
-	err = testInfo.storage.InsertFormula(testInfo.storage.DB, &storage.Formula{
+	err = testInfo.storage.InsertFormula(testInfo.storage, &storage.Formula{
 		Account: "test_account",
 		ID:      "formula_2",
 		Name:    "test-name",
@@ -82,7 +82,7 @@ func populateDB() {
 		panic(err)
 	}
 
-	err = testInfo.storage.InsertJob(testInfo.storage.DB, &storage.Job{
+	err = testInfo.storage.InsertJob(testInfo.storage, &storage.Job{
 		Account:    "test_account",
 		ID:         "test_job1",
 		Name:       "testjob1",
@@ -91,7 +91,7 @@ func populateDB() {
 	if err != nil {
 		panic(err)
 	}
-	err = testInfo.storage.InsertJob(testInfo.storage.DB, &storage.Job{
+	err = testInfo.storage.InsertJob(testInfo.storage, &storage.Job{
 		Account:    "test_account",
 		ID:         "test_job2",
 		Name:       "testjob2",
@@ -152,7 +152,7 @@ func TestUpdateFormulaIndex(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, results)
 
-	err = testInfo.storage.UpdateFormula(testInfo.storage.DB, "test_account", testInfo.formula1ID, storage.UpdatableFormulaFields{
+	err = testInfo.storage.UpdateFormula(testInfo.storage, "test_account", testInfo.formula1ID, storage.UpdatableFormulaFields{
 		Name: ptr("testupdate"),
 	})
 	require.NoError(t, err)
@@ -174,7 +174,7 @@ func TestUpdateJobIndex(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, results, 2)
 
-	err = testInfo.storage.UpdateJob(testInfo.storage.DB, "test_account", "test_job1", storage.UpdatableJobFields{
+	err = testInfo.storage.UpdateJob(testInfo.storage, "test_account", "test_job1", storage.UpdatableJobFields{
 		Name: ptr("testdifferent"),
 	})
 	require.NoError(t, err)
@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 
 	qb "github.com/Masterminds/squirrel"
 )
@@ -25,12 +24,12 @@ type UpdatableAccountFields struct {
 	Modified *int64
 }
 
-func (db *DB) ListAccounts(conn Queryable, offset, limit int) ([]Account, error) {
+func (db *sqlDB) ListAccounts(conn Queryable, offset, limit int) ([]Account, error) {
 	if limit == 0 || limit > db.maxResultsLimit {
 		limit = db.maxResultsLimit
 	}
 
-	query, args := qb.Select("id", "name", "hash", "state", "created", "modified").
+	query, args := db.builder.Select("id", "name", "hash", "state", "created", "modified").
 		From("accounts").OrderBy("id").Limit(uint64(limit)).Offset(uint64(offset)).MustSql()
 
 	accounts := []Account{}
@@ -42,12 +41,12 @@ func (db *DB) ListAccounts(conn Queryable, offset, limit int) ([]Account, error)
 	return accounts, nil
 }
 
-func (db *DB) InsertAccount(conn Queryable, account *Account) error {
-	_, err := qb.Insert("accounts").Columns("id", "name", "hash", "state", "created", "modified").Values(
+func (db *sqlDB) InsertAccount(conn Queryable, account *Account) error {
+	_, err := db.builder.Insert("accounts").Columns("id", "name", "hash", "state", "created", "modified").Values(
 		account.ID, account.Name, account.Hash, account.State, account.Created, account.Modified,
 	).RunWith(conn).Exec()
 	if err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		if db.dialect.IsUniqueViolation(err) {
 			return ErrEntityExists
 		}
 
@@ -57,8 +56,8 @@ func (db *DB) InsertAccount(conn Queryable, account *Account) error {
 	return nil
 }
 
-func (db *DB) GetAccount(conn Queryable, id string) (Account, error) {
-	query, args := qb.Select("id", "name", "hash", "state", "created", "modified").
+func (db *sqlDB) GetAccount(conn Queryable, id string) (Account, error) {
+	query, args := db.builder.Select("id", "name", "hash", "state", "created", "modified").
 		From("accounts").Where(qb.Eq{"id": id}).MustSql()
 
 	account := Account{}
@@ -74,8 +73,8 @@ func (db *DB) GetAccount(conn Queryable, id string) (Account, error) {
 	return account, nil
 }
 
-func (db *DB) UpdateAccount(conn Queryable, id string, fields UpdatableAccountFields) error {
-	query := qb.Update("accounts")
+func (db *sqlDB) UpdateAccount(conn Queryable, id string, fields UpdatableAccountFields) error {
+	query := db.builder.Update("accounts")
 
 	if fields.Name != nil {
 		query = query.Set("name", fields.Name)
@@ -105,8 +104,8 @@ func (db *DB) UpdateAccount(conn Queryable, id string, fields UpdatableAccountFi
 	return nil
 }
 
-func (db *DB) DeleteAccount(conn Queryable, id string) error {
-	_, err := qb.Delete("accounts").Where(qb.Eq{"id": id}).RunWith(conn).Exec()
+func (db *sqlDB) DeleteAccount(conn Queryable, id string) error {
+	_, err := db.builder.Delete("accounts").Where(qb.Eq{"id": id}).RunWith(conn).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
@@ -2,19 +2,16 @@ package storage
 
 import (
 	"errors"
-	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 )
 
 func TestCRUDAccounts(t *testing.T) {
-	path := tempFile()
-	db, err := New(path, 200)
+	db, err := newTestDB(t)
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer os.Remove(path)
 
 	account := Account{
 		ID:       "test_account",
@@ -0,0 +1,153 @@
+package storage
+
+import (
+	"fmt"
+
+	qb "github.com/Masterminds/squirrel"
+)
+
+type AuditEvent struct {
+	ID         int64
+	Account    string
+	Actor      string
+	Method     string
+	EntityKind string `db:"entity_kind"`
+	EntityID   string `db:"entity_id"`
+	Before     *string
+	After      *string
+	SourceIP   string `db:"source_ip"`
+	Created    int64
+}
+
+// AuditEventFilter narrows down the audit events returned by ListAuditEvents. Zero values match everything.
+type AuditEventFilter struct {
+	EntityKind string
+	EntityID   string
+
+	// Only events created at or after Since and before Until; in epoch milli.
+	Since int64
+	Until int64
+}
+
+// InsertAuditEvent appends an event to the audit log and sets its ID.
+func (db *sqlDB) InsertAuditEvent(conn Queryable, event *AuditEvent) error {
+	query, args := db.builder.Insert("audit_events").
+		Columns("account", "actor", "method", "entity_kind", "entity_id", "before", "after", "source_ip", "created").
+		Values(event.Account, event.Actor, event.Method, event.EntityKind, event.EntityID, event.Before, event.After,
+			event.SourceIP, event.Created).
+		Suffix("RETURNING id").
+		MustSql()
+
+	err := conn.QueryRow(query, args...).Scan(&event.ID)
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// ListAuditEvents returns an account's audit events from newest to oldest.
+func (db *sqlDB) ListAuditEvents(conn Queryable, account string, filter AuditEventFilter, offset, limit int) ([]AuditEvent, error) {
+	if limit == 0 || limit > db.maxResultsLimit {
+		limit = db.maxResultsLimit
+	}
+
+	query := db.builder.Select("id", "account", "actor", "method", "entity_kind", "entity_id", "before", "after",
+		"source_ip", "created").
+		From("audit_events").
+		Where(qb.Eq{"account": account})
+
+	if filter.EntityKind != "" {
+		query = query.Where(qb.Eq{"entity_kind": filter.EntityKind})
+	}
+
+	if filter.EntityID != "" {
+		query = query.Where(qb.Eq{"entity_id": filter.EntityID})
+	}
+
+	if filter.Since != 0 {
+		query = query.Where(qb.GtOrEq{"created": filter.Since})
+	}
+
+	if filter.Until != 0 {
+		query = query.Where(qb.Lt{"created": filter.Until})
+	}
+
+	sql, args := query.OrderBy("id DESC").Limit(uint64(limit)).Offset(uint64(offset)).MustSql()
+
+	events := []AuditEvent{}
+	err := conn.Select(&events, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return events, nil
+}
+
+// ListAuditEventsAfter returns an account's audit events recorded after the event with the id given, oldest first. Kinds
+// limits the events to entities of those kinds; empty matches every kind.
+func (db *sqlDB) ListAuditEventsAfter(conn Queryable, account string, after int64, kinds []string, limit int) ([]AuditEvent, error) {
+	if limit == 0 || limit > db.maxResultsLimit {
+		limit = db.maxResultsLimit
+	}
+
+	query := db.builder.Select("id", "account", "actor", "method", "entity_kind", "entity_id", "before", "after",
+		"source_ip", "created").
+		From("audit_events").
+		Where(qb.Eq{"account": account}).
+		Where(qb.Gt{"id": after})
+
+	if len(kinds) > 0 {
+		query = query.Where(qb.Eq{"entity_kind": kinds})
+	}
+
+	sql, args := query.OrderBy("id ASC").Limit(uint64(limit)).MustSql()
+
+	events := []AuditEvent{}
+	err := conn.Select(&events, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return events, nil
+}
+
+// ListAuditEventIDsAfter returns the ID and creation time of the events recorded for every account after the given
+// id, oldest first. Transactions can commit in a different order than they were given IDs, so an ID missing from the
+// list may yet be committed; see WatchEvents.
+func (db *sqlDB) ListAuditEventIDsAfter(conn Queryable, after int64, limit int) ([]AuditEvent, error) {
+	if limit == 0 || limit > db.maxResultsLimit {
+		limit = db.maxResultsLimit
+	}
+
+	query, args := db.builder.Select("id", "created").
+		From("audit_events").
+		Where(qb.Gt{"id": after}).
+		OrderBy("id ASC").
+		Limit(uint64(limit)).
+		MustSql()
+
+	events := []AuditEvent{}
+	err := conn.Select(&events, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return events, nil
+}
+
+// GetLatestAuditEventID returns the id of the last event recorded for an account; 0 if there are none.
+func (db *sqlDB) GetLatestAuditEventID(conn Queryable, account string) (int64, error) {
+	query, args := db.builder.Select("COALESCE(MAX(id), 0)").
+		From("audit_events").
+		Where(qb.Eq{"account": account}).
+		MustSql()
+
+	var id int64
+	err := conn.Get(&id, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return id, nil
+}
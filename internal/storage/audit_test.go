@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAuditEvents(t *testing.T) {
+	db, err := newTestDB(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after := `{"id":"test_formula"}`
+
+	events := []AuditEvent{
+		{
+			Account:    "test_account",
+			Actor:      "test_token",
+			Method:     "/proto.Basecoat/CreateFormula",
+			EntityKind: "FORMULA",
+			EntityID:   "test_formula",
+			After:      &after,
+			SourceIP:   "127.0.0.1",
+			Created:    10,
+		},
+		{
+			Account:    "test_account",
+			Actor:      "test_token",
+			Method:     "/proto.Basecoat/CreateBase",
+			EntityKind: "BASE",
+			EntityID:   "test_base",
+			After:      &after,
+			SourceIP:   "127.0.0.1",
+			Created:    20,
+		},
+	}
+
+	for i := range events {
+		err = db.InsertAuditEvent(db, &events[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if events[0].ID == 0 || events[1].ID <= events[0].ID {
+		t.Fatalf("expected increasing event ids; found %d and %d", events[0].ID, events[1].ID)
+	}
+
+	fetchedEvents, err := db.ListAuditEvents(db, "test_account", AuditEventFilter{}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]AuditEvent{events[1], events[0]}, fetchedEvents); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	fetchedEvents, err = db.ListAuditEvents(db, "test_account", AuditEventFilter{EntityKind: "FORMULA"}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]AuditEvent{events[0]}, fetchedEvents); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	fetchedEvents, err = db.ListAuditEvents(db, "test_account", AuditEventFilter{Since: 15}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]AuditEvent{events[1]}, fetchedEvents); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	fetchedEvents, err = db.ListAuditEventsAfter(db, "test_account", 0, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(events, fetchedEvents); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	fetchedEvents, err = db.ListAuditEventsAfter(db, "test_account", events[0].ID, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]AuditEvent{events[1]}, fetchedEvents); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	fetchedEvents, err = db.ListAuditEventsAfter(db, "test_account", 0, []string{"FORMULA"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]AuditEvent{events[0]}, fetchedEvents); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	ids, err := db.ListAuditEventIDsAfter(db, events[0].ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]AuditEvent{{ID: events[1].ID, Created: events[1].Created}}, ids); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	latest, err := db.GetLatestAuditEventID(db, "test_account")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if latest != events[1].ID {
+		t.Errorf("expected latest event id %d; got %d", events[1].ID, latest)
+	}
+
+	latest, err = db.GetLatestAuditEventID(db, "other_account")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if latest != 0 {
+		t.Errorf("expected no latest event id for an account without events; got %d", latest)
+	}
+
+	_, err = db.Exec("DELETE FROM audit_events")
+	if err == nil {
+		t.Fatal("expected audit events to be append-only; delete succeeded")
+	}
+}
@@ -0,0 +1,265 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// backupTimeFormat is the layout used to embed the time a backup was taken into its file name. It sorts
+// lexically in the same order as it sorts chronologically.
+const backupTimeFormat = "20060102T150405Z"
+
+const (
+	backupPrefix = "basecoat-"
+	backupSuffix = ".db"
+)
+
+// ErrIntegrityCheck is returned when a database file fails verification.
+var ErrIntegrityCheck = errors.New("storage: database failed integrity check")
+
+// BackupFile is a single backup located on disk.
+type BackupFile struct {
+	Path  string
+	Taken time.Time
+}
+
+// BackupName returns the file name a backup taken at the given time should be stored under.
+func BackupName(taken time.Time) string {
+	return backupPrefix + taken.UTC().Format(backupTimeFormat) + backupSuffix
+}
+
+// VerifyIntegrity opens the database file at path in read-only mode and makes sure that it is both a structurally
+// sound sqlite database and a Basecoat database.
+func VerifyIntegrity(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("could not open database file %q: %w", path, err)
+	}
+
+	db, err := sqlx.Connect("sqlite3", fmt.Sprintf("file:%s?mode=ro", path))
+	if err != nil {
+		return fmt.Errorf("could not open database file %q: %v; %w", path, err, ErrIntegrityCheck)
+	}
+	defer db.Close()
+
+	results := []string{}
+	err = db.Select(&results, "PRAGMA integrity_check")
+	if err != nil {
+		return fmt.Errorf("could not run integrity check: %v; %w", err, ErrIntegrityCheck)
+	}
+
+	if len(results) != 1 || results[0] != "ok" {
+		return fmt.Errorf("%s; %w", strings.Join(results, "; "), ErrIntegrityCheck)
+	}
+
+	var migrationCount int
+	err = db.Get(&migrationCount, "SELECT count(*) FROM migrations")
+	if err != nil {
+		return fmt.Errorf("not a basecoat database: %v; %w", err, ErrIntegrityCheck)
+	}
+
+	return nil
+}
+
+// sqliteCompanionSuffixes are the files sqlite keeps next to a database in WAL mode. The write-ahead log holds
+// writes which haven't been checkpointed into the database file yet, so a database is only whole together with it.
+var sqliteCompanionSuffixes = []string{"-wal", "-shm"}
+
+// Restore replaces the database at dst with the backup located at src. Both the backup and the copy made of it
+// are verified before the swap happens so that a bad backup never replaces a working database. The database being
+// replaced is kept alongside the restored copy with a ".pre-restore" suffix, along with its write-ahead log.
+//
+// Restore must not be run while the Basecoat service is using dst.
+func Restore(src, dst string) error {
+	err := VerifyIntegrity(src)
+	if err != nil {
+		return fmt.Errorf("backup %q could not be verified: %w", src, err)
+	}
+
+	tmp := dst + ".restore"
+	// Does nothing once the copy has been moved into place.
+	defer os.Remove(tmp)
+
+	err = copyFile(src, tmp)
+	if err != nil {
+		return fmt.Errorf("could not copy backup into place: %w", err)
+	}
+
+	return replaceDatabase(tmp, dst)
+}
+
+// replaceDatabase verifies the database at src and then swaps it in for the one at dst, which is kept with a
+// ".pre-restore" suffix.
+func replaceDatabase(src, dst string) error {
+	err := VerifyIntegrity(src)
+	if err != nil {
+		return fmt.Errorf("restored database could not be verified: %w", err)
+	}
+
+	err = moveDatabase(dst, dst+".pre-restore")
+	if err != nil {
+		return fmt.Errorf("could not move current database out of the way: %w", err)
+	}
+
+	err = os.Rename(src, dst)
+	if err != nil {
+		return fmt.Errorf("could not move restored database into place: %w", err)
+	}
+
+	return nil
+}
+
+// moveDatabase moves the sqlite database at src, along with its write-ahead log and shared memory files, to dst.
+// Companion files already at dst are removed so they aren't mistaken for the moved database's. If any file can't be
+// moved, those already moved are put back. A database which doesn't exist is left as it is.
+func moveDatabase(src, dst string) error {
+	if _, err := os.Stat(src); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	for _, suffix := range sqliteCompanionSuffixes {
+		err := os.Remove(dst + suffix)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("could not remove stale %s file: %w", suffix, err)
+		}
+	}
+
+	moved := []string{}
+	for _, suffix := range append([]string{""}, sqliteCompanionSuffixes...) {
+		err := os.Rename(src+suffix, dst+suffix)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			for _, suffix := range moved {
+				_ = os.Rename(dst+suffix, src+suffix)
+			}
+			return err
+		}
+
+		moved = append(moved, suffix)
+	}
+
+	return nil
+}
+
+// ListBackups returns all backups found in dir ordered from oldest to newest.
+func ListBackups(dir string) ([]BackupFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	backups := []BackupFile{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		name := entry.Name()
+		if !strings.HasPrefix(name, backupPrefix) || !strings.HasSuffix(name, backupSuffix) {
+			continue
+		}
+
+		taken, err := time.Parse(backupTimeFormat, strings.TrimSuffix(strings.TrimPrefix(name, backupPrefix), backupSuffix))
+		if err != nil {
+			continue
+		}
+
+		backups = append(backups, BackupFile{
+			Path:  filepath.Join(dir, name),
+			Taken: taken,
+		})
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Taken.Before(backups[j].Taken)
+	})
+
+	return backups, nil
+}
+
+// FindBackup returns the most recent backup in dir taken at or before the given time.
+func FindBackup(dir string, at time.Time) (BackupFile, error) {
+	backups, err := ListBackups(dir)
+	if err != nil {
+		return BackupFile{}, err
+	}
+
+	for i := len(backups) - 1; i >= 0; i-- {
+		if !backups[i].Taken.After(at) {
+			return backups[i], nil
+		}
+	}
+
+	return BackupFile{}, ErrEntityNotFound
+}
+
+// PruneBackups removes backups from dir which fall outside of the retention rules given. Keep is the number of most
+// recent backups to retain and maxAge is the oldest a backup can be; a zero value disables that rule. The most recent
+// backup is never removed. Returns the paths of the removed backups.
+func PruneBackups(dir string, keep int, maxAge time.Duration, now time.Time) ([]string, error) {
+	backups, err := ListBackups(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := []string{}
+
+	for i, backup := range backups {
+		newerCount := len(backups) - 1 - i
+		if newerCount == 0 {
+			break
+		}
+
+		expiredByCount := keep > 0 && newerCount >= keep
+		expiredByAge := maxAge > 0 && now.Sub(backup.Taken) > maxAge
+
+		if !expiredByCount && !expiredByAge {
+			continue
+		}
+
+		err := os.Remove(backup.Path)
+		if err != nil {
+			return removed, err
+		}
+
+		removed = append(removed, backup.Path)
+	}
+
+	return removed, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(out, in)
+	if err != nil {
+		out.Close()
+		return err
+	}
+
+	err = out.Sync()
+	if err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
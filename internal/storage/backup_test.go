@@ -0,0 +1,200 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	path := tempFile()
+	db, err := New(EngineSQLite, path, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+
+	err = db.InsertAccount(db, &Account{
+		ID: "test_account",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	backupDir := t.TempDir()
+	backupPath := filepath.Join(backupDir, BackupName(time.Now()))
+
+	err = db.Backup(backupPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = VerifyIntegrity(backupPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Changes made after the backup should not be present once restored.
+	err = db.InsertAccount(db, &Account{
+		ID: "test_account_2",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db.Close()
+
+	err = Restore(backupPath, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path + ".pre-restore")
+
+	restored, err := New(EngineSQLite, path, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restored.Close()
+
+	_, err = restored.GetAccount(restored, "test_account")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = restored.GetAccount(restored, "test_account_2")
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Fatal("expected error Not Found; found alternate error")
+	}
+}
+
+func TestRestoreRejectsCorruptBackup(t *testing.T) {
+	path := tempFile()
+	db, err := New(EngineSQLite, path, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+	defer db.Close()
+
+	backupPath := filepath.Join(t.TempDir(), BackupName(time.Now()))
+
+	err = os.WriteFile(backupPath, []byte("this is not a database"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = Restore(backupPath, path)
+	if !errors.Is(err, ErrIntegrityCheck) {
+		t.Fatalf("expected integrity check error; found %v", err)
+	}
+
+	if _, err := os.Stat(path + ".pre-restore"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatal("current database should not have been moved")
+	}
+}
+
+func TestPruneBackups(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 5; i++ {
+		name := BackupName(now.Add(-time.Duration(i) * 24 * time.Hour))
+		err := os.WriteFile(filepath.Join(dir, name), []byte{}, 0o600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	removed, err := PruneBackups(dir, 3, 0, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(removed) != 2 {
+		t.Errorf("expected 2 backups to be removed by count; removed %d", len(removed))
+	}
+
+	removed, err = PruneBackups(dir, 0, 36*time.Hour, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(removed) != 1 {
+		t.Errorf("expected 1 backup to be removed by age; removed %d", len(removed))
+	}
+
+	backups, err := ListBackups(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(backups) != 2 {
+		t.Fatalf("expected 2 remaining backups; found %d", len(backups))
+	}
+
+	found, err := FindBackup(dir, now.Add(-12*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !found.Taken.Equal(now.Add(-24 * time.Hour)) {
+		t.Errorf("expected backup taken at %v; found %v", now.Add(-24*time.Hour), found.Taken)
+	}
+}
+
+func TestRestoreKeepsWriteAheadLogOfReplacedDatabase(t *testing.T) {
+	path := tempFile()
+	db, err := New(EngineSQLite, path, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+	defer db.Close()
+
+	backupPath := filepath.Join(t.TempDir(), BackupName(time.Now()))
+	err = db.Backup(backupPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.InsertAccount(db, &Account{ID: "test_account"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A copy of the database taken while it's open still has its latest writes in the write-ahead log, as it would
+	// after a crash.
+	live := filepath.Join(t.TempDir(), "basecoat.db")
+	for _, suffix := range []string{"", "-wal"} {
+		err = copyFile(path+suffix, live+suffix)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = Restore(backupPath, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(live + ".restore"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected temporary copy of backup to be gone; found %v", err)
+	}
+
+	if _, err := os.Stat(live + "-wal"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected write-ahead log to have moved with the replaced database; found %v", err)
+	}
+
+	replaced, err := New(EngineSQLite, live+".pre-restore", 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer replaced.Close()
+
+	_, err = replaced.GetAccount(replaced, "test_account")
+	if err != nil {
+		t.Fatalf("expected writes in the write-ahead log to be kept with the replaced database; found %v", err)
+	}
+}
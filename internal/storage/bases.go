@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 
 	qb "github.com/Masterminds/squirrel"
 )
@@ -15,20 +14,24 @@ type Base struct {
 	Label        string
 	Manufacturer string
 	Created      int64
+	Version      int64
 }
 
 type UpdatableBaseFields struct {
 	Label        *string
 	Manufacturer *string
+
+	// Only apply the update if the entity is still at this version.
+	Version *int64
 }
 
-func (db *DB) ListBases(conn Queryable, account string, offset, limit int) ([]Base, error) {
+func (db *sqlDB) ListBases(conn Queryable, account string, offset, limit int) ([]Base, error) {
 	if limit == 0 || limit > db.maxResultsLimit {
 		limit = db.maxResultsLimit
 	}
 
-	query, args := qb.Select("account", "id", "label", "manufacturer", "created").
-		From("bases").Where(qb.Eq{"account": account}).
+	query, args := db.builder.Select("account", "id", "label", "manufacturer", "created", "version").
+		From("bases").Where(qb.Eq{"account": account, "deleted": 0}).
 		OrderBy("id").Limit(uint64(limit)).Offset(uint64(offset)).MustSql()
 
 	bases := []Base{}
@@ -40,11 +43,11 @@ func (db *DB) ListBases(conn Queryable, account string, offset, limit int) ([]Ba
 	return bases, nil
 }
 
-func (db *DB) InsertBase(conn Queryable, base *Base) error {
-	_, err := qb.Insert("bases").Columns("account", "id", "label", "manufacturer", "created").
-		Values(base.Account, base.ID, base.Label, base.Manufacturer, base.Created).RunWith(conn).Exec()
+func (db *sqlDB) InsertBase(conn Queryable, base *Base) error {
+	_, err := db.builder.Insert("bases").Columns("account", "id", "label", "manufacturer", "created", "version").
+		Values(base.Account, base.ID, base.Label, base.Manufacturer, base.Created, base.Version).RunWith(conn).Exec()
 	if err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		if db.dialect.IsUniqueViolation(err) {
 			return ErrEntityExists
 		}
 
@@ -54,9 +57,9 @@ func (db *DB) InsertBase(conn Queryable, base *Base) error {
 	return nil
 }
 
-func (db *DB) GetBase(conn Queryable, account, id string) (Base, error) {
-	query, args := qb.Select("account", "id", "label", "manufacturer", "created").From("bases").
-		Where(qb.Eq{"account": account, "id": id}).MustSql()
+func (db *sqlDB) GetBase(conn Queryable, account, id string) (Base, error) {
+	query, args := db.builder.Select("account", "id", "label", "manufacturer", "created", "version").From("bases").
+		Where(qb.Eq{"account": account, "id": id, "deleted": 0}).MustSql()
 
 	base := Base{}
 	err := conn.Get(&base, query, args...)
@@ -71,9 +74,10 @@ func (db *DB) GetBase(conn Queryable, account, id string) (Base, error) {
 	return base, nil
 }
 
-func (db *DB) ListBaseFormulas(conn Queryable, account, base string) ([]FormulaBase, error) {
-	query, args := qb.Select("account", "formula", "base", "amount").From("formula_bases").
-		Where(qb.Eq{"account": account, "base": base}).MustSql()
+func (db *sqlDB) ListBaseFormulas(conn Queryable, account, base string) ([]FormulaBase, error) {
+	query, args := db.builder.Select("account", "formula", "base", "amount").From("formula_bases").
+		Where(qb.Eq{"account": account, "base": base}).
+		Where(notDeleted("formula", "formulas", account)).MustSql()
 
 	formulaBases := []FormulaBase{}
 	err := conn.Select(&formulaBases, query, args...)
@@ -84,8 +88,8 @@ func (db *DB) ListBaseFormulas(conn Queryable, account, base string) ([]FormulaB
 	return formulaBases, nil
 }
 
-func (db *DB) UpdateBase(conn Queryable, account, id string, fields UpdatableBaseFields) error {
-	query := qb.Update("bases")
+func (db *sqlDB) UpdateBase(conn Queryable, account, id string, fields UpdatableBaseFields) error {
+	query := db.builder.Update("bases")
 
 	if fields.Label != nil {
 		query = query.Set("label", fields.Label)
@@ -95,20 +99,11 @@ func (db *DB) UpdateBase(conn Queryable, account, id string, fields UpdatableBas
 		query = query.Set("manufacturer", fields.Manufacturer)
 	}
 
-	_, err := query.Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrEntityNotFound
-		}
-
-		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
-	}
-
-	return nil
+	return db.updateVersioned(conn, "bases", account, id, query, fields.Version)
 }
 
-func (db *DB) DeleteBase(conn Queryable, account, id string) error {
-	_, err := qb.Delete("bases").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
+func (db *sqlDB) DeleteBase(conn Queryable, account, id string) error {
+	_, err := db.builder.Delete("bases").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
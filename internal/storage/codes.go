@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	qb "github.com/Masterminds/squirrel"
+)
+
+// Code is a short code printed on labels which identifies a single entity. Codes are unique across every account so
+// that a scanned code can be resolved without knowing who it belongs to.
+type Code struct {
+	Code       string
+	Account    string
+	EntityKind string `db:"entity_kind"`
+	EntityID   string `db:"entity_id"`
+	Created    int64
+}
+
+// InsertCode saves a new code. ErrEntityExists is returned if either the code is already taken or the entity already
+// has a code.
+func (db *sqlDB) InsertCode(conn Queryable, code *Code) error {
+	_, err := db.builder.Insert("codes").Columns("code", "account", "entity_kind", "entity_id", "created").
+		Values(code.Code, code.Account, code.EntityKind, code.EntityID, code.Created).RunWith(conn).Exec()
+	if err != nil {
+		if db.dialect.IsUniqueViolation(err) {
+			return ErrEntityExists
+		}
+
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// GetCode looks up a code regardless of the account it belongs to.
+func (db *sqlDB) GetCode(conn Queryable, code string) (Code, error) {
+	query, args := db.builder.Select("code", "account", "entity_kind", "entity_id", "created").From("codes").
+		Where(qb.Eq{"code": code}).MustSql()
+
+	fetched := Code{}
+	err := conn.Get(&fetched, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Code{}, ErrEntityNotFound
+		}
+
+		return Code{}, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return fetched, nil
+}
+
+// GetEntityCode returns the code given to an entity.
+func (db *sqlDB) GetEntityCode(conn Queryable, account, kind, id string) (Code, error) {
+	query, args := db.builder.Select("code", "account", "entity_kind", "entity_id", "created").From("codes").
+		Where(qb.Eq{"account": account, "entity_kind": kind, "entity_id": id}).MustSql()
+
+	fetched := Code{}
+	err := conn.Get(&fetched, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Code{}, ErrEntityNotFound
+		}
+
+		return Code{}, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return fetched, nil
+}
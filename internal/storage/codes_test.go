@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCodes(t *testing.T) {
+	db, err := newTestDB(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := Account{
+		ID: "test_account",
+	}
+
+	err = db.InsertAccount(db, &account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code := Code{
+		Code:       "K7MX3QPT",
+		Account:    account.ID,
+		EntityKind: "FORMULA",
+		EntityID:   "test_formula",
+		Created:    2,
+	}
+
+	err = db.InsertCode(db, &code)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fetchedCode, err := db.GetCode(db, code.Code)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(code, fetchedCode); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	fetchedCode, err = db.GetEntityCode(db, account.ID, "FORMULA", "test_formula")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(code, fetchedCode); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	// Neither the code nor the entity can be given out twice.
+	duplicateCode := code
+	duplicateCode.EntityID = "other_formula"
+	err = db.InsertCode(db, &duplicateCode)
+	if !errors.Is(err, ErrEntityExists) {
+		t.Errorf("expected reusing a code to fail with Entity Exists; found %v", err)
+	}
+
+	secondCode := code
+	secondCode.Code = "A2B3C4D5"
+	err = db.InsertCode(db, &secondCode)
+	if !errors.Is(err, ErrEntityExists) {
+		t.Errorf("expected a second code for one entity to fail with Entity Exists; found %v", err)
+	}
+
+	_, err = db.GetCode(db, "NOTACODE")
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected error Not Found; found %v", err)
+	}
+}
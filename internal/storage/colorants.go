@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 
 	qb "github.com/Masterminds/squirrel"
 )
@@ -14,21 +13,28 @@ type Colorant struct {
 	ID           string
 	Label        string
 	Manufacturer string
-	Created      int64
+	// The code a tinting dispenser knows this colorant by.
+	DispenserCode string `db:"dispenser_code"`
+	Created       int64
+	Version       int64
 }
 
 type UpdatableColorantFields struct {
-	Label        *string
-	Manufacturer *string
+	Label         *string
+	Manufacturer  *string
+	DispenserCode *string
+
+	// Only apply the update if the entity is still at this version.
+	Version *int64
 }
 
-func (db *DB) ListColorants(conn Queryable, account string, offset, limit int) ([]Colorant, error) {
+func (db *sqlDB) ListColorants(conn Queryable, account string, offset, limit int) ([]Colorant, error) {
 	if limit == 0 || limit > db.maxResultsLimit {
 		limit = db.maxResultsLimit
 	}
 
-	query, args := qb.Select("account", "id", "label", "manufacturer", "created").
-		From("colorants").Where(qb.Eq{"account": account}).
+	query, args := db.builder.Select("account", "id", "label", "manufacturer", "dispenser_code", "created", "version").
+		From("colorants").Where(qb.Eq{"account": account, "deleted": 0}).
 		OrderBy("id").Limit(uint64(limit)).Offset(uint64(offset)).MustSql()
 
 	colorants := []Colorant{}
@@ -40,11 +46,12 @@ func (db *DB) ListColorants(conn Queryable, account string, offset, limit int) (
 	return colorants, nil
 }
 
-func (db *DB) InsertColorant(conn Queryable, colorant *Colorant) error {
-	_, err := qb.Insert("colorants").Columns("account", "id", "label", "manufacturer", "created").
-		Values(colorant.Account, colorant.ID, colorant.Label, colorant.Manufacturer, colorant.Created).RunWith(conn).Exec()
+func (db *sqlDB) InsertColorant(conn Queryable, colorant *Colorant) error {
+	_, err := db.builder.Insert("colorants").Columns("account", "id", "label", "manufacturer", "dispenser_code", "created", "version").
+		Values(colorant.Account, colorant.ID, colorant.Label, colorant.Manufacturer, colorant.DispenserCode,
+			colorant.Created, colorant.Version).RunWith(conn).Exec()
 	if err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		if db.dialect.IsUniqueViolation(err) {
 			return ErrEntityExists
 		}
 
@@ -54,9 +61,9 @@ func (db *DB) InsertColorant(conn Queryable, colorant *Colorant) error {
 	return nil
 }
 
-func (db *DB) GetColorant(conn Queryable, account, id string) (Colorant, error) {
-	query, args := qb.Select("account", "id", "label", "manufacturer", "created").From("colorants").
-		Where(qb.Eq{"account": account, "id": id}).MustSql()
+func (db *sqlDB) GetColorant(conn Queryable, account, id string) (Colorant, error) {
+	query, args := db.builder.Select("account", "id", "label", "manufacturer", "dispenser_code", "created", "version").From("colorants").
+		Where(qb.Eq{"account": account, "id": id, "deleted": 0}).MustSql()
 
 	colorant := Colorant{}
 	err := conn.Get(&colorant, query, args...)
@@ -71,8 +78,8 @@ func (db *DB) GetColorant(conn Queryable, account, id string) (Colorant, error)
 	return colorant, nil
 }
 
-func (db *DB) UpdateColorant(conn Queryable, account, id string, fields UpdatableColorantFields) error {
-	query := qb.Update("colorants")
+func (db *sqlDB) UpdateColorant(conn Queryable, account, id string, fields UpdatableColorantFields) error {
+	query := db.builder.Update("colorants")
 
 	if fields.Label != nil {
 		query = query.Set("label", fields.Label)
@@ -82,20 +89,15 @@ func (db *DB) UpdateColorant(conn Queryable, account, id string, fields Updatabl
 		query = query.Set("manufacturer", fields.Manufacturer)
 	}
 
-	_, err := query.Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrEntityNotFound
-		}
-
-		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	if fields.DispenserCode != nil {
+		query = query.Set("dispenser_code", fields.DispenserCode)
 	}
 
-	return nil
+	return db.updateVersioned(conn, "colorants", account, id, query, fields.Version)
 }
 
-func (db *DB) DeleteColorant(conn Queryable, account, id string) error {
-	_, err := qb.Delete("colorants").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
+func (db *sqlDB) DeleteColorant(conn Queryable, account, id string) error {
+	_, err := db.builder.Delete("colorants").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
@@ -107,24 +109,18 @@ func (db *DB) DeleteColorant(conn Queryable, account, id string) error {
 	return nil
 }
 
-func (db *DB) AssociateColorantWithFormula(conn Queryable, formulaColorant *FormulaColorant) error {
-	_, err := qb.Insert("formula_colorants").Columns("account", "formula", "colorant", "amount").Values(
+func (db *sqlDB) AssociateColorantWithFormula(conn Queryable, formulaColorant *FormulaColorant) error {
+	insert := db.builder.Insert("formula_colorants").Columns("account", "formula", "colorant", "amount").Values(
 		formulaColorant.Account, formulaColorant.Formula, formulaColorant.Colorant, formulaColorant.Amount,
-	).RunWith(conn).Exec()
-	if err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
-			return ErrEntityExists
-		}
+	)
 
-		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
-	}
-
-	return nil
+	return db.insertAssociation(conn, insert)
 }
 
-func (db *DB) ListFormulaColorants(conn Queryable, account, formula string) ([]FormulaColorant, error) {
-	query, args := qb.Select("account", "formula", "colorant", "amount").From("formula_colorants").
-		Where(qb.Eq{"account": account, "formula": formula}).MustSql()
+func (db *sqlDB) ListFormulaColorants(conn Queryable, account, formula string) ([]FormulaColorant, error) {
+	query, args := db.builder.Select("account", "formula", "colorant", "amount").From("formula_colorants").
+		Where(qb.Eq{"account": account, "formula": formula}).
+		Where(notDeleted("colorant", "colorants", account)).MustSql()
 
 	formulaColorants := []FormulaColorant{}
 	err := conn.Select(&formulaColorants, query, args...)
@@ -135,9 +131,10 @@ func (db *DB) ListFormulaColorants(conn Queryable, account, formula string) ([]F
 	return formulaColorants, nil
 }
 
-func (db *DB) ListColorantFormulas(conn Queryable, account, colorant string) ([]FormulaColorant, error) {
-	query, args := qb.Select("account", "formula", "colorant", "amount").From("formula_colorants").
-		Where(qb.Eq{"account": account, "colorant": colorant}).MustSql()
+func (db *sqlDB) ListColorantFormulas(conn Queryable, account, colorant string) ([]FormulaColorant, error) {
+	query, args := db.builder.Select("account", "formula", "colorant", "amount").From("formula_colorants").
+		Where(qb.Eq{"account": account, "colorant": colorant}).
+		Where(notDeleted("formula", "formulas", account)).MustSql()
 
 	formulaColorants := []FormulaColorant{}
 	err := conn.Select(&formulaColorants, query, args...)
@@ -148,8 +145,8 @@ func (db *DB) ListColorantFormulas(conn Queryable, account, colorant string) ([]
 	return formulaColorants, nil
 }
 
-func (db *DB) DeleteFormulaColorant(conn Queryable, account, formula, colorant string) error {
-	_, err := qb.Delete("formula_colorants").Where(qb.Eq{"account": account, "formula": formula, "colorant": colorant}).RunWith(conn).Exec()
+func (db *sqlDB) DeleteFormulaColorant(conn Queryable, account, formula, colorant string) error {
+	_, err := db.builder.Delete("formula_colorants").Where(qb.Eq{"account": account, "formula": formula, "colorant": colorant}).RunWith(conn).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
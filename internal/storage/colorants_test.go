@@ -2,19 +2,16 @@ package storage
 
 import (
 	"errors"
-	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 )
 
 func TestCRUDColorants(t *testing.T) {
-	path := tempFile()
-	db, err := New(path, 200)
+	db, err := newTestDB(t)
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer os.Remove(path)
 
 	account := Account{
 		ID: "test_account",
@@ -36,11 +33,12 @@ func TestCRUDColorants(t *testing.T) {
 	}
 
 	colorant := Colorant{
-		Account:      account.ID,
-		ID:           "test_colorant",
-		Label:        "label",
-		Manufacturer: "test_colorant_manu",
-		Created:      2,
+		Account:       account.ID,
+		ID:            "test_colorant",
+		Label:         "label",
+		Manufacturer:  "test_colorant_manu",
+		DispenserCode: "KX",
+		Created:       2,
 	}
 
 	err = db.InsertColorant(db, &colorant)
@@ -70,6 +68,21 @@ func TestCRUDColorants(t *testing.T) {
 		t.Errorf("unexpected map values (-want +got):\n%s", diff)
 	}
 
+	dispenserCode := "AXX"
+	err = db.UpdateColorant(db, account.ID, colorant.ID, UpdatableColorantFields{DispenserCode: &dispenserCode})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fetchedColorant, err = db.GetColorant(db, account.ID, colorant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fetchedColorant.DispenserCode != dispenserCode {
+		t.Errorf("expected dispenser code %q; found %q", dispenserCode, fetchedColorant.DispenserCode)
+	}
+
 	formulaColorant := FormulaColorant{
 		Account:  "test_account",
 		Formula:  "test_formula",
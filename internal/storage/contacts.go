@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 
 	qb "github.com/Masterminds/squirrel"
 )
@@ -17,6 +16,7 @@ type Contact struct {
 	Phone    string
 	Created  int64
 	Modified int64
+	Version  int64
 }
 
 type UpdatableContactFields struct {
@@ -24,16 +24,19 @@ type UpdatableContactFields struct {
 	Email    *string
 	Phone    *string
 	Modified *int64
+
+	// Only apply the update if the entity is still at this version.
+	Version *int64
 }
 
-func (db *DB) ListContacts(conn Queryable, account string, offset, limit int) ([]Contact, error) {
+func (db *sqlDB) ListContacts(conn Queryable, account string, offset, limit int) ([]Contact, error) {
 	if limit == 0 || limit > db.maxResultsLimit {
 		limit = db.maxResultsLimit
 	}
 
-	query, args := qb.Select("account", "id", "name", "email", "phone", "created", "modified").
+	query, args := db.builder.Select("account", "id", "name", "email", "phone", "created", "modified", "version").
 		From("contacts").
-		Where(qb.Eq{"account": account}).
+		Where(qb.Eq{"account": account, "deleted": 0}).
 		OrderBy("id").
 		Limit(uint64(limit)).
 		Offset(uint64(offset)).
@@ -48,12 +51,12 @@ func (db *DB) ListContacts(conn Queryable, account string, offset, limit int) ([
 	return contacts, nil
 }
 
-func (db *DB) InsertContact(conn Queryable, contact *Contact) error {
-	_, err := qb.Insert("contacts").Columns("account", "id", "name", "email", "phone", "created", "modified").Values(
-		contact.Account, contact.ID, contact.Name, contact.Email, contact.Phone, contact.Created, contact.Modified,
+func (db *sqlDB) InsertContact(conn Queryable, contact *Contact) error {
+	_, err := db.builder.Insert("contacts").Columns("account", "id", "name", "email", "phone", "created", "modified", "version").Values(
+		contact.Account, contact.ID, contact.Name, contact.Email, contact.Phone, contact.Created, contact.Modified, contact.Version,
 	).RunWith(conn).Exec()
 	if err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		if db.dialect.IsUniqueViolation(err) {
 			return ErrEntityExists
 		}
 
@@ -63,9 +66,9 @@ func (db *DB) InsertContact(conn Queryable, contact *Contact) error {
 	return nil
 }
 
-func (db *DB) GetContact(conn Queryable, account, id string) (Contact, error) {
-	query, args := qb.Select("account", "id", "name", "email", "phone", "created", "modified").From("contacts").
-		Where(qb.Eq{"account": account, "id": id}).MustSql()
+func (db *sqlDB) GetContact(conn Queryable, account, id string) (Contact, error) {
+	query, args := db.builder.Select("account", "id", "name", "email", "phone", "created", "modified", "version").From("contacts").
+		Where(qb.Eq{"account": account, "id": id, "deleted": 0}).MustSql()
 
 	contact := Contact{}
 	err := conn.Get(&contact, query, args...)
@@ -80,8 +83,8 @@ func (db *DB) GetContact(conn Queryable, account, id string) (Contact, error) {
 	return contact, nil
 }
 
-func (db *DB) UpdateContact(conn Queryable, account, id string, fields UpdatableContactFields) error {
-	query := qb.Update("contacts")
+func (db *sqlDB) UpdateContact(conn Queryable, account, id string, fields UpdatableContactFields) error {
+	query := db.builder.Update("contacts")
 
 	if fields.Name != nil {
 		query = query.Set("name", fields.Name)
@@ -99,20 +102,11 @@ func (db *DB) UpdateContact(conn Queryable, account, id string, fields Updatable
 		query = query.Set("modified", fields.Modified)
 	}
 
-	_, err := query.Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrEntityNotFound
-		}
-
-		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
-	}
-
-	return nil
+	return db.updateVersioned(conn, "contacts", account, id, query, fields.Version)
 }
 
-func (db *DB) DeleteContact(conn Queryable, account, id string) error {
-	_, err := qb.Delete("contacts").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
+func (db *sqlDB) DeleteContact(conn Queryable, account, id string) error {
+	_, err := db.builder.Delete("contacts").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
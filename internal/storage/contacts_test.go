@@ -2,19 +2,16 @@ package storage
 
 import (
 	"errors"
-	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 )
 
 func TestCRUDContacts(t *testing.T) {
-	path := tempFile()
-	db, err := New(path, 200)
+	db, err := newTestDB(t)
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer os.Remove(path)
 
 	account := Account{
 		ID: "test_account",
@@ -64,6 +61,7 @@ func TestCRUDContacts(t *testing.T) {
 
 	contact.Name = "Updated Contact"
 	contact.Modified = 1
+	contact.Version = 1
 
 	err = db.UpdateContact(db, account.ID, contact.ID, UpdatableContactFields{
 		Name:     &contact.Name,
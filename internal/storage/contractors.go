@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 
 	qb "github.com/Masterminds/squirrel"
 )
@@ -16,22 +15,26 @@ type Contractor struct {
 	Contact  *string
 	Created  int64
 	Modified int64
+	Version  int64
 }
 
 type UpdatableContractorFields struct {
 	Company  *string
 	Contact  *string
 	Modified *int64
+
+	// Only apply the update if the entity is still at this version.
+	Version *int64
 }
 
-func (db *DB) ListContractors(conn Queryable, account string, offset, limit int) ([]Contractor, error) {
+func (db *sqlDB) ListContractors(conn Queryable, account string, offset, limit int) ([]Contractor, error) {
 	if limit == 0 || limit > db.maxResultsLimit {
 		limit = db.maxResultsLimit
 	}
 
-	query, args := qb.Select("account", "id", "company", "contact", "created", "modified").
+	query, args := db.builder.Select("account", "id", "company", "contact", "created", "modified", "version").
 		From("contractors").
-		Where(qb.Eq{"account": account}).
+		Where(qb.Eq{"account": account, "deleted": 0}).
 		OrderBy("id").
 		Limit(uint64(limit)).
 		Offset(uint64(offset)).
@@ -46,12 +49,12 @@ func (db *DB) ListContractors(conn Queryable, account string, offset, limit int)
 	return contractors, nil
 }
 
-func (db *DB) InsertContractor(conn Queryable, contractor *Contractor) error {
-	_, err := qb.Insert("contractors").Columns("account", "id", "company", "contact", "created", "modified").Values(
-		contractor.Account, contractor.ID, contractor.Company, contractor.Contact, contractor.Created, contractor.Modified,
+func (db *sqlDB) InsertContractor(conn Queryable, contractor *Contractor) error {
+	_, err := db.builder.Insert("contractors").Columns("account", "id", "company", "contact", "created", "modified", "version").Values(
+		contractor.Account, contractor.ID, contractor.Company, contractor.Contact, contractor.Created, contractor.Modified, contractor.Version,
 	).RunWith(conn).Exec()
 	if err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		if db.dialect.IsUniqueViolation(err) {
 			return ErrEntityExists
 		}
 
@@ -61,9 +64,9 @@ func (db *DB) InsertContractor(conn Queryable, contractor *Contractor) error {
 	return nil
 }
 
-func (db *DB) GetContractor(conn Queryable, account, id string) (Contractor, error) {
-	query, args := qb.Select("account", "id", "company", "contact", "created", "modified").From("contractors").
-		Where(qb.Eq{"account": account, "id": id}).MustSql()
+func (db *sqlDB) GetContractor(conn Queryable, account, id string) (Contractor, error) {
+	query, args := db.builder.Select("account", "id", "company", "contact", "created", "modified", "version").From("contractors").
+		Where(qb.Eq{"account": account, "id": id, "deleted": 0}).MustSql()
 
 	contractor := Contractor{}
 	err := conn.Get(&contractor, query, args...)
@@ -78,8 +81,8 @@ func (db *DB) GetContractor(conn Queryable, account, id string) (Contractor, err
 	return contractor, nil
 }
 
-func (db *DB) UpdateContractor(conn Queryable, account, id string, fields UpdatableContractorFields) error {
-	query := qb.Update("contractors")
+func (db *sqlDB) UpdateContractor(conn Queryable, account, id string, fields UpdatableContractorFields) error {
+	query := db.builder.Update("contractors")
 
 	if fields.Company != nil {
 		query = query.Set("company", fields.Company)
@@ -93,20 +96,11 @@ func (db *DB) UpdateContractor(conn Queryable, account, id string, fields Updata
 		query = query.Set("modified", fields.Modified)
 	}
 
-	_, err := query.Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrEntityNotFound
-		}
-
-		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
-	}
-
-	return nil
+	return db.updateVersioned(conn, "contractors", account, id, query, fields.Version)
 }
 
-func (db *DB) DeleteContractor(conn Queryable, account, id string) error {
-	_, err := qb.Delete("contractors").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
+func (db *sqlDB) DeleteContractor(conn Queryable, account, id string) error {
+	_, err := db.builder.Delete("contractors").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
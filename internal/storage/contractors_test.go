@@ -2,19 +2,16 @@ package storage
 
 import (
 	"errors"
-	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 )
 
 func TestCRUDContractors(t *testing.T) {
-	path := tempFile()
-	db, err := New(path, 200)
+	db, err := newTestDB(t)
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer os.Remove(path)
 
 	account := Account{
 		ID: "test_account",
@@ -71,6 +68,7 @@ func TestCRUDContractors(t *testing.T) {
 
 	contractor.Company = "Updated Contractor"
 	contractor.Modified = 1
+	contractor.Version = 1
 
 	err = db.UpdateContractor(db, account.ID, contractor.ID, UpdatableContractorFields{
 		Company:  &contractor.Company,
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"fmt"
+
+	qb "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// Engine is the name of a database Basecoat is able to store its data in.
+type Engine string
+
+const (
+	EngineSQLite   Engine = "sqlite"
+	EnginePostgres Engine = "postgres"
+)
+
+// Dialect is supplied by each database engine Basecoat supports to the queries they share. All storage queries are
+// written once against the query builder; the dialect only supplies the parts which differ between engines.
+type Dialect interface {
+	// Engine returns the name of the engine this dialect describes.
+	Engine() Engine
+
+	// Open connects to the database described by dsn. For sqlite this is the path to the database file and for
+	// postgres it is a connection string.
+	Open(dsn string) (*sqlx.DB, error)
+
+	// Migrations returns the engine's schema migrations in the order they should be run.
+	Migrations() []migration
+
+	// Placeholder is the bind parameter format expected by the engine's driver.
+	Placeholder() qb.PlaceholderFormat
+
+	// IsUniqueViolation reports whether err was caused by an insert conflicting with an existing primary key or
+	// unique constraint.
+	IsUniqueViolation(err error) bool
+}
+
+// dialectMigrations reads the migrations stored under migrations/<engine>/ in the order given.
+func dialectMigrations(engine Engine, files ...string) []migration {
+	migrations := []migration{}
+	for id, file := range files {
+		migrations = append(migrations,
+			migrationQuery(fmt.Sprint(id), string(mustReadFile(fmt.Sprintf("migrations/%s/%s", engine, file)))))
+	}
+
+	return migrations
+}
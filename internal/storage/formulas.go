@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 
 	qb "github.com/Masterminds/squirrel"
 )
@@ -15,8 +14,11 @@ type Formula struct {
 	Name     string
 	Number   string
 	Notes    string
+	Sheen    string
+	Color    string
 	Created  int64
 	Modified int64
+	Version  int64
 }
 
 type FormulaColorant struct {
@@ -37,17 +39,23 @@ type UpdatableFormulaFields struct {
 	Name     *string
 	Number   *string
 	Notes    *string
+	Sheen    *string
+	Color    *string
 	Modified *int64
+
+	// Only apply the update if the entity is still at this version.
+	Version *int64
 }
 
-func (db *DB) ListFormulas(conn Queryable, account string, offset, limit int) ([]Formula, error) {
+func (db *sqlDB) ListFormulas(conn Queryable, account string, offset, limit int) ([]Formula, error) {
 	if limit == 0 || limit > db.maxResultsLimit {
 		limit = db.maxResultsLimit
 	}
 
-	query, args := qb.Select("account", "id", "name", "number", "notes", "created", "modified").
+	query, args := db.builder.Select("account", "id", "name", "number", "notes", "sheen", "color", "created", "modified",
+		"version").
 		From("formulas").
-		Where(qb.Eq{"account": account}).
+		Where(qb.Eq{"account": account, "deleted": 0}).
 		OrderBy("id").
 		Limit(uint64(limit)).
 		Offset(uint64(offset)).
@@ -62,12 +70,14 @@ func (db *DB) ListFormulas(conn Queryable, account string, offset, limit int) ([
 	return formulas, nil
 }
 
-func (db *DB) InsertFormula(conn Queryable, formula *Formula) error {
-	_, err := qb.Insert("formulas").Columns("account", "id", "name", "number", "notes", "created", "modified").Values(
-		formula.Account, formula.ID, formula.Name, formula.Number, formula.Notes, formula.Created, formula.Modified,
+func (db *sqlDB) InsertFormula(conn Queryable, formula *Formula) error {
+	_, err := db.builder.Insert("formulas").Columns("account", "id", "name", "number", "notes", "sheen", "color",
+		"created", "modified", "version").Values(
+		formula.Account, formula.ID, formula.Name, formula.Number, formula.Notes, formula.Sheen, formula.Color,
+		formula.Created, formula.Modified, formula.Version,
 	).RunWith(conn).Exec()
 	if err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		if db.dialect.IsUniqueViolation(err) {
 			return ErrEntityExists
 		}
 
@@ -77,24 +87,18 @@ func (db *DB) InsertFormula(conn Queryable, formula *Formula) error {
 	return nil
 }
 
-func (db *DB) AssociateBaseWithFormula(conn Queryable, formulaBase *FormulaBase) error {
-	_, err := qb.Insert("formula_bases").Columns("account", "formula", "base", "amount").Values(
+func (db *sqlDB) AssociateBaseWithFormula(conn Queryable, formulaBase *FormulaBase) error {
+	insert := db.builder.Insert("formula_bases").Columns("account", "formula", "base", "amount").Values(
 		formulaBase.Account, formulaBase.Formula, formulaBase.Base, formulaBase.Amount,
-	).RunWith(conn).Exec()
-	if err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
-			return ErrEntityExists
-		}
+	)
 
-		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
-	}
-
-	return nil
+	return db.insertAssociation(conn, insert)
 }
 
-func (db *DB) ListFormulaBases(conn Queryable, account, formula string) ([]FormulaBase, error) {
-	query, args := qb.Select("account", "formula", "base", "amount").From("formula_bases").
-		Where(qb.Eq{"account": account, "formula": formula}).MustSql()
+func (db *sqlDB) ListFormulaBases(conn Queryable, account, formula string) ([]FormulaBase, error) {
+	query, args := db.builder.Select("account", "formula", "base", "amount").From("formula_bases").
+		Where(qb.Eq{"account": account, "formula": formula}).
+		Where(notDeleted("base", "bases", account)).MustSql()
 
 	formulaBases := []FormulaBase{}
 	err := conn.Select(&formulaBases, query, args...)
@@ -105,8 +109,8 @@ func (db *DB) ListFormulaBases(conn Queryable, account, formula string) ([]Formu
 	return formulaBases, nil
 }
 
-func (db *DB) DeleteFormulaBase(conn Queryable, account, formula, base string) error {
-	_, err := qb.Delete("formula_bases").Where(qb.Eq{"account": account, "formula": formula, "base": base}).RunWith(conn).Exec()
+func (db *sqlDB) DeleteFormulaBase(conn Queryable, account, formula, base string) error {
+	_, err := db.builder.Delete("formula_bases").Where(qb.Eq{"account": account, "formula": formula, "base": base}).RunWith(conn).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
@@ -118,9 +122,10 @@ func (db *DB) DeleteFormulaBase(conn Queryable, account, formula, base string) e
 	return nil
 }
 
-func (db *DB) ListFormulaJobs(conn Queryable, account, formula string) ([]FormulaJob, error) {
-	query, args := qb.Select("account", "job", "formula").From("formula_jobs").
-		Where(qb.Eq{"account": account, "formula": formula}).MustSql()
+func (db *sqlDB) ListFormulaJobs(conn Queryable, account, formula string) ([]FormulaJob, error) {
+	query, args := db.builder.Select("account", "job", "formula", "area").From("formula_jobs").
+		Where(qb.Eq{"account": account, "formula": formula}).
+		Where(notDeleted("job", "jobs", account)).MustSql()
 
 	jobFormulas := []FormulaJob{}
 	err := conn.Select(&jobFormulas, query, args...)
@@ -131,9 +136,10 @@ func (db *DB) ListFormulaJobs(conn Queryable, account, formula string) ([]Formul
 	return jobFormulas, nil
 }
 
-func (db *DB) GetFormula(conn Queryable, account, id string) (Formula, error) {
-	query, args := qb.Select("account", "id", "name", "number", "notes", "created", "modified").From("formulas").
-		Where(qb.Eq{"account": account, "id": id}).MustSql()
+func (db *sqlDB) GetFormula(conn Queryable, account, id string) (Formula, error) {
+	query, args := db.builder.Select("account", "id", "name", "number", "notes", "sheen", "color", "created", "modified",
+		"version").From("formulas").
+		Where(qb.Eq{"account": account, "id": id, "deleted": 0}).MustSql()
 
 	formula := Formula{}
 	err := conn.Get(&formula, query, args...)
@@ -148,8 +154,8 @@ func (db *DB) GetFormula(conn Queryable, account, id string) (Formula, error) {
 	return formula, nil
 }
 
-func (db *DB) UpdateFormula(conn Queryable, account, id string, fields UpdatableFormulaFields) error {
-	query := qb.Update("formulas")
+func (db *sqlDB) UpdateFormula(conn Queryable, account, id string, fields UpdatableFormulaFields) error {
+	query := db.builder.Update("formulas")
 
 	if fields.Name != nil {
 		query = query.Set("name", fields.Name)
@@ -163,24 +169,23 @@ func (db *DB) UpdateFormula(conn Queryable, account, id string, fields Updatable
 		query = query.Set("notes", fields.Notes)
 	}
 
-	if fields.Modified != nil {
-		query = query.Set("modified", fields.Modified)
+	if fields.Sheen != nil {
+		query = query.Set("sheen", fields.Sheen)
 	}
 
-	_, err := query.Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrEntityNotFound
-		}
+	if fields.Color != nil {
+		query = query.Set("color", fields.Color)
+	}
 
-		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	if fields.Modified != nil {
+		query = query.Set("modified", fields.Modified)
 	}
 
-	return nil
+	return db.updateVersioned(conn, "formulas", account, id, query, fields.Version)
 }
 
-func (db *DB) DeleteFormula(conn Queryable, account, id string) error {
-	_, err := qb.Delete("formulas").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
+func (db *sqlDB) DeleteFormula(conn Queryable, account, id string) error {
+	_, err := db.builder.Delete("formulas").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
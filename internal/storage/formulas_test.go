@@ -2,19 +2,16 @@ package storage
 
 import (
 	"errors"
-	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 )
 
 func TestCRUDFormulas(t *testing.T) {
-	path := tempFile()
-	db, err := New(path, 200)
+	db, err := newTestDB(t)
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer os.Remove(path)
 
 	account := Account{
 		ID: "test_account",
@@ -31,6 +28,8 @@ func TestCRUDFormulas(t *testing.T) {
 		Name:     "Test Formula",
 		Number:   "Formula number",
 		Notes:    "Formula notes",
+		Sheen:    "Eggshell",
+		Color:    "#4A5A6B",
 		Created:  0,
 		Modified: 0,
 	}
@@ -63,16 +62,21 @@ func TestCRUDFormulas(t *testing.T) {
 	}
 
 	formula.Name = "Updated Formula"
+	formula.Sheen = "Satin"
 	formula.Modified = 1
 
 	err = db.UpdateFormula(db, account.ID, formula.ID, UpdatableFormulaFields{
 		Name:     &formula.Name,
+		Sheen:    &formula.Sheen,
 		Modified: &formula.Modified,
+		Version:  &formula.Version,
 	})
 	if err != nil {
 		t.Fatal(err)
 	}
 
+	formula.Version = 1
+
 	fetchedFormula, err = db.GetFormula(db, account.ID, formula.ID)
 	if err != nil {
 		t.Fatal(err)
@@ -82,6 +86,23 @@ func TestCRUDFormulas(t *testing.T) {
 		t.Errorf("unexpected map values (-want +got):\n%s", diff)
 	}
 
+	staleVersion := int64(0)
+	err = db.UpdateFormula(db, account.ID, formula.ID, UpdatableFormulaFields{
+		Notes:   ptr("stale notes"),
+		Version: &staleVersion,
+	})
+	if !errors.Is(err, ErrVersionMismatch) {
+		t.Fatalf("expected error version mismatch when updating a stale formula; found %v", err)
+	}
+
+	err = db.UpdateFormula(db, account.ID, "missing_formula", UpdatableFormulaFields{
+		Notes:   ptr("missing notes"),
+		Version: &staleVersion,
+	})
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Fatalf("expected error not found when updating a missing formula; found %v", err)
+	}
+
 	newColorant := Colorant{
 		Account:      account.ID,
 		ID:           "test_colorant",
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	qb "github.com/Masterminds/squirrel"
+)
+
+// IdempotencyKey is the response to a call made with an idempotency key. A call retried with the same key is answered
+// with the response kept instead of being made again. A key is claimed before the call is made and its response is
+// filled in by the same transaction that makes the call's changes, so a key is only ever seen with its response.
+type IdempotencyKey struct {
+	Account string
+	Key     string
+	Method  string
+	// A hash of the request the key was first used with; a key can't be reused for a different request.
+	RequestHash string `db:"request_hash"`
+	Response    []byte
+	Created     int64
+}
+
+// InsertIdempotencyKey claims an idempotency key for a call, optionally along with its response. A key used before
+// expired is replaced since it's no longer honored; ErrEntityExists is returned if the key was used since. The
+// uniqueness of a key within an account is what keeps two servers from both making the same call: a claim made while
+// another transaction holds an uncommitted claim on the key waits for it to finish.
+func (db *sqlDB) InsertIdempotencyKey(conn Queryable, key *IdempotencyKey, expired int64) error {
+	result, err := db.builder.Insert("idempotency_keys").
+		Columns("account", "key", "method", "request_hash", "response", "created").
+		Values(key.Account, key.Key, key.Method, key.RequestHash, key.Response, key.Created).
+		Suffix(`ON CONFLICT (account, key) DO UPDATE SET method = excluded.method,
+		request_hash = excluded.request_hash, response = excluded.response, created = excluded.created
+		WHERE idempotency_keys.created < ?`, expired).
+		RunWith(conn).Exec()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	if rows == 0 {
+		return ErrEntityExists
+	}
+
+	return nil
+}
+
+// UpdateIdempotencyKeyResponse saves the response to the call a key was claimed for. Returns ErrEntityNotFound if the
+// key was never claimed.
+func (db *sqlDB) UpdateIdempotencyKeyResponse(conn Queryable, account, key string, response []byte) error {
+	result, err := db.builder.Update("idempotency_keys").Set("response", response).
+		Where(qb.Eq{"account": account, "key": key}).RunWith(conn).Exec()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	if rows == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// GetIdempotencyKey returns the key given if it was used after the time given; ErrEntityNotFound otherwise.
+func (db *sqlDB) GetIdempotencyKey(conn Queryable, account, key string, after int64) (IdempotencyKey, error) {
+	query, args := db.builder.Select("account", "key", "method", "request_hash", "response", "created").
+		From("idempotency_keys").Where(qb.Eq{"account": account, "key": key}).Where(qb.GtOrEq{"created": after}).
+		MustSql()
+
+	idempotencyKey := IdempotencyKey{}
+	err := conn.Get(&idempotencyKey, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return IdempotencyKey{}, ErrEntityNotFound
+		}
+
+		return IdempotencyKey{}, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return idempotencyKey, nil
+}
+
+// PurgeIdempotencyKeys removes every key used before the time given so that it can be used again. Returns the number
+// of keys removed.
+func (db *sqlDB) PurgeIdempotencyKeys(conn Queryable, before int64) (int64, error) {
+	result, err := db.builder.Delete("idempotency_keys").Where(qb.Lt{"created": before}).RunWith(conn).Exec()
+	if err != nil {
+		return 0, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return rows, nil
+}
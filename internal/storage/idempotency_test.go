@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	db, err := newTestDB(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := Account{
+		ID: "test_account",
+	}
+
+	err = db.InsertAccount(db, &account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := IdempotencyKey{
+		Account:     account.ID,
+		Key:         "test_key",
+		Method:      "/proto.Basecoat/CreateFormula",
+		RequestHash: "test_hash",
+		Response:    []byte{0x0a, 0x02, 0x01, 0x02},
+		Created:     10,
+	}
+
+	err = db.InsertIdempotencyKey(db, &key, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.InsertIdempotencyKey(db, &key, 5)
+	if !errors.Is(err, ErrEntityExists) {
+		t.Errorf("expected ErrEntityExists inserting a key twice; got %v", err)
+	}
+
+	fetchedKey, err := db.GetIdempotencyKey(db, account.ID, "test_key", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(key, fetchedKey); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	// Keys used before the window are treated as if they were never used.
+	_, err = db.GetIdempotencyKey(db, account.ID, "test_key", 11)
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound for a key outside the window; got %v", err)
+	}
+
+	_, err = db.GetIdempotencyKey(db, "other_account", "test_key", 5)
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound for another account's key; got %v", err)
+	}
+
+	// Keys which expired are replaced even before they're purged.
+	renewedKey := key
+	renewedKey.Created = 20
+	err = db.InsertIdempotencyKey(db, &renewedKey, 11)
+	if err != nil {
+		t.Errorf("expected an expired key to be usable again; got %v", err)
+	}
+
+	purged, err := db.PurgeIdempotencyKeys(db, 21)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if purged != 1 {
+		t.Errorf("expected 1 key to be purged; purged %d", purged)
+	}
+
+	err = db.InsertIdempotencyKey(db, &key, 0)
+	if err != nil {
+		t.Errorf("expected a purged key to be usable again; got %v", err)
+	}
+}
+
+func TestIdempotencyKeyClaims(t *testing.T) {
+	tests := map[string]struct {
+		commit   bool
+		expected error // What a second claim made while the first is still open gets once the first finishes.
+	}{
+		"first claim commits": {
+			commit:   true,
+			expected: ErrEntityExists,
+		},
+		"first claim rolls back": {
+			commit:   false,
+			expected: nil,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, err := newTestDB(t)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.InsertAccount(db, &Account{ID: "test_account"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			claim := IdempotencyKey{
+				Account:     "test_account",
+				Key:         "test_key",
+				Method:      "/proto.Basecoat/CreateFormula",
+				RequestHash: "test_hash",
+				Response:    []byte{},
+				Created:     10,
+			}
+
+			tx, err := db.Beginx()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.InsertIdempotencyKey(tx, &claim, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			second := make(chan error)
+			go func() {
+				second <- InsideTx(db, func(tx *sqlx.Tx) error {
+					return db.InsertIdempotencyKey(tx, &claim, 5)
+				})
+			}()
+
+			err = db.UpdateIdempotencyKeyResponse(tx, "test_account", "test_key", []byte{0x0a})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.commit {
+				err = tx.Commit()
+			} else {
+				err = tx.Rollback()
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = <-second
+			if !errors.Is(err, tc.expected) {
+				t.Errorf("expected second claim to return %v; got %v", tc.expected, err)
+			}
+		})
+	}
+
+	db, err := newTestDB(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.UpdateIdempotencyKeyResponse(db, "test_account", "missing_key", []byte{0x0a})
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound saving the response of an unclaimed key; got %v", err)
+	}
+}
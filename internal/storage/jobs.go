@@ -4,7 +4,6 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
 
 	qb "github.com/Masterminds/squirrel"
 )
@@ -19,30 +18,36 @@ type Job struct {
 	Contact    *string
 	Created    int64
 	Modified   int64
+	Version    int64
 }
 
 type FormulaJob struct {
 	Account string
 	Job     string
 	Formula string
+	Area    string
 }
 
 type UpdatableJobFields struct {
-	Name     *string
-	Address  *string
-	Notes    *string
-	Contact  *string
-	Modified *int64
+	Name       *string
+	Contractor *string
+	Address    *string
+	Notes      *string
+	Contact    *string
+	Modified   *int64
+
+	// Only apply the update if the entity is still at this version.
+	Version *int64
 }
 
-func (db *DB) ListJobs(conn Queryable, account string, offset, limit int) ([]Job, error) {
+func (db *sqlDB) ListJobs(conn Queryable, account string, offset, limit int) ([]Job, error) {
 	if limit == 0 || limit > db.maxResultsLimit {
 		limit = db.maxResultsLimit
 	}
 
-	query, args := qb.Select("account", "id", "contractor", "name", "address", "notes", "contact", "created", "modified").
+	query, args := db.builder.Select("account", "id", "contractor", "name", "address", "notes", "contact", "created", "modified", "version").
 		From("jobs").
-		Where(qb.Eq{"account": account}).
+		Where(qb.Eq{"account": account, "deleted": 0}).
 		OrderBy("id").
 		Limit(uint64(limit)).
 		Offset(uint64(offset)).
@@ -57,12 +62,29 @@ func (db *DB) ListJobs(conn Queryable, account string, offset, limit int) ([]Job
 	return jobs, nil
 }
 
-func (db *DB) InsertJob(conn Queryable, job *Job) error {
-	_, err := qb.Insert("jobs").Columns("account", "id", "contractor", "name", "address", "notes", "contact", "created", "modified").Values(
-		job.Account, job.ID, job.Contractor, job.Name, job.Address, job.Notes, job.Contact, job.Created, job.Modified,
+// ListContractorJobs returns all jobs which the given contractor is working.
+func (db *sqlDB) ListContractorJobs(conn Queryable, account, contractor string) ([]Job, error) {
+	query, args := db.builder.Select("account", "id", "contractor", "name", "address", "notes", "contact", "created", "modified", "version").
+		From("jobs").
+		Where(qb.Eq{"account": account, "contractor": contractor, "deleted": 0}).
+		OrderBy("id").
+		MustSql()
+
+	jobs := []Job{}
+	err := conn.Select(&jobs, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return jobs, nil
+}
+
+func (db *sqlDB) InsertJob(conn Queryable, job *Job) error {
+	_, err := db.builder.Insert("jobs").Columns("account", "id", "contractor", "name", "address", "notes", "contact", "created", "modified", "version").Values(
+		job.Account, job.ID, job.Contractor, job.Name, job.Address, job.Notes, job.Contact, job.Created, job.Modified, job.Version,
 	).RunWith(conn).Exec()
 	if err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+		if db.dialect.IsUniqueViolation(err) {
 			return ErrEntityExists
 		}
 
@@ -72,9 +94,9 @@ func (db *DB) InsertJob(conn Queryable, job *Job) error {
 	return nil
 }
 
-func (db *DB) GetJob(conn Queryable, account, id string) (Job, error) {
-	query, args := qb.Select("account", "id", "contractor", "name", "address", "notes", "contact", "created", "modified").From("jobs").
-		Where(qb.Eq{"account": account, "id": id}).MustSql()
+func (db *sqlDB) GetJob(conn Queryable, account, id string) (Job, error) {
+	query, args := db.builder.Select("account", "id", "contractor", "name", "address", "notes", "contact", "created", "modified", "version").From("jobs").
+		Where(qb.Eq{"account": account, "id": id, "deleted": 0}).MustSql()
 
 	job := Job{}
 	err := conn.Get(&job, query, args...)
@@ -89,13 +111,17 @@ func (db *DB) GetJob(conn Queryable, account, id string) (Job, error) {
 	return job, nil
 }
 
-func (db *DB) UpdateJob(conn Queryable, account, id string, fields UpdatableJobFields) error {
-	query := qb.Update("jobs")
+func (db *sqlDB) UpdateJob(conn Queryable, account, id string, fields UpdatableJobFields) error {
+	query := db.builder.Update("jobs")
 
 	if fields.Name != nil {
 		query = query.Set("name", fields.Name)
 	}
 
+	if fields.Contractor != nil {
+		query = query.Set("contractor", fields.Contractor)
+	}
+
 	if fields.Address != nil {
 		query = query.Set("address", fields.Address)
 	}
@@ -112,20 +138,11 @@ func (db *DB) UpdateJob(conn Queryable, account, id string, fields UpdatableJobF
 		query = query.Set("modified", fields.Modified)
 	}
 
-	_, err := query.Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrEntityNotFound
-		}
-
-		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
-	}
-
-	return nil
+	return db.updateVersioned(conn, "jobs", account, id, query, fields.Version)
 }
 
-func (db *DB) DeleteJob(conn Queryable, account, id string) error {
-	_, err := qb.Delete("jobs").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
+func (db *sqlDB) DeleteJob(conn Queryable, account, id string) error {
+	_, err := db.builder.Delete("jobs").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
@@ -137,24 +154,18 @@ func (db *DB) DeleteJob(conn Queryable, account, id string) error {
 	return nil
 }
 
-func (db *DB) AssociateFormulaWithJob(conn Queryable, formulaJob *FormulaJob) error {
-	_, err := qb.Insert("formula_jobs").Columns("account", "job", "formula").Values(
-		formulaJob.Account, formulaJob.Job, formulaJob.Formula,
-	).RunWith(conn).Exec()
-	if err != nil {
-		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
-			return ErrEntityExists
-		}
+func (db *sqlDB) AssociateFormulaWithJob(conn Queryable, formulaJob *FormulaJob) error {
+	insert := db.builder.Insert("formula_jobs").Columns("account", "job", "formula", "area").Values(
+		formulaJob.Account, formulaJob.Job, formulaJob.Formula, formulaJob.Area,
+	)
 
-		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
-	}
-
-	return nil
+	return db.insertAssociation(conn, insert)
 }
 
-func (db *DB) ListJobFormulas(conn Queryable, account, job string) ([]FormulaJob, error) {
-	query, args := qb.Select("account", "job", "formula").From("formula_jobs").
-		Where(qb.Eq{"account": account, "job": job}).MustSql()
+func (db *sqlDB) ListJobFormulas(conn Queryable, account, job string) ([]FormulaJob, error) {
+	query, args := db.builder.Select("account", "job", "formula", "area").From("formula_jobs").
+		Where(qb.Eq{"account": account, "job": job}).
+		Where(notDeleted("formula", "formulas", account)).MustSql()
 
 	jobFormulas := []FormulaJob{}
 	err := conn.Select(&jobFormulas, query, args...)
@@ -165,8 +176,8 @@ func (db *DB) ListJobFormulas(conn Queryable, account, job string) ([]FormulaJob
 	return jobFormulas, nil
 }
 
-func (db *DB) DeleteJobFormula(conn Queryable, account, job, formula string) error {
-	_, err := qb.Delete("formula_jobs").Where(qb.Eq{"account": account, "job": job, "formula": formula}).RunWith(conn).Exec()
+func (db *sqlDB) DeleteJobFormula(conn Queryable, account, job, formula string) error {
+	_, err := db.builder.Delete("formula_jobs").Where(qb.Eq{"account": account, "job": job, "formula": formula}).RunWith(conn).Exec()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil
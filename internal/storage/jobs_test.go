@@ -2,7 +2,6 @@ package storage
 
 import (
 	"errors"
-	"os"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -13,12 +12,10 @@ func ptr[T any](v T) *T {
 }
 
 func TestCRUDJobs(t *testing.T) {
-	path := tempFile()
-	db, err := New(path, 200)
+	db, err := newTestDB(t)
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer os.Remove(path)
 
 	account := Account{
 		ID: "test_account",
@@ -73,6 +70,15 @@ func TestCRUDJobs(t *testing.T) {
 		t.Errorf("unexpected map values (-want +got):\n%s", diff)
 	}
 
+	contractorJobs, err := db.ListContractorJobs(db, account.ID, "test_contractor")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]Job{job}, contractorJobs); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
 	fetchedJob, err := db.GetJob(db, account.ID, job.ID)
 	if err != nil {
 		t.Fatal(err)
@@ -84,6 +90,7 @@ func TestCRUDJobs(t *testing.T) {
 
 	job.Name = "Updated Job"
 	job.Modified = 1
+	job.Version = 1
 
 	err = db.UpdateJob(db, account.ID, job.ID, UpdatableJobFields{
 		Name:     &job.Name,
@@ -114,6 +121,7 @@ func TestCRUDJobs(t *testing.T) {
 		Account: account.ID,
 		Job:     job.ID,
 		Formula: "test_formula",
+		Area:    "Kitchen walls",
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -128,6 +136,7 @@ func TestCRUDJobs(t *testing.T) {
 		Account: account.ID,
 		Job:     job.ID,
 		Formula: "test_formula",
+		Area:    "Kitchen walls",
 	}, fetchedJobFormulas[0]); diff != "" {
 		t.Errorf("unexpected map values (-want +got):\n%s", diff)
 	}
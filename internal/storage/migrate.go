@@ -38,6 +38,37 @@ func (s *migrate) migrate(db *sqlx.DB) error {
 	return nil
 }
 
+// check makes sure the schema of the database is exactly the one the migrations given bring it to, without changing
+// it. It fails if the database has yet to be migrated or was migrated by a newer version of Basecoat.
+func (s *migrate) check(db *sqlx.DB) error {
+	applied := []string{}
+	err := db.Select(&applied, "SELECT id FROM migrations")
+	if err != nil {
+		return fmt.Errorf("could not read schema version; the database may not have been created by Basecoat: %v; %w",
+			err, ErrPreconditionFailure)
+	}
+
+	expected := map[string]bool{}
+	for _, m := range s.Migrations {
+		expected[m.ID] = true
+	}
+
+	for _, id := range applied {
+		if !expected[id] {
+			return fmt.Errorf("database schema is newer than this version of Basecoat supports; found migration %s; %w",
+				id, ErrPreconditionFailure)
+		}
+		delete(expected, id)
+	}
+
+	if len(expected) > 0 {
+		return fmt.Errorf("database schema is older than this version of Basecoat expects; start the service to "+
+			"migrate it first; %w", ErrPreconditionFailure)
+	}
+
+	return nil
+}
+
 func (s *migrate) createMigrationTable(db *sqlx.DB) error {
 	_, err := db.Exec("CREATE TABLE IF NOT EXISTS migrations (id TEXT PRIMARY KEY )")
 	if err != nil {
@@ -0,0 +1,2 @@
+-- Where on the job a formula was used; ex. "Kitchen walls". Printed in the job's report.
+ALTER TABLE formula_jobs ADD COLUMN area TEXT NOT NULL DEFAULT '';
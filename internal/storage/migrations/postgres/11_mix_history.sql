@@ -0,0 +1,3 @@
+-- Mixes are a record of what happened, so the formula a mix was made from is kept as written, like its job, rather than
+-- the mix being removed along with the formula when it's purged from the trash.
+ALTER TABLE mixes DROP CONSTRAINT IF EXISTS mixes_account_formula_fkey;
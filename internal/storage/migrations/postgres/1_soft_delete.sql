@@ -0,0 +1,6 @@
+ALTER TABLE colorants ADD COLUMN deleted BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE bases ADD COLUMN deleted BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE formulas ADD COLUMN deleted BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE contractors ADD COLUMN deleted BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE jobs ADD COLUMN deleted BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE contacts ADD COLUMN deleted BIGINT NOT NULL DEFAULT 0;
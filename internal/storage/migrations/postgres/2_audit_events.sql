@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS audit_events (
+    id          BIGSERIAL PRIMARY KEY,
+    account     TEXT    NOT NULL,
+    actor       TEXT    NOT NULL,
+    method      TEXT    NOT NULL,
+    entity_kind TEXT    NOT NULL,
+    entity_id   TEXT    NOT NULL,
+    before      TEXT,
+    after       TEXT,
+    source_ip   TEXT    NOT NULL,
+    created     BIGINT  NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_account_created ON audit_events (account, created);
+CREATE INDEX IF NOT EXISTS audit_events_entity ON audit_events (account, entity_kind, entity_id);
+
+-- The audit log is append-only; nothing is allowed to change or remove an event once it has been written.
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit events cannot be %', CASE TG_OP WHEN 'UPDATE' THEN 'modified' ELSE 'deleted' END;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_no_update BEFORE UPDATE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+CREATE TRIGGER audit_events_no_delete BEFORE DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
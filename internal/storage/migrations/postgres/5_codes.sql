@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS codes (
+    code        TEXT    NOT NULL,
+    account     TEXT    NOT NULL,
+    entity_kind TEXT    NOT NULL,
+    entity_id   TEXT    NOT NULL,
+    created     BIGINT  NOT NULL,
+    PRIMARY KEY (code),
+    UNIQUE (account, entity_kind, entity_id),
+    FOREIGN KEY (account) REFERENCES accounts(id) ON DELETE CASCADE
+);
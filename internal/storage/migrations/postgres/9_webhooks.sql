@@ -0,0 +1,37 @@
+-- A webhook posts each of an account's events of the types it subscribes to to its url, signed with its secret.
+-- Events are stored comma separated; ex. "job.updated,mix.created".
+CREATE TABLE IF NOT EXISTS webhooks (
+    account  TEXT    NOT NULL,
+    id       TEXT COLLATE "C" NOT NULL,
+    url      TEXT    NOT NULL,
+    events   TEXT    NOT NULL,
+    secret   TEXT    NOT NULL,
+    created  BIGINT  NOT NULL,
+    modified BIGINT  NOT NULL,
+    PRIMARY KEY (account, id),
+    FOREIGN KEY (account) REFERENCES accounts(id) ON DELETE CASCADE
+);
+
+-- A delivery is a single event to be posted to a webhook. It's queued in the same transaction as the change it
+-- describes, retried until it succeeds or runs out of attempts and then kept as the webhook's delivery log. Sequence
+-- is the id of the audit event it was made from; 0 for test deliveries.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id            BIGSERIAL PRIMARY KEY,
+    account       TEXT    NOT NULL,
+    webhook       TEXT    NOT NULL,
+    event         TEXT    NOT NULL,
+    sequence      BIGINT  NOT NULL,
+    payload       TEXT    NOT NULL,
+    state         TEXT    NOT NULL,
+    attempts      BIGINT  NOT NULL,
+    next_attempt  BIGINT  NOT NULL,
+    response_code BIGINT  NOT NULL,
+    error         TEXT    NOT NULL,
+    created       BIGINT  NOT NULL,
+    modified      BIGINT  NOT NULL,
+    FOREIGN KEY (account, webhook) REFERENCES webhooks(account, id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_due ON webhook_deliveries (state, next_attempt);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook ON webhook_deliveries (account, webhook, id);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_modified ON webhook_deliveries (modified);
@@ -0,0 +1,25 @@
+-- Mixes are a record of what happened, so the formula a mix was made from is kept as written, like its job, rather than
+-- the mix being removed along with the formula when it's purged from the trash. Sqlite can't drop a foreign key, so
+-- the table is rebuilt without it.
+CREATE TABLE mixes_history (
+    account   TEXT    NOT NULL,
+    id        TEXT    NOT NULL,
+    formula   TEXT    NOT NULL,
+    job       TEXT    NOT NULL,
+    container TEXT    NOT NULL,
+    quantity  INTEGER NOT NULL,
+    notes     TEXT    NOT NULL,
+    actor     TEXT    NOT NULL,
+    created   INTEGER NOT NULL,
+    PRIMARY KEY (account, id),
+    FOREIGN KEY (account) REFERENCES accounts(id) ON DELETE CASCADE
+) STRICT;
+
+INSERT INTO mixes_history (account, id, formula, job, container, quantity, notes, actor, created)
+    SELECT account, id, formula, job, container, quantity, notes, actor, created FROM mixes;
+
+DROP TABLE mixes;
+ALTER TABLE mixes_history RENAME TO mixes;
+
+CREATE INDEX IF NOT EXISTS mixes_formula ON mixes (account, formula, created);
+CREATE INDEX IF NOT EXISTS mixes_job ON mixes (account, job, created);
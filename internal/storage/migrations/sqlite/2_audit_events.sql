@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS audit_events (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    account     TEXT    NOT NULL,
+    actor       TEXT    NOT NULL,
+    method      TEXT    NOT NULL,
+    entity_kind TEXT    NOT NULL,
+    entity_id   TEXT    NOT NULL,
+    before      TEXT,
+    after       TEXT,
+    source_ip   TEXT    NOT NULL,
+    created     INTEGER NOT NULL
+) STRICT;
+
+CREATE INDEX IF NOT EXISTS audit_events_account_created ON audit_events (account, created);
+CREATE INDEX IF NOT EXISTS audit_events_entity ON audit_events (account, entity_kind, entity_id);
+
+-- The audit log is append-only; nothing is allowed to change or remove an event once it has been written.
+CREATE TRIGGER IF NOT EXISTS audit_events_no_update BEFORE UPDATE ON audit_events
+BEGIN
+    SELECT RAISE(ABORT, 'audit events cannot be modified');
+END;
+
+CREATE TRIGGER IF NOT EXISTS audit_events_no_delete BEFORE DELETE ON audit_events
+BEGIN
+    SELECT RAISE(ABORT, 'audit events cannot be deleted');
+END;
@@ -0,0 +1,6 @@
+ALTER TABLE colorants ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE bases ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE formulas ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE contractors ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE jobs ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE contacts ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
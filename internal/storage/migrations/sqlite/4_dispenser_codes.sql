@@ -0,0 +1 @@
+ALTER TABLE colorants ADD COLUMN dispenser_code TEXT NOT NULL DEFAULT '';
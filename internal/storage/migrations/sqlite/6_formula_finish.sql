@@ -0,0 +1,2 @@
+ALTER TABLE formulas ADD COLUMN sheen TEXT NOT NULL DEFAULT '';
+ALTER TABLE formulas ADD COLUMN color TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,18 @@
+-- The job a mix was for is kept as written; mixes are a record of what happened and aren't changed when the job is.
+CREATE TABLE IF NOT EXISTS mixes (
+    account   TEXT    NOT NULL,
+    id        TEXT    NOT NULL,
+    formula   TEXT    NOT NULL,
+    job       TEXT    NOT NULL,
+    container TEXT    NOT NULL,
+    quantity  INTEGER NOT NULL,
+    notes     TEXT    NOT NULL,
+    actor     TEXT    NOT NULL,
+    created   INTEGER NOT NULL,
+    PRIMARY KEY (account, id),
+    FOREIGN KEY (account) REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (account, formula) REFERENCES formulas(account, id) ON DELETE CASCADE
+) STRICT;
+
+CREATE INDEX IF NOT EXISTS mixes_formula ON mixes (account, formula, created);
+CREATE INDEX IF NOT EXISTS mixes_job ON mixes (account, job, created);
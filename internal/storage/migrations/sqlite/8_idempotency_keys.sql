@@ -0,0 +1,14 @@
+-- The response to a call made with an idempotency key, kept so that retrying the call returns it again instead of
+-- making the change twice. Keys are removed once they're older than the configured window.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    account      TEXT    NOT NULL,
+    key          TEXT    NOT NULL,
+    method       TEXT    NOT NULL,
+    request_hash TEXT    NOT NULL,
+    response     BLOB    NOT NULL,
+    created      INTEGER NOT NULL,
+    PRIMARY KEY (account, key),
+    FOREIGN KEY (account) REFERENCES accounts(id) ON DELETE CASCADE
+) STRICT;
+
+CREATE INDEX IF NOT EXISTS idempotency_keys_created ON idempotency_keys (created);
@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	qb "github.com/Masterminds/squirrel"
+)
+
+// Mix is a record of paint mixed from a formula. Mixes are never changed once recorded.
+type Mix struct {
+	Account   string
+	ID        string
+	Formula   string
+	Job       string
+	Container string
+	Quantity  int64
+	Notes     string
+	Actor     string
+	Created   int64
+}
+
+// MixFilter narrows the mixes returned by ListMixes; empty fields match every mix.
+type MixFilter struct {
+	Formula string
+	Job     string
+}
+
+func (db *sqlDB) InsertMix(conn Queryable, mix *Mix) error {
+	_, err := db.builder.Insert("mixes").
+		Columns("account", "id", "formula", "job", "container", "quantity", "notes", "actor", "created").
+		Values(mix.Account, mix.ID, mix.Formula, mix.Job, mix.Container, mix.Quantity, mix.Notes, mix.Actor,
+			mix.Created).RunWith(conn).Exec()
+	if err != nil {
+		if db.dialect.IsUniqueViolation(err) {
+			return ErrEntityExists
+		}
+
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+func (db *sqlDB) GetMix(conn Queryable, account, id string) (Mix, error) {
+	query, args := db.builder.Select("account", "id", "formula", "job", "container", "quantity", "notes", "actor",
+		"created").From("mixes").Where(qb.Eq{"account": account, "id": id}).MustSql()
+
+	mix := Mix{}
+	err := conn.Get(&mix, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Mix{}, ErrEntityNotFound
+		}
+
+		return Mix{}, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return mix, nil
+}
+
+// ListMixes returns an account's mixes, most recent first.
+func (db *sqlDB) ListMixes(conn Queryable, account string, filter MixFilter, offset, limit int) ([]Mix, error) {
+	if limit == 0 || limit > db.maxResultsLimit {
+		limit = db.maxResultsLimit
+	}
+
+	query := db.builder.Select("account", "id", "formula", "job", "container", "quantity", "notes", "actor",
+		"created").
+		From("mixes").
+		Where(qb.Eq{"account": account})
+
+	if filter.Formula != "" {
+		query = query.Where(qb.Eq{"formula": filter.Formula})
+	}
+
+	if filter.Job != "" {
+		query = query.Where(qb.Eq{"job": filter.Job})
+	}
+
+	sql, args := query.OrderBy("created DESC", "id").Limit(uint64(limit)).Offset(uint64(offset)).MustSql()
+
+	mixes := []Mix{}
+	err := conn.Select(&mixes, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return mixes, nil
+}
@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMixes(t *testing.T) {
+	db, err := newTestDB(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := Account{
+		ID: "test_account",
+	}
+
+	err = db.InsertAccount(db, &account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"test_formula", "other_formula"} {
+		err = db.InsertFormula(db, &Formula{Account: account.ID, ID: id, Name: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mixes := []Mix{
+		{
+			Account:   account.ID,
+			ID:        "first_mix",
+			Formula:   "test_formula",
+			Job:       "test_job",
+			Container: "1 gal",
+			Quantity:  2,
+			Notes:     "Touch-up",
+			Actor:     "test_token",
+			Created:   1,
+		},
+		{
+			Account:   account.ID,
+			ID:        "second_mix",
+			Formula:   "other_formula",
+			Container: "1 qt",
+			Quantity:  1,
+			Actor:     "test_token",
+			Created:   2,
+		},
+		{
+			Account:   account.ID,
+			ID:        "third_mix",
+			Formula:   "test_formula",
+			Container: "5 gal",
+			Quantity:  1,
+			Actor:     "test_token",
+			Created:   3,
+		},
+	}
+
+	for _, mix := range mixes {
+		mix := mix
+		err = db.InsertMix(db, &mix)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fetchedMix, err := db.GetMix(db, account.ID, "first_mix")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(mixes[0], fetchedMix); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	// Mixes come back most recent first.
+	tests := map[string]struct {
+		filter MixFilter
+		want   []Mix
+	}{
+		"all":     {MixFilter{}, []Mix{mixes[2], mixes[1], mixes[0]}},
+		"formula": {MixFilter{Formula: "test_formula"}, []Mix{mixes[2], mixes[0]}},
+		"job":     {MixFilter{Job: "test_job"}, []Mix{mixes[0]}},
+		"none":    {MixFilter{Formula: "other_formula", Job: "test_job"}, []Mix{}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fetchedMixes, err := db.ListMixes(db, account.ID, tc.filter, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want, fetchedMixes); diff != "" {
+				t.Errorf("unexpected map values (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	duplicateMix := mixes[0]
+	err = db.InsertMix(db, &duplicateMix)
+	if !errors.Is(err, ErrEntityExists) {
+		t.Errorf("expected error Entity Exists; found %v", err)
+	}
+
+	_, err = db.GetMix(db, account.ID, "missing_mix")
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected error Not Found; found %v", err)
+	}
+
+	// Mixes are a record of what happened; they outlive the formula they were made from.
+	err = db.SoftDelete(db, "FORMULA", account.ID, "test_formula", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.PurgeDeleted(db, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fetchedMixes, err := db.ListMixes(db, account.ID, MixFilter{Formula: "test_formula"}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]Mix{mixes[2], mixes[0]}, fetchedMixes); diff != "" {
+		t.Errorf("expected mixes of a purged formula to be kept (-want +got):\n%s", diff)
+	}
+}
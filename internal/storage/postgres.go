@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+
+	qb "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Postgres stores Basecoat's data in a PostgreSQL database, which lets several Basecoat servers share the same data.
+// Version 15 or later is required.
+type Postgres struct {
+	*sqlDB
+}
+
+var _ DB = (*Postgres)(nil)
+
+// NewPostgres connects to the postgres database described by the connection string given and brings its schema up
+// to date.
+func NewPostgres(dsn string, maxResultsLimit int) (*Postgres, error) {
+	db, err := newSQLDB(postgresDialect{}, dsn, maxResultsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Postgres{db}, nil
+}
+
+// OpenPostgres connects to the postgres database described by the connection string given without changing its
+// schema; see Open.
+func OpenPostgres(dsn string, maxResultsLimit int) (*Postgres, error) {
+	db, err := openSQLDB(postgresDialect{}, dsn, maxResultsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Postgres{db}, nil
+}
+
+// Backup is not supported for postgres; a shared database server is expected to be backed up by its own tooling
+// such as pg_dump.
+func (db *Postgres) Backup(_ string) error {
+	return fmt.Errorf("backups of postgres databases should be taken with pg_dump; %w", ErrPreconditionFailure)
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) Engine() Engine {
+	return EnginePostgres
+}
+
+func (postgresDialect) Open(dsn string) (*sqlx.DB, error) {
+	return sqlx.Connect("postgres", dsn)
+}
+
+func (postgresDialect) Migrations() []migration {
+	return dialectMigrations(EnginePostgres, "0_init.sql", "1_soft_delete.sql", "2_audit_events.sql", "3_versions.sql",
+		"4_dispenser_codes.sql", "5_codes.sql", "6_formula_finish.sql", "7_mixes.sql", "8_idempotency_keys.sql",
+		"9_webhooks.sql", "10_formula_job_area.sql",
+		"11_mix_history.sql")
+}
+
+func (postgresDialect) Placeholder() qb.PlaceholderFormat {
+	return qb.Dollar
+}
+
+// uniqueViolation is the postgres error code for a unique or primary key constraint violation.
+const uniqueViolation = "23505"
+
+func (postgresDialect) IsUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+
+	return pqErr.Code == uniqueViolation
+}
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	qb "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/mattn/go-sqlite3"
+)
+
+// SQLite stores Basecoat's data in a single sqlite database file. It's the default engine and the only one which can
+// be backed up and restored by Basecoat itself.
+type SQLite struct {
+	*sqlDB
+	path string
+}
+
+var _ DB = (*SQLite)(nil)
+
+// NewSQLite opens the sqlite database at path, creating it if it doesn't exist yet, and brings its schema up to date.
+func NewSQLite(path string, maxResultsLimit int) (*SQLite, error) {
+	db, err := newSQLDB(sqliteDialect{}, path, maxResultsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SQLite{db, path}, nil
+}
+
+// OpenSQLite opens the existing sqlite database at path without changing its schema; see Open.
+func OpenSQLite(path string, maxResultsLimit int) (*SQLite, error) {
+	// Connecting would otherwise create an empty database in its place.
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("could not open database file %q: %v; %w", path, err, ErrEntityNotFound)
+	}
+
+	db, err := openSQLDB(sqliteDialect{}, path, maxResultsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SQLite{db, path}, nil
+}
+
+// Backup writes a consistent copy of the live database to path. The file at path must not already exist.
+//
+// It uses sqlite's "VACUUM INTO" which is safe to run while the database is being written to; readers and writers
+// are not blocked for the duration of the copy.
+func (db *SQLite) Backup(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("backup file %q already exists; %w", path, ErrEntityExists)
+	}
+
+	_, err := db.Exec("VACUUM INTO ?", path)
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) Engine() Engine {
+	return EngineSQLite
+}
+
+func (sqliteDialect) Open(dsn string) (*sqlx.DB, error) {
+	return sqlx.Connect("sqlite3", fmt.Sprintf("%s?_journal=wal&_fk=true&_timeout=5000", dsn))
+}
+
+func (sqliteDialect) Migrations() []migration {
+	return dialectMigrations(EngineSQLite, "0_init.sql", "1_soft_delete.sql", "2_audit_events.sql", "3_versions.sql",
+		"4_dispenser_codes.sql", "5_codes.sql", "6_formula_finish.sql", "7_mixes.sql", "8_idempotency_keys.sql",
+		"9_webhooks.sql", "10_formula_job_area.sql",
+		"11_mix_history.sql")
+}
+
+func (sqliteDialect) Placeholder() qb.PlaceholderFormat {
+	return qb.Question
+}
+
+func (sqliteDialect) IsUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+
+	return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique ||
+		sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+}
@@ -8,8 +8,8 @@ import (
 	"errors"
 	"fmt"
 
+	qb "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
-	_ "github.com/mattn/go-sqlite3" // Provides sqlite3 lib
 	"github.com/rs/zerolog/log"
 )
 
@@ -28,6 +28,9 @@ var (
 
 	// ErrInternal is returned when there was an unknown internal DB error.
 	ErrInternal = errors.New("storage: unknown db error")
+
+	// ErrVersionMismatch is returned when an entity was changed by someone else since the caller last read it.
+	ErrVersionMismatch = errors.New("storage: entity version does not match")
 )
 
 // Queryable includes methods shared by sqlx.Tx and sqlx.DB so they can
@@ -52,9 +55,123 @@ type Queryable interface {
 	NamedQuery(string, interface{}) (*sqlx.Rows, error)
 }
 
-// DB is a representation of the datastore
-type DB struct {
+// DB is the datastore Basecoat keeps its data in. It's implemented by each database engine Basecoat can store its data
+// in; see SQLite and Postgres.
+//
+// Every call takes the connection it should run on so that callers can make several calls inside a single transaction
+// with InsideTx. The DB itself can be passed to make a call on its own.
+type DB interface {
+	Queryable
+	Beginx() (*sqlx.Tx, error)
+	Close() error
+
+	// Engine returns the database engine the db is stored in.
+	Engine() Engine
+
+	// Backup writes a consistent copy of the live database to path. The file at path must not already exist.
+	Backup(path string) error
+
+	ListAccounts(conn Queryable, offset, limit int) ([]Account, error)
+	InsertAccount(conn Queryable, account *Account) error
+	GetAccount(conn Queryable, id string) (Account, error)
+	UpdateAccount(conn Queryable, id string, fields UpdatableAccountFields) error
+	DeleteAccount(conn Queryable, id string) error
+
+	InsertAuditEvent(conn Queryable, event *AuditEvent) error
+	ListAuditEvents(conn Queryable, account string, filter AuditEventFilter, offset, limit int) ([]AuditEvent, error)
+	ListAuditEventsAfter(conn Queryable, account string, after int64, kinds []string, limit int) ([]AuditEvent, error)
+	ListAuditEventIDsAfter(conn Queryable, after int64, limit int) ([]AuditEvent, error)
+	GetLatestAuditEventID(conn Queryable, account string) (int64, error)
+
+	ListBases(conn Queryable, account string, offset, limit int) ([]Base, error)
+	InsertBase(conn Queryable, base *Base) error
+	GetBase(conn Queryable, account, id string) (Base, error)
+	ListBaseFormulas(conn Queryable, account, base string) ([]FormulaBase, error)
+	UpdateBase(conn Queryable, account, id string, fields UpdatableBaseFields) error
+	DeleteBase(conn Queryable, account, id string) error
+
+	InsertCode(conn Queryable, code *Code) error
+	GetCode(conn Queryable, code string) (Code, error)
+	GetEntityCode(conn Queryable, account, kind, id string) (Code, error)
+
+	ListColorants(conn Queryable, account string, offset, limit int) ([]Colorant, error)
+	InsertColorant(conn Queryable, colorant *Colorant) error
+	GetColorant(conn Queryable, account, id string) (Colorant, error)
+	UpdateColorant(conn Queryable, account, id string, fields UpdatableColorantFields) error
+	DeleteColorant(conn Queryable, account, id string) error
+	AssociateColorantWithFormula(conn Queryable, formulaColorant *FormulaColorant) error
+	ListFormulaColorants(conn Queryable, account, formula string) ([]FormulaColorant, error)
+	ListColorantFormulas(conn Queryable, account, colorant string) ([]FormulaColorant, error)
+	DeleteFormulaColorant(conn Queryable, account, formula, colorant string) error
+
+	ListContacts(conn Queryable, account string, offset, limit int) ([]Contact, error)
+	InsertContact(conn Queryable, contact *Contact) error
+	GetContact(conn Queryable, account, id string) (Contact, error)
+	UpdateContact(conn Queryable, account, id string, fields UpdatableContactFields) error
+	DeleteContact(conn Queryable, account, id string) error
+
+	ListContractors(conn Queryable, account string, offset, limit int) ([]Contractor, error)
+	InsertContractor(conn Queryable, contractor *Contractor) error
+	GetContractor(conn Queryable, account, id string) (Contractor, error)
+	UpdateContractor(conn Queryable, account, id string, fields UpdatableContractorFields) error
+	DeleteContractor(conn Queryable, account, id string) error
+
+	ListFormulas(conn Queryable, account string, offset, limit int) ([]Formula, error)
+	InsertFormula(conn Queryable, formula *Formula) error
+	AssociateBaseWithFormula(conn Queryable, formulaBase *FormulaBase) error
+	ListFormulaBases(conn Queryable, account, formula string) ([]FormulaBase, error)
+	DeleteFormulaBase(conn Queryable, account, formula, base string) error
+	ListFormulaJobs(conn Queryable, account, formula string) ([]FormulaJob, error)
+	GetFormula(conn Queryable, account, id string) (Formula, error)
+	UpdateFormula(conn Queryable, account, id string, fields UpdatableFormulaFields) error
+	DeleteFormula(conn Queryable, account, id string) error
+
+	InsertIdempotencyKey(conn Queryable, key *IdempotencyKey, expired int64) error
+	UpdateIdempotencyKeyResponse(conn Queryable, account, key string, response []byte) error
+	GetIdempotencyKey(conn Queryable, account, key string, after int64) (IdempotencyKey, error)
+	PurgeIdempotencyKeys(conn Queryable, before int64) (int64, error)
+
+	ListJobs(conn Queryable, account string, offset, limit int) ([]Job, error)
+	ListContractorJobs(conn Queryable, account, contractor string) ([]Job, error)
+	InsertJob(conn Queryable, job *Job) error
+	GetJob(conn Queryable, account, id string) (Job, error)
+	UpdateJob(conn Queryable, account, id string, fields UpdatableJobFields) error
+	DeleteJob(conn Queryable, account, id string) error
+	AssociateFormulaWithJob(conn Queryable, formulaJob *FormulaJob) error
+	ListJobFormulas(conn Queryable, account, job string) ([]FormulaJob, error)
+	DeleteJobFormula(conn Queryable, account, job, formula string) error
+
+	InsertMix(conn Queryable, mix *Mix) error
+	GetMix(conn Queryable, account, id string) (Mix, error)
+	ListMixes(conn Queryable, account string, filter MixFilter, offset, limit int) ([]Mix, error)
+
+	SoftDelete(conn Queryable, kind, account, id string, deleted int64) error
+	Undelete(conn Queryable, kind, account, id string) error
+	GetDeleted(conn Queryable, kind, account, id string) (DeletedEntity, error)
+	ListDeleted(conn Queryable, account, kind string, offset, limit int) ([]DeletedEntity, error)
+	PurgeDeleted(conn Queryable, before int64) (int64, error)
+
+	ListWebhooks(conn Queryable, account string) ([]Webhook, error)
+	GetWebhook(conn Queryable, account, id string) (Webhook, error)
+	InsertWebhook(conn Queryable, webhook *Webhook) error
+	UpdateWebhook(conn Queryable, account, id string, fields UpdatableWebhookFields) error
+	DeleteWebhook(conn Queryable, account, id string) error
+	InsertWebhookDelivery(conn Queryable, delivery *WebhookDelivery) error
+	ListWebhookDeliveries(conn Queryable, account, webhook string, offset, limit int) ([]WebhookDelivery, error)
+	ListDueWebhookDeliveries(conn Queryable, now int64, limit int) ([]WebhookDelivery, error)
+	ClaimWebhookDelivery(conn Queryable, id, nextAttempt, lease int64) (bool, error)
+	UpdateWebhookDelivery(conn Queryable, id int64, fields UpdatableWebhookDeliveryFields) error
+	PurgeWebhookDeliveries(conn Queryable, before int64) (int64, error)
+}
+
+// sqlDB holds the queries shared by every engine. They're written once against the query builder; the dialect only
+// supplies the parts which differ between engines.
+type sqlDB struct {
 	maxResultsLimit int
+	dialect         Dialect
+
+	// builder is used to start every query so that it is rendered with the dialect's placeholders.
+	builder qb.StatementBuilderType
 	*sqlx.DB
 }
 
@@ -67,34 +184,105 @@ func mustReadFile(path string) []byte {
 	return file
 }
 
-// New creates a new db with given settings
-func New(path string, maxResultsLimit int) (DB, error) {
-	dsn := fmt.Sprintf("%s?_journal=wal&_fk=true&_timeout=5000", path)
+// New creates a new db with given settings. The engine determines how dsn is interpreted; for sqlite it is the path
+// to the database file and for postgres it is a connection string.
+func New(engine Engine, dsn string, maxResultsLimit int) (DB, error) {
+	switch engine {
+	case EngineSQLite, "":
+		db, err := NewSQLite(dsn, maxResultsLimit)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	case EnginePostgres:
+		db, err := NewPostgres(dsn, maxResultsLimit)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	default:
+		return nil, fmt.Errorf("unknown storage engine %q; must be one of %q or %q; %w",
+			engine, EngineSQLite, EnginePostgres, ErrPreconditionFailure)
+	}
+}
+
+// Open opens an existing db without changing its schema; it fails if the schema isn't the one this version of
+// Basecoat expects. It's meant for tools run alongside the service, which must leave migrating the database to the
+// service itself. Arguments are the same as for New.
+func Open(engine Engine, dsn string, maxResultsLimit int) (DB, error) {
+	switch engine {
+	case EngineSQLite, "":
+		db, err := OpenSQLite(dsn, maxResultsLimit)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	case EnginePostgres:
+		db, err := OpenPostgres(dsn, maxResultsLimit)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	default:
+		return nil, fmt.Errorf("unknown storage engine %q; must be one of %q or %q; %w",
+			engine, EngineSQLite, EnginePostgres, ErrPreconditionFailure)
+	}
+}
+
+// newSQLDB connects to the database described by dsn and brings its schema up to date.
+func newSQLDB(dialect Dialect, dsn string, maxResultsLimit int) (*sqlDB, error) {
+	return connectSQLDB(dialect, dsn, maxResultsLimit, func(migration *migrate, db *sqlx.DB) error {
+		return migration.migrate(db)
+	})
+}
+
+// openSQLDB connects to the database described by dsn and makes sure its schema is up to date without changing it.
+func openSQLDB(dialect Dialect, dsn string, maxResultsLimit int) (*sqlDB, error) {
+	return connectSQLDB(dialect, dsn, maxResultsLimit, func(migration *migrate, db *sqlx.DB) error {
+		return migration.check(db)
+	})
+}
 
-	db, err := sqlx.Connect("sqlite3", dsn)
+// connectSQLDB connects to the database described by dsn and hands it to prepare along with the dialect's migrations.
+func connectSQLDB(dialect Dialect, dsn string, maxResultsLimit int,
+	prepare func(*migrate, *sqlx.DB) error,
+) (*sqlDB, error) {
+	db, err := dialect.Open(dsn)
 	if err != nil {
-		return DB{}, err
+		return nil, err
 	}
 
 	migration := migrate{
-		Migrations: []migration{
-			migrationQuery("0", string(mustReadFile("migrations/0_init.sql"))),
-		},
+		Migrations: dialect.Migrations(),
 	}
 
-	err = migration.migrate(db)
+	err = prepare(&migration, db)
 	if err != nil {
-		return DB{}, err
+		db.Close()
+		return nil, err
 	}
 
-	return DB{
-		maxResultsLimit,
-		db,
+	return &sqlDB{
+		maxResultsLimit: maxResultsLimit,
+		dialect:         dialect,
+		builder:         qb.StatementBuilder.PlaceholderFormat(dialect.Placeholder()),
+		DB:              db,
 	}, nil
 }
 
+// Engine returns the database engine the db is stored in.
+func (db *sqlDB) Engine() Engine {
+	return db.dialect.Engine()
+}
+
+// rebind converts a hand written query using "?" placeholders into the dialect's placeholder format.
+func (db *sqlDB) rebind(query string) string {
+	query, _ = db.dialect.Placeholder().ReplacePlaceholders(query)
+	return query
+}
+
 // InsideTx is a convenience function so that callers can run multiple queries inside a transaction.
-func InsideTx(db *sqlx.DB, fn func(*sqlx.Tx) error) error {
+func InsideTx(db DB, fn func(*sqlx.Tx) error) error {
 	tx, err := db.Beginx()
 	if err != nil {
 		return err
@@ -120,3 +308,93 @@ func InsideTx(db *sqlx.DB, fn func(*sqlx.Tx) error) error {
 
 	return nil
 }
+
+// InsideSavepoint runs fn inside a savepoint of a transaction which is already open. If fn returns an error only the
+// changes fn made are rolled back and the transaction can continue to be used.
+func InsideSavepoint(tx *sqlx.Tx, fn func(*sqlx.Tx) error) error {
+	_, err := tx.Exec("SAVEPOINT inside_savepoint")
+	if err != nil {
+		return fmt.Errorf("creating savepoint: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		if _, rerr := tx.Exec("ROLLBACK TO SAVEPOINT inside_savepoint"); rerr != nil {
+			err = fmt.Errorf("%w: rolling back to savepoint: %v", err, rerr)
+		}
+		return err
+	}
+
+	_, err = tx.Exec("RELEASE SAVEPOINT inside_savepoint")
+	if err != nil {
+		return fmt.Errorf("releasing savepoint: %w", err)
+	}
+
+	return nil
+}
+
+// insertAssociation inserts a row linking two entities and returns ErrEntityExists if the link already exists.
+//
+// Conflicts are skipped by the database rather than raised as errors because a failed statement inside a postgres
+// transaction aborts the rest of the transaction; callers are allowed to carry on after an existing association.
+func (db *sqlDB) insertAssociation(conn Queryable, insert qb.InsertBuilder) error {
+	result, err := insert.Suffix("ON CONFLICT DO NOTHING").RunWith(conn).Exec()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	if rows == 0 {
+		return ErrEntityExists
+	}
+
+	return nil
+}
+
+// updateVersioned applies an update to a single entity in table. Every update increments the entity's version. When
+// expected is given the update only applies if the stored version still matches it; ErrVersionMismatch is returned
+// otherwise so that callers can tell a concurrent change apart from an entity that doesn't exist.
+func (db *sqlDB) updateVersioned(conn Queryable, table, account, id string, update qb.UpdateBuilder,
+	expected *int64,
+) error {
+	where := qb.Eq{"account": account, "id": id, "deleted": 0}
+	if expected != nil {
+		where["version"] = *expected
+	}
+
+	result, err := update.Set("version", qb.Expr("version + 1")).Where(where).RunWith(conn).Exec()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	if rows > 0 {
+		return nil
+	}
+
+	if expected == nil {
+		return ErrEntityNotFound
+	}
+
+	query, args := db.builder.Select("count(*)").From(table).
+		Where(qb.Eq{"account": account, "id": id, "deleted": 0}).MustSql()
+
+	var count int
+	err = conn.Get(&count, query, args...)
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	if count == 0 {
+		return ErrEntityNotFound
+	}
+
+	return ErrVersionMismatch
+}
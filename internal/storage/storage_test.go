@@ -1,9 +1,76 @@
 package storage
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
 )
 
+// testPostgresDSNEnv names the environment variable which switches the storage tests from sqlite to postgres. It
+// should hold a connection string for a server the tests are free to create schemas in; each test gets its own
+// schema which is dropped once the test completes.
+const testPostgresDSNEnv = "BASECOAT_TEST_POSTGRES_DSN"
+
+// newTestDB returns an empty database for a single test which is cleaned up once the test completes.
+func newTestDB(t *testing.T) (DB, error) {
+	dsn := os.Getenv(testPostgresDSNEnv)
+	if dsn == "" {
+		path := tempFile()
+		t.Cleanup(func() { os.Remove(path) })
+
+		return New(EngineSQLite, path, 200)
+	}
+
+	admin, err := sqlx.Connect("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := fmt.Sprintf("basecoat_test_%d", time.Now().UnixNano())
+	_, err = admin.Exec("CREATE SCHEMA " + schema)
+	if err != nil {
+		admin.Close()
+		return nil, err
+	}
+
+	db, err := New(EnginePostgres, withSearchPath(dsn, schema), 200)
+	t.Cleanup(func() {
+		if err == nil {
+			db.Close()
+		}
+		_, _ = admin.Exec("DROP SCHEMA " + schema + " CASCADE")
+		admin.Close()
+	})
+
+	return db, err
+}
+
+// withSearchPath adds a search path to a postgres connection string so that unqualified tables resolve to schema.
+// Both URL and key/value style connection strings are accepted.
+func withSearchPath(dsn, schema string) string {
+	if !strings.Contains(dsn, "://") {
+		return dsn + " search_path=" + schema
+	}
+
+	parsed, err := url.Parse(dsn)
+	if err != nil {
+		panic(err)
+	}
+
+	query := parsed.Query()
+	query.Set("search_path", schema)
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String()
+}
+
 func tempFile() string {
 	f, err := os.CreateTemp("", "basecoat-test-")
 	if err != nil {
@@ -106,3 +173,130 @@ func tempFile() string {
 
 // 	t.Fatalf("transaction did not rollback successfully")
 // }
+
+func TestInsideSavepoint(t *testing.T) {
+	db, err := newTestDB(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.InsertAccount(db, &Account{ID: "test_account"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = InsideTx(db, func(tx *sqlx.Tx) error {
+		err := InsideSavepoint(tx, func(tx *sqlx.Tx) error {
+			return db.InsertContact(tx, &Contact{Account: "test_account", ID: "kept"})
+		})
+		if err != nil {
+			return err
+		}
+
+		err = InsideSavepoint(tx, func(tx *sqlx.Tx) error {
+			err := db.InsertContact(tx, &Contact{Account: "test_account", ID: "rolled_back"})
+			if err != nil {
+				return err
+			}
+
+			// A duplicate insert fails the savepoint; on postgres this would also abort the transaction if the
+			// savepoint were not rolled back.
+			return db.InsertContact(tx, &Contact{Account: "test_account", ID: "kept"})
+		})
+		if !errors.Is(err, ErrEntityExists) {
+			t.Errorf("expected savepoint to fail with ErrEntityExists; got %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.GetContact(db, "test_account", "kept")
+	if err != nil {
+		t.Errorf("expected contact inserted before the failed savepoint to be committed; got %v", err)
+	}
+
+	_, err = db.GetContact(db, "test_account", "rolled_back")
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected contact inserted inside the failed savepoint to be rolled back; got %v", err)
+	}
+}
+
+// migrationCount returns the number of migrations applied to the sqlite database at path; -1 if it has none at all.
+func migrationCount(t *testing.T, path string) int {
+	t.Helper()
+
+	db, err := sqlx.Connect("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	count := 0
+	err = db.Get(&count, "SELECT count(*) FROM migrations")
+	if err != nil {
+		return -1
+	}
+
+	return count
+}
+
+func TestOpenLeavesSchemaAlone(t *testing.T) {
+	migrations := sqliteDialect{}.Migrations()
+	latest := migrations[len(migrations)-1].ID
+
+	tests := map[string]struct {
+		change string
+		err    error
+	}{
+		"up to date":   {},
+		"older":        {change: "DELETE FROM migrations WHERE id = '" + latest + "'", err: ErrPreconditionFailure},
+		"newer":        {change: "INSERT INTO migrations (id) VALUES ('9999')", err: ErrPreconditionFailure},
+		"not basecoat": {change: "DROP TABLE migrations", err: ErrPreconditionFailure},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := tempFile()
+			t.Cleanup(func() { os.Remove(path) })
+
+			db, err := New(EngineSQLite, path, 200)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.change != "" {
+				_, err = db.(*SQLite).Exec(tc.change)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			db.Close()
+
+			before := migrationCount(t, path)
+
+			opened, err := Open(EngineSQLite, path, 200)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected error %v; found %v", tc.err, err)
+			}
+			if err == nil {
+				opened.Close()
+			}
+
+			// A database which doesn't match is reported, never migrated.
+			if after := migrationCount(t, path); after != before {
+				t.Errorf("expected schema to be left alone; migrations went from %d to %d", before, after)
+			}
+		})
+	}
+
+	path := filepath.Join(t.TempDir(), "missing.db")
+	_, err := Open(EngineSQLite, path, 200)
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected missing database to be not found; found %v", err)
+	}
+	if _, err := os.Stat(path); err == nil {
+		t.Errorf("expected missing database not to be created")
+	}
+}
@@ -0,0 +1,211 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	qb "github.com/Masterminds/squirrel"
+)
+
+// Entities are never removed from the database when deleted by a user. Instead they are marked with the time they
+// were deleted and hidden from all normal queries. This keeps the associations between them intact so that an
+// entity restored from the trash comes back exactly as it was. Entities which have been in the trash for long enough
+// are eventually purged for good.
+
+// trashTables maps each kind of entity that can be moved to the trash to its table and the column that is used
+// as its human readable name.
+var trashTables = map[string]struct {
+	table string
+	name  string
+}{
+	"FORMULA":    {"formulas", "name"},
+	"BASE":       {"bases", "label"},
+	"COLORANT":   {"colorants", "label"},
+	"CONTACT":    {"contacts", "name"},
+	"CONTRACTOR": {"contractors", "company"},
+	"JOB":        {"jobs", "name"},
+}
+
+// purgeOrder is the order in which entity kinds are purged so that entities are removed before anything they
+// depend on.
+var purgeOrder = []string{"JOB", "FORMULA", "CONTRACTOR", "CONTACT", "BASE", "COLORANT"}
+
+// purgeGuards keeps entities in the trash which something else still refers to from being purged.
+var purgeGuards = map[string]string{
+	"CONTRACTOR": "NOT EXISTS (SELECT 1 FROM jobs WHERE jobs.account = contractors.account AND " +
+		"jobs.contractor = contractors.id)",
+	"BASE": "NOT EXISTS (SELECT 1 FROM formula_bases WHERE formula_bases.account = bases.account AND " +
+		"formula_bases.base = bases.id)",
+	"COLORANT": "NOT EXISTS (SELECT 1 FROM formula_colorants WHERE formula_colorants.account = colorants.account AND " +
+		"formula_colorants.colorant = colorants.id)",
+}
+
+// DeletedEntity is an entity that currently resides in the trash.
+type DeletedEntity struct {
+	Kind    string
+	Account string
+	ID      string
+	Name    string
+	Deleted int64
+}
+
+// notDeleted returns a condition which only matches when column refers to an entity in table that is not in the trash.
+func notDeleted(column, table, account string) qb.Sqlizer {
+	return qb.Expr(fmt.Sprintf("%s IN (SELECT id FROM %s WHERE account = ? AND deleted = 0)", column, table), account)
+}
+
+func trashTable(kind string) (string, string, error) {
+	entity, ok := trashTables[kind]
+	if !ok {
+		return "", "", fmt.Errorf("entity kind %q cannot be deleted; %w", kind, ErrPreconditionFailure)
+	}
+
+	return entity.table, entity.name, nil
+}
+
+// SoftDelete moves an entity into the trash.
+func (db *sqlDB) SoftDelete(conn Queryable, kind, account, id string, deleted int64) error {
+	table, _, err := trashTable(kind)
+	if err != nil {
+		return err
+	}
+
+	result, err := db.builder.Update(table).Set("deleted", deleted).
+		Where(qb.Eq{"account": account, "id": id, "deleted": 0}).RunWith(conn).Exec()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	if rows == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// Undelete restores an entity from the trash.
+func (db *sqlDB) Undelete(conn Queryable, kind, account, id string) error {
+	table, _, err := trashTable(kind)
+	if err != nil {
+		return err
+	}
+
+	result, err := db.builder.Update(table).Set("deleted", 0).
+		Where(qb.Eq{"account": account, "id": id}).Where(qb.NotEq{"deleted": 0}).RunWith(conn).Exec()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	if rows == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// GetDeleted returns a single entity from the trash; ErrEntityNotFound if there is no entity with that id or it isn't
+// in the trash. Entities in the trash keep their id, so it can't be reused until they're purged.
+func (db *sqlDB) GetDeleted(conn Queryable, kind, account, id string) (DeletedEntity, error) {
+	table, name, err := trashTable(kind)
+	if err != nil {
+		return DeletedEntity{}, err
+	}
+
+	query, args := db.builder.Select("account", "id", name+" AS name", "deleted").From(table).
+		Where(qb.Eq{"account": account, "id": id}).Where(qb.NotEq{"deleted": 0}).MustSql()
+
+	entity := DeletedEntity{Kind: kind}
+	err = conn.Get(&entity, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return DeletedEntity{}, ErrEntityNotFound
+		}
+
+		return DeletedEntity{}, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return entity, nil
+}
+
+// ListDeleted returns the entities in an account's trash ordered from most to least recently deleted. Kind limits
+// the results to a single kind of entity; an empty kind returns all kinds.
+func (db *sqlDB) ListDeleted(conn Queryable, account, kind string, offset, limit int) ([]DeletedEntity, error) {
+	if limit == 0 || limit > db.maxResultsLimit {
+		limit = db.maxResultsLimit
+	}
+
+	kinds := purgeOrder
+	if kind != "" {
+		if _, _, err := trashTable(kind); err != nil {
+			return nil, err
+		}
+		kinds = []string{kind}
+	}
+
+	selects := []string{}
+	args := []interface{}{}
+	for _, kind := range kinds {
+		table, name, _ := trashTable(kind)
+		selects = append(selects, fmt.Sprintf(
+			"SELECT CAST(? AS TEXT) AS kind, account, id, %s AS name, deleted FROM %s WHERE account = ? AND deleted != 0",
+			name, table))
+		args = append(args, kind, account)
+	}
+
+	query := strings.Join(selects, " UNION ALL ") + " ORDER BY deleted DESC, kind, id LIMIT ? OFFSET ?"
+	args = append(args, limit, offset)
+
+	entities := []DeletedEntity{}
+	err := conn.Select(&entities, db.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return entities, nil
+}
+
+// PurgeDeleted permanently removes all entities which were moved to the trash before the given time. Removing an
+// entity also removes its associations. Returns the number of entities removed.
+//
+// Contractors are only purged once no job refers to them, since a job requires a contractor. Likewise bases and
+// colorants are only purged once no formula uses them, since removing them would silently change the recipes of
+// formulas still in use. Formulas in the trash count too; they are purged first and the bases and colorants they
+// used go in a later purge.
+func (db *sqlDB) PurgeDeleted(conn Queryable, before int64) (int64, error) {
+	var total int64
+
+	for _, kind := range purgeOrder {
+		table, _, _ := trashTable(kind)
+
+		query := db.builder.Delete(table).Where(qb.NotEq{"deleted": 0}).Where(qb.Lt{"deleted": before})
+		if guard, guarded := purgeGuards[kind]; guarded {
+			query = query.Where(guard)
+		}
+
+		result, err := query.RunWith(conn).Exec()
+		if err != nil {
+			return total, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+		}
+
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return total, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+		}
+
+		total += rows
+	}
+
+	return total, nil
+}
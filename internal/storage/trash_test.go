@@ -0,0 +1,312 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSoftDeleteAndUndelete(t *testing.T) {
+	db, err := newTestDB(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := Account{
+		ID: "test_account",
+	}
+
+	err = db.InsertAccount(db, &account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formula := Formula{
+		Account: account.ID,
+		ID:      "test_formula",
+		Name:    "test_formula_name",
+	}
+
+	err = db.InsertFormula(db, &formula)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	colorant := Colorant{
+		Account: account.ID,
+		ID:      "test_colorant",
+		Label:   "test_colorant_label",
+	}
+
+	err = db.InsertColorant(db, &colorant)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formulaColorant := FormulaColorant{
+		Account:  account.ID,
+		Formula:  formula.ID,
+		Colorant: colorant.ID,
+		Amount:   "5",
+	}
+
+	err = db.AssociateColorantWithFormula(db, &formulaColorant)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.SoftDelete(db, "COLORANT", account.ID, colorant.ID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.GetColorant(db, account.ID, colorant.ID)
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Fatal("expected error Not Found; found alternate error")
+	}
+
+	formulaColorants, err := db.ListFormulaColorants(db, account.ID, formula.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(formulaColorants) != 0 {
+		t.Errorf("expected associations with deleted colorant to be hidden; found %d", len(formulaColorants))
+	}
+
+	err = db.SoftDelete(db, "COLORANT", account.ID, colorant.ID, 11)
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Fatal("expected error Not Found when deleting twice; found alternate error")
+	}
+
+	deleted, err := db.ListDeleted(db, account.ID, "", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []DeletedEntity{{
+		Kind:    "COLORANT",
+		Account: account.ID,
+		ID:      colorant.ID,
+		Name:    colorant.Label,
+		Deleted: 10,
+	}}
+
+	if diff := cmp.Diff(expected, deleted); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	trashed, err := db.GetDeleted(db, "COLORANT", account.ID, colorant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expected[0], trashed); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	// The id stays taken while the colorant is in the trash.
+	err = db.InsertColorant(db, &colorant)
+	if !errors.Is(err, ErrEntityExists) {
+		t.Fatal("expected error Exists when reusing the id of an entity in the trash; found alternate error")
+	}
+
+	err = db.Undelete(db, "COLORANT", account.ID, colorant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.GetDeleted(db, "COLORANT", account.ID, colorant.ID)
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Fatal("expected error Not Found for an entity restored from the trash; found alternate error")
+	}
+
+	_, err = db.GetColorant(db, account.ID, colorant.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formulaColorants, err = db.ListFormulaColorants(db, account.ID, formula.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]FormulaColorant{formulaColorant}, formulaColorants); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	err = db.Undelete(db, "COLORANT", account.ID, colorant.ID)
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Fatal("expected error Not Found when restoring an entity not in the trash; found alternate error")
+	}
+}
+
+func TestPurgeDeleted(t *testing.T) {
+	db, err := newTestDB(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := Account{
+		ID: "test_account",
+	}
+
+	err = db.InsertAccount(db, &account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"old_formula", "new_formula", "live_formula"} {
+		err = db.InsertFormula(db, &Formula{
+			Account: account.ID,
+			ID:      id,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = db.SoftDelete(db, "FORMULA", account.ID, "old_formula", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.SoftDelete(db, "FORMULA", account.ID, "new_formula", 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	purged, err := db.PurgeDeleted(db, 15)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if purged != 1 {
+		t.Errorf("expected 1 entity to be purged; purged %d", purged)
+	}
+
+	err = db.Undelete(db, "FORMULA", account.ID, "old_formula")
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Fatal("expected purged formula to be gone; found alternate error")
+	}
+
+	err = db.Undelete(db, "FORMULA", account.ID, "new_formula")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	formulas, err := db.ListFormulas(db, account.ID, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(formulas) != 2 {
+		t.Errorf("expected 2 elements in list found %d", len(formulas))
+	}
+}
+
+func TestPurgeDeletedKeepsIngredientsInUse(t *testing.T) {
+	tests := map[string]struct {
+		kind      string
+		insert    func(db DB, id string) error
+		associate func(db DB, formula, id string) error
+		remove    func(db DB, formula, id string) error
+	}{
+		"base": {
+			kind: "BASE",
+			insert: func(db DB, id string) error {
+				return db.InsertBase(db, &Base{Account: "test_account", ID: id})
+			},
+			associate: func(db DB, formula, id string) error {
+				return db.AssociateBaseWithFormula(db, &FormulaBase{
+					Account: "test_account", Formula: formula, Base: id, Amount: "1 gal",
+				})
+			},
+			remove: func(db DB, formula, id string) error {
+				return db.DeleteFormulaBase(db, "test_account", formula, id)
+			},
+		},
+		"colorant": {
+			kind: "COLORANT",
+			insert: func(db DB, id string) error {
+				return db.InsertColorant(db, &Colorant{Account: "test_account", ID: id})
+			},
+			associate: func(db DB, formula, id string) error {
+				return db.AssociateColorantWithFormula(db, &FormulaColorant{
+					Account: "test_account", Formula: formula, Colorant: id, Amount: "2Y24",
+				})
+			},
+			remove: func(db DB, formula, id string) error {
+				return db.DeleteFormulaColorant(db, "test_account", formula, id)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			db, err := newTestDB(t)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.InsertAccount(db, &Account{ID: "test_account"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = db.InsertFormula(db, &Formula{Account: "test_account", ID: "live_formula"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, id := range []string{"used", "unused"} {
+				err = tc.insert(db, id)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = db.SoftDelete(db, tc.kind, "test_account", id, 10)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err = tc.associate(db, "live_formula", "used")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			purged, err := db.PurgeDeleted(db, 15)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if purged != 1 {
+				t.Errorf("expected only the unused %s to be purged; purged %d", name, purged)
+			}
+
+			// The one still in a live formula's recipe stays in the trash, where it can be restored from.
+			deleted, err := db.ListDeleted(db, "test_account", tc.kind, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(deleted) != 1 || deleted[0].ID != "used" {
+				t.Fatalf("expected the used %s to be left in the trash; found %v", name, deleted)
+			}
+
+			// Once nothing uses it, it's purged like anything else.
+			err = tc.remove(db, "live_formula", "used")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			purged, err = db.PurgeDeleted(db, 15)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if purged != 1 {
+				t.Errorf("expected the %s no longer used to be purged; purged %d", name, purged)
+			}
+		})
+	}
+}
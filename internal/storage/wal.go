@@ -0,0 +1,461 @@
+package storage
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// The write-ahead log is shipped so that the database can be restored to any point in time, rather than only to when
+// a backup was taken. Shipping works in generations: a generation starts with a copy of the database file and of its
+// write-ahead log, and every shipment after that adds a segment holding the frames committed to the log since the
+// last one. Restoring lays a generation's segments over its copy of the log, up to the point in time asked for, and
+// lets sqlite apply them.
+//
+// Sqlite checkpoints the log into the database file by itself and, once it has, starts writing the log from the
+// beginning again. Frames shipped from the old log no longer follow on from the new one, so a new generation is
+// started whenever that's happened since the last shipment.
+//
+// An archive is laid out as:
+//
+//	generation-20230101T000000.000Z/
+//		base.db
+//		base.wal
+//		00000001-20230101T000010.000Z.wal
+//		00000002-20230101T000020.000Z.wal
+
+// walTimeFormat is the layout times are embedded into generation and segment names with. Shipments can be less than
+// a second apart so it keeps milliseconds; like backupTimeFormat it sorts in chronological order.
+const walTimeFormat = "20060102T150405.000Z"
+
+const (
+	walGenerationPrefix = "generation-"
+	walBaseDatabase     = "base.db"
+	walBaseLog          = "base.wal"
+	walSegmentSuffix    = ".wal"
+)
+
+const (
+	walHeaderSize      = 32
+	walFrameHeaderSize = 24
+)
+
+// WALGeneration is a single generation of a write-ahead log archive.
+type WALGeneration struct {
+	Path    string
+	Started time.Time
+
+	// Segments are ordered from first shipped to last.
+	Segments []WALSegment
+}
+
+// WALSegment is the frames shipped from the write-ahead log at a single point in time.
+type WALSegment struct {
+	Path    string
+	Shipped time.Time
+}
+
+// WALShipper copies frames committed to a sqlite database's write-ahead log into an archive.
+type WALShipper struct {
+	db  *SQLite
+	dir string
+
+	// Where the current generation is at; the zero value means a new generation has to be started.
+	generation string
+	salt       [8]byte
+	offset     int64 // The end of the last frame shipped.
+	sequence   int
+}
+
+// NewWALShipper ships the write-ahead log of db to the archive directory dir, starting a new generation on its first
+// shipment.
+func NewWALShipper(db *SQLite, dir string) *WALShipper {
+	return &WALShipper{
+		db:  db,
+		dir: dir,
+	}
+}
+
+// Ship copies the frames committed to the write-ahead log since the last shipment into the archive. The database is
+// locked for writing for the duration; writers wait for it as they would for any other.
+func (s *WALShipper) Ship(now time.Time) error {
+	ctx := context.Background()
+
+	conn, err := s.db.Connx(ctx)
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+	defer conn.Close()
+
+	// With the write lock held nothing can be committed, so the log can neither grow nor start again from the
+	// beginning while it's read.
+	_, err = conn.ExecContext(ctx, "BEGIN IMMEDIATE")
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+	defer conn.ExecContext(ctx, "ROLLBACK") //nolint:errcheck
+
+	log, err := os.ReadFile(s.db.path + "-wal")
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	salt, end := parseWAL(log)
+
+	if s.generation == "" || salt != s.salt || end < s.offset {
+		return s.startGeneration(now, salt, log[:end])
+	}
+
+	if end == s.offset {
+		return nil
+	}
+
+	name := fmt.Sprintf("%08d-%s%s", s.sequence+1, now.UTC().Format(walTimeFormat), walSegmentSuffix)
+	err = writeFileSynced(filepath.Join(s.dir, s.generation, name), log[s.offset:end])
+	if err != nil {
+		return err
+	}
+
+	s.offset = end
+	s.sequence++
+
+	return nil
+}
+
+// startGeneration copies the database and the committed part of its log into a new generation. It must be called
+// with the write lock held.
+func (s *WALShipper) startGeneration(now time.Time, salt [8]byte, log []byte) error {
+	generation := walGenerationPrefix + now.UTC().Format(walTimeFormat)
+	path := filepath.Join(s.dir, generation)
+
+	err := os.MkdirAll(path, 0o700)
+	if err != nil {
+		return err
+	}
+
+	// A checkpoint started before the lock was taken may still be copying frames into the database file. Those
+	// frames are also in the log copied alongside it, and win over whatever was copied of the file once applied.
+	err = copyFile(s.db.path, filepath.Join(path, walBaseDatabase))
+	if err != nil {
+		_ = os.RemoveAll(path)
+		return err
+	}
+
+	err = writeFileSynced(filepath.Join(path, walBaseLog), log)
+	if err != nil {
+		_ = os.RemoveAll(path)
+		return err
+	}
+
+	s.generation = generation
+	s.salt = salt
+	s.offset = int64(len(log))
+	s.sequence = 0
+
+	return nil
+}
+
+// parseWAL returns the salt of a write-ahead log and the end of its last valid commit frame. Frames past it belong to
+// a transaction which hasn't committed or are left over from before the log was last started over. A log without a
+// valid header has a zero salt and no frames.
+func parseWAL(log []byte) (salt [8]byte, end int64) {
+	if len(log) < walHeaderSize {
+		return [8]byte{}, 0
+	}
+
+	var order binary.ByteOrder
+	switch binary.BigEndian.Uint32(log[0:4]) {
+	case 0x377f0682:
+		order = binary.LittleEndian
+	case 0x377f0683:
+		order = binary.BigEndian
+	default:
+		return [8]byte{}, 0
+	}
+
+	s0, s1 := walChecksum(order, log[0:24], 0, 0)
+	if s0 != binary.BigEndian.Uint32(log[24:28]) || s1 != binary.BigEndian.Uint32(log[28:32]) {
+		return [8]byte{}, 0
+	}
+
+	copy(salt[:], log[16:24])
+	pageSize := int64(binary.BigEndian.Uint32(log[8:12]))
+	if pageSize == 1 {
+		pageSize = 65536
+	}
+
+	end = walHeaderSize
+	for offset := int64(walHeaderSize); offset+walFrameHeaderSize+pageSize <= int64(len(log)); {
+		frame := log[offset : offset+walFrameHeaderSize+pageSize]
+
+		var frameSalt [8]byte
+		copy(frameSalt[:], frame[8:16])
+		if frameSalt != salt {
+			break
+		}
+
+		s0, s1 = walChecksum(order, frame[0:8], s0, s1)
+		s0, s1 = walChecksum(order, frame[walFrameHeaderSize:], s0, s1)
+		if s0 != binary.BigEndian.Uint32(frame[16:20]) || s1 != binary.BigEndian.Uint32(frame[20:24]) {
+			break
+		}
+
+		offset += int64(len(frame))
+
+		// Only commit frames record the size of the database after the commit.
+		if binary.BigEndian.Uint32(frame[4:8]) != 0 {
+			end = offset
+		}
+	}
+
+	return salt, end
+}
+
+// walChecksum continues the running checksum sqlite keeps over its write-ahead log with data.
+func walChecksum(order binary.ByteOrder, data []byte, s0, s1 uint32) (uint32, uint32) {
+	for i := 0; i+8 <= len(data); i += 8 {
+		s0 += order.Uint32(data[i:i+4]) + s1
+		s1 += order.Uint32(data[i+4:i+8]) + s0
+	}
+
+	return s0, s1
+}
+
+// ListWALGenerations returns the generations of the write-ahead log archive in dir from oldest to newest.
+func ListWALGenerations(dir string) ([]WALGeneration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	generations := []WALGeneration{}
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), walGenerationPrefix) {
+			continue
+		}
+
+		started, err := time.Parse(walTimeFormat, strings.TrimPrefix(entry.Name(), walGenerationPrefix))
+		if err != nil {
+			continue
+		}
+
+		generation := WALGeneration{
+			Path:    filepath.Join(dir, entry.Name()),
+			Started: started,
+		}
+
+		generation.Segments, err = listWALSegments(generation.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		generations = append(generations, generation)
+	}
+
+	sort.Slice(generations, func(i, j int) bool {
+		return generations[i].Started.Before(generations[j].Started)
+	})
+
+	return generations, nil
+}
+
+func listWALSegments(dir string) ([]WALSegment, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	type numberedSegment struct {
+		WALSegment
+		sequence int
+	}
+
+	numbered := []numberedSegment{}
+	for _, entry := range entries {
+		sequenceRaw, shippedRaw, found := strings.Cut(strings.TrimSuffix(entry.Name(), walSegmentSuffix), "-")
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), walSegmentSuffix) || !found {
+			continue
+		}
+
+		sequence, err := strconv.Atoi(sequenceRaw)
+		if err != nil {
+			continue
+		}
+
+		shipped, err := time.Parse(walTimeFormat, shippedRaw)
+		if err != nil {
+			continue
+		}
+
+		numbered = append(numbered, numberedSegment{
+			WALSegment: WALSegment{Path: filepath.Join(dir, entry.Name()), Shipped: shipped},
+			sequence:   sequence,
+		})
+	}
+
+	sort.Slice(numbered, func(i, j int) bool {
+		return numbered[i].sequence < numbered[j].sequence
+	})
+
+	segments := []WALSegment{}
+	for i, segment := range numbered {
+		// A segment only follows on from the one before it; anything after a missing one can't be applied.
+		if segment.sequence != i+1 {
+			break
+		}
+		segments = append(segments, segment.WALSegment)
+	}
+
+	return segments, nil
+}
+
+// FindWALGeneration returns the most recent generation in dir started at or before the given time, with only the
+// segments shipped by then.
+func FindWALGeneration(dir string, at time.Time) (WALGeneration, error) {
+	generations, err := ListWALGenerations(dir)
+	if err != nil {
+		return WALGeneration{}, err
+	}
+
+	for i := len(generations) - 1; i >= 0; i-- {
+		generation := generations[i]
+		if generation.Started.After(at) {
+			continue
+		}
+
+		segments := []WALSegment{}
+		for _, segment := range generation.Segments {
+			if segment.Shipped.After(at) {
+				break
+			}
+			segments = append(segments, segment)
+		}
+		generation.Segments = segments
+
+		return generation, nil
+	}
+
+	return WALGeneration{}, ErrEntityNotFound
+}
+
+// Restored returns the point in time a database restored from the generation is as of.
+func (g WALGeneration) Restored() time.Time {
+	if len(g.Segments) == 0 {
+		return g.Started
+	}
+
+	return g.Segments[len(g.Segments)-1].Shipped
+}
+
+// RestoreWAL replaces the database at dst with the one rebuilt from a generation of a write-ahead log archive; see
+// FindWALGeneration. As with Restore, the rebuilt database is verified before the swap and the database being
+// replaced is kept alongside it with a ".pre-restore" suffix.
+//
+// RestoreWAL must not be run while the Basecoat service is using dst.
+func RestoreWAL(generation WALGeneration, dst string) error {
+	tmp := dst + ".restore"
+	// Does nothing once the rebuilt database has been moved into place.
+	defer os.Remove(tmp)
+	defer func() {
+		for _, suffix := range sqliteCompanionSuffixes {
+			_ = os.Remove(tmp + suffix)
+		}
+	}()
+
+	err := copyFile(filepath.Join(generation.Path, walBaseDatabase), tmp)
+	if err != nil {
+		return fmt.Errorf("could not copy generation %q into place: %w", generation.Path, err)
+	}
+
+	log, err := os.ReadFile(filepath.Join(generation.Path, walBaseLog))
+	if err != nil {
+		return fmt.Errorf("could not read generation %q: %w", generation.Path, err)
+	}
+
+	for _, segment := range generation.Segments {
+		frames, err := os.ReadFile(segment.Path)
+		if err != nil {
+			return fmt.Errorf("could not read segment %q: %w", segment.Path, err)
+		}
+		log = append(log, frames...)
+	}
+
+	err = writeFileSynced(tmp+"-wal", log)
+	if err != nil {
+		return fmt.Errorf("could not write rebuilt write-ahead log: %w", err)
+	}
+
+	// Sqlite applies the log when the database is opened; checkpointing it writes it into the database file.
+	db, err := sqlx.Connect("sqlite3", fmt.Sprintf("file:%s?_journal=wal", tmp))
+	if err != nil {
+		return fmt.Errorf("could not open rebuilt database: %v; %w", err, ErrIntegrityCheck)
+	}
+
+	_, err = db.Exec("PRAGMA wal_checkpoint(TRUNCATE)")
+	db.Close()
+	if err != nil {
+		return fmt.Errorf("could not apply write-ahead log: %v; %w", err, ErrIntegrityCheck)
+	}
+
+	return replaceDatabase(tmp, dst)
+}
+
+// PruneWALGenerations removes generations from dir which can only restore the database to points in time older than
+// maxAge; those followed by a generation which started before then. The most recent generation is never removed and
+// a zero maxAge keeps every generation. Returns the paths of the removed generations.
+func PruneWALGenerations(dir string, maxAge time.Duration, now time.Time) ([]string, error) {
+	if maxAge == 0 {
+		return []string{}, nil
+	}
+
+	generations, err := ListWALGenerations(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := []string{}
+	for i := 0; i < len(generations)-1; i++ {
+		if now.Sub(generations[i+1].Started) <= maxAge {
+			break
+		}
+
+		err := os.RemoveAll(generations[i].Path)
+		if err != nil {
+			return removed, err
+		}
+
+		removed = append(removed, generations[i].Path)
+	}
+
+	return removed, nil
+}
+
+func writeFileSynced(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestShipAndRestoreWAL(t *testing.T) {
+	path := tempFile()
+	db, err := NewSQLite(path, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(path)
+	defer db.Close()
+
+	dir := t.TempDir()
+	shipper := NewWALShipper(db, dir)
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Ships the write-ahead log after each account is added; the account is shipped as of the time returned.
+	shipAccount := func(id string, minutes int) time.Time {
+		t.Helper()
+
+		err := db.InsertAccount(db, &Account{ID: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		shipped := start.Add(time.Duration(minutes) * time.Minute)
+		err = shipper.Ship(shipped)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return shipped
+	}
+
+	first := shipAccount("account_1", 0)
+	second := shipAccount("account_2", 1)
+	third := shipAccount("account_3", 2)
+
+	// Once the log has been checkpointed and started over, shipping carries on in a new generation.
+	_, err = db.Exec("PRAGMA wal_checkpoint(TRUNCATE)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fourth := shipAccount("account_4", 3)
+
+	generations, err := ListWALGenerations(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(generations) != 2 || !generations[0].Started.Equal(first) || !generations[1].Started.Equal(fourth) {
+		t.Fatalf("expected generations started at %s and %s; found %v", first, fourth, generations)
+	}
+
+	tests := map[string]struct {
+		at       time.Time
+		accounts []string
+	}{
+		"start of generation": {at: first, accounts: []string{"account_1"}},
+		"between shipments":   {at: second.Add(30 * time.Second), accounts: []string{"account_1", "account_2"}},
+		"last shipment":       {at: third, accounts: []string{"account_1", "account_2", "account_3"}},
+		"next generation":     {at: fourth, accounts: []string{"account_1", "account_2", "account_3", "account_4"}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			generation, err := FindWALGeneration(dir, tc.at)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			restoredPath := filepath.Join(t.TempDir(), "basecoat.db")
+			err = RestoreWAL(generation, restoredPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			restored, err := NewSQLite(restoredPath, 200)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer restored.Close()
+
+			accounts, err := restored.ListAccounts(restored, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(accounts) != len(tc.accounts) {
+				t.Fatalf("expected accounts %v; found %v", tc.accounts, accounts)
+			}
+			for i, account := range accounts {
+				if account.ID != tc.accounts[i] {
+					t.Errorf("expected accounts %v; found %v", tc.accounts, accounts)
+				}
+			}
+		})
+	}
+
+	_, err = FindWALGeneration(dir, first.Add(-time.Second))
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected no generation before the first; found %v", err)
+	}
+}
+
+func TestPruneWALGenerations(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	for _, started := range []time.Time{now.Add(-72 * time.Hour), now.Add(-48 * time.Hour), now.Add(-time.Hour)} {
+		err := os.Mkdir(filepath.Join(dir, walGenerationPrefix+started.Format(walTimeFormat)), 0o700)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The second generation covers up to an hour ago, so only the first is past a day old.
+	removed, err := PruneWALGenerations(dir, 24*time.Hour, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(removed) != 1 || filepath.Base(removed[0]) != walGenerationPrefix+now.Add(-72*time.Hour).Format(walTimeFormat) {
+		t.Errorf("unexpected generations removed: %v", removed)
+	}
+
+	generations, err := ListWALGenerations(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(generations) != 2 {
+		t.Errorf("expected 2 generations left; found %d", len(generations))
+	}
+}
@@ -0,0 +1,313 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	qb "github.com/Masterminds/squirrel"
+)
+
+type Webhook struct {
+	Account  string
+	ID       string
+	URL      string
+	Events   string // Comma separated event types; ex. "job.updated,mix.created".
+	Secret   string
+	Created  int64
+	Modified int64
+}
+
+type UpdatableWebhookFields struct {
+	URL      *string
+	Events   *string
+	Secret   *string
+	Modified *int64
+}
+
+type WebhookDelivery struct {
+	ID           int64
+	Account      string
+	Webhook      string
+	Event        string
+	Sequence     int64
+	Payload      string
+	State        string
+	Attempts     int64
+	NextAttempt  int64 `db:"next_attempt"`
+	ResponseCode int64 `db:"response_code"`
+	Error        string
+	Created      int64
+	Modified     int64
+}
+
+type UpdatableWebhookDeliveryFields struct {
+	State        *string
+	Attempts     *int64
+	NextAttempt  *int64
+	ResponseCode *int64
+	Error        *string
+	Modified     *int64
+}
+
+// webhookDeliveryPending is the state of deliveries which haven't been made yet; it must match
+// models.WebhookDeliveryStatePending.
+const webhookDeliveryPending = "PENDING"
+
+func (db *sqlDB) ListWebhooks(conn Queryable, account string) ([]Webhook, error) {
+	query, args := db.builder.Select("account", "id", "url", "events", "secret", "created", "modified").
+		From("webhooks").
+		Where(qb.Eq{"account": account}).
+		OrderBy("id").
+		MustSql()
+
+	webhooks := []Webhook{}
+	err := conn.Select(&webhooks, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return webhooks, nil
+}
+
+func (db *sqlDB) GetWebhook(conn Queryable, account, id string) (Webhook, error) {
+	query, args := db.builder.Select("account", "id", "url", "events", "secret", "created", "modified").
+		From("webhooks").
+		Where(qb.Eq{"account": account, "id": id}).
+		MustSql()
+
+	webhook := Webhook{}
+	err := conn.Get(&webhook, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Webhook{}, ErrEntityNotFound
+		}
+
+		return Webhook{}, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return webhook, nil
+}
+
+func (db *sqlDB) InsertWebhook(conn Queryable, webhook *Webhook) error {
+	_, err := db.builder.Insert("webhooks").
+		Columns("account", "id", "url", "events", "secret", "created", "modified").
+		Values(webhook.Account, webhook.ID, webhook.URL, webhook.Events, webhook.Secret, webhook.Created,
+			webhook.Modified).
+		RunWith(conn).Exec()
+	if err != nil {
+		if db.dialect.IsUniqueViolation(err) {
+			return ErrEntityExists
+		}
+
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+func (db *sqlDB) UpdateWebhook(conn Queryable, account, id string, fields UpdatableWebhookFields) error {
+	query := db.builder.Update("webhooks")
+
+	if fields.URL != nil {
+		query = query.Set("url", fields.URL)
+	}
+
+	if fields.Events != nil {
+		query = query.Set("events", fields.Events)
+	}
+
+	if fields.Secret != nil {
+		query = query.Set("secret", fields.Secret)
+	}
+
+	if fields.Modified != nil {
+		query = query.Set("modified", fields.Modified)
+	}
+
+	result, err := query.Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	if rows == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// DeleteWebhook removes a webhook along with its delivery log.
+func (db *sqlDB) DeleteWebhook(conn Queryable, account, id string) error {
+	result, err := db.builder.Delete("webhooks").Where(qb.Eq{"account": account, "id": id}).RunWith(conn).Exec()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	if rows == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// InsertWebhookDelivery queues a delivery and sets its ID.
+func (db *sqlDB) InsertWebhookDelivery(conn Queryable, delivery *WebhookDelivery) error {
+	query, args := db.builder.Insert("webhook_deliveries").
+		Columns("account", "webhook", "event", "sequence", "payload", "state", "attempts", "next_attempt",
+			"response_code", "error", "created", "modified").
+		Values(delivery.Account, delivery.Webhook, delivery.Event, delivery.Sequence, delivery.Payload, delivery.State,
+			delivery.Attempts, delivery.NextAttempt, delivery.ResponseCode, delivery.Error, delivery.Created,
+			delivery.Modified).
+		Suffix("RETURNING id").
+		MustSql()
+
+	err := conn.QueryRow(query, args...).Scan(&delivery.ID)
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveries returns a webhook's delivery log from newest to oldest.
+func (db *sqlDB) ListWebhookDeliveries(conn Queryable, account, webhook string, offset, limit int) ([]WebhookDelivery, error) {
+	if limit == 0 || limit > db.maxResultsLimit {
+		limit = db.maxResultsLimit
+	}
+
+	query, args := db.builder.Select("id", "account", "webhook", "event", "sequence", "payload", "state", "attempts",
+		"next_attempt", "response_code", "error", "created", "modified").
+		From("webhook_deliveries").
+		Where(qb.Eq{"account": account, "webhook": webhook}).
+		OrderBy("id DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		MustSql()
+
+	deliveries := []WebhookDelivery{}
+	err := conn.Select(&deliveries, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return deliveries, nil
+}
+
+// ListDueWebhookDeliveries returns pending deliveries, of every account, whose next attempt is due at the time given;
+// longest overdue first.
+func (db *sqlDB) ListDueWebhookDeliveries(conn Queryable, now int64, limit int) ([]WebhookDelivery, error) {
+	if limit == 0 || limit > db.maxResultsLimit {
+		limit = db.maxResultsLimit
+	}
+
+	query, args := db.builder.Select("id", "account", "webhook", "event", "sequence", "payload", "state", "attempts",
+		"next_attempt", "response_code", "error", "created", "modified").
+		From("webhook_deliveries").
+		Where(qb.Eq{"state": webhookDeliveryPending}).
+		Where(qb.LtOrEq{"next_attempt": now}).
+		OrderBy("next_attempt", "id").
+		Limit(uint64(limit)).
+		MustSql()
+
+	deliveries := []WebhookDelivery{}
+	err := conn.Select(&deliveries, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return deliveries, nil
+}
+
+// ClaimWebhookDelivery pushes back the next attempt of a pending delivery to lease so that no one else makes it in the
+// meantime. It returns false if the delivery was claimed or changed by someone else since it was read with
+// nextAttempt.
+func (db *sqlDB) ClaimWebhookDelivery(conn Queryable, id, nextAttempt, lease int64) (bool, error) {
+	result, err := db.builder.Update("webhook_deliveries").
+		Set("next_attempt", lease).
+		Where(qb.Eq{"id": id, "state": webhookDeliveryPending, "next_attempt": nextAttempt}).
+		RunWith(conn).Exec()
+	if err != nil {
+		return false, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return rows > 0, nil
+}
+
+func (db *sqlDB) UpdateWebhookDelivery(conn Queryable, id int64, fields UpdatableWebhookDeliveryFields) error {
+	query := db.builder.Update("webhook_deliveries")
+
+	if fields.State != nil {
+		query = query.Set("state", fields.State)
+	}
+
+	if fields.Attempts != nil {
+		query = query.Set("attempts", fields.Attempts)
+	}
+
+	if fields.NextAttempt != nil {
+		query = query.Set("next_attempt", fields.NextAttempt)
+	}
+
+	if fields.ResponseCode != nil {
+		query = query.Set("response_code", fields.ResponseCode)
+	}
+
+	if fields.Error != nil {
+		query = query.Set("error", fields.Error)
+	}
+
+	if fields.Modified != nil {
+		query = query.Set("modified", fields.Modified)
+	}
+
+	result, err := query.Where(qb.Eq{"id": id}).RunWith(conn).Exec()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	if rows == 0 {
+		return ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// PurgeWebhookDeliveries removes deliveries which were last attempted before the time given, in epoch milli, from
+// every delivery log. Deliveries still being retried are kept.
+func (db *sqlDB) PurgeWebhookDeliveries(conn Queryable, before int64) (int64, error) {
+	result, err := db.builder.Delete("webhook_deliveries").
+		Where(qb.NotEq{"state": webhookDeliveryPending}).
+		Where(qb.Lt{"modified": before}).
+		RunWith(conn).Exec()
+	if err != nil {
+		return 0, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("database error occurred: %v; %w", err, ErrInternal)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCRUDWebhooks(t *testing.T) {
+	db, err := newTestDB(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := Account{
+		ID: "test_account",
+	}
+
+	err = db.InsertAccount(db, &account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	webhook := Webhook{
+		Account: "test_account",
+		ID:      "test_webhook",
+		URL:     "http://localhost:9000/hook",
+		Events:  "job.updated,mix.created",
+		Secret:  "shh",
+		Created: 1,
+	}
+
+	err = db.InsertWebhook(db, &webhook)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.InsertWebhook(db, &webhook)
+	if !errors.Is(err, ErrEntityExists) {
+		t.Errorf("expected ErrEntityExists inserting a webhook twice; got %v", err)
+	}
+
+	webhooks, err := db.ListWebhooks(db, account.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]Webhook{webhook}, webhooks); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	webhook.URL = "https://example.com/hook"
+	webhook.Modified = 2
+
+	err = db.UpdateWebhook(db, account.ID, webhook.ID, UpdatableWebhookFields{
+		URL:      &webhook.URL,
+		Modified: &webhook.Modified,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fetchedWebhook, err := db.GetWebhook(db, account.ID, webhook.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(webhook, fetchedWebhook); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	err = db.UpdateWebhook(db, account.ID, "missing", UpdatableWebhookFields{URL: &webhook.URL})
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound updating a missing webhook; got %v", err)
+	}
+
+	delivery := WebhookDelivery{
+		Account:     account.ID,
+		Webhook:     webhook.ID,
+		Event:       "job.updated",
+		Sequence:    10,
+		Payload:     `{"type":"job.updated"}`,
+		State:       "PENDING",
+		NextAttempt: 100,
+		Created:     100,
+		Modified:    100,
+	}
+
+	err = db.InsertWebhookDelivery(db, &delivery)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if delivery.ID == 0 {
+		t.Fatal("expected delivery id to be set")
+	}
+
+	due, err := db.ListDueWebhookDeliveries(db, 99, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(due) != 0 {
+		t.Errorf("expected no deliveries due before their next attempt; got %d", len(due))
+	}
+
+	due, err = db.ListDueWebhookDeliveries(db, 100, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]WebhookDelivery{delivery}, due); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	claimed, err := db.ClaimWebhookDelivery(db, delivery.ID, delivery.NextAttempt, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !claimed {
+		t.Error("expected delivery to be claimed")
+	}
+
+	claimed, err = db.ClaimWebhookDelivery(db, delivery.ID, delivery.NextAttempt, 200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if claimed {
+		t.Error("expected a delivery to only be claimed once")
+	}
+
+	delivery.State = "SUCCEEDED"
+	delivery.Attempts = 1
+	delivery.NextAttempt = 200
+	delivery.ResponseCode = 204
+	delivery.Modified = 150
+
+	err = db.UpdateWebhookDelivery(db, delivery.ID, UpdatableWebhookDeliveryFields{
+		State:        &delivery.State,
+		Attempts:     &delivery.Attempts,
+		ResponseCode: &delivery.ResponseCode,
+		Modified:     &delivery.Modified,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deliveries, err := db.ListWebhookDeliveries(db, account.ID, webhook.ID, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff([]WebhookDelivery{delivery}, deliveries); diff != "" {
+		t.Errorf("unexpected map values (-want +got):\n%s", diff)
+	}
+
+	due, err = db.ListDueWebhookDeliveries(db, 1000, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(due) != 0 {
+		t.Errorf("expected deliveries which succeeded to no longer be due; got %d", len(due))
+	}
+
+	purged, err := db.PurgeWebhookDeliveries(db, 151)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if purged != 1 {
+		t.Errorf("expected 1 delivery to be purged; purged %d", purged)
+	}
+
+	err = db.DeleteWebhook(db, account.ID, webhook.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = db.GetWebhook(db, account.ID, webhook.ID)
+	if !errors.Is(err, ErrEntityNotFound) {
+		t.Errorf("expected ErrEntityNotFound getting a deleted webhook; got %v", err)
+	}
+}
@@ -0,0 +1,343 @@
+// Package webhook posts an account's events to the webhooks subscribed to them.
+//
+// Deliveries are queued in the same transaction as the change they describe, so a change is never made without its
+// deliveries, and then posted by a Dispatcher running in the background. A delivery which fails is retried with
+// exponential backoff until it succeeds or runs out of attempts. Either way it's kept as the webhook's delivery log.
+//
+// Every delivery is a JSON document (see models.WebhookPayload) posted with the headers below. The signature is the
+// hex encoded HMAC-SHA256 of the timestamp header, a period and the body, keyed with the webhook's secret; see Sign.
+// Receivers should check it and the timestamp before trusting a delivery. A delivery may be posted more than once;
+// the delivery header stays the same between attempts.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/schedule"
+	"github.com/clintjedwards/basecoat/internal/storage"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// HeaderEvent is the type of event delivered; ex. "job.updated".
+	HeaderEvent = "X-Basecoat-Event"
+	// HeaderDelivery is the ID of the delivery; the same for every attempt at it.
+	HeaderDelivery = "X-Basecoat-Delivery"
+	// HeaderTimestamp is when the attempt was made in epoch seconds.
+	HeaderTimestamp = "X-Basecoat-Timestamp"
+	// HeaderSignature is "sha256=" followed by the signature of the attempt; see Sign.
+	HeaderSignature = "X-Basecoat-Signature"
+)
+
+// deliveryBatchSize is the most deliveries made at once.
+const deliveryBatchSize = 50
+
+// maxErrorBody is how much of a failed response's body is kept in the delivery log.
+const maxErrorBody = 512
+
+// Sign returns the signature of a delivery; the hex encoded HMAC-SHA256 of the timestamp, a period and the body.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns true if the signature header of a delivery was made with the secret given.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	expected := "sha256=" + Sign(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// Queue adds a delivery of the event for every webhook of the event's account subscribed to its type. It should be
+// called with the transaction that made the change the event describes.
+func Queue(db storage.DB, conn storage.Queryable, event *models.AuditEvent) error {
+	webhooksRaw, err := db.ListWebhooks(conn, event.Account)
+	if err != nil {
+		return err
+	}
+
+	if len(webhooksRaw) == 0 {
+		return nil
+	}
+
+	payload := models.NewWebhookPayload(event)
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	for _, webhookRaw := range webhooksRaw {
+		var webhook models.Webhook
+		webhook.FromStorage(&webhookRaw)
+
+		if !webhook.Subscribes(payload.Type) {
+			continue
+		}
+
+		delivery := models.NewWebhookDelivery(event.Account, webhook.ID, payload.Type, event.ID, string(body))
+		err = db.InsertWebhookDelivery(conn, delivery.ToStorage())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Backoff returns how long to wait before the next attempt at a delivery which has failed the given number of times.
+func Backoff(config *config.Webhooks, attempts int64) time.Duration {
+	backoff := config.Backoff
+	for i := int64(1); i < attempts && backoff < config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > config.MaxBackoff {
+		return config.MaxBackoff
+	}
+
+	return backoff
+}
+
+// Dispatcher posts deliveries to webhooks as they come due.
+type Dispatcher struct {
+	db     storage.DB
+	config *config.Webhooks
+	client *http.Client
+}
+
+func NewDispatcher(db storage.DB, config *config.Webhooks) *Dispatcher {
+	return &Dispatcher{
+		db:     db,
+		config: config,
+		client: &http.Client{
+			Timeout: config.Timeout,
+			// A redirected POST turns into a GET; the webhook's URL should be updated instead.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Run makes deliveries as they come due. It blocks forever and should be run in a goroutine.
+func (d *Dispatcher) Run() {
+	schedule.Every(d.config.PollInterval, d.DeliverDue)
+}
+
+// DeliverDue makes every delivery which is due, all at once, and returns once they've all been attempted.
+func (d *Dispatcher) DeliverDue() {
+	for {
+		deliveriesRaw, err := d.db.ListDueWebhookDeliveries(d.db, time.Now().UnixMilli(), deliveryBatchSize)
+		if err != nil {
+			log.Error().Err(err).Msg("could not retrieve webhook deliveries")
+			return
+		}
+
+		if len(deliveriesRaw) == 0 {
+			return
+		}
+
+		wg := sync.WaitGroup{}
+		for _, deliveryRaw := range deliveriesRaw {
+			var delivery models.WebhookDelivery
+			delivery.FromStorage(&deliveryRaw)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.attempt(&delivery)
+			}()
+		}
+		wg.Wait()
+
+		if len(deliveriesRaw) < deliveryBatchSize {
+			return
+		}
+	}
+}
+
+// attempt makes a single attempt at a delivery and records the outcome.
+func (d *Dispatcher) attempt(delivery *models.WebhookDelivery) {
+	// Claiming the delivery keeps other servers sharing the database from making it at the same time. If this server
+	// goes away mid attempt the delivery is picked up again once the claim runs out.
+	lease := time.Now().Add(2 * d.config.Timeout).UnixMilli()
+	claimed, err := d.db.ClaimWebhookDelivery(d.db, delivery.ID, delivery.NextAttempt, lease)
+	if err != nil {
+		log.Error().Err(err).Int64("delivery", delivery.ID).Msg("could not claim webhook delivery")
+		return
+	}
+
+	if !claimed {
+		return
+	}
+
+	webhookRaw, err := d.db.GetWebhook(d.db, delivery.Account, delivery.Webhook)
+	if err != nil {
+		// Deleting a webhook deletes its deliveries too, so this is only ever a passing error.
+		log.Error().Err(err).Int64("delivery", delivery.ID).Msg("could not retrieve webhook for delivery")
+		return
+	}
+
+	var webhook models.Webhook
+	webhook.FromStorage(&webhookRaw)
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.config.Timeout)
+	defer cancel()
+
+	d.send(ctx, &webhook, delivery)
+
+	if delivery.State == models.WebhookDeliveryStatePending && delivery.Attempts >= int64(d.config.MaxAttempts) {
+		delivery.State = models.WebhookDeliveryStateFailed
+	}
+
+	if delivery.State == models.WebhookDeliveryStatePending {
+		delivery.NextAttempt = time.Now().Add(Backoff(d.config, delivery.Attempts)).UnixMilli()
+	}
+
+	state := string(delivery.State)
+	err = d.db.UpdateWebhookDelivery(d.db, delivery.ID, storage.UpdatableWebhookDeliveryFields{
+		State:        &state,
+		Attempts:     &delivery.Attempts,
+		NextAttempt:  &delivery.NextAttempt,
+		ResponseCode: &delivery.ResponseCode,
+		Error:        &delivery.Error,
+		Modified:     &delivery.Modified,
+	})
+	if err != nil {
+		log.Error().Err(err).Int64("delivery", delivery.ID).Msg("could not save webhook delivery")
+		return
+	}
+
+	switch delivery.State {
+	case models.WebhookDeliveryStateSucceeded:
+		log.Debug().Int64("delivery", delivery.ID).Str("webhook", webhook.ID).Msg("webhook delivered")
+	case models.WebhookDeliveryStateFailed:
+		log.Warn().Int64("delivery", delivery.ID).Str("webhook", webhook.ID).Str("error", delivery.Error).
+			Int64("attempts", delivery.Attempts).Msg("gave up on webhook delivery")
+	}
+}
+
+// Ping posts a test delivery to the webhook right away, without retrying it if it fails, and adds it to the webhook's
+// delivery log.
+func (d *Dispatcher) Ping(ctx context.Context, webhook *models.Webhook) (*models.WebhookDelivery, error) {
+	body, err := json.Marshal(models.WebhookPayload{
+		Type:    models.WebhookEventPing,
+		Account: webhook.Account,
+		Created: time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := models.NewWebhookDelivery(webhook.Account, webhook.ID, models.WebhookEventPing, 0, string(body))
+	delivery.State = models.WebhookDeliveryStateFailed
+
+	// The delivery is saved first to give it an ID to send; as failed so that it isn't picked up by Run meanwhile.
+	deliveryRaw := delivery.ToStorage()
+	err = d.db.InsertWebhookDelivery(d.db, deliveryRaw)
+	if err != nil {
+		return nil, err
+	}
+	delivery.ID = deliveryRaw.ID
+
+	ctx, cancel := context.WithTimeout(ctx, d.config.Timeout)
+	defer cancel()
+
+	d.send(ctx, webhook, delivery)
+
+	if delivery.State == models.WebhookDeliveryStatePending {
+		delivery.State = models.WebhookDeliveryStateFailed
+	}
+
+	state := string(delivery.State)
+	err = d.db.UpdateWebhookDelivery(d.db, delivery.ID, storage.UpdatableWebhookDeliveryFields{
+		State:        &state,
+		Attempts:     &delivery.Attempts,
+		ResponseCode: &delivery.ResponseCode,
+		Error:        &delivery.Error,
+		Modified:     &delivery.Modified,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// send posts the delivery to the webhook once and updates the delivery with the outcome; succeeded if the webhook
+// answered with a 2xx status and still pending otherwise.
+func (d *Dispatcher) send(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) {
+	delivery.Attempts++
+	delivery.ResponseCode = 0
+	delivery.Error = ""
+
+	code, err := d.post(ctx, webhook, delivery)
+	delivery.ResponseCode = int64(code)
+	delivery.Modified = time.Now().UnixMilli()
+
+	if err != nil {
+		delivery.State = models.WebhookDeliveryStatePending
+		delivery.Error = err.Error()
+		return
+	}
+
+	delivery.State = models.WebhookDeliveryStateSucceeded
+}
+
+func (d *Dispatcher) post(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "Basecoat-Webhook")
+	request.Header.Set(HeaderEvent, delivery.Event)
+	request.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	request.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(HeaderSignature, "sha256="+Sign(webhook.Secret, timestamp, body))
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			return 0, fmt.Errorf("webhook did not respond within %s", d.config.Timeout)
+		}
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, response.Body)
+		return response.StatusCode, nil
+	}
+
+	excerpt, _ := io.ReadAll(io.LimitReader(response.Body, maxErrorBody))
+	if len(excerpt) == 0 {
+		return response.StatusCode, fmt.Errorf("webhook responded with %s", response.Status)
+	}
+
+	return response.StatusCode, fmt.Errorf("webhook responded with %s: %s", response.Status, bytes.TrimSpace(excerpt))
+}
@@ -0,0 +1,260 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/clintjedwards/basecoat/internal/config"
+	"github.com/clintjedwards/basecoat/internal/models"
+	"github.com/clintjedwards/basecoat/internal/storage"
+)
+
+// receiver is a local webhook which checks the signature of every delivery and fails the first few it's sent.
+type receiver struct {
+	t      *testing.T
+	secret string
+
+	mu       sync.Mutex
+	failures int // How many more deliveries to fail.
+	received []models.WebhookPayload
+	ids      []string
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		r.t.Error(err)
+		return
+	}
+
+	timestamp, err := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		r.t.Errorf("could not parse timestamp header: %v", err)
+	}
+
+	if !Verify(r.secret, timestamp, body, req.Header.Get(HeaderSignature)) {
+		r.t.Errorf("delivery has an invalid signature %q", req.Header.Get(HeaderSignature))
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ids = append(r.ids, req.Header.Get(HeaderDelivery))
+
+	if r.failures > 0 {
+		r.failures--
+		http.Error(w, "try again later", http.StatusServiceUnavailable)
+		return
+	}
+
+	payload := models.WebhookPayload{}
+	err = json.Unmarshal(body, &payload)
+	if err != nil {
+		r.t.Errorf("could not decode delivery: %v", err)
+	}
+
+	if req.Header.Get(HeaderEvent) != payload.Type {
+		r.t.Errorf("expected event header %q to match payload type %q", req.Header.Get(HeaderEvent), payload.Type)
+	}
+
+	r.received = append(r.received, payload)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func testDB(t *testing.T) storage.DB {
+	path := filepath.Join(t.TempDir(), "basecoat.db")
+
+	db, err := storage.New(storage.EngineSQLite, path, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Remove(path) })
+
+	err = db.InsertAccount(db, &storage.Account{ID: "shop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"type":"ping"}`)
+	signature := "sha256=" + Sign("secret", 1700000000, body)
+
+	if !Verify("secret", 1700000000, body, signature) {
+		t.Error("expected signature to verify")
+	}
+
+	if Verify("other", 1700000000, body, signature) {
+		t.Error("expected signature made with another secret not to verify")
+	}
+
+	if Verify("secret", 1700000001, body, signature) {
+		t.Error("expected signature made at another time not to verify")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	config := &config.Webhooks{Backoff: 30 * time.Second, MaxBackoff: 5 * time.Minute}
+
+	tests := map[int64]time.Duration{
+		1:  30 * time.Second,
+		2:  time.Minute,
+		3:  2 * time.Minute,
+		4:  4 * time.Minute,
+		5:  5 * time.Minute,
+		40: 5 * time.Minute,
+	}
+
+	for attempts, want := range tests {
+		if got := Backoff(config, attempts); got != want {
+			t.Errorf("backoff after %d attempts; want %s got %s", attempts, want, got)
+		}
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	db := testDB(t)
+
+	hook := &receiver{t: t, secret: "shh", failures: 2}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	webhook := models.NewWebhook("shop", server.URL, []string{"mix.created"}, "shh")
+	err := db.InsertWebhook(db, webhook.ToStorage())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after := `{"id":"m1","formula":"f1"}`
+	events := []*models.AuditEvent{
+		{ID: 1, Account: "shop", Method: "/proto.Basecoat/RecordMix", EntityKind: models.EntityKindMix, EntityID: "m1", After: &after},
+		{ID: 2, Account: "shop", Method: "/proto.Basecoat/UpdateJob", EntityKind: models.EntityKindJob, EntityID: "j1", Before: &after, After: &after},
+	}
+
+	for _, event := range events {
+		err = Queue(db, db, event)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dispatcher := NewDispatcher(db, &config.Webhooks{
+		Timeout:     time.Second,
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	})
+
+	// The receiver fails the first two attempts; the third succeeds.
+	for i := 0; i < 3; i++ {
+		dispatcher.DeliverDue()
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if len(hook.received) != 1 {
+		t.Fatalf("expected the subscribed event to be delivered once; got %d deliveries", len(hook.received))
+	}
+
+	if hook.received[0].Type != "mix.created" || hook.received[0].Sequence != 1 || hook.received[0].EntityID != "m1" ||
+		hook.received[0].Method != "RecordMix" || string(hook.received[0].Entity) != after {
+		t.Errorf("unexpected payload: %+v", hook.received[0])
+	}
+
+	if len(hook.ids) != 3 || hook.ids[0] != hook.ids[2] {
+		t.Errorf("expected every attempt to be made with the same delivery id; got %v", hook.ids)
+	}
+
+	deliveries, err := db.ListWebhookDeliveries(db, "shop", webhook.ID, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deliveries) != 1 {
+		t.Fatalf("expected 1 delivery in the log; got %d", len(deliveries))
+	}
+
+	if deliveries[0].State != string(models.WebhookDeliveryStateSucceeded) || deliveries[0].Attempts != 3 ||
+		deliveries[0].ResponseCode != http.StatusNoContent {
+		t.Errorf("unexpected delivery: %+v", deliveries[0])
+	}
+
+	// A delivery which keeps failing is given up on after the last attempt.
+	hook.failures = 10
+	err = Queue(db, db, events[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		dispatcher.DeliverDue()
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	deliveries, err = db.ListWebhookDeliveries(db, "shop", webhook.ID, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if deliveries[0].State != string(models.WebhookDeliveryStateFailed) || deliveries[0].Attempts != 3 ||
+		deliveries[0].ResponseCode != http.StatusServiceUnavailable || deliveries[0].Error == "" {
+		t.Errorf("unexpected delivery: %+v", deliveries[0])
+	}
+}
+
+func TestPing(t *testing.T) {
+	db := testDB(t)
+
+	hook := &receiver{t: t, secret: "shh", failures: 1}
+	server := httptest.NewServer(hook)
+	defer server.Close()
+
+	webhook := models.NewWebhook("shop", server.URL, []string{"job.updated"}, "shh")
+	err := db.InsertWebhook(db, webhook.ToStorage())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dispatcher := NewDispatcher(db, &config.Webhooks{Timeout: time.Second, MaxAttempts: 3, Backoff: time.Millisecond})
+
+	// Pings aren't retried.
+	delivery, err := dispatcher.Ping(context.Background(), webhook)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if delivery.State != models.WebhookDeliveryStateFailed || delivery.ResponseCode != http.StatusServiceUnavailable {
+		t.Errorf("expected ping to fail; got %+v", delivery)
+	}
+
+	dispatcher.DeliverDue()
+
+	delivery, err = dispatcher.Ping(context.Background(), webhook)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if delivery.State != models.WebhookDeliveryStateSucceeded || len(hook.received) != 1 ||
+		hook.received[0].Type != models.WebhookEventPing {
+		t.Errorf("expected ping to succeed; got %+v", delivery)
+	}
+
+	deliveries, err := db.ListWebhookDeliveries(db, "shop", webhook.ID, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deliveries) != 2 {
+		t.Errorf("expected both pings in the delivery log; got %d", len(deliveries))
+	}
+}
@@ -1,7 +1,14 @@
 package main
 
-import "github.com/clintjedwards/basecoat/internal/cmd"
+import (
+	"os"
+
+	"github.com/clintjedwards/basecoat/internal/cmd"
+)
 
 func main() {
-	_ = cmd.RootCmd.Execute()
+	// Commands report their own errors; all that's left is telling scripts the command failed.
+	if err := cmd.RootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
 }
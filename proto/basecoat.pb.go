@@ -25,7 +25,7 @@ var file_basecoat_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x62, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61,
 	0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x32, 0xd3, 0x1a, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x12, 0x4d,
+	0x6f, 0x32, 0xbf, 0x29, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x12, 0x4d,
 	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
 	0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
@@ -59,189 +59,308 @@ var file_basecoat_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f,
 	0x67, 0x67, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x46,
-	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d,
-	0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x4c,
-	0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f,
-	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x68, 0x0a, 0x17, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72,
-	0x6d, 0x75, 0x6c, 0x61, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x25, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72,
-	0x6d, 0x75, 0x6c, 0x61, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x57, 0x69, 0x74, 0x68, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x1a, 0x44, 0x69,
-	0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
-	0x61, 0x46, 0x72, 0x6f, 0x6d, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72,
-	0x6d, 0x75, 0x6c, 0x61, 0x46, 0x72, 0x6f, 0x6d, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x73,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x46, 0x72,
-	0x6f, 0x6d, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
-	0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x1b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72,
-	0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65,
-	0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6b, 0x0a, 0x18, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42,
-	0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x26,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x17,
+	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x57, 0x69, 0x74, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x57, 0x69, 0x74, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65,
-	0x42, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68,
-	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x74, 0x0a, 0x1b, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42,
-	0x61, 0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x29,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x1a, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x46, 0x72, 0x6f,
+	0x6d, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x46, 0x72, 0x6f, 0x6d, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75,
-	0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x46, 0x72, 0x6f, 0x6d, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x28, 0x01, 0x12, 0x4a, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x0b, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x4d, 0x69, 0x78, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x69,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x78, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
+	0x18, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x57, 0x69,
+	0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x1b, 0x44, 0x69,
+	0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61,
-	0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
-	0x61, 0x73, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
-	0x74, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
-	0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x12,
-	0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x1c,
-	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
-	0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x2a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61,
-	0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x1f, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73,
-	0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x46, 0x72,
-	0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x61, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
+	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
 	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
-	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12,
-	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x1c, 0x41, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x73, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x80, 0x01, 0x0a, 0x1f, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x12, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
+	0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x61,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74,
+	0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x61, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
 	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
 	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
 	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
-	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x4c, 0x69,
-	0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x69, 0x6e, 0x74, 0x6a, 0x65, 0x64, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3b, 0x0a, 0x08, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x6e, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x46, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x54,
+	0x65, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65,
+	0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6c, 0x69, 0x6e, 0x74, 0x6a, 0x65, 0x64, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_basecoat_proto_goTypes = []interface{}{
@@ -252,178 +371,282 @@ var file_basecoat_proto_goTypes = []interface{}{
 	(*CreateAccountRequest)(nil),                    // 4: proto.CreateAccountRequest
 	(*UpdateAccountRequest)(nil),                    // 5: proto.UpdateAccountRequest
 	(*ToggleAccountStateRequest)(nil),               // 6: proto.ToggleAccountStateRequest
-	(*GetFormulaRequest)(nil),                       // 7: proto.GetFormulaRequest
-	(*ListFormulasRequest)(nil),                     // 8: proto.ListFormulasRequest
-	(*CreateFormulaRequest)(nil),                    // 9: proto.CreateFormulaRequest
-	(*AssociateFormulaWithJobRequest)(nil),          // 10: proto.AssociateFormulaWithJobRequest
-	(*DisassociateFormulaFromJobRequest)(nil),       // 11: proto.DisassociateFormulaFromJobRequest
-	(*UpdateFormulaRequest)(nil),                    // 12: proto.UpdateFormulaRequest
-	(*DeleteFormulaRequest)(nil),                    // 13: proto.DeleteFormulaRequest
-	(*GetBaseRequest)(nil),                          // 14: proto.GetBaseRequest
-	(*ListBasesRequest)(nil),                        // 15: proto.ListBasesRequest
-	(*CreateBaseRequest)(nil),                       // 16: proto.CreateBaseRequest
-	(*AssociateBaseWithFormulaRequest)(nil),         // 17: proto.AssociateBaseWithFormulaRequest
-	(*DisassociateBaseFromFormulaRequest)(nil),      // 18: proto.DisassociateBaseFromFormulaRequest
-	(*UpdateBaseRequest)(nil),                       // 19: proto.UpdateBaseRequest
-	(*DeleteBaseRequest)(nil),                       // 20: proto.DeleteBaseRequest
-	(*GetColorantRequest)(nil),                      // 21: proto.GetColorantRequest
-	(*ListColorantsRequest)(nil),                    // 22: proto.ListColorantsRequest
-	(*CreateColorantRequest)(nil),                   // 23: proto.CreateColorantRequest
-	(*AssociateColorantWithFormulaRequest)(nil),     // 24: proto.AssociateColorantWithFormulaRequest
-	(*DisassociateColorantFromFormulaRequest)(nil),  // 25: proto.DisassociateColorantFromFormulaRequest
-	(*UpdateColorantRequest)(nil),                   // 26: proto.UpdateColorantRequest
-	(*DeleteColorantRequest)(nil),                   // 27: proto.DeleteColorantRequest
-	(*GetContactRequest)(nil),                       // 28: proto.GetContactRequest
-	(*ListContactsRequest)(nil),                     // 29: proto.ListContactsRequest
-	(*CreateContactRequest)(nil),                    // 30: proto.CreateContactRequest
-	(*UpdateContactRequest)(nil),                    // 31: proto.UpdateContactRequest
-	(*DeleteContactRequest)(nil),                    // 32: proto.DeleteContactRequest
-	(*GetContractorRequest)(nil),                    // 33: proto.GetContractorRequest
-	(*ListContractorsRequest)(nil),                  // 34: proto.ListContractorsRequest
-	(*CreateContractorRequest)(nil),                 // 35: proto.CreateContractorRequest
-	(*UpdateContractorRequest)(nil),                 // 36: proto.UpdateContractorRequest
-	(*DeleteContractorRequest)(nil),                 // 37: proto.DeleteContractorRequest
-	(*GetJobRequest)(nil),                           // 38: proto.GetJobRequest
-	(*ListJobsRequest)(nil),                         // 39: proto.ListJobsRequest
-	(*CreateJobRequest)(nil),                        // 40: proto.CreateJobRequest
-	(*UpdateJobRequest)(nil),                        // 41: proto.UpdateJobRequest
-	(*DeleteJobRequest)(nil),                        // 42: proto.DeleteJobRequest
-	(*CreateAPITokenResponse)(nil),                  // 43: proto.CreateAPITokenResponse
-	(*GetSystemInfoResponse)(nil),                   // 44: proto.GetSystemInfoResponse
-	(*GetAccountResponse)(nil),                      // 45: proto.GetAccountResponse
-	(*ListAccountsResponse)(nil),                    // 46: proto.ListAccountsResponse
-	(*CreateAccountResponse)(nil),                   // 47: proto.CreateAccountResponse
-	(*UpdateAccountResponse)(nil),                   // 48: proto.UpdateAccountResponse
-	(*ToggleAccountStateResponse)(nil),              // 49: proto.ToggleAccountStateResponse
-	(*GetFormulaResponse)(nil),                      // 50: proto.GetFormulaResponse
-	(*ListFormulasResponse)(nil),                    // 51: proto.ListFormulasResponse
-	(*CreateFormulaResponse)(nil),                   // 52: proto.CreateFormulaResponse
-	(*AssociateFormulaWithJobResponse)(nil),         // 53: proto.AssociateFormulaWithJobResponse
-	(*DisassociateFormulaFromJobResponse)(nil),      // 54: proto.DisassociateFormulaFromJobResponse
-	(*UpdateFormulaResponse)(nil),                   // 55: proto.UpdateFormulaResponse
-	(*DeleteFormulaResponse)(nil),                   // 56: proto.DeleteFormulaResponse
-	(*GetBaseResponse)(nil),                         // 57: proto.GetBaseResponse
-	(*ListBasesResponse)(nil),                       // 58: proto.ListBasesResponse
-	(*CreateBaseResponse)(nil),                      // 59: proto.CreateBaseResponse
-	(*AssociateBaseWithFormulaResponse)(nil),        // 60: proto.AssociateBaseWithFormulaResponse
-	(*DisassociateBaseFromFormulaResponse)(nil),     // 61: proto.DisassociateBaseFromFormulaResponse
-	(*UpdateBaseResponse)(nil),                      // 62: proto.UpdateBaseResponse
-	(*DeleteBaseResponse)(nil),                      // 63: proto.DeleteBaseResponse
-	(*GetColorantResponse)(nil),                     // 64: proto.GetColorantResponse
-	(*ListColorantsResponse)(nil),                   // 65: proto.ListColorantsResponse
-	(*CreateColorantResponse)(nil),                  // 66: proto.CreateColorantResponse
-	(*AssociateColorantWithFormulaResponse)(nil),    // 67: proto.AssociateColorantWithFormulaResponse
-	(*DisassociateColorantFromFormulaResponse)(nil), // 68: proto.DisassociateColorantFromFormulaResponse
-	(*UpdateColorantResponse)(nil),                  // 69: proto.UpdateColorantResponse
-	(*DeleteColorantResponse)(nil),                  // 70: proto.DeleteColorantResponse
-	(*GetContactResponse)(nil),                      // 71: proto.GetContactResponse
-	(*ListContactsResponse)(nil),                    // 72: proto.ListContactsResponse
-	(*CreateContactResponse)(nil),                   // 73: proto.CreateContactResponse
-	(*UpdateContactResponse)(nil),                   // 74: proto.UpdateContactResponse
-	(*DeleteContactResponse)(nil),                   // 75: proto.DeleteContactResponse
-	(*GetContractorResponse)(nil),                   // 76: proto.GetContractorResponse
-	(*ListContractorsResponse)(nil),                 // 77: proto.ListContractorsResponse
-	(*CreateContractorResponse)(nil),                // 78: proto.CreateContractorResponse
-	(*UpdateContractorResponse)(nil),                // 79: proto.UpdateContractorResponse
-	(*DeleteContractorResponse)(nil),                // 80: proto.DeleteContractorResponse
-	(*GetJobResponse)(nil),                          // 81: proto.GetJobResponse
-	(*ListJobsResponse)(nil),                        // 82: proto.ListJobsResponse
-	(*CreateJobResponse)(nil),                       // 83: proto.CreateJobResponse
-	(*UpdateJobResponse)(nil),                       // 84: proto.UpdateJobResponse
-	(*DeleteJobResponse)(nil),                       // 85: proto.DeleteJobResponse
+	(*ExportAccountRequest)(nil),                    // 7: proto.ExportAccountRequest
+	(*ImportAccountRequest)(nil),                    // 8: proto.ImportAccountRequest
+	(*GetFormulaRequest)(nil),                       // 9: proto.GetFormulaRequest
+	(*ListFormulasRequest)(nil),                     // 10: proto.ListFormulasRequest
+	(*CreateFormulaRequest)(nil),                    // 11: proto.CreateFormulaRequest
+	(*AssociateFormulaWithJobRequest)(nil),          // 12: proto.AssociateFormulaWithJobRequest
+	(*DisassociateFormulaFromJobRequest)(nil),       // 13: proto.DisassociateFormulaFromJobRequest
+	(*UpdateFormulaRequest)(nil),                    // 14: proto.UpdateFormulaRequest
+	(*DeleteFormulaRequest)(nil),                    // 15: proto.DeleteFormulaRequest
+	(*ImportFormulasRequest)(nil),                   // 16: proto.ImportFormulasRequest
+	(*ExportFormulaRequest)(nil),                    // 17: proto.ExportFormulaRequest
+	(*RenderLabelRequest)(nil),                      // 18: proto.RenderLabelRequest
+	(*GetFormulaRecipeRequest)(nil),                 // 19: proto.GetFormulaRecipeRequest
+	(*RecordMixRequest)(nil),                        // 20: proto.RecordMixRequest
+	(*GetMixRequest)(nil),                           // 21: proto.GetMixRequest
+	(*ListMixesRequest)(nil),                        // 22: proto.ListMixesRequest
+	(*GetCodeRequest)(nil),                          // 23: proto.GetCodeRequest
+	(*ResolveCodeRequest)(nil),                      // 24: proto.ResolveCodeRequest
+	(*GetBaseRequest)(nil),                          // 25: proto.GetBaseRequest
+	(*ListBasesRequest)(nil),                        // 26: proto.ListBasesRequest
+	(*CreateBaseRequest)(nil),                       // 27: proto.CreateBaseRequest
+	(*AssociateBaseWithFormulaRequest)(nil),         // 28: proto.AssociateBaseWithFormulaRequest
+	(*DisassociateBaseFromFormulaRequest)(nil),      // 29: proto.DisassociateBaseFromFormulaRequest
+	(*UpdateBaseRequest)(nil),                       // 30: proto.UpdateBaseRequest
+	(*DeleteBaseRequest)(nil),                       // 31: proto.DeleteBaseRequest
+	(*GetColorantRequest)(nil),                      // 32: proto.GetColorantRequest
+	(*ListColorantsRequest)(nil),                    // 33: proto.ListColorantsRequest
+	(*CreateColorantRequest)(nil),                   // 34: proto.CreateColorantRequest
+	(*AssociateColorantWithFormulaRequest)(nil),     // 35: proto.AssociateColorantWithFormulaRequest
+	(*DisassociateColorantFromFormulaRequest)(nil),  // 36: proto.DisassociateColorantFromFormulaRequest
+	(*UpdateColorantRequest)(nil),                   // 37: proto.UpdateColorantRequest
+	(*DeleteColorantRequest)(nil),                   // 38: proto.DeleteColorantRequest
+	(*GetContactRequest)(nil),                       // 39: proto.GetContactRequest
+	(*ListContactsRequest)(nil),                     // 40: proto.ListContactsRequest
+	(*CreateContactRequest)(nil),                    // 41: proto.CreateContactRequest
+	(*UpdateContactRequest)(nil),                    // 42: proto.UpdateContactRequest
+	(*DeleteContactRequest)(nil),                    // 43: proto.DeleteContactRequest
+	(*GetContractorRequest)(nil),                    // 44: proto.GetContractorRequest
+	(*ListContractorsRequest)(nil),                  // 45: proto.ListContractorsRequest
+	(*CreateContractorRequest)(nil),                 // 46: proto.CreateContractorRequest
+	(*UpdateContractorRequest)(nil),                 // 47: proto.UpdateContractorRequest
+	(*DeleteContractorRequest)(nil),                 // 48: proto.DeleteContractorRequest
+	(*GetJobRequest)(nil),                           // 49: proto.GetJobRequest
+	(*ListJobsRequest)(nil),                         // 50: proto.ListJobsRequest
+	(*CreateJobRequest)(nil),                        // 51: proto.CreateJobRequest
+	(*UpdateJobRequest)(nil),                        // 52: proto.UpdateJobRequest
+	(*DeleteJobRequest)(nil),                        // 53: proto.DeleteJobRequest
+	(*GenerateJobReportRequest)(nil),                // 54: proto.GenerateJobReportRequest
+	(*ListDeletedRequest)(nil),                      // 55: proto.ListDeletedRequest
+	(*UndeleteRequest)(nil),                         // 56: proto.UndeleteRequest
+	(*ListAuditEventsRequest)(nil),                  // 57: proto.ListAuditEventsRequest
+	(*WatchEventsRequest)(nil),                      // 58: proto.WatchEventsRequest
+	(*GetWebhookRequest)(nil),                       // 59: proto.GetWebhookRequest
+	(*ListWebhooksRequest)(nil),                     // 60: proto.ListWebhooksRequest
+	(*CreateWebhookRequest)(nil),                    // 61: proto.CreateWebhookRequest
+	(*UpdateWebhookRequest)(nil),                    // 62: proto.UpdateWebhookRequest
+	(*DeleteWebhookRequest)(nil),                    // 63: proto.DeleteWebhookRequest
+	(*ListWebhookDeliveriesRequest)(nil),            // 64: proto.ListWebhookDeliveriesRequest
+	(*TestWebhookRequest)(nil),                      // 65: proto.TestWebhookRequest
+	(*BatchCreateRequest)(nil),                      // 66: proto.BatchCreateRequest
+	(*BatchUpdateRequest)(nil),                      // 67: proto.BatchUpdateRequest
+	(*BatchDeleteRequest)(nil),                      // 68: proto.BatchDeleteRequest
+	(*CreateAPITokenResponse)(nil),                  // 69: proto.CreateAPITokenResponse
+	(*GetSystemInfoResponse)(nil),                   // 70: proto.GetSystemInfoResponse
+	(*GetAccountResponse)(nil),                      // 71: proto.GetAccountResponse
+	(*ListAccountsResponse)(nil),                    // 72: proto.ListAccountsResponse
+	(*CreateAccountResponse)(nil),                   // 73: proto.CreateAccountResponse
+	(*UpdateAccountResponse)(nil),                   // 74: proto.UpdateAccountResponse
+	(*ToggleAccountStateResponse)(nil),              // 75: proto.ToggleAccountStateResponse
+	(*ExportAccountResponse)(nil),                   // 76: proto.ExportAccountResponse
+	(*ImportAccountResponse)(nil),                   // 77: proto.ImportAccountResponse
+	(*GetFormulaResponse)(nil),                      // 78: proto.GetFormulaResponse
+	(*ListFormulasResponse)(nil),                    // 79: proto.ListFormulasResponse
+	(*CreateFormulaResponse)(nil),                   // 80: proto.CreateFormulaResponse
+	(*AssociateFormulaWithJobResponse)(nil),         // 81: proto.AssociateFormulaWithJobResponse
+	(*DisassociateFormulaFromJobResponse)(nil),      // 82: proto.DisassociateFormulaFromJobResponse
+	(*UpdateFormulaResponse)(nil),                   // 83: proto.UpdateFormulaResponse
+	(*DeleteFormulaResponse)(nil),                   // 84: proto.DeleteFormulaResponse
+	(*ImportFormulasResponse)(nil),                  // 85: proto.ImportFormulasResponse
+	(*ExportFormulaResponse)(nil),                   // 86: proto.ExportFormulaResponse
+	(*RenderLabelResponse)(nil),                     // 87: proto.RenderLabelResponse
+	(*GetFormulaRecipeResponse)(nil),                // 88: proto.GetFormulaRecipeResponse
+	(*RecordMixResponse)(nil),                       // 89: proto.RecordMixResponse
+	(*GetMixResponse)(nil),                          // 90: proto.GetMixResponse
+	(*ListMixesResponse)(nil),                       // 91: proto.ListMixesResponse
+	(*GetCodeResponse)(nil),                         // 92: proto.GetCodeResponse
+	(*ResolveCodeResponse)(nil),                     // 93: proto.ResolveCodeResponse
+	(*GetBaseResponse)(nil),                         // 94: proto.GetBaseResponse
+	(*ListBasesResponse)(nil),                       // 95: proto.ListBasesResponse
+	(*CreateBaseResponse)(nil),                      // 96: proto.CreateBaseResponse
+	(*AssociateBaseWithFormulaResponse)(nil),        // 97: proto.AssociateBaseWithFormulaResponse
+	(*DisassociateBaseFromFormulaResponse)(nil),     // 98: proto.DisassociateBaseFromFormulaResponse
+	(*UpdateBaseResponse)(nil),                      // 99: proto.UpdateBaseResponse
+	(*DeleteBaseResponse)(nil),                      // 100: proto.DeleteBaseResponse
+	(*GetColorantResponse)(nil),                     // 101: proto.GetColorantResponse
+	(*ListColorantsResponse)(nil),                   // 102: proto.ListColorantsResponse
+	(*CreateColorantResponse)(nil),                  // 103: proto.CreateColorantResponse
+	(*AssociateColorantWithFormulaResponse)(nil),    // 104: proto.AssociateColorantWithFormulaResponse
+	(*DisassociateColorantFromFormulaResponse)(nil), // 105: proto.DisassociateColorantFromFormulaResponse
+	(*UpdateColorantResponse)(nil),                  // 106: proto.UpdateColorantResponse
+	(*DeleteColorantResponse)(nil),                  // 107: proto.DeleteColorantResponse
+	(*GetContactResponse)(nil),                      // 108: proto.GetContactResponse
+	(*ListContactsResponse)(nil),                    // 109: proto.ListContactsResponse
+	(*CreateContactResponse)(nil),                   // 110: proto.CreateContactResponse
+	(*UpdateContactResponse)(nil),                   // 111: proto.UpdateContactResponse
+	(*DeleteContactResponse)(nil),                   // 112: proto.DeleteContactResponse
+	(*GetContractorResponse)(nil),                   // 113: proto.GetContractorResponse
+	(*ListContractorsResponse)(nil),                 // 114: proto.ListContractorsResponse
+	(*CreateContractorResponse)(nil),                // 115: proto.CreateContractorResponse
+	(*UpdateContractorResponse)(nil),                // 116: proto.UpdateContractorResponse
+	(*DeleteContractorResponse)(nil),                // 117: proto.DeleteContractorResponse
+	(*GetJobResponse)(nil),                          // 118: proto.GetJobResponse
+	(*ListJobsResponse)(nil),                        // 119: proto.ListJobsResponse
+	(*CreateJobResponse)(nil),                       // 120: proto.CreateJobResponse
+	(*UpdateJobResponse)(nil),                       // 121: proto.UpdateJobResponse
+	(*DeleteJobResponse)(nil),                       // 122: proto.DeleteJobResponse
+	(*GenerateJobReportResponse)(nil),               // 123: proto.GenerateJobReportResponse
+	(*ListDeletedResponse)(nil),                     // 124: proto.ListDeletedResponse
+	(*UndeleteResponse)(nil),                        // 125: proto.UndeleteResponse
+	(*ListAuditEventsResponse)(nil),                 // 126: proto.ListAuditEventsResponse
+	(*WatchEventsResponse)(nil),                     // 127: proto.WatchEventsResponse
+	(*GetWebhookResponse)(nil),                      // 128: proto.GetWebhookResponse
+	(*ListWebhooksResponse)(nil),                    // 129: proto.ListWebhooksResponse
+	(*CreateWebhookResponse)(nil),                   // 130: proto.CreateWebhookResponse
+	(*UpdateWebhookResponse)(nil),                   // 131: proto.UpdateWebhookResponse
+	(*DeleteWebhookResponse)(nil),                   // 132: proto.DeleteWebhookResponse
+	(*ListWebhookDeliveriesResponse)(nil),           // 133: proto.ListWebhookDeliveriesResponse
+	(*TestWebhookResponse)(nil),                     // 134: proto.TestWebhookResponse
+	(*BatchCreateResponse)(nil),                     // 135: proto.BatchCreateResponse
+	(*BatchUpdateResponse)(nil),                     // 136: proto.BatchUpdateResponse
+	(*BatchDeleteResponse)(nil),                     // 137: proto.BatchDeleteResponse
 }
 var file_basecoat_proto_depIdxs = []int32{
-	0,  // 0: proto.Basecoat.CreateAPIToken:input_type -> proto.CreateAPITokenRequest
-	1,  // 1: proto.Basecoat.GetSystemInfo:input_type -> proto.GetSystemInfoRequest
-	2,  // 2: proto.Basecoat.GetAccount:input_type -> proto.GetAccountRequest
-	3,  // 3: proto.Basecoat.ListAccounts:input_type -> proto.ListAccountsRequest
-	4,  // 4: proto.Basecoat.CreateAccount:input_type -> proto.CreateAccountRequest
-	5,  // 5: proto.Basecoat.UpdateAccount:input_type -> proto.UpdateAccountRequest
-	6,  // 6: proto.Basecoat.ToggleAccountState:input_type -> proto.ToggleAccountStateRequest
-	7,  // 7: proto.Basecoat.GetFormula:input_type -> proto.GetFormulaRequest
-	8,  // 8: proto.Basecoat.ListFormulas:input_type -> proto.ListFormulasRequest
-	9,  // 9: proto.Basecoat.CreateFormula:input_type -> proto.CreateFormulaRequest
-	10, // 10: proto.Basecoat.AssociateFormulaWithJob:input_type -> proto.AssociateFormulaWithJobRequest
-	11, // 11: proto.Basecoat.DisassociateFormulaFromJob:input_type -> proto.DisassociateFormulaFromJobRequest
-	12, // 12: proto.Basecoat.UpdateFormula:input_type -> proto.UpdateFormulaRequest
-	13, // 13: proto.Basecoat.DeleteFormula:input_type -> proto.DeleteFormulaRequest
-	14, // 14: proto.Basecoat.GetBase:input_type -> proto.GetBaseRequest
-	15, // 15: proto.Basecoat.ListBases:input_type -> proto.ListBasesRequest
-	16, // 16: proto.Basecoat.CreateBase:input_type -> proto.CreateBaseRequest
-	17, // 17: proto.Basecoat.AssociateBaseWithFormula:input_type -> proto.AssociateBaseWithFormulaRequest
-	18, // 18: proto.Basecoat.DisassociateBaseFromFormula:input_type -> proto.DisassociateBaseFromFormulaRequest
-	19, // 19: proto.Basecoat.UpdateBase:input_type -> proto.UpdateBaseRequest
-	20, // 20: proto.Basecoat.DeleteBase:input_type -> proto.DeleteBaseRequest
-	21, // 21: proto.Basecoat.GetColorant:input_type -> proto.GetColorantRequest
-	22, // 22: proto.Basecoat.ListColorants:input_type -> proto.ListColorantsRequest
-	23, // 23: proto.Basecoat.CreateColorant:input_type -> proto.CreateColorantRequest
-	24, // 24: proto.Basecoat.AssociateColorantWithFormula:input_type -> proto.AssociateColorantWithFormulaRequest
-	25, // 25: proto.Basecoat.DisassociateColorantFromFormula:input_type -> proto.DisassociateColorantFromFormulaRequest
-	26, // 26: proto.Basecoat.UpdateColorant:input_type -> proto.UpdateColorantRequest
-	27, // 27: proto.Basecoat.DeleteColorant:input_type -> proto.DeleteColorantRequest
-	28, // 28: proto.Basecoat.GetContact:input_type -> proto.GetContactRequest
-	29, // 29: proto.Basecoat.ListContacts:input_type -> proto.ListContactsRequest
-	30, // 30: proto.Basecoat.CreateContact:input_type -> proto.CreateContactRequest
-	31, // 31: proto.Basecoat.UpdateContact:input_type -> proto.UpdateContactRequest
-	32, // 32: proto.Basecoat.DeleteContact:input_type -> proto.DeleteContactRequest
-	33, // 33: proto.Basecoat.GetContractor:input_type -> proto.GetContractorRequest
-	34, // 34: proto.Basecoat.ListContractors:input_type -> proto.ListContractorsRequest
-	35, // 35: proto.Basecoat.CreateContractor:input_type -> proto.CreateContractorRequest
-	36, // 36: proto.Basecoat.UpdateContractor:input_type -> proto.UpdateContractorRequest
-	37, // 37: proto.Basecoat.DeleteContractor:input_type -> proto.DeleteContractorRequest
-	38, // 38: proto.Basecoat.GetJob:input_type -> proto.GetJobRequest
-	39, // 39: proto.Basecoat.ListJobs:input_type -> proto.ListJobsRequest
-	40, // 40: proto.Basecoat.CreateJob:input_type -> proto.CreateJobRequest
-	41, // 41: proto.Basecoat.UpdateJob:input_type -> proto.UpdateJobRequest
-	42, // 42: proto.Basecoat.DeleteJob:input_type -> proto.DeleteJobRequest
-	43, // 43: proto.Basecoat.CreateAPIToken:output_type -> proto.CreateAPITokenResponse
-	44, // 44: proto.Basecoat.GetSystemInfo:output_type -> proto.GetSystemInfoResponse
-	45, // 45: proto.Basecoat.GetAccount:output_type -> proto.GetAccountResponse
-	46, // 46: proto.Basecoat.ListAccounts:output_type -> proto.ListAccountsResponse
-	47, // 47: proto.Basecoat.CreateAccount:output_type -> proto.CreateAccountResponse
-	48, // 48: proto.Basecoat.UpdateAccount:output_type -> proto.UpdateAccountResponse
-	49, // 49: proto.Basecoat.ToggleAccountState:output_type -> proto.ToggleAccountStateResponse
-	50, // 50: proto.Basecoat.GetFormula:output_type -> proto.GetFormulaResponse
-	51, // 51: proto.Basecoat.ListFormulas:output_type -> proto.ListFormulasResponse
-	52, // 52: proto.Basecoat.CreateFormula:output_type -> proto.CreateFormulaResponse
-	53, // 53: proto.Basecoat.AssociateFormulaWithJob:output_type -> proto.AssociateFormulaWithJobResponse
-	54, // 54: proto.Basecoat.DisassociateFormulaFromJob:output_type -> proto.DisassociateFormulaFromJobResponse
-	55, // 55: proto.Basecoat.UpdateFormula:output_type -> proto.UpdateFormulaResponse
-	56, // 56: proto.Basecoat.DeleteFormula:output_type -> proto.DeleteFormulaResponse
-	57, // 57: proto.Basecoat.GetBase:output_type -> proto.GetBaseResponse
-	58, // 58: proto.Basecoat.ListBases:output_type -> proto.ListBasesResponse
-	59, // 59: proto.Basecoat.CreateBase:output_type -> proto.CreateBaseResponse
-	60, // 60: proto.Basecoat.AssociateBaseWithFormula:output_type -> proto.AssociateBaseWithFormulaResponse
-	61, // 61: proto.Basecoat.DisassociateBaseFromFormula:output_type -> proto.DisassociateBaseFromFormulaResponse
-	62, // 62: proto.Basecoat.UpdateBase:output_type -> proto.UpdateBaseResponse
-	63, // 63: proto.Basecoat.DeleteBase:output_type -> proto.DeleteBaseResponse
-	64, // 64: proto.Basecoat.GetColorant:output_type -> proto.GetColorantResponse
-	65, // 65: proto.Basecoat.ListColorants:output_type -> proto.ListColorantsResponse
-	66, // 66: proto.Basecoat.CreateColorant:output_type -> proto.CreateColorantResponse
-	67, // 67: proto.Basecoat.AssociateColorantWithFormula:output_type -> proto.AssociateColorantWithFormulaResponse
-	68, // 68: proto.Basecoat.DisassociateColorantFromFormula:output_type -> proto.DisassociateColorantFromFormulaResponse
-	69, // 69: proto.Basecoat.UpdateColorant:output_type -> proto.UpdateColorantResponse
-	70, // 70: proto.Basecoat.DeleteColorant:output_type -> proto.DeleteColorantResponse
-	71, // 71: proto.Basecoat.GetContact:output_type -> proto.GetContactResponse
-	72, // 72: proto.Basecoat.ListContacts:output_type -> proto.ListContactsResponse
-	73, // 73: proto.Basecoat.CreateContact:output_type -> proto.CreateContactResponse
-	74, // 74: proto.Basecoat.UpdateContact:output_type -> proto.UpdateContactResponse
-	75, // 75: proto.Basecoat.DeleteContact:output_type -> proto.DeleteContactResponse
-	76, // 76: proto.Basecoat.GetContractor:output_type -> proto.GetContractorResponse
-	77, // 77: proto.Basecoat.ListContractors:output_type -> proto.ListContractorsResponse
-	78, // 78: proto.Basecoat.CreateContractor:output_type -> proto.CreateContractorResponse
-	79, // 79: proto.Basecoat.UpdateContractor:output_type -> proto.UpdateContractorResponse
-	80, // 80: proto.Basecoat.DeleteContractor:output_type -> proto.DeleteContractorResponse
-	81, // 81: proto.Basecoat.GetJob:output_type -> proto.GetJobResponse
-	82, // 82: proto.Basecoat.ListJobs:output_type -> proto.ListJobsResponse
-	83, // 83: proto.Basecoat.CreateJob:output_type -> proto.CreateJobResponse
-	84, // 84: proto.Basecoat.UpdateJob:output_type -> proto.UpdateJobResponse
-	85, // 85: proto.Basecoat.DeleteJob:output_type -> proto.DeleteJobResponse
-	43, // [43:86] is the sub-list for method output_type
-	0,  // [0:43] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: proto.Basecoat.CreateAPIToken:input_type -> proto.CreateAPITokenRequest
+	1,   // 1: proto.Basecoat.GetSystemInfo:input_type -> proto.GetSystemInfoRequest
+	2,   // 2: proto.Basecoat.GetAccount:input_type -> proto.GetAccountRequest
+	3,   // 3: proto.Basecoat.ListAccounts:input_type -> proto.ListAccountsRequest
+	4,   // 4: proto.Basecoat.CreateAccount:input_type -> proto.CreateAccountRequest
+	5,   // 5: proto.Basecoat.UpdateAccount:input_type -> proto.UpdateAccountRequest
+	6,   // 6: proto.Basecoat.ToggleAccountState:input_type -> proto.ToggleAccountStateRequest
+	7,   // 7: proto.Basecoat.ExportAccount:input_type -> proto.ExportAccountRequest
+	8,   // 8: proto.Basecoat.ImportAccount:input_type -> proto.ImportAccountRequest
+	9,   // 9: proto.Basecoat.GetFormula:input_type -> proto.GetFormulaRequest
+	10,  // 10: proto.Basecoat.ListFormulas:input_type -> proto.ListFormulasRequest
+	11,  // 11: proto.Basecoat.CreateFormula:input_type -> proto.CreateFormulaRequest
+	12,  // 12: proto.Basecoat.AssociateFormulaWithJob:input_type -> proto.AssociateFormulaWithJobRequest
+	13,  // 13: proto.Basecoat.DisassociateFormulaFromJob:input_type -> proto.DisassociateFormulaFromJobRequest
+	14,  // 14: proto.Basecoat.UpdateFormula:input_type -> proto.UpdateFormulaRequest
+	15,  // 15: proto.Basecoat.DeleteFormula:input_type -> proto.DeleteFormulaRequest
+	16,  // 16: proto.Basecoat.ImportFormulas:input_type -> proto.ImportFormulasRequest
+	17,  // 17: proto.Basecoat.ExportFormula:input_type -> proto.ExportFormulaRequest
+	18,  // 18: proto.Basecoat.RenderLabel:input_type -> proto.RenderLabelRequest
+	19,  // 19: proto.Basecoat.GetFormulaRecipe:input_type -> proto.GetFormulaRecipeRequest
+	20,  // 20: proto.Basecoat.RecordMix:input_type -> proto.RecordMixRequest
+	21,  // 21: proto.Basecoat.GetMix:input_type -> proto.GetMixRequest
+	22,  // 22: proto.Basecoat.ListMixes:input_type -> proto.ListMixesRequest
+	23,  // 23: proto.Basecoat.GetCode:input_type -> proto.GetCodeRequest
+	24,  // 24: proto.Basecoat.ResolveCode:input_type -> proto.ResolveCodeRequest
+	25,  // 25: proto.Basecoat.GetBase:input_type -> proto.GetBaseRequest
+	26,  // 26: proto.Basecoat.ListBases:input_type -> proto.ListBasesRequest
+	27,  // 27: proto.Basecoat.CreateBase:input_type -> proto.CreateBaseRequest
+	28,  // 28: proto.Basecoat.AssociateBaseWithFormula:input_type -> proto.AssociateBaseWithFormulaRequest
+	29,  // 29: proto.Basecoat.DisassociateBaseFromFormula:input_type -> proto.DisassociateBaseFromFormulaRequest
+	30,  // 30: proto.Basecoat.UpdateBase:input_type -> proto.UpdateBaseRequest
+	31,  // 31: proto.Basecoat.DeleteBase:input_type -> proto.DeleteBaseRequest
+	32,  // 32: proto.Basecoat.GetColorant:input_type -> proto.GetColorantRequest
+	33,  // 33: proto.Basecoat.ListColorants:input_type -> proto.ListColorantsRequest
+	34,  // 34: proto.Basecoat.CreateColorant:input_type -> proto.CreateColorantRequest
+	35,  // 35: proto.Basecoat.AssociateColorantWithFormula:input_type -> proto.AssociateColorantWithFormulaRequest
+	36,  // 36: proto.Basecoat.DisassociateColorantFromFormula:input_type -> proto.DisassociateColorantFromFormulaRequest
+	37,  // 37: proto.Basecoat.UpdateColorant:input_type -> proto.UpdateColorantRequest
+	38,  // 38: proto.Basecoat.DeleteColorant:input_type -> proto.DeleteColorantRequest
+	39,  // 39: proto.Basecoat.GetContact:input_type -> proto.GetContactRequest
+	40,  // 40: proto.Basecoat.ListContacts:input_type -> proto.ListContactsRequest
+	41,  // 41: proto.Basecoat.CreateContact:input_type -> proto.CreateContactRequest
+	42,  // 42: proto.Basecoat.UpdateContact:input_type -> proto.UpdateContactRequest
+	43,  // 43: proto.Basecoat.DeleteContact:input_type -> proto.DeleteContactRequest
+	44,  // 44: proto.Basecoat.GetContractor:input_type -> proto.GetContractorRequest
+	45,  // 45: proto.Basecoat.ListContractors:input_type -> proto.ListContractorsRequest
+	46,  // 46: proto.Basecoat.CreateContractor:input_type -> proto.CreateContractorRequest
+	47,  // 47: proto.Basecoat.UpdateContractor:input_type -> proto.UpdateContractorRequest
+	48,  // 48: proto.Basecoat.DeleteContractor:input_type -> proto.DeleteContractorRequest
+	49,  // 49: proto.Basecoat.GetJob:input_type -> proto.GetJobRequest
+	50,  // 50: proto.Basecoat.ListJobs:input_type -> proto.ListJobsRequest
+	51,  // 51: proto.Basecoat.CreateJob:input_type -> proto.CreateJobRequest
+	52,  // 52: proto.Basecoat.UpdateJob:input_type -> proto.UpdateJobRequest
+	53,  // 53: proto.Basecoat.DeleteJob:input_type -> proto.DeleteJobRequest
+	54,  // 54: proto.Basecoat.GenerateJobReport:input_type -> proto.GenerateJobReportRequest
+	55,  // 55: proto.Basecoat.ListDeleted:input_type -> proto.ListDeletedRequest
+	56,  // 56: proto.Basecoat.Undelete:input_type -> proto.UndeleteRequest
+	57,  // 57: proto.Basecoat.ListAuditEvents:input_type -> proto.ListAuditEventsRequest
+	58,  // 58: proto.Basecoat.WatchEvents:input_type -> proto.WatchEventsRequest
+	59,  // 59: proto.Basecoat.GetWebhook:input_type -> proto.GetWebhookRequest
+	60,  // 60: proto.Basecoat.ListWebhooks:input_type -> proto.ListWebhooksRequest
+	61,  // 61: proto.Basecoat.CreateWebhook:input_type -> proto.CreateWebhookRequest
+	62,  // 62: proto.Basecoat.UpdateWebhook:input_type -> proto.UpdateWebhookRequest
+	63,  // 63: proto.Basecoat.DeleteWebhook:input_type -> proto.DeleteWebhookRequest
+	64,  // 64: proto.Basecoat.ListWebhookDeliveries:input_type -> proto.ListWebhookDeliveriesRequest
+	65,  // 65: proto.Basecoat.TestWebhook:input_type -> proto.TestWebhookRequest
+	66,  // 66: proto.Basecoat.BatchCreate:input_type -> proto.BatchCreateRequest
+	67,  // 67: proto.Basecoat.BatchUpdate:input_type -> proto.BatchUpdateRequest
+	68,  // 68: proto.Basecoat.BatchDelete:input_type -> proto.BatchDeleteRequest
+	69,  // 69: proto.Basecoat.CreateAPIToken:output_type -> proto.CreateAPITokenResponse
+	70,  // 70: proto.Basecoat.GetSystemInfo:output_type -> proto.GetSystemInfoResponse
+	71,  // 71: proto.Basecoat.GetAccount:output_type -> proto.GetAccountResponse
+	72,  // 72: proto.Basecoat.ListAccounts:output_type -> proto.ListAccountsResponse
+	73,  // 73: proto.Basecoat.CreateAccount:output_type -> proto.CreateAccountResponse
+	74,  // 74: proto.Basecoat.UpdateAccount:output_type -> proto.UpdateAccountResponse
+	75,  // 75: proto.Basecoat.ToggleAccountState:output_type -> proto.ToggleAccountStateResponse
+	76,  // 76: proto.Basecoat.ExportAccount:output_type -> proto.ExportAccountResponse
+	77,  // 77: proto.Basecoat.ImportAccount:output_type -> proto.ImportAccountResponse
+	78,  // 78: proto.Basecoat.GetFormula:output_type -> proto.GetFormulaResponse
+	79,  // 79: proto.Basecoat.ListFormulas:output_type -> proto.ListFormulasResponse
+	80,  // 80: proto.Basecoat.CreateFormula:output_type -> proto.CreateFormulaResponse
+	81,  // 81: proto.Basecoat.AssociateFormulaWithJob:output_type -> proto.AssociateFormulaWithJobResponse
+	82,  // 82: proto.Basecoat.DisassociateFormulaFromJob:output_type -> proto.DisassociateFormulaFromJobResponse
+	83,  // 83: proto.Basecoat.UpdateFormula:output_type -> proto.UpdateFormulaResponse
+	84,  // 84: proto.Basecoat.DeleteFormula:output_type -> proto.DeleteFormulaResponse
+	85,  // 85: proto.Basecoat.ImportFormulas:output_type -> proto.ImportFormulasResponse
+	86,  // 86: proto.Basecoat.ExportFormula:output_type -> proto.ExportFormulaResponse
+	87,  // 87: proto.Basecoat.RenderLabel:output_type -> proto.RenderLabelResponse
+	88,  // 88: proto.Basecoat.GetFormulaRecipe:output_type -> proto.GetFormulaRecipeResponse
+	89,  // 89: proto.Basecoat.RecordMix:output_type -> proto.RecordMixResponse
+	90,  // 90: proto.Basecoat.GetMix:output_type -> proto.GetMixResponse
+	91,  // 91: proto.Basecoat.ListMixes:output_type -> proto.ListMixesResponse
+	92,  // 92: proto.Basecoat.GetCode:output_type -> proto.GetCodeResponse
+	93,  // 93: proto.Basecoat.ResolveCode:output_type -> proto.ResolveCodeResponse
+	94,  // 94: proto.Basecoat.GetBase:output_type -> proto.GetBaseResponse
+	95,  // 95: proto.Basecoat.ListBases:output_type -> proto.ListBasesResponse
+	96,  // 96: proto.Basecoat.CreateBase:output_type -> proto.CreateBaseResponse
+	97,  // 97: proto.Basecoat.AssociateBaseWithFormula:output_type -> proto.AssociateBaseWithFormulaResponse
+	98,  // 98: proto.Basecoat.DisassociateBaseFromFormula:output_type -> proto.DisassociateBaseFromFormulaResponse
+	99,  // 99: proto.Basecoat.UpdateBase:output_type -> proto.UpdateBaseResponse
+	100, // 100: proto.Basecoat.DeleteBase:output_type -> proto.DeleteBaseResponse
+	101, // 101: proto.Basecoat.GetColorant:output_type -> proto.GetColorantResponse
+	102, // 102: proto.Basecoat.ListColorants:output_type -> proto.ListColorantsResponse
+	103, // 103: proto.Basecoat.CreateColorant:output_type -> proto.CreateColorantResponse
+	104, // 104: proto.Basecoat.AssociateColorantWithFormula:output_type -> proto.AssociateColorantWithFormulaResponse
+	105, // 105: proto.Basecoat.DisassociateColorantFromFormula:output_type -> proto.DisassociateColorantFromFormulaResponse
+	106, // 106: proto.Basecoat.UpdateColorant:output_type -> proto.UpdateColorantResponse
+	107, // 107: proto.Basecoat.DeleteColorant:output_type -> proto.DeleteColorantResponse
+	108, // 108: proto.Basecoat.GetContact:output_type -> proto.GetContactResponse
+	109, // 109: proto.Basecoat.ListContacts:output_type -> proto.ListContactsResponse
+	110, // 110: proto.Basecoat.CreateContact:output_type -> proto.CreateContactResponse
+	111, // 111: proto.Basecoat.UpdateContact:output_type -> proto.UpdateContactResponse
+	112, // 112: proto.Basecoat.DeleteContact:output_type -> proto.DeleteContactResponse
+	113, // 113: proto.Basecoat.GetContractor:output_type -> proto.GetContractorResponse
+	114, // 114: proto.Basecoat.ListContractors:output_type -> proto.ListContractorsResponse
+	115, // 115: proto.Basecoat.CreateContractor:output_type -> proto.CreateContractorResponse
+	116, // 116: proto.Basecoat.UpdateContractor:output_type -> proto.UpdateContractorResponse
+	117, // 117: proto.Basecoat.DeleteContractor:output_type -> proto.DeleteContractorResponse
+	118, // 118: proto.Basecoat.GetJob:output_type -> proto.GetJobResponse
+	119, // 119: proto.Basecoat.ListJobs:output_type -> proto.ListJobsResponse
+	120, // 120: proto.Basecoat.CreateJob:output_type -> proto.CreateJobResponse
+	121, // 121: proto.Basecoat.UpdateJob:output_type -> proto.UpdateJobResponse
+	122, // 122: proto.Basecoat.DeleteJob:output_type -> proto.DeleteJobResponse
+	123, // 123: proto.Basecoat.GenerateJobReport:output_type -> proto.GenerateJobReportResponse
+	124, // 124: proto.Basecoat.ListDeleted:output_type -> proto.ListDeletedResponse
+	125, // 125: proto.Basecoat.Undelete:output_type -> proto.UndeleteResponse
+	126, // 126: proto.Basecoat.ListAuditEvents:output_type -> proto.ListAuditEventsResponse
+	127, // 127: proto.Basecoat.WatchEvents:output_type -> proto.WatchEventsResponse
+	128, // 128: proto.Basecoat.GetWebhook:output_type -> proto.GetWebhookResponse
+	129, // 129: proto.Basecoat.ListWebhooks:output_type -> proto.ListWebhooksResponse
+	130, // 130: proto.Basecoat.CreateWebhook:output_type -> proto.CreateWebhookResponse
+	131, // 131: proto.Basecoat.UpdateWebhook:output_type -> proto.UpdateWebhookResponse
+	132, // 132: proto.Basecoat.DeleteWebhook:output_type -> proto.DeleteWebhookResponse
+	133, // 133: proto.Basecoat.ListWebhookDeliveries:output_type -> proto.ListWebhookDeliveriesResponse
+	134, // 134: proto.Basecoat.TestWebhook:output_type -> proto.TestWebhookResponse
+	135, // 135: proto.Basecoat.BatchCreate:output_type -> proto.BatchCreateResponse
+	136, // 136: proto.Basecoat.BatchUpdate:output_type -> proto.BatchUpdateResponse
+	137, // 137: proto.Basecoat.BatchDelete:output_type -> proto.BatchDeleteResponse
+	69,  // [69:138] is the sub-list for method output_type
+	0,   // [0:69] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_basecoat_proto_init() }
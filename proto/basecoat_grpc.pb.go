@@ -26,6 +26,8 @@ const (
 	Basecoat_CreateAccount_FullMethodName                   = "/proto.Basecoat/CreateAccount"
 	Basecoat_UpdateAccount_FullMethodName                   = "/proto.Basecoat/UpdateAccount"
 	Basecoat_ToggleAccountState_FullMethodName              = "/proto.Basecoat/ToggleAccountState"
+	Basecoat_ExportAccount_FullMethodName                   = "/proto.Basecoat/ExportAccount"
+	Basecoat_ImportAccount_FullMethodName                   = "/proto.Basecoat/ImportAccount"
 	Basecoat_GetFormula_FullMethodName                      = "/proto.Basecoat/GetFormula"
 	Basecoat_ListFormulas_FullMethodName                    = "/proto.Basecoat/ListFormulas"
 	Basecoat_CreateFormula_FullMethodName                   = "/proto.Basecoat/CreateFormula"
@@ -33,6 +35,15 @@ const (
 	Basecoat_DisassociateFormulaFromJob_FullMethodName      = "/proto.Basecoat/DisassociateFormulaFromJob"
 	Basecoat_UpdateFormula_FullMethodName                   = "/proto.Basecoat/UpdateFormula"
 	Basecoat_DeleteFormula_FullMethodName                   = "/proto.Basecoat/DeleteFormula"
+	Basecoat_ImportFormulas_FullMethodName                  = "/proto.Basecoat/ImportFormulas"
+	Basecoat_ExportFormula_FullMethodName                   = "/proto.Basecoat/ExportFormula"
+	Basecoat_RenderLabel_FullMethodName                     = "/proto.Basecoat/RenderLabel"
+	Basecoat_GetFormulaRecipe_FullMethodName                = "/proto.Basecoat/GetFormulaRecipe"
+	Basecoat_RecordMix_FullMethodName                       = "/proto.Basecoat/RecordMix"
+	Basecoat_GetMix_FullMethodName                          = "/proto.Basecoat/GetMix"
+	Basecoat_ListMixes_FullMethodName                       = "/proto.Basecoat/ListMixes"
+	Basecoat_GetCode_FullMethodName                         = "/proto.Basecoat/GetCode"
+	Basecoat_ResolveCode_FullMethodName                     = "/proto.Basecoat/ResolveCode"
 	Basecoat_GetBase_FullMethodName                         = "/proto.Basecoat/GetBase"
 	Basecoat_ListBases_FullMethodName                       = "/proto.Basecoat/ListBases"
 	Basecoat_CreateBase_FullMethodName                      = "/proto.Basecoat/CreateBase"
@@ -62,6 +73,21 @@ const (
 	Basecoat_CreateJob_FullMethodName                       = "/proto.Basecoat/CreateJob"
 	Basecoat_UpdateJob_FullMethodName                       = "/proto.Basecoat/UpdateJob"
 	Basecoat_DeleteJob_FullMethodName                       = "/proto.Basecoat/DeleteJob"
+	Basecoat_GenerateJobReport_FullMethodName               = "/proto.Basecoat/GenerateJobReport"
+	Basecoat_ListDeleted_FullMethodName                     = "/proto.Basecoat/ListDeleted"
+	Basecoat_Undelete_FullMethodName                        = "/proto.Basecoat/Undelete"
+	Basecoat_ListAuditEvents_FullMethodName                 = "/proto.Basecoat/ListAuditEvents"
+	Basecoat_WatchEvents_FullMethodName                     = "/proto.Basecoat/WatchEvents"
+	Basecoat_GetWebhook_FullMethodName                      = "/proto.Basecoat/GetWebhook"
+	Basecoat_ListWebhooks_FullMethodName                    = "/proto.Basecoat/ListWebhooks"
+	Basecoat_CreateWebhook_FullMethodName                   = "/proto.Basecoat/CreateWebhook"
+	Basecoat_UpdateWebhook_FullMethodName                   = "/proto.Basecoat/UpdateWebhook"
+	Basecoat_DeleteWebhook_FullMethodName                   = "/proto.Basecoat/DeleteWebhook"
+	Basecoat_ListWebhookDeliveries_FullMethodName           = "/proto.Basecoat/ListWebhookDeliveries"
+	Basecoat_TestWebhook_FullMethodName                     = "/proto.Basecoat/TestWebhook"
+	Basecoat_BatchCreate_FullMethodName                     = "/proto.Basecoat/BatchCreate"
+	Basecoat_BatchUpdate_FullMethodName                     = "/proto.Basecoat/BatchUpdate"
+	Basecoat_BatchDelete_FullMethodName                     = "/proto.Basecoat/BatchDelete"
 )
 
 // BasecoatClient is the client API for Basecoat service.
@@ -78,6 +104,8 @@ type BasecoatClient interface {
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error)
 	ToggleAccountState(ctx context.Context, in *ToggleAccountStateRequest, opts ...grpc.CallOption) (*ToggleAccountStateResponse, error)
+	ExportAccount(ctx context.Context, in *ExportAccountRequest, opts ...grpc.CallOption) (Basecoat_ExportAccountClient, error)
+	ImportAccount(ctx context.Context, opts ...grpc.CallOption) (Basecoat_ImportAccountClient, error)
 	// Formula routes
 	GetFormula(ctx context.Context, in *GetFormulaRequest, opts ...grpc.CallOption) (*GetFormulaResponse, error)
 	ListFormulas(ctx context.Context, in *ListFormulasRequest, opts ...grpc.CallOption) (*ListFormulasResponse, error)
@@ -86,6 +114,17 @@ type BasecoatClient interface {
 	DisassociateFormulaFromJob(ctx context.Context, in *DisassociateFormulaFromJobRequest, opts ...grpc.CallOption) (*DisassociateFormulaFromJobResponse, error)
 	UpdateFormula(ctx context.Context, in *UpdateFormulaRequest, opts ...grpc.CallOption) (*UpdateFormulaResponse, error)
 	DeleteFormula(ctx context.Context, in *DeleteFormulaRequest, opts ...grpc.CallOption) (*DeleteFormulaResponse, error)
+	ImportFormulas(ctx context.Context, opts ...grpc.CallOption) (Basecoat_ImportFormulasClient, error)
+	ExportFormula(ctx context.Context, in *ExportFormulaRequest, opts ...grpc.CallOption) (*ExportFormulaResponse, error)
+	RenderLabel(ctx context.Context, in *RenderLabelRequest, opts ...grpc.CallOption) (*RenderLabelResponse, error)
+	GetFormulaRecipe(ctx context.Context, in *GetFormulaRecipeRequest, opts ...grpc.CallOption) (*GetFormulaRecipeResponse, error)
+	// Mix routes
+	RecordMix(ctx context.Context, in *RecordMixRequest, opts ...grpc.CallOption) (*RecordMixResponse, error)
+	GetMix(ctx context.Context, in *GetMixRequest, opts ...grpc.CallOption) (*GetMixResponse, error)
+	ListMixes(ctx context.Context, in *ListMixesRequest, opts ...grpc.CallOption) (*ListMixesResponse, error)
+	// Code routes
+	GetCode(ctx context.Context, in *GetCodeRequest, opts ...grpc.CallOption) (*GetCodeResponse, error)
+	ResolveCode(ctx context.Context, in *ResolveCodeRequest, opts ...grpc.CallOption) (*ResolveCodeResponse, error)
 	// Base routes
 	GetBase(ctx context.Context, in *GetBaseRequest, opts ...grpc.CallOption) (*GetBaseResponse, error)
 	ListBases(ctx context.Context, in *ListBasesRequest, opts ...grpc.CallOption) (*ListBasesResponse, error)
@@ -120,6 +159,26 @@ type BasecoatClient interface {
 	CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*CreateJobResponse, error)
 	UpdateJob(ctx context.Context, in *UpdateJobRequest, opts ...grpc.CallOption) (*UpdateJobResponse, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
+	GenerateJobReport(ctx context.Context, in *GenerateJobReportRequest, opts ...grpc.CallOption) (*GenerateJobReportResponse, error)
+	// Trash routes
+	ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeletedResponse, error)
+	Undelete(ctx context.Context, in *UndeleteRequest, opts ...grpc.CallOption) (*UndeleteResponse, error)
+	// Audit routes
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// Event routes
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (Basecoat_WatchEventsClient, error)
+	// Webhook routes
+	GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	TestWebhook(ctx context.Context, in *TestWebhookRequest, opts ...grpc.CallOption) (*TestWebhookResponse, error)
+	// Batch routes
+	BatchCreate(ctx context.Context, in *BatchCreateRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error)
+	BatchUpdate(ctx context.Context, in *BatchUpdateRequest, opts ...grpc.CallOption) (*BatchUpdateResponse, error)
+	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error)
 }
 
 type basecoatClient struct {
@@ -193,6 +252,72 @@ func (c *basecoatClient) ToggleAccountState(ctx context.Context, in *ToggleAccou
 	return out, nil
 }
 
+func (c *basecoatClient) ExportAccount(ctx context.Context, in *ExportAccountRequest, opts ...grpc.CallOption) (Basecoat_ExportAccountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Basecoat_ServiceDesc.Streams[0], Basecoat_ExportAccount_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &basecoatExportAccountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Basecoat_ExportAccountClient interface {
+	Recv() (*ExportAccountResponse, error)
+	grpc.ClientStream
+}
+
+type basecoatExportAccountClient struct {
+	grpc.ClientStream
+}
+
+func (x *basecoatExportAccountClient) Recv() (*ExportAccountResponse, error) {
+	m := new(ExportAccountResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *basecoatClient) ImportAccount(ctx context.Context, opts ...grpc.CallOption) (Basecoat_ImportAccountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Basecoat_ServiceDesc.Streams[1], Basecoat_ImportAccount_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &basecoatImportAccountClient{stream}
+	return x, nil
+}
+
+type Basecoat_ImportAccountClient interface {
+	Send(*ImportAccountRequest) error
+	CloseAndRecv() (*ImportAccountResponse, error)
+	grpc.ClientStream
+}
+
+type basecoatImportAccountClient struct {
+	grpc.ClientStream
+}
+
+func (x *basecoatImportAccountClient) Send(m *ImportAccountRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *basecoatImportAccountClient) CloseAndRecv() (*ImportAccountResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportAccountResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *basecoatClient) GetFormula(ctx context.Context, in *GetFormulaRequest, opts ...grpc.CallOption) (*GetFormulaResponse, error) {
 	out := new(GetFormulaResponse)
 	err := c.cc.Invoke(ctx, Basecoat_GetFormula_FullMethodName, in, out, opts...)
@@ -256,6 +381,112 @@ func (c *basecoatClient) DeleteFormula(ctx context.Context, in *DeleteFormulaReq
 	return out, nil
 }
 
+func (c *basecoatClient) ImportFormulas(ctx context.Context, opts ...grpc.CallOption) (Basecoat_ImportFormulasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Basecoat_ServiceDesc.Streams[2], Basecoat_ImportFormulas_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &basecoatImportFormulasClient{stream}
+	return x, nil
+}
+
+type Basecoat_ImportFormulasClient interface {
+	Send(*ImportFormulasRequest) error
+	CloseAndRecv() (*ImportFormulasResponse, error)
+	grpc.ClientStream
+}
+
+type basecoatImportFormulasClient struct {
+	grpc.ClientStream
+}
+
+func (x *basecoatImportFormulasClient) Send(m *ImportFormulasRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *basecoatImportFormulasClient) CloseAndRecv() (*ImportFormulasResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportFormulasResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *basecoatClient) ExportFormula(ctx context.Context, in *ExportFormulaRequest, opts ...grpc.CallOption) (*ExportFormulaResponse, error) {
+	out := new(ExportFormulaResponse)
+	err := c.cc.Invoke(ctx, Basecoat_ExportFormula_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) RenderLabel(ctx context.Context, in *RenderLabelRequest, opts ...grpc.CallOption) (*RenderLabelResponse, error) {
+	out := new(RenderLabelResponse)
+	err := c.cc.Invoke(ctx, Basecoat_RenderLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) GetFormulaRecipe(ctx context.Context, in *GetFormulaRecipeRequest, opts ...grpc.CallOption) (*GetFormulaRecipeResponse, error) {
+	out := new(GetFormulaRecipeResponse)
+	err := c.cc.Invoke(ctx, Basecoat_GetFormulaRecipe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) RecordMix(ctx context.Context, in *RecordMixRequest, opts ...grpc.CallOption) (*RecordMixResponse, error) {
+	out := new(RecordMixResponse)
+	err := c.cc.Invoke(ctx, Basecoat_RecordMix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) GetMix(ctx context.Context, in *GetMixRequest, opts ...grpc.CallOption) (*GetMixResponse, error) {
+	out := new(GetMixResponse)
+	err := c.cc.Invoke(ctx, Basecoat_GetMix_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) ListMixes(ctx context.Context, in *ListMixesRequest, opts ...grpc.CallOption) (*ListMixesResponse, error) {
+	out := new(ListMixesResponse)
+	err := c.cc.Invoke(ctx, Basecoat_ListMixes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) GetCode(ctx context.Context, in *GetCodeRequest, opts ...grpc.CallOption) (*GetCodeResponse, error) {
+	out := new(GetCodeResponse)
+	err := c.cc.Invoke(ctx, Basecoat_GetCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) ResolveCode(ctx context.Context, in *ResolveCodeRequest, opts ...grpc.CallOption) (*ResolveCodeResponse, error) {
+	out := new(ResolveCodeResponse)
+	err := c.cc.Invoke(ctx, Basecoat_ResolveCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *basecoatClient) GetBase(ctx context.Context, in *GetBaseRequest, opts ...grpc.CallOption) (*GetBaseResponse, error) {
 	out := new(GetBaseResponse)
 	err := c.cc.Invoke(ctx, Basecoat_GetBase_FullMethodName, in, out, opts...)
@@ -517,6 +748,164 @@ func (c *basecoatClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, op
 	return out, nil
 }
 
+func (c *basecoatClient) GenerateJobReport(ctx context.Context, in *GenerateJobReportRequest, opts ...grpc.CallOption) (*GenerateJobReportResponse, error) {
+	out := new(GenerateJobReportResponse)
+	err := c.cc.Invoke(ctx, Basecoat_GenerateJobReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) ListDeleted(ctx context.Context, in *ListDeletedRequest, opts ...grpc.CallOption) (*ListDeletedResponse, error) {
+	out := new(ListDeletedResponse)
+	err := c.cc.Invoke(ctx, Basecoat_ListDeleted_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) Undelete(ctx context.Context, in *UndeleteRequest, opts ...grpc.CallOption) (*UndeleteResponse, error) {
+	out := new(UndeleteResponse)
+	err := c.cc.Invoke(ctx, Basecoat_Undelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, Basecoat_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (Basecoat_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Basecoat_ServiceDesc.Streams[3], Basecoat_WatchEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &basecoatWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Basecoat_WatchEventsClient interface {
+	Recv() (*WatchEventsResponse, error)
+	grpc.ClientStream
+}
+
+type basecoatWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *basecoatWatchEventsClient) Recv() (*WatchEventsResponse, error) {
+	m := new(WatchEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *basecoatClient) GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error) {
+	out := new(GetWebhookResponse)
+	err := c.cc.Invoke(ctx, Basecoat_GetWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, Basecoat_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, Basecoat_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error) {
+	out := new(UpdateWebhookResponse)
+	err := c.cc.Invoke(ctx, Basecoat_UpdateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, Basecoat_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, Basecoat_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) TestWebhook(ctx context.Context, in *TestWebhookRequest, opts ...grpc.CallOption) (*TestWebhookResponse, error) {
+	out := new(TestWebhookResponse)
+	err := c.cc.Invoke(ctx, Basecoat_TestWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) BatchCreate(ctx context.Context, in *BatchCreateRequest, opts ...grpc.CallOption) (*BatchCreateResponse, error) {
+	out := new(BatchCreateResponse)
+	err := c.cc.Invoke(ctx, Basecoat_BatchCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) BatchUpdate(ctx context.Context, in *BatchUpdateRequest, opts ...grpc.CallOption) (*BatchUpdateResponse, error) {
+	out := new(BatchUpdateResponse)
+	err := c.cc.Invoke(ctx, Basecoat_BatchUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *basecoatClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error) {
+	out := new(BatchDeleteResponse)
+	err := c.cc.Invoke(ctx, Basecoat_BatchDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BasecoatServer is the server API for Basecoat service.
 // All implementations must embed UnimplementedBasecoatServer
 // for forward compatibility
@@ -531,6 +920,8 @@ type BasecoatServer interface {
 	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
 	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
 	ToggleAccountState(context.Context, *ToggleAccountStateRequest) (*ToggleAccountStateResponse, error)
+	ExportAccount(*ExportAccountRequest, Basecoat_ExportAccountServer) error
+	ImportAccount(Basecoat_ImportAccountServer) error
 	// Formula routes
 	GetFormula(context.Context, *GetFormulaRequest) (*GetFormulaResponse, error)
 	ListFormulas(context.Context, *ListFormulasRequest) (*ListFormulasResponse, error)
@@ -539,6 +930,17 @@ type BasecoatServer interface {
 	DisassociateFormulaFromJob(context.Context, *DisassociateFormulaFromJobRequest) (*DisassociateFormulaFromJobResponse, error)
 	UpdateFormula(context.Context, *UpdateFormulaRequest) (*UpdateFormulaResponse, error)
 	DeleteFormula(context.Context, *DeleteFormulaRequest) (*DeleteFormulaResponse, error)
+	ImportFormulas(Basecoat_ImportFormulasServer) error
+	ExportFormula(context.Context, *ExportFormulaRequest) (*ExportFormulaResponse, error)
+	RenderLabel(context.Context, *RenderLabelRequest) (*RenderLabelResponse, error)
+	GetFormulaRecipe(context.Context, *GetFormulaRecipeRequest) (*GetFormulaRecipeResponse, error)
+	// Mix routes
+	RecordMix(context.Context, *RecordMixRequest) (*RecordMixResponse, error)
+	GetMix(context.Context, *GetMixRequest) (*GetMixResponse, error)
+	ListMixes(context.Context, *ListMixesRequest) (*ListMixesResponse, error)
+	// Code routes
+	GetCode(context.Context, *GetCodeRequest) (*GetCodeResponse, error)
+	ResolveCode(context.Context, *ResolveCodeRequest) (*ResolveCodeResponse, error)
 	// Base routes
 	GetBase(context.Context, *GetBaseRequest) (*GetBaseResponse, error)
 	ListBases(context.Context, *ListBasesRequest) (*ListBasesResponse, error)
@@ -573,6 +975,26 @@ type BasecoatServer interface {
 	CreateJob(context.Context, *CreateJobRequest) (*CreateJobResponse, error)
 	UpdateJob(context.Context, *UpdateJobRequest) (*UpdateJobResponse, error)
 	DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
+	GenerateJobReport(context.Context, *GenerateJobReportRequest) (*GenerateJobReportResponse, error)
+	// Trash routes
+	ListDeleted(context.Context, *ListDeletedRequest) (*ListDeletedResponse, error)
+	Undelete(context.Context, *UndeleteRequest) (*UndeleteResponse, error)
+	// Audit routes
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// Event routes
+	WatchEvents(*WatchEventsRequest, Basecoat_WatchEventsServer) error
+	// Webhook routes
+	GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	TestWebhook(context.Context, *TestWebhookRequest) (*TestWebhookResponse, error)
+	// Batch routes
+	BatchCreate(context.Context, *BatchCreateRequest) (*BatchCreateResponse, error)
+	BatchUpdate(context.Context, *BatchUpdateRequest) (*BatchUpdateResponse, error)
+	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteResponse, error)
 	mustEmbedUnimplementedBasecoatServer()
 }
 
@@ -601,6 +1023,12 @@ func (UnimplementedBasecoatServer) UpdateAccount(context.Context, *UpdateAccount
 func (UnimplementedBasecoatServer) ToggleAccountState(context.Context, *ToggleAccountStateRequest) (*ToggleAccountStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleAccountState not implemented")
 }
+func (UnimplementedBasecoatServer) ExportAccount(*ExportAccountRequest, Basecoat_ExportAccountServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAccount not implemented")
+}
+func (UnimplementedBasecoatServer) ImportAccount(Basecoat_ImportAccountServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportAccount not implemented")
+}
 func (UnimplementedBasecoatServer) GetFormula(context.Context, *GetFormulaRequest) (*GetFormulaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFormula not implemented")
 }
@@ -622,6 +1050,33 @@ func (UnimplementedBasecoatServer) UpdateFormula(context.Context, *UpdateFormula
 func (UnimplementedBasecoatServer) DeleteFormula(context.Context, *DeleteFormulaRequest) (*DeleteFormulaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFormula not implemented")
 }
+func (UnimplementedBasecoatServer) ImportFormulas(Basecoat_ImportFormulasServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportFormulas not implemented")
+}
+func (UnimplementedBasecoatServer) ExportFormula(context.Context, *ExportFormulaRequest) (*ExportFormulaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportFormula not implemented")
+}
+func (UnimplementedBasecoatServer) RenderLabel(context.Context, *RenderLabelRequest) (*RenderLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderLabel not implemented")
+}
+func (UnimplementedBasecoatServer) GetFormulaRecipe(context.Context, *GetFormulaRecipeRequest) (*GetFormulaRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFormulaRecipe not implemented")
+}
+func (UnimplementedBasecoatServer) RecordMix(context.Context, *RecordMixRequest) (*RecordMixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordMix not implemented")
+}
+func (UnimplementedBasecoatServer) GetMix(context.Context, *GetMixRequest) (*GetMixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMix not implemented")
+}
+func (UnimplementedBasecoatServer) ListMixes(context.Context, *ListMixesRequest) (*ListMixesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMixes not implemented")
+}
+func (UnimplementedBasecoatServer) GetCode(context.Context, *GetCodeRequest) (*GetCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCode not implemented")
+}
+func (UnimplementedBasecoatServer) ResolveCode(context.Context, *ResolveCodeRequest) (*ResolveCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCode not implemented")
+}
 func (UnimplementedBasecoatServer) GetBase(context.Context, *GetBaseRequest) (*GetBaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBase not implemented")
 }
@@ -709,6 +1164,51 @@ func (UnimplementedBasecoatServer) UpdateJob(context.Context, *UpdateJobRequest)
 func (UnimplementedBasecoatServer) DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteJob not implemented")
 }
+func (UnimplementedBasecoatServer) GenerateJobReport(context.Context, *GenerateJobReportRequest) (*GenerateJobReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateJobReport not implemented")
+}
+func (UnimplementedBasecoatServer) ListDeleted(context.Context, *ListDeletedRequest) (*ListDeletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeleted not implemented")
+}
+func (UnimplementedBasecoatServer) Undelete(context.Context, *UndeleteRequest) (*UndeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelete not implemented")
+}
+func (UnimplementedBasecoatServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedBasecoatServer) WatchEvents(*WatchEventsRequest, Basecoat_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedBasecoatServer) GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedBasecoatServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedBasecoatServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedBasecoatServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedBasecoatServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedBasecoatServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedBasecoatServer) TestWebhook(context.Context, *TestWebhookRequest) (*TestWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestWebhook not implemented")
+}
+func (UnimplementedBasecoatServer) BatchCreate(context.Context, *BatchCreateRequest) (*BatchCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
+}
+func (UnimplementedBasecoatServer) BatchUpdate(context.Context, *BatchUpdateRequest) (*BatchUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdate not implemented")
+}
+func (UnimplementedBasecoatServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
 func (UnimplementedBasecoatServer) mustEmbedUnimplementedBasecoatServer() {}
 
 // UnsafeBasecoatServer may be embedded to opt out of forward compatibility for this service.
@@ -848,6 +1348,53 @@ func _Basecoat_ToggleAccountState_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Basecoat_ExportAccount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAccountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BasecoatServer).ExportAccount(m, &basecoatExportAccountServer{stream})
+}
+
+type Basecoat_ExportAccountServer interface {
+	Send(*ExportAccountResponse) error
+	grpc.ServerStream
+}
+
+type basecoatExportAccountServer struct {
+	grpc.ServerStream
+}
+
+func (x *basecoatExportAccountServer) Send(m *ExportAccountResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Basecoat_ImportAccount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BasecoatServer).ImportAccount(&basecoatImportAccountServer{stream})
+}
+
+type Basecoat_ImportAccountServer interface {
+	SendAndClose(*ImportAccountResponse) error
+	Recv() (*ImportAccountRequest, error)
+	grpc.ServerStream
+}
+
+type basecoatImportAccountServer struct {
+	grpc.ServerStream
+}
+
+func (x *basecoatImportAccountServer) SendAndClose(m *ImportAccountResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *basecoatImportAccountServer) Recv() (*ImportAccountRequest, error) {
+	m := new(ImportAccountRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Basecoat_GetFormula_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFormulaRequest)
 	if err := dec(in); err != nil {
@@ -974,15 +1521,185 @@ func _Basecoat_DeleteFormula_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Basecoat_GetBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBaseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BasecoatServer).GetBase(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
+func _Basecoat_ImportFormulas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BasecoatServer).ImportFormulas(&basecoatImportFormulasServer{stream})
+}
+
+type Basecoat_ImportFormulasServer interface {
+	SendAndClose(*ImportFormulasResponse) error
+	Recv() (*ImportFormulasRequest, error)
+	grpc.ServerStream
+}
+
+type basecoatImportFormulasServer struct {
+	grpc.ServerStream
+}
+
+func (x *basecoatImportFormulasServer) SendAndClose(m *ImportFormulasResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *basecoatImportFormulasServer) Recv() (*ImportFormulasRequest, error) {
+	m := new(ImportFormulasRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Basecoat_ExportFormula_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportFormulaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).ExportFormula(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_ExportFormula_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).ExportFormula(ctx, req.(*ExportFormulaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_RenderLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).RenderLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_RenderLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).RenderLabel(ctx, req.(*RenderLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_GetFormulaRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFormulaRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).GetFormulaRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_GetFormulaRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).GetFormulaRecipe(ctx, req.(*GetFormulaRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_RecordMix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordMixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).RecordMix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_RecordMix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).RecordMix(ctx, req.(*RecordMixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_GetMix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).GetMix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_GetMix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).GetMix(ctx, req.(*GetMixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_ListMixes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMixesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).ListMixes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_ListMixes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).ListMixes(ctx, req.(*ListMixesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_GetCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).GetCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_GetCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).GetCode(ctx, req.(*GetCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_ResolveCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).ResolveCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_ResolveCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).ResolveCode(ctx, req.(*ResolveCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_GetBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).GetBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
 		Server:     srv,
 		FullMethod: Basecoat_GetBase_FullMethodName,
 	}
@@ -1496,6 +2213,279 @@ func _Basecoat_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Basecoat_GenerateJobReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateJobReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).GenerateJobReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_GenerateJobReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).GenerateJobReport(ctx, req.(*GenerateJobReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_ListDeleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).ListDeleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_ListDeleted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).ListDeleted(ctx, req.(*ListDeletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_Undelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).Undelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_Undelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).Undelete(ctx, req.(*UndeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BasecoatServer).WatchEvents(m, &basecoatWatchEventsServer{stream})
+}
+
+type Basecoat_WatchEventsServer interface {
+	Send(*WatchEventsResponse) error
+	grpc.ServerStream
+}
+
+type basecoatWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *basecoatWatchEventsServer) Send(m *WatchEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Basecoat_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_GetWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).GetWebhook(ctx, req.(*GetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_TestWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).TestWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_TestWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).TestWebhook(ctx, req.(*TestWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).BatchCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_BatchCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).BatchCreate(ctx, req.(*BatchCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_BatchUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).BatchUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_BatchUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).BatchUpdate(ctx, req.(*BatchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Basecoat_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BasecoatServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Basecoat_BatchDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BasecoatServer).BatchDelete(ctx, req.(*BatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Basecoat_ServiceDesc is the grpc.ServiceDesc for Basecoat service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1559,6 +2549,38 @@ var Basecoat_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFormula",
 			Handler:    _Basecoat_DeleteFormula_Handler,
 		},
+		{
+			MethodName: "ExportFormula",
+			Handler:    _Basecoat_ExportFormula_Handler,
+		},
+		{
+			MethodName: "RenderLabel",
+			Handler:    _Basecoat_RenderLabel_Handler,
+		},
+		{
+			MethodName: "GetFormulaRecipe",
+			Handler:    _Basecoat_GetFormulaRecipe_Handler,
+		},
+		{
+			MethodName: "RecordMix",
+			Handler:    _Basecoat_RecordMix_Handler,
+		},
+		{
+			MethodName: "GetMix",
+			Handler:    _Basecoat_GetMix_Handler,
+		},
+		{
+			MethodName: "ListMixes",
+			Handler:    _Basecoat_ListMixes_Handler,
+		},
+		{
+			MethodName: "GetCode",
+			Handler:    _Basecoat_GetCode_Handler,
+		},
+		{
+			MethodName: "ResolveCode",
+			Handler:    _Basecoat_ResolveCode_Handler,
+		},
 		{
 			MethodName: "GetBase",
 			Handler:    _Basecoat_GetBase_Handler,
@@ -1675,7 +2697,84 @@ var Basecoat_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJob",
 			Handler:    _Basecoat_DeleteJob_Handler,
 		},
+		{
+			MethodName: "GenerateJobReport",
+			Handler:    _Basecoat_GenerateJobReport_Handler,
+		},
+		{
+			MethodName: "ListDeleted",
+			Handler:    _Basecoat_ListDeleted_Handler,
+		},
+		{
+			MethodName: "Undelete",
+			Handler:    _Basecoat_Undelete_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Basecoat_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _Basecoat_GetWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Basecoat_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _Basecoat_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _Basecoat_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _Basecoat_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Basecoat_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "TestWebhook",
+			Handler:    _Basecoat_TestWebhook_Handler,
+		},
+		{
+			MethodName: "BatchCreate",
+			Handler:    _Basecoat_BatchCreate_Handler,
+		},
+		{
+			MethodName: "BatchUpdate",
+			Handler:    _Basecoat_BatchUpdate_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _Basecoat_BatchDelete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportAccount",
+			Handler:       _Basecoat_ExportAccount_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportAccount",
+			Handler:       _Basecoat_ImportAccount_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ImportFormulas",
+			Handler:       _Basecoat_ImportFormulas_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _Basecoat_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "basecoat.proto",
 }
@@ -20,6 +20,206 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ImportConflictPolicy controls what happens when an imported entity has the
+// same id as an entity that already exists in the target account.
+type ImportConflictPolicy int32
+
+const (
+	// Keep the existing entity; references to it in the archive point at it.
+	ImportConflictPolicy_SKIP ImportConflictPolicy = 0
+	// Replace the existing entity with the one from the archive.
+	ImportConflictPolicy_OVERWRITE ImportConflictPolicy = 1
+	// Import the entity under a newly generated id.
+	ImportConflictPolicy_RENAME ImportConflictPolicy = 2
+)
+
+// Enum value maps for ImportConflictPolicy.
+var (
+	ImportConflictPolicy_name = map[int32]string{
+		0: "SKIP",
+		1: "OVERWRITE",
+		2: "RENAME",
+	}
+	ImportConflictPolicy_value = map[string]int32{
+		"SKIP":      0,
+		"OVERWRITE": 1,
+		"RENAME":    2,
+	}
+)
+
+func (x ImportConflictPolicy) Enum() *ImportConflictPolicy {
+	p := new(ImportConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ImportConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_basecoat_transport_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportConflictPolicy) Type() protoreflect.EnumType {
+	return &file_basecoat_transport_proto_enumTypes[0]
+}
+
+func (x ImportConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportConflictPolicy.Descriptor instead.
+func (ImportConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{0}
+}
+
+type LabelFormat int32
+
+const (
+	// A single 4x3 inch page for laser printers.
+	LabelFormat_PDF LabelFormat = 0
+	// A Zebra Programming Language script for 203 dpi thermal printers.
+	LabelFormat_ZPL LabelFormat = 1
+)
+
+// Enum value maps for LabelFormat.
+var (
+	LabelFormat_name = map[int32]string{
+		0: "PDF",
+		1: "ZPL",
+	}
+	LabelFormat_value = map[string]int32{
+		"PDF": 0,
+		"ZPL": 1,
+	}
+)
+
+func (x LabelFormat) Enum() *LabelFormat {
+	p := new(LabelFormat)
+	*p = x
+	return p
+}
+
+func (x LabelFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LabelFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_basecoat_transport_proto_enumTypes[1].Descriptor()
+}
+
+func (LabelFormat) Type() protoreflect.EnumType {
+	return &file_basecoat_transport_proto_enumTypes[1]
+}
+
+func (x LabelFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LabelFormat.Descriptor instead.
+func (LabelFormat) EnumDescriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{1}
+}
+
+// A batch applies many creates, updates or deletes in a single call. All items
+// in a batch run inside one transaction.
+type BatchMode int32
+
+const (
+	// Either every item in the batch is applied or none of them are.
+	BatchMode_ATOMIC BatchMode = 0
+	// Items which fail are skipped and the rest of the batch is still applied.
+	BatchMode_BEST_EFFORT BatchMode = 1
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "ATOMIC",
+		1: "BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"ATOMIC":      0,
+		"BEST_EFFORT": 1,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_basecoat_transport_proto_enumTypes[2].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_basecoat_transport_proto_enumTypes[2]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{2}
+}
+
+type GenerateJobReportRequest_Format int32
+
+const (
+	// A letter sized document.
+	GenerateJobReportRequest_PDF GenerateJobReportRequest_Format = 0
+	// A single self-contained page.
+	GenerateJobReportRequest_HTML GenerateJobReportRequest_Format = 1
+)
+
+// Enum value maps for GenerateJobReportRequest_Format.
+var (
+	GenerateJobReportRequest_Format_name = map[int32]string{
+		0: "PDF",
+		1: "HTML",
+	}
+	GenerateJobReportRequest_Format_value = map[string]int32{
+		"PDF":  0,
+		"HTML": 1,
+	}
+)
+
+func (x GenerateJobReportRequest_Format) Enum() *GenerateJobReportRequest_Format {
+	p := new(GenerateJobReportRequest_Format)
+	*p = x
+	return p
+}
+
+func (x GenerateJobReportRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GenerateJobReportRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_basecoat_transport_proto_enumTypes[3].Descriptor()
+}
+
+func (GenerateJobReportRequest_Format) Type() protoreflect.EnumType {
+	return &file_basecoat_transport_proto_enumTypes[3]
+}
+
+func (x GenerateJobReportRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GenerateJobReportRequest_Format.Descriptor instead.
+func (GenerateJobReportRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{89, 0}
+}
+
 type CreateAPITokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -608,14 +808,16 @@ func (x *ToggleAccountStateResponse) GetState() AccountState {
 	return AccountState_UNKNOWN
 }
 
-type GetSystemInfoRequest struct {
+type ExportAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetSystemInfoRequest) Reset() {
-	*x = GetSystemInfoRequest{}
+func (x *ExportAccountRequest) Reset() {
+	*x = ExportAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -623,13 +825,13 @@ func (x *GetSystemInfoRequest) Reset() {
 	}
 }
 
-func (x *GetSystemInfoRequest) String() string {
+func (x *ExportAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemInfoRequest) ProtoMessage() {}
+func (*ExportAccountRequest) ProtoMessage() {}
 
-func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -641,23 +843,28 @@ func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetSystemInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportAccountRequest.ProtoReflect.Descriptor instead.
+func (*ExportAccountRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{12}
 }
 
-type GetSystemInfoResponse struct {
+func (x *ExportAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ExportAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commit          string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
-	FrontendEnabled bool   `protobuf:"varint,2,opt,name=frontend_enabled,json=frontendEnabled,proto3" json:"frontend_enabled,omitempty"`
-	Semver          string `protobuf:"bytes,3,opt,name=semver,proto3" json:"semver,omitempty"`
+	Record *AccountArchiveRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
 }
 
-func (x *GetSystemInfoResponse) Reset() {
-	*x = GetSystemInfoResponse{}
+func (x *ExportAccountResponse) Reset() {
+	*x = ExportAccountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -665,13 +872,13 @@ func (x *GetSystemInfoResponse) Reset() {
 	}
 }
 
-func (x *GetSystemInfoResponse) String() string {
+func (x *ExportAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemInfoResponse) ProtoMessage() {}
+func (*ExportAccountResponse) ProtoMessage() {}
 
-func (x *GetSystemInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -683,43 +890,32 @@ func (x *GetSystemInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetSystemInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportAccountResponse.ProtoReflect.Descriptor instead.
+func (*ExportAccountResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetSystemInfoResponse) GetCommit() string {
-	if x != nil {
-		return x.Commit
-	}
-	return ""
-}
-
-func (x *GetSystemInfoResponse) GetFrontendEnabled() bool {
-	if x != nil {
-		return x.FrontendEnabled
-	}
-	return false
-}
-
-func (x *GetSystemInfoResponse) GetSemver() string {
+func (x *ExportAccountResponse) GetRecord() *AccountArchiveRecord {
 	if x != nil {
-		return x.Semver
+		return x.Record
 	}
-	return ""
+	return nil
 }
 
-// Formula transport messages
-type GetFormulaRequest struct {
+type ImportAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The account to import into and the conflict policy are only read from the
+	// first message in the stream.
+	Account string                `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Policy  ImportConflictPolicy  `protobuf:"varint,2,opt,name=policy,proto3,enum=proto.ImportConflictPolicy" json:"policy,omitempty"`
+	Record  *AccountArchiveRecord `protobuf:"bytes,3,opt,name=record,proto3" json:"record,omitempty"`
 }
 
-func (x *GetFormulaRequest) Reset() {
-	*x = GetFormulaRequest{}
+func (x *ImportAccountRequest) Reset() {
+	*x = ImportAccountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -727,13 +923,13 @@ func (x *GetFormulaRequest) Reset() {
 	}
 }
 
-func (x *GetFormulaRequest) String() string {
+func (x *ImportAccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFormulaRequest) ProtoMessage() {}
+func (*ImportAccountRequest) ProtoMessage() {}
 
-func (x *GetFormulaRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportAccountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -745,28 +941,45 @@ func (x *GetFormulaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFormulaRequest.ProtoReflect.Descriptor instead.
-func (*GetFormulaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportAccountRequest.ProtoReflect.Descriptor instead.
+func (*ImportAccountRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetFormulaRequest) GetId() string {
+func (x *ImportAccountRequest) GetAccount() string {
 	if x != nil {
-		return x.Id
+		return x.Account
 	}
 	return ""
 }
 
-type GetFormulaResponse struct {
+func (x *ImportAccountRequest) GetPolicy() ImportConflictPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return ImportConflictPolicy_SKIP
+}
+
+func (x *ImportAccountRequest) GetRecord() *AccountArchiveRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type ImportAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formula *Formula `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
+	Created     int64 `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Overwritten int64 `protobuf:"varint,2,opt,name=overwritten,proto3" json:"overwritten,omitempty"`
+	Skipped     int64 `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Renamed     int64 `protobuf:"varint,4,opt,name=renamed,proto3" json:"renamed,omitempty"`
 }
 
-func (x *GetFormulaResponse) Reset() {
-	*x = GetFormulaResponse{}
+func (x *ImportAccountResponse) Reset() {
+	*x = ImportAccountResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -774,13 +987,13 @@ func (x *GetFormulaResponse) Reset() {
 	}
 }
 
-func (x *GetFormulaResponse) String() string {
+func (x *ImportAccountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFormulaResponse) ProtoMessage() {}
+func (*ImportAccountResponse) ProtoMessage() {}
 
-func (x *GetFormulaResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportAccountResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -792,34 +1005,47 @@ func (x *GetFormulaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFormulaResponse.ProtoReflect.Descriptor instead.
-func (*GetFormulaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportAccountResponse.ProtoReflect.Descriptor instead.
+func (*ImportAccountResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetFormulaResponse) GetFormula() *Formula {
+func (x *ImportAccountResponse) GetCreated() int64 {
 	if x != nil {
-		return x.Formula
+		return x.Created
 	}
-	return nil
+	return 0
 }
 
-type ListFormulasRequest struct {
+func (x *ImportAccountResponse) GetOverwritten() int64 {
+	if x != nil {
+		return x.Overwritten
+	}
+	return 0
+}
+
+func (x *ImportAccountResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportAccountResponse) GetRenamed() int64 {
+	if x != nil {
+		return x.Renamed
+	}
+	return 0
+}
+
+type GetSystemInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// offset is a pagination parameter that defines where to start when counting
-	// the list of objects to return.
-	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	// limit is a pagination parameter that defines how many objects to return
-	// per result.
-	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"` // Allows you to fuzzy search through formulas.
 }
 
-func (x *ListFormulasRequest) Reset() {
-	*x = ListFormulasRequest{}
+func (x *GetSystemInfoRequest) Reset() {
+	*x = GetSystemInfoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -827,13 +1053,13 @@ func (x *ListFormulasRequest) Reset() {
 	}
 }
 
-func (x *ListFormulasRequest) String() string {
+func (x *GetSystemInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFormulasRequest) ProtoMessage() {}
+func (*GetSystemInfoRequest) ProtoMessage() {}
 
-func (x *ListFormulasRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -845,42 +1071,23 @@ func (x *ListFormulasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFormulasRequest.ProtoReflect.Descriptor instead.
-func (*ListFormulasRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSystemInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListFormulasRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-func (x *ListFormulasRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListFormulasRequest) GetFilter() string {
-	if x != nil {
-		return x.Filter
-	}
-	return ""
-}
-
-type ListFormulasResponse struct {
+type GetSystemInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formulas []*FormulaMetadata `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
+	Commit          string `protobuf:"bytes,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	FrontendEnabled bool   `protobuf:"varint,2,opt,name=frontend_enabled,json=frontendEnabled,proto3" json:"frontend_enabled,omitempty"`
+	Semver          string `protobuf:"bytes,3,opt,name=semver,proto3" json:"semver,omitempty"`
 }
 
-func (x *ListFormulasResponse) Reset() {
-	*x = ListFormulasResponse{}
+func (x *GetSystemInfoResponse) Reset() {
+	*x = GetSystemInfoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -888,13 +1095,13 @@ func (x *ListFormulasResponse) Reset() {
 	}
 }
 
-func (x *ListFormulasResponse) String() string {
+func (x *GetSystemInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFormulasResponse) ProtoMessage() {}
+func (*GetSystemInfoResponse) ProtoMessage() {}
 
-func (x *ListFormulasResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSystemInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -906,30 +1113,43 @@ func (x *ListFormulasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFormulasResponse.ProtoReflect.Descriptor instead.
-func (*ListFormulasResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSystemInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListFormulasResponse) GetFormulas() []*FormulaMetadata {
+func (x *GetSystemInfoResponse) GetCommit() string {
 	if x != nil {
-		return x.Formulas
+		return x.Commit
 	}
-	return nil
+	return ""
 }
 
-type CreateFormulaRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *GetSystemInfoResponse) GetFrontendEnabled() bool {
+	if x != nil {
+		return x.FrontendEnabled
+	}
+	return false
+}
+
+func (x *GetSystemInfoResponse) GetSemver() string {
+	if x != nil {
+		return x.Semver
+	}
+	return ""
+}
+
+// Formula transport messages
+type GetFormulaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Number string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
-	Notes  string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateFormulaRequest) Reset() {
-	*x = CreateFormulaRequest{}
+func (x *GetFormulaRequest) Reset() {
+	*x = GetFormulaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -937,13 +1157,13 @@ func (x *CreateFormulaRequest) Reset() {
 	}
 }
 
-func (x *CreateFormulaRequest) String() string {
+func (x *GetFormulaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFormulaRequest) ProtoMessage() {}
+func (*GetFormulaRequest) ProtoMessage() {}
 
-func (x *CreateFormulaRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFormulaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -955,42 +1175,28 @@ func (x *CreateFormulaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFormulaRequest.ProtoReflect.Descriptor instead.
-func (*CreateFormulaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFormulaRequest.ProtoReflect.Descriptor instead.
+func (*GetFormulaRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateFormulaRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateFormulaRequest) GetNumber() string {
-	if x != nil {
-		return x.Number
-	}
-	return ""
-}
-
-func (x *CreateFormulaRequest) GetNotes() string {
+func (x *GetFormulaRequest) GetId() string {
 	if x != nil {
-		return x.Notes
+		return x.Id
 	}
 	return ""
 }
 
-type CreateFormulaResponse struct {
+type GetFormulaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formula *FormulaMetadata `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
+	Formula *Formula `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
 }
 
-func (x *CreateFormulaResponse) Reset() {
-	*x = CreateFormulaResponse{}
+func (x *GetFormulaResponse) Reset() {
+	*x = GetFormulaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -998,13 +1204,13 @@ func (x *CreateFormulaResponse) Reset() {
 	}
 }
 
-func (x *CreateFormulaResponse) String() string {
+func (x *GetFormulaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFormulaResponse) ProtoMessage() {}
+func (*GetFormulaResponse) ProtoMessage() {}
 
-func (x *CreateFormulaResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFormulaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1016,31 +1222,34 @@ func (x *CreateFormulaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFormulaResponse.ProtoReflect.Descriptor instead.
-func (*CreateFormulaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFormulaResponse.ProtoReflect.Descriptor instead.
+func (*GetFormulaResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CreateFormulaResponse) GetFormula() *FormulaMetadata {
+func (x *GetFormulaResponse) GetFormula() *Formula {
 	if x != nil {
 		return x.Formula
 	}
 	return nil
 }
 
-type UpdateFormulaRequest struct {
+type ListFormulasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Number string `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
-	Notes  string `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	// offset is a pagination parameter that defines where to start when counting
+	// the list of objects to return.
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit is a pagination parameter that defines how many objects to return
+	// per result.
+	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"` // Allows you to fuzzy search through formulas.
 }
 
-func (x *UpdateFormulaRequest) Reset() {
-	*x = UpdateFormulaRequest{}
+func (x *ListFormulasRequest) Reset() {
+	*x = ListFormulasRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1048,13 +1257,13 @@ func (x *UpdateFormulaRequest) Reset() {
 	}
 }
 
-func (x *UpdateFormulaRequest) String() string {
+func (x *ListFormulasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFormulaRequest) ProtoMessage() {}
+func (*ListFormulasRequest) ProtoMessage() {}
 
-func (x *UpdateFormulaRequest) ProtoReflect() protoreflect.Message {
+func (x *ListFormulasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1066,49 +1275,42 @@ func (x *UpdateFormulaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFormulaRequest.ProtoReflect.Descriptor instead.
-func (*UpdateFormulaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFormulasRequest.ProtoReflect.Descriptor instead.
+func (*ListFormulasRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UpdateFormulaRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateFormulaRequest) GetName() string {
+func (x *ListFormulasRequest) GetOffset() int64 {
 	if x != nil {
-		return x.Name
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateFormulaRequest) GetNumber() string {
+func (x *ListFormulasRequest) GetLimit() int64 {
 	if x != nil {
-		return x.Number
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateFormulaRequest) GetNotes() string {
+func (x *ListFormulasRequest) GetFilter() string {
 	if x != nil {
-		return x.Notes
+		return x.Filter
 	}
 	return ""
 }
 
-type UpdateFormulaResponse struct {
+type ListFormulasResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formula *FormulaMetadata `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
+	Formulas []*FormulaMetadata `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
 }
 
-func (x *UpdateFormulaResponse) Reset() {
-	*x = UpdateFormulaResponse{}
+func (x *ListFormulasResponse) Reset() {
+	*x = ListFormulasResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1116,13 +1318,13 @@ func (x *UpdateFormulaResponse) Reset() {
 	}
 }
 
-func (x *UpdateFormulaResponse) String() string {
+func (x *ListFormulasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateFormulaResponse) ProtoMessage() {}
+func (*ListFormulasResponse) ProtoMessage() {}
 
-func (x *UpdateFormulaResponse) ProtoReflect() protoreflect.Message {
+func (x *ListFormulasResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1134,28 +1336,31 @@ func (x *UpdateFormulaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateFormulaResponse.ProtoReflect.Descriptor instead.
-func (*UpdateFormulaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListFormulasResponse.ProtoReflect.Descriptor instead.
+func (*ListFormulasResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateFormulaResponse) GetFormula() *FormulaMetadata {
+func (x *ListFormulasResponse) GetFormulas() []*FormulaMetadata {
 	if x != nil {
-		return x.Formula
+		return x.Formulas
 	}
 	return nil
 }
 
-type DeleteFormulaRequest struct {
+// An ingredient is a base or colorant to be used in a formula along with the
+// amount of it required.
+type FormulaIngredient struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *DeleteFormulaRequest) Reset() {
-	*x = DeleteFormulaRequest{}
+func (x *FormulaIngredient) Reset() {
+	*x = FormulaIngredient{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1163,13 +1368,13 @@ func (x *DeleteFormulaRequest) Reset() {
 	}
 }
 
-func (x *DeleteFormulaRequest) String() string {
+func (x *FormulaIngredient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFormulaRequest) ProtoMessage() {}
+func (*FormulaIngredient) ProtoMessage() {}
 
-func (x *DeleteFormulaRequest) ProtoReflect() protoreflect.Message {
+func (x *FormulaIngredient) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1181,26 +1386,44 @@ func (x *DeleteFormulaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFormulaRequest.ProtoReflect.Descriptor instead.
-func (*DeleteFormulaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FormulaIngredient.ProtoReflect.Descriptor instead.
+func (*FormulaIngredient) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteFormulaRequest) GetId() string {
+func (x *FormulaIngredient) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteFormulaResponse struct {
+func (x *FormulaIngredient) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// The formula is created along with all of its bases and colorants or not at
+// all.
+type CreateFormulaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name      string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Number    string               `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Notes     string               `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Bases     []*FormulaIngredient `protobuf:"bytes,4,rep,name=bases,proto3" json:"bases,omitempty"`
+	Colorants []*FormulaIngredient `protobuf:"bytes,5,rep,name=colorants,proto3" json:"colorants,omitempty"`
+	Sheen     string               `protobuf:"bytes,6,opt,name=sheen,proto3" json:"sheen,omitempty"`
+	// Hex RGB value; ex. "#4A5A6B".
+	Color string `protobuf:"bytes,7,opt,name=color,proto3" json:"color,omitempty"`
 }
 
-func (x *DeleteFormulaResponse) Reset() {
-	*x = DeleteFormulaResponse{}
+func (x *CreateFormulaRequest) Reset() {
+	*x = CreateFormulaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1208,13 +1431,13 @@ func (x *DeleteFormulaResponse) Reset() {
 	}
 }
 
-func (x *DeleteFormulaResponse) String() string {
+func (x *CreateFormulaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFormulaResponse) ProtoMessage() {}
+func (*CreateFormulaRequest) ProtoMessage() {}
 
-func (x *DeleteFormulaResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateFormulaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1226,22 +1449,70 @@ func (x *DeleteFormulaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFormulaResponse.ProtoReflect.Descriptor instead.
-func (*DeleteFormulaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateFormulaRequest.ProtoReflect.Descriptor instead.
+func (*CreateFormulaRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{23}
 }
 
-// Base transport messages
-type GetBaseRequest struct {
+func (x *CreateFormulaRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFormulaRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *CreateFormulaRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateFormulaRequest) GetBases() []*FormulaIngredient {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+func (x *CreateFormulaRequest) GetColorants() []*FormulaIngredient {
+	if x != nil {
+		return x.Colorants
+	}
+	return nil
+}
+
+func (x *CreateFormulaRequest) GetSheen() string {
+	if x != nil {
+		return x.Sheen
+	}
+	return ""
+}
+
+func (x *CreateFormulaRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type CreateFormulaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Formula *FormulaMetadata `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
 }
 
-func (x *GetBaseRequest) Reset() {
-	*x = GetBaseRequest{}
+func (x *CreateFormulaResponse) Reset() {
+	*x = CreateFormulaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1249,13 +1520,13 @@ func (x *GetBaseRequest) Reset() {
 	}
 }
 
-func (x *GetBaseRequest) String() string {
+func (x *CreateFormulaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBaseRequest) ProtoMessage() {}
+func (*CreateFormulaResponse) ProtoMessage() {}
 
-func (x *GetBaseRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateFormulaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1267,28 +1538,45 @@ func (x *GetBaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBaseRequest.ProtoReflect.Descriptor instead.
-func (*GetBaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateFormulaResponse.ProtoReflect.Descriptor instead.
+func (*CreateFormulaResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetBaseRequest) GetId() string {
+func (x *CreateFormulaResponse) GetFormula() *FormulaMetadata {
 	if x != nil {
-		return x.Id
+		return x.Formula
 	}
-	return ""
+	return nil
 }
 
-type GetBaseResponse struct {
+// Only the fields which are set are changed. Version must match the formula's
+// current version or the update is rejected with ABORTED.
+//
+// Bases and colorants listed are added to the formula, or have their amount
+// changed if the formula already uses them. When replace_bases or
+// replace_colorants is set the formula's bases or colorants become exactly
+// those listed instead; an empty list removes them all.
+type UpdateFormulaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Base *Base `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id               string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             *string              `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Number           *string              `protobuf:"bytes,3,opt,name=number,proto3,oneof" json:"number,omitempty"`
+	Notes            *string              `protobuf:"bytes,4,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	Version          int64                `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Bases            []*FormulaIngredient `protobuf:"bytes,6,rep,name=bases,proto3" json:"bases,omitempty"`
+	Colorants        []*FormulaIngredient `protobuf:"bytes,7,rep,name=colorants,proto3" json:"colorants,omitempty"`
+	ReplaceBases     bool                 `protobuf:"varint,8,opt,name=replace_bases,json=replaceBases,proto3" json:"replace_bases,omitempty"`
+	ReplaceColorants bool                 `protobuf:"varint,9,opt,name=replace_colorants,json=replaceColorants,proto3" json:"replace_colorants,omitempty"`
+	Sheen            *string              `protobuf:"bytes,10,opt,name=sheen,proto3,oneof" json:"sheen,omitempty"`
+	Color            *string              `protobuf:"bytes,11,opt,name=color,proto3,oneof" json:"color,omitempty"`
 }
 
-func (x *GetBaseResponse) Reset() {
-	*x = GetBaseResponse{}
+func (x *UpdateFormulaRequest) Reset() {
+	*x = UpdateFormulaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1296,13 +1584,13 @@ func (x *GetBaseResponse) Reset() {
 	}
 }
 
-func (x *GetBaseResponse) String() string {
+func (x *UpdateFormulaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBaseResponse) ProtoMessage() {}
+func (*UpdateFormulaRequest) ProtoMessage() {}
 
-func (x *GetBaseResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateFormulaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1314,26 +1602,98 @@ func (x *GetBaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBaseResponse.ProtoReflect.Descriptor instead.
-func (*GetBaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateFormulaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFormulaRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetBaseResponse) GetBase() *Base {
+func (x *UpdateFormulaRequest) GetId() string {
 	if x != nil {
-		return x.Base
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFormulaRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFormulaRequest) GetNumber() string {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return ""
+}
+
+func (x *UpdateFormulaRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateFormulaRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateFormulaRequest) GetBases() []*FormulaIngredient {
+	if x != nil {
+		return x.Bases
 	}
 	return nil
 }
 
-type ListBasesRequest struct {
+func (x *UpdateFormulaRequest) GetColorants() []*FormulaIngredient {
+	if x != nil {
+		return x.Colorants
+	}
+	return nil
+}
+
+func (x *UpdateFormulaRequest) GetReplaceBases() bool {
+	if x != nil {
+		return x.ReplaceBases
+	}
+	return false
+}
+
+func (x *UpdateFormulaRequest) GetReplaceColorants() bool {
+	if x != nil {
+		return x.ReplaceColorants
+	}
+	return false
+}
+
+func (x *UpdateFormulaRequest) GetSheen() string {
+	if x != nil && x.Sheen != nil {
+		return *x.Sheen
+	}
+	return ""
+}
+
+func (x *UpdateFormulaRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+type UpdateFormulaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Formula *FormulaMetadata `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
 }
 
-func (x *ListBasesRequest) Reset() {
-	*x = ListBasesRequest{}
+func (x *UpdateFormulaResponse) Reset() {
+	*x = UpdateFormulaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1341,13 +1701,13 @@ func (x *ListBasesRequest) Reset() {
 	}
 }
 
-func (x *ListBasesRequest) String() string {
+func (x *UpdateFormulaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBasesRequest) ProtoMessage() {}
+func (*UpdateFormulaResponse) ProtoMessage() {}
 
-func (x *ListBasesRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateFormulaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1359,21 +1719,28 @@ func (x *ListBasesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBasesRequest.ProtoReflect.Descriptor instead.
-func (*ListBasesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateFormulaResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFormulaResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{26}
 }
 
-type ListBasesResponse struct {
+func (x *UpdateFormulaResponse) GetFormula() *FormulaMetadata {
+	if x != nil {
+		return x.Formula
+	}
+	return nil
+}
+
+type DeleteFormulaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bases []*BaseMetadata `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListBasesResponse) Reset() {
-	*x = ListBasesResponse{}
+func (x *DeleteFormulaRequest) Reset() {
+	*x = DeleteFormulaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1381,13 +1748,13 @@ func (x *ListBasesResponse) Reset() {
 	}
 }
 
-func (x *ListBasesResponse) String() string {
+func (x *DeleteFormulaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBasesResponse) ProtoMessage() {}
+func (*DeleteFormulaRequest) ProtoMessage() {}
 
-func (x *ListBasesResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteFormulaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1399,29 +1766,26 @@ func (x *ListBasesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBasesResponse.ProtoReflect.Descriptor instead.
-func (*ListBasesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteFormulaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFormulaRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ListBasesResponse) GetBases() []*BaseMetadata {
+func (x *DeleteFormulaRequest) GetId() string {
 	if x != nil {
-		return x.Bases
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type CreateBaseRequest struct {
+type DeleteFormulaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Label        string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Manufacturer string `protobuf:"bytes,2,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
 }
 
-func (x *CreateBaseRequest) Reset() {
-	*x = CreateBaseRequest{}
+func (x *DeleteFormulaResponse) Reset() {
+	*x = DeleteFormulaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1429,13 +1793,13 @@ func (x *CreateBaseRequest) Reset() {
 	}
 }
 
-func (x *CreateBaseRequest) String() string {
+func (x *DeleteFormulaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBaseRequest) ProtoMessage() {}
+func (*DeleteFormulaResponse) ProtoMessage() {}
 
-func (x *CreateBaseRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteFormulaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1447,35 +1811,26 @@ func (x *CreateBaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBaseRequest.ProtoReflect.Descriptor instead.
-func (*CreateBaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteFormulaResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFormulaResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateBaseRequest) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-func (x *CreateBaseRequest) GetManufacturer() string {
-	if x != nil {
-		return x.Manufacturer
-	}
-	return ""
-}
-
-type CreateBaseResponse struct {
+// A base or colorant referenced by an imported formula. Ingredients are
+// matched to existing bases and colorants by manufacturer and label, ignoring
+// case; missing ones are created.
+type ImportIngredient struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Base *BaseMetadata `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Label        string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Manufacturer string `protobuf:"bytes,2,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Amount       string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *CreateBaseResponse) Reset() {
-	*x = CreateBaseResponse{}
+func (x *ImportIngredient) Reset() {
+	*x = ImportIngredient{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1483,13 +1838,13 @@ func (x *CreateBaseResponse) Reset() {
 	}
 }
 
-func (x *CreateBaseResponse) String() string {
+func (x *ImportIngredient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBaseResponse) ProtoMessage() {}
+func (*ImportIngredient) ProtoMessage() {}
 
-func (x *CreateBaseResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportIngredient) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1501,30 +1856,49 @@ func (x *CreateBaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBaseResponse.ProtoReflect.Descriptor instead.
-func (*CreateBaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportIngredient.ProtoReflect.Descriptor instead.
+func (*ImportIngredient) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreateBaseResponse) GetBase() *BaseMetadata {
+func (x *ImportIngredient) GetLabel() string {
 	if x != nil {
-		return x.Base
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-type UpdateBaseRequest struct {
+func (x *ImportIngredient) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *ImportIngredient) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// A single formula read from a legacy formula book.
+type ImportFormulaRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label        string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Manufacturer string `protobuf:"bytes,3,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	// Where the formula was found in the source file; used when reporting on it.
+	Row       uint32              `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Name      string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Number    string              `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
+	Notes     string              `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Bases     []*ImportIngredient `protobuf:"bytes,5,rep,name=bases,proto3" json:"bases,omitempty"`
+	Colorants []*ImportIngredient `protobuf:"bytes,6,rep,name=colorants,proto3" json:"colorants,omitempty"`
 }
 
-func (x *UpdateBaseRequest) Reset() {
-	*x = UpdateBaseRequest{}
+func (x *ImportFormulaRecord) Reset() {
+	*x = ImportFormulaRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1532,13 +1906,13 @@ func (x *UpdateBaseRequest) Reset() {
 	}
 }
 
-func (x *UpdateBaseRequest) String() string {
+func (x *ImportFormulaRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBaseRequest) ProtoMessage() {}
+func (*ImportFormulaRecord) ProtoMessage() {}
 
-func (x *UpdateBaseRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportFormulaRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1550,42 +1924,66 @@ func (x *UpdateBaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBaseRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportFormulaRecord.ProtoReflect.Descriptor instead.
+func (*ImportFormulaRecord) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *UpdateBaseRequest) GetId() string {
+func (x *ImportFormulaRecord) GetRow() uint32 {
 	if x != nil {
-		return x.Id
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportFormulaRecord) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateBaseRequest) GetLabel() string {
+func (x *ImportFormulaRecord) GetNumber() string {
 	if x != nil {
-		return x.Label
+		return x.Number
 	}
 	return ""
 }
 
-func (x *UpdateBaseRequest) GetManufacturer() string {
+func (x *ImportFormulaRecord) GetNotes() string {
 	if x != nil {
-		return x.Manufacturer
+		return x.Notes
 	}
 	return ""
 }
 
-type UpdateBaseResponse struct {
+func (x *ImportFormulaRecord) GetBases() []*ImportIngredient {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+func (x *ImportFormulaRecord) GetColorants() []*ImportIngredient {
+	if x != nil {
+		return x.Colorants
+	}
+	return nil
+}
+
+type ImportFormulasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Base *BaseMetadata `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// Whether to only report what the import would do is only read from the
+	// first message in the stream.
+	DryRun bool                 `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Record *ImportFormulaRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
 }
 
-func (x *UpdateBaseResponse) Reset() {
-	*x = UpdateBaseResponse{}
+func (x *ImportFormulasRequest) Reset() {
+	*x = ImportFormulasRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1593,13 +1991,13 @@ func (x *UpdateBaseResponse) Reset() {
 	}
 }
 
-func (x *UpdateBaseResponse) String() string {
+func (x *ImportFormulasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBaseResponse) ProtoMessage() {}
+func (*ImportFormulasRequest) ProtoMessage() {}
 
-func (x *UpdateBaseResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportFormulasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1611,30 +2009,38 @@ func (x *UpdateBaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBaseResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportFormulasRequest.ProtoReflect.Descriptor instead.
+func (*ImportFormulasRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdateBaseResponse) GetBase() *BaseMetadata {
+func (x *ImportFormulasRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Base
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportFormulasRequest) GetRecord() *ImportFormulaRecord {
+	if x != nil {
+		return x.Record
 	}
 	return nil
 }
 
-type AssociateBaseWithFormulaRequest struct {
+// A formula which was not imported.
+type ImportSkippedRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formula string `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
-	Base    string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Row    uint32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Number string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *AssociateBaseWithFormulaRequest) Reset() {
-	*x = AssociateBaseWithFormulaRequest{}
+func (x *ImportSkippedRecord) Reset() {
+	*x = ImportSkippedRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1642,13 +2048,13 @@ func (x *AssociateBaseWithFormulaRequest) Reset() {
 	}
 }
 
-func (x *AssociateBaseWithFormulaRequest) String() string {
+func (x *ImportSkippedRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssociateBaseWithFormulaRequest) ProtoMessage() {}
+func (*ImportSkippedRecord) ProtoMessage() {}
 
-func (x *AssociateBaseWithFormulaRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportSkippedRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1660,40 +2066,47 @@ func (x *AssociateBaseWithFormulaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssociateBaseWithFormulaRequest.ProtoReflect.Descriptor instead.
-func (*AssociateBaseWithFormulaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportSkippedRecord.ProtoReflect.Descriptor instead.
+func (*ImportSkippedRecord) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *AssociateBaseWithFormulaRequest) GetFormula() string {
+func (x *ImportSkippedRecord) GetRow() uint32 {
 	if x != nil {
-		return x.Formula
+		return x.Row
 	}
-	return ""
+	return 0
 }
 
-func (x *AssociateBaseWithFormulaRequest) GetBase() string {
+func (x *ImportSkippedRecord) GetNumber() string {
 	if x != nil {
-		return x.Base
+		return x.Number
 	}
 	return ""
 }
 
-func (x *AssociateBaseWithFormulaRequest) GetAmount() string {
+func (x *ImportSkippedRecord) GetReason() string {
 	if x != nil {
-		return x.Amount
+		return x.Reason
 	}
 	return ""
 }
 
-type AssociateBaseWithFormulaResponse struct {
+type ImportFormulasResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	FormulasCreated  []*FormulaMetadata     `protobuf:"bytes,1,rep,name=formulas_created,json=formulasCreated,proto3" json:"formulas_created,omitempty"`
+	BasesCreated     []*BaseMetadata        `protobuf:"bytes,2,rep,name=bases_created,json=basesCreated,proto3" json:"bases_created,omitempty"`
+	ColorantsCreated []*ColorantMetadata    `protobuf:"bytes,3,rep,name=colorants_created,json=colorantsCreated,proto3" json:"colorants_created,omitempty"`
+	Skipped          []*ImportSkippedRecord `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	// False for a dry run; nothing was saved.
+	Committed bool `protobuf:"varint,5,opt,name=committed,proto3" json:"committed,omitempty"`
 }
 
-func (x *AssociateBaseWithFormulaResponse) Reset() {
-	*x = AssociateBaseWithFormulaResponse{}
+func (x *ImportFormulasResponse) Reset() {
+	*x = ImportFormulasResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1701,13 +2114,13 @@ func (x *AssociateBaseWithFormulaResponse) Reset() {
 	}
 }
 
-func (x *AssociateBaseWithFormulaResponse) String() string {
+func (x *ImportFormulasResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssociateBaseWithFormulaResponse) ProtoMessage() {}
+func (*ImportFormulasResponse) ProtoMessage() {}
 
-func (x *AssociateBaseWithFormulaResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportFormulasResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1719,22 +2132,61 @@ func (x *AssociateBaseWithFormulaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssociateBaseWithFormulaResponse.ProtoReflect.Descriptor instead.
-func (*AssociateBaseWithFormulaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportFormulasResponse.ProtoReflect.Descriptor instead.
+func (*ImportFormulasResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{33}
 }
 
-type DisassociateBaseFromFormulaRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *ImportFormulasResponse) GetFormulasCreated() []*FormulaMetadata {
+	if x != nil {
+		return x.FormulasCreated
+	}
+	return nil
+}
+
+func (x *ImportFormulasResponse) GetBasesCreated() []*BaseMetadata {
+	if x != nil {
+		return x.BasesCreated
+	}
+	return nil
+}
+
+func (x *ImportFormulasResponse) GetColorantsCreated() []*ColorantMetadata {
+	if x != nil {
+		return x.ColorantsCreated
+	}
+	return nil
+}
+
+func (x *ImportFormulasResponse) GetSkipped() []*ImportSkippedRecord {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *ImportFormulasResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+// Formula amounts are kept for a gallon; an export scales them to the
+// container given, ex. "1 qt" or "5 gal". A gallon is used if left empty.
+type ExportFormulaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formula string `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
-	Base    string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The dispenser file format to write; ex. "fluidmanagement" or "corob".
+	Format    string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Container string `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
 }
 
-func (x *DisassociateBaseFromFormulaRequest) Reset() {
-	*x = DisassociateBaseFromFormulaRequest{}
+func (x *ExportFormulaRequest) Reset() {
+	*x = ExportFormulaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1742,13 +2194,13 @@ func (x *DisassociateBaseFromFormulaRequest) Reset() {
 	}
 }
 
-func (x *DisassociateBaseFromFormulaRequest) String() string {
+func (x *ExportFormulaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisassociateBaseFromFormulaRequest) ProtoMessage() {}
+func (*ExportFormulaRequest) ProtoMessage() {}
 
-func (x *DisassociateBaseFromFormulaRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportFormulaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1760,33 +2212,45 @@ func (x *DisassociateBaseFromFormulaRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisassociateBaseFromFormulaRequest.ProtoReflect.Descriptor instead.
-func (*DisassociateBaseFromFormulaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportFormulaRequest.ProtoReflect.Descriptor instead.
+func (*ExportFormulaRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DisassociateBaseFromFormulaRequest) GetFormula() string {
+func (x *ExportFormulaRequest) GetId() string {
 	if x != nil {
-		return x.Formula
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DisassociateBaseFromFormulaRequest) GetBase() string {
+func (x *ExportFormulaRequest) GetFormat() string {
 	if x != nil {
-		return x.Base
+		return x.Format
 	}
 	return ""
 }
 
-type DisassociateBaseFromFormulaResponse struct {
+func (x *ExportFormulaRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+type ExportFormulaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The formula file, ready to be loaded by the dispenser.
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	// A suggested name to save the file under.
+	FileName string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 }
 
-func (x *DisassociateBaseFromFormulaResponse) Reset() {
-	*x = DisassociateBaseFromFormulaResponse{}
+func (x *ExportFormulaResponse) Reset() {
+	*x = ExportFormulaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1794,13 +2258,13 @@ func (x *DisassociateBaseFromFormulaResponse) Reset() {
 	}
 }
 
-func (x *DisassociateBaseFromFormulaResponse) String() string {
+func (x *ExportFormulaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisassociateBaseFromFormulaResponse) ProtoMessage() {}
+func (*ExportFormulaResponse) ProtoMessage() {}
 
-func (x *DisassociateBaseFromFormulaResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportFormulaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1812,21 +2276,46 @@ func (x *DisassociateBaseFromFormulaResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisassociateBaseFromFormulaResponse.ProtoReflect.Descriptor instead.
-func (*DisassociateBaseFromFormulaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportFormulaResponse.ProtoReflect.Descriptor instead.
+func (*ExportFormulaResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{35}
 }
 
-type DeleteBaseRequest struct {
+func (x *ExportFormulaResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportFormulaResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type RenderLabelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *DeleteBaseRequest) Reset() {
-	*x = DeleteBaseRequest{}
+	Id     string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format LabelFormat `protobuf:"varint,2,opt,name=format,proto3,enum=proto.LabelFormat" json:"format,omitempty"`
+	// The job the paint was mixed for. May be left empty if the formula belongs
+	// to a single job.
+	Job string `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
+	// The size of the can; colorant amounts are scaled from a gallon to it. A
+	// gallon is used if left empty.
+	Container string `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
+	// The mix the can was filled by. Its code is printed on the label in place
+	// of the formula's, and its job and container are used in place of those
+	// above.
+	Mix string `protobuf:"bytes,5,opt,name=mix,proto3" json:"mix,omitempty"`
+}
+
+func (x *RenderLabelRequest) Reset() {
+	*x = RenderLabelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1834,13 +2323,13 @@ func (x *DeleteBaseRequest) Reset() {
 	}
 }
 
-func (x *DeleteBaseRequest) String() string {
+func (x *RenderLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBaseRequest) ProtoMessage() {}
+func (*RenderLabelRequest) ProtoMessage() {}
 
-func (x *DeleteBaseRequest) ProtoReflect() protoreflect.Message {
+func (x *RenderLabelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1852,26 +2341,59 @@ func (x *DeleteBaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBaseRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenderLabelRequest.ProtoReflect.Descriptor instead.
+func (*RenderLabelRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeleteBaseRequest) GetId() string {
+func (x *RenderLabelRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteBaseResponse struct {
+func (x *RenderLabelRequest) GetFormat() LabelFormat {
+	if x != nil {
+		return x.Format
+	}
+	return LabelFormat_PDF
+}
+
+func (x *RenderLabelRequest) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *RenderLabelRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *RenderLabelRequest) GetMix() string {
+	if x != nil {
+		return x.Mix
+	}
+	return ""
+}
+
+type RenderLabelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Content     []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// A suggested name to save the label under.
+	FileName string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
 }
 
-func (x *DeleteBaseResponse) Reset() {
-	*x = DeleteBaseResponse{}
+func (x *RenderLabelResponse) Reset() {
+	*x = RenderLabelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1879,13 +2401,13 @@ func (x *DeleteBaseResponse) Reset() {
 	}
 }
 
-func (x *DeleteBaseResponse) String() string {
+func (x *RenderLabelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBaseResponse) ProtoMessage() {}
+func (*RenderLabelResponse) ProtoMessage() {}
 
-func (x *DeleteBaseResponse) ProtoReflect() protoreflect.Message {
+func (x *RenderLabelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1897,22 +2419,45 @@ func (x *DeleteBaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBaseResponse.ProtoReflect.Descriptor instead.
-func (*DeleteBaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenderLabelResponse.ProtoReflect.Descriptor instead.
+func (*RenderLabelResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{37}
 }
 
-// Colorant transport messages
-type GetColorantRequest struct {
+func (x *RenderLabelResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *RenderLabelResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RenderLabelResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+// Returns the bases and colorants of a formula with their names and amounts
+// scaled to the container given, ex. "1 qt". A gallon is used if left empty.
+type GetFormulaRecipeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Container string `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
 }
 
-func (x *GetColorantRequest) Reset() {
-	*x = GetColorantRequest{}
+func (x *GetFormulaRecipeRequest) Reset() {
+	*x = GetFormulaRecipeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1920,13 +2465,13 @@ func (x *GetColorantRequest) Reset() {
 	}
 }
 
-func (x *GetColorantRequest) String() string {
+func (x *GetFormulaRecipeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetColorantRequest) ProtoMessage() {}
+func (*GetFormulaRecipeRequest) ProtoMessage() {}
 
-func (x *GetColorantRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFormulaRecipeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1938,28 +2483,42 @@ func (x *GetColorantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetColorantRequest.ProtoReflect.Descriptor instead.
-func (*GetColorantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFormulaRecipeRequest.ProtoReflect.Descriptor instead.
+func (*GetFormulaRecipeRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetColorantRequest) GetId() string {
+func (x *GetFormulaRecipeRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetColorantResponse struct {
+func (x *GetFormulaRecipeRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+// A single base or colorant of a formula's recipe.
+type RecipeIngredient struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Colorant *Colorant `protobuf:"bytes,1,opt,name=colorant,proto3" json:"colorant,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The base or colorant's label.
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Manufacturer string `protobuf:"bytes,3,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	// The amount for the container; amounts which can't be read are returned as
+	// they are.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *GetColorantResponse) Reset() {
-	*x = GetColorantResponse{}
+func (x *RecipeIngredient) Reset() {
+	*x = RecipeIngredient{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1967,13 +2526,13 @@ func (x *GetColorantResponse) Reset() {
 	}
 }
 
-func (x *GetColorantResponse) String() string {
+func (x *RecipeIngredient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetColorantResponse) ProtoMessage() {}
+func (*RecipeIngredient) ProtoMessage() {}
 
-func (x *GetColorantResponse) ProtoReflect() protoreflect.Message {
+func (x *RecipeIngredient) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1985,26 +2544,52 @@ func (x *GetColorantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetColorantResponse.ProtoReflect.Descriptor instead.
-func (*GetColorantResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecipeIngredient.ProtoReflect.Descriptor instead.
+func (*RecipeIngredient) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetColorantResponse) GetColorant() *Colorant {
+func (x *RecipeIngredient) GetId() string {
 	if x != nil {
-		return x.Colorant
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListColorantsRequest struct {
+func (x *RecipeIngredient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeIngredient) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *RecipeIngredient) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GetFormulaRecipeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The container the amounts are for, ex. "1 qt".
+	Container string              `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Bases     []*RecipeIngredient `protobuf:"bytes,2,rep,name=bases,proto3" json:"bases,omitempty"`
+	Colorants []*RecipeIngredient `protobuf:"bytes,3,rep,name=colorants,proto3" json:"colorants,omitempty"`
 }
 
-func (x *ListColorantsRequest) Reset() {
-	*x = ListColorantsRequest{}
+func (x *GetFormulaRecipeResponse) Reset() {
+	*x = GetFormulaRecipeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2012,13 +2597,13 @@ func (x *ListColorantsRequest) Reset() {
 	}
 }
 
-func (x *ListColorantsRequest) String() string {
+func (x *GetFormulaRecipeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListColorantsRequest) ProtoMessage() {}
+func (*GetFormulaRecipeResponse) ProtoMessage() {}
 
-func (x *ListColorantsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFormulaRecipeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2030,21 +2615,49 @@ func (x *ListColorantsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListColorantsRequest.ProtoReflect.Descriptor instead.
-func (*ListColorantsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFormulaRecipeResponse.ProtoReflect.Descriptor instead.
+func (*GetFormulaRecipeResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{40}
 }
 
-type ListColorantsResponse struct {
+func (x *GetFormulaRecipeResponse) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *GetFormulaRecipeResponse) GetBases() []*RecipeIngredient {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+func (x *GetFormulaRecipeResponse) GetColorants() []*RecipeIngredient {
+	if x != nil {
+		return x.Colorants
+	}
+	return nil
+}
+
+type RecordMixRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Colorants []*ColorantMetadata `protobuf:"bytes,1,rep,name=colorants,proto3" json:"colorants,omitempty"`
+	Formula string `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
+	// The job the paint was mixed for; may be left empty.
+	Job string `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	// The size of each can; a gallon is used if left empty.
+	Container string `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
+	// How many cans were mixed; one if left empty.
+	Quantity int64  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Notes    string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
 }
 
-func (x *ListColorantsResponse) Reset() {
-	*x = ListColorantsResponse{}
+func (x *RecordMixRequest) Reset() {
+	*x = RecordMixRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2052,13 +2665,13 @@ func (x *ListColorantsResponse) Reset() {
 	}
 }
 
-func (x *ListColorantsResponse) String() string {
+func (x *RecordMixRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListColorantsResponse) ProtoMessage() {}
+func (*RecordMixRequest) ProtoMessage() {}
 
-func (x *ListColorantsResponse) ProtoReflect() protoreflect.Message {
+func (x *RecordMixRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2070,29 +2683,56 @@ func (x *ListColorantsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListColorantsResponse.ProtoReflect.Descriptor instead.
-func (*ListColorantsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecordMixRequest.ProtoReflect.Descriptor instead.
+func (*RecordMixRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListColorantsResponse) GetColorants() []*ColorantMetadata {
+func (x *RecordMixRequest) GetFormula() string {
 	if x != nil {
-		return x.Colorants
+		return x.Formula
 	}
-	return nil
+	return ""
 }
 
-type CreateColorantRequest struct {
+func (x *RecordMixRequest) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *RecordMixRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *RecordMixRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RecordMixRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type RecordMixResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Label        string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Manufacturer string `protobuf:"bytes,2,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Mix *Mix `protobuf:"bytes,1,opt,name=mix,proto3" json:"mix,omitempty"`
 }
 
-func (x *CreateColorantRequest) Reset() {
-	*x = CreateColorantRequest{}
+func (x *RecordMixResponse) Reset() {
+	*x = RecordMixResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2100,13 +2740,13 @@ func (x *CreateColorantRequest) Reset() {
 	}
 }
 
-func (x *CreateColorantRequest) String() string {
+func (x *RecordMixResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateColorantRequest) ProtoMessage() {}
+func (*RecordMixResponse) ProtoMessage() {}
 
-func (x *CreateColorantRequest) ProtoReflect() protoreflect.Message {
+func (x *RecordMixResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2118,35 +2758,28 @@ func (x *CreateColorantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateColorantRequest.ProtoReflect.Descriptor instead.
-func (*CreateColorantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecordMixResponse.ProtoReflect.Descriptor instead.
+func (*RecordMixResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CreateColorantRequest) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-func (x *CreateColorantRequest) GetManufacturer() string {
+func (x *RecordMixResponse) GetMix() *Mix {
 	if x != nil {
-		return x.Manufacturer
+		return x.Mix
 	}
-	return ""
+	return nil
 }
 
-type CreateColorantResponse struct {
+type GetMixRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Colorant *ColorantMetadata `protobuf:"bytes,1,opt,name=colorant,proto3" json:"colorant,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateColorantResponse) Reset() {
-	*x = CreateColorantResponse{}
+func (x *GetMixRequest) Reset() {
+	*x = GetMixRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2154,13 +2787,13 @@ func (x *CreateColorantResponse) Reset() {
 	}
 }
 
-func (x *CreateColorantResponse) String() string {
+func (x *GetMixRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateColorantResponse) ProtoMessage() {}
+func (*GetMixRequest) ProtoMessage() {}
 
-func (x *CreateColorantResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMixRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2172,30 +2805,28 @@ func (x *CreateColorantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateColorantResponse.ProtoReflect.Descriptor instead.
-func (*CreateColorantResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMixRequest.ProtoReflect.Descriptor instead.
+func (*GetMixRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CreateColorantResponse) GetColorant() *ColorantMetadata {
+func (x *GetMixRequest) GetId() string {
 	if x != nil {
-		return x.Colorant
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type UpdateColorantRequest struct {
+type GetMixResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Label        string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Manufacturer string `protobuf:"bytes,3,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Mix *Mix `protobuf:"bytes,1,opt,name=mix,proto3" json:"mix,omitempty"`
 }
 
-func (x *UpdateColorantRequest) Reset() {
-	*x = UpdateColorantRequest{}
+func (x *GetMixResponse) Reset() {
+	*x = GetMixResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2203,13 +2834,13 @@ func (x *UpdateColorantRequest) Reset() {
 	}
 }
 
-func (x *UpdateColorantRequest) String() string {
+func (x *GetMixResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateColorantRequest) ProtoMessage() {}
+func (*GetMixResponse) ProtoMessage() {}
 
-func (x *UpdateColorantRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMixResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2221,42 +2852,33 @@ func (x *UpdateColorantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateColorantRequest.ProtoReflect.Descriptor instead.
-func (*UpdateColorantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMixResponse.ProtoReflect.Descriptor instead.
+func (*GetMixResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UpdateColorantRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateColorantRequest) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-func (x *UpdateColorantRequest) GetManufacturer() string {
+func (x *GetMixResponse) GetMix() *Mix {
 	if x != nil {
-		return x.Manufacturer
+		return x.Mix
 	}
-	return ""
+	return nil
 }
 
-type UpdateColorantResponse struct {
+// Lists mixes most recent first; only those of the formula or job given if
+// either is set.
+type ListMixesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Colorant *ColorantMetadata `protobuf:"bytes,1,opt,name=colorant,proto3" json:"colorant,omitempty"`
+	Offset  int64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Formula string `protobuf:"bytes,3,opt,name=formula,proto3" json:"formula,omitempty"`
+	Job     string `protobuf:"bytes,4,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *UpdateColorantResponse) Reset() {
-	*x = UpdateColorantResponse{}
+func (x *ListMixesRequest) Reset() {
+	*x = ListMixesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2264,13 +2886,13 @@ func (x *UpdateColorantResponse) Reset() {
 	}
 }
 
-func (x *UpdateColorantResponse) String() string {
+func (x *ListMixesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateColorantResponse) ProtoMessage() {}
+func (*ListMixesRequest) ProtoMessage() {}
 
-func (x *UpdateColorantResponse) ProtoReflect() protoreflect.Message {
+func (x *ListMixesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2282,30 +2904,49 @@ func (x *UpdateColorantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateColorantResponse.ProtoReflect.Descriptor instead.
-func (*UpdateColorantResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMixesRequest.ProtoReflect.Descriptor instead.
+func (*ListMixesRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UpdateColorantResponse) GetColorant() *ColorantMetadata {
+func (x *ListMixesRequest) GetOffset() int64 {
 	if x != nil {
-		return x.Colorant
+		return x.Offset
 	}
-	return nil
+	return 0
 }
 
-type AssociateColorantWithFormulaRequest struct {
+func (x *ListMixesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMixesRequest) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+func (x *ListMixesRequest) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+type ListMixesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formula  string `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
-	Colorant string `protobuf:"bytes,2,opt,name=colorant,proto3" json:"colorant,omitempty"`
-	Amount   string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Mixes []*Mix `protobuf:"bytes,1,rep,name=mixes,proto3" json:"mixes,omitempty"`
 }
 
-func (x *AssociateColorantWithFormulaRequest) Reset() {
-	*x = AssociateColorantWithFormulaRequest{}
+func (x *ListMixesResponse) Reset() {
+	*x = ListMixesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2313,13 +2954,13 @@ func (x *AssociateColorantWithFormulaRequest) Reset() {
 	}
 }
 
-func (x *AssociateColorantWithFormulaRequest) String() string {
+func (x *ListMixesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssociateColorantWithFormulaRequest) ProtoMessage() {}
+func (*ListMixesResponse) ProtoMessage() {}
 
-func (x *AssociateColorantWithFormulaRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMixesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2331,40 +2972,31 @@ func (x *AssociateColorantWithFormulaRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssociateColorantWithFormulaRequest.ProtoReflect.Descriptor instead.
-func (*AssociateColorantWithFormulaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMixesResponse.ProtoReflect.Descriptor instead.
+func (*ListMixesResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *AssociateColorantWithFormulaRequest) GetFormula() string {
-	if x != nil {
-		return x.Formula
-	}
-	return ""
-}
-
-func (x *AssociateColorantWithFormulaRequest) GetColorant() string {
-	if x != nil {
-		return x.Colorant
-	}
-	return ""
-}
-
-func (x *AssociateColorantWithFormulaRequest) GetAmount() string {
+func (x *ListMixesResponse) GetMixes() []*Mix {
 	if x != nil {
-		return x.Amount
+		return x.Mixes
 	}
-	return ""
+	return nil
 }
 
-type AssociateColorantWithFormulaResponse struct {
+// Returns the entity's code, giving it one if it doesn't have one yet. Only
+// formulas, jobs and mixes have codes.
+type GetCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Kind EntityKind `protobuf:"varint,1,opt,name=kind,proto3,enum=proto.EntityKind" json:"kind,omitempty"`
+	Id   string     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *AssociateColorantWithFormulaResponse) Reset() {
-	*x = AssociateColorantWithFormulaResponse{}
+func (x *GetCodeRequest) Reset() {
+	*x = GetCodeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2372,13 +3004,13 @@ func (x *AssociateColorantWithFormulaResponse) Reset() {
 	}
 }
 
-func (x *AssociateColorantWithFormulaResponse) String() string {
+func (x *GetCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssociateColorantWithFormulaResponse) ProtoMessage() {}
+func (*GetCodeRequest) ProtoMessage() {}
 
-func (x *AssociateColorantWithFormulaResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2390,22 +3022,35 @@ func (x *AssociateColorantWithFormulaResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssociateColorantWithFormulaResponse.ProtoReflect.Descriptor instead.
-func (*AssociateColorantWithFormulaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetCodeRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{47}
 }
 
-type DisassociateColorantFromFormulaRequest struct {
+func (x *GetCodeRequest) GetKind() EntityKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EntityKind_ENTITY_KIND_UNKNOWN
+}
+
+func (x *GetCodeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formula  string `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
-	Colorant string `protobuf:"bytes,2,opt,name=colorant,proto3" json:"colorant,omitempty"`
+	Code *Code `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *DisassociateColorantFromFormulaRequest) Reset() {
-	*x = DisassociateColorantFromFormulaRequest{}
+func (x *GetCodeResponse) Reset() {
+	*x = GetCodeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2413,13 +3058,13 @@ func (x *DisassociateColorantFromFormulaRequest) Reset() {
 	}
 }
 
-func (x *DisassociateColorantFromFormulaRequest) String() string {
+func (x *GetCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisassociateColorantFromFormulaRequest) ProtoMessage() {}
+func (*GetCodeResponse) ProtoMessage() {}
 
-func (x *DisassociateColorantFromFormulaRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2431,33 +3076,29 @@ func (x *DisassociateColorantFromFormulaRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisassociateColorantFromFormulaRequest.ProtoReflect.Descriptor instead.
-func (*DisassociateColorantFromFormulaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetCodeResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *DisassociateColorantFromFormulaRequest) GetFormula() string {
-	if x != nil {
-		return x.Formula
-	}
-	return ""
-}
-
-func (x *DisassociateColorantFromFormulaRequest) GetColorant() string {
+func (x *GetCodeResponse) GetCode() *Code {
 	if x != nil {
-		return x.Colorant
+		return x.Code
 	}
-	return ""
+	return nil
 }
 
-type DisassociateColorantFromFormulaResponse struct {
+type ResolveCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Case, spaces and dashes are ignored.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (x *DisassociateColorantFromFormulaResponse) Reset() {
-	*x = DisassociateColorantFromFormulaResponse{}
+func (x *ResolveCodeRequest) Reset() {
+	*x = ResolveCodeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2465,13 +3106,13 @@ func (x *DisassociateColorantFromFormulaResponse) Reset() {
 	}
 }
 
-func (x *DisassociateColorantFromFormulaResponse) String() string {
+func (x *ResolveCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisassociateColorantFromFormulaResponse) ProtoMessage() {}
+func (*ResolveCodeRequest) ProtoMessage() {}
 
-func (x *DisassociateColorantFromFormulaResponse) ProtoReflect() protoreflect.Message {
+func (x *ResolveCodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2483,21 +3124,33 @@ func (x *DisassociateColorantFromFormulaResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisassociateColorantFromFormulaResponse.ProtoReflect.Descriptor instead.
-func (*DisassociateColorantFromFormulaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveCodeRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCodeRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{49}
 }
 
-type DeleteColorantRequest struct {
+func (x *ResolveCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ResolveCodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code *Code `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Types that are assignable to Entity:
+	//	*ResolveCodeResponse_Formula
+	//	*ResolveCodeResponse_Job
+	//	*ResolveCodeResponse_Mix
+	Entity isResolveCodeResponse_Entity `protobuf_oneof:"entity"`
 }
 
-func (x *DeleteColorantRequest) Reset() {
-	*x = DeleteColorantRequest{}
+func (x *ResolveCodeResponse) Reset() {
+	*x = ResolveCodeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2505,13 +3158,13 @@ func (x *DeleteColorantRequest) Reset() {
 	}
 }
 
-func (x *DeleteColorantRequest) String() string {
+func (x *ResolveCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteColorantRequest) ProtoMessage() {}
+func (*ResolveCodeResponse) ProtoMessage() {}
 
-func (x *DeleteColorantRequest) ProtoReflect() protoreflect.Message {
+func (x *ResolveCodeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2523,57 +3176,70 @@ func (x *DeleteColorantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteColorantRequest.ProtoReflect.Descriptor instead.
-func (*DeleteColorantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveCodeResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCodeResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DeleteColorantRequest) GetId() string {
+func (x *ResolveCodeResponse) GetCode() *Code {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
-	return ""
+	return nil
 }
 
-type DeleteColorantResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *ResolveCodeResponse) GetEntity() isResolveCodeResponse_Entity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
 }
 
-func (x *DeleteColorantResponse) Reset() {
-	*x = DeleteColorantResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[51]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ResolveCodeResponse) GetFormula() *Formula {
+	if x, ok := x.GetEntity().(*ResolveCodeResponse_Formula); ok {
+		return x.Formula
 	}
+	return nil
 }
 
-func (x *DeleteColorantResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ResolveCodeResponse) GetJob() *Job {
+	if x, ok := x.GetEntity().(*ResolveCodeResponse_Job); ok {
+		return x.Job
+	}
+	return nil
 }
 
-func (*DeleteColorantResponse) ProtoMessage() {}
-
-func (x *DeleteColorantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_basecoat_transport_proto_msgTypes[51]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ResolveCodeResponse) GetMix() *Mix {
+	if x, ok := x.GetEntity().(*ResolveCodeResponse_Mix); ok {
+		return x.Mix
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DeleteColorantResponse.ProtoReflect.Descriptor instead.
-func (*DeleteColorantResponse) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{51}
+type isResolveCodeResponse_Entity interface {
+	isResolveCodeResponse_Entity()
 }
 
-type GetJobRequest struct {
+type ResolveCodeResponse_Formula struct {
+	Formula *Formula `protobuf:"bytes,2,opt,name=formula,proto3,oneof"`
+}
+
+type ResolveCodeResponse_Job struct {
+	Job *Job `protobuf:"bytes,3,opt,name=job,proto3,oneof"`
+}
+
+type ResolveCodeResponse_Mix struct {
+	Mix *Mix `protobuf:"bytes,4,opt,name=mix,proto3,oneof"`
+}
+
+func (*ResolveCodeResponse_Formula) isResolveCodeResponse_Entity() {}
+
+func (*ResolveCodeResponse_Job) isResolveCodeResponse_Entity() {}
+
+func (*ResolveCodeResponse_Mix) isResolveCodeResponse_Entity() {}
+
+// Base transport messages
+type GetBaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2581,23 +3247,23 @@ type GetJobRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetJobRequest) Reset() {
-	*x = GetJobRequest{}
+func (x *GetBaseRequest) Reset() {
+	*x = GetBaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[52]
+		mi := &file_basecoat_transport_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetJobRequest) String() string {
+func (x *GetBaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobRequest) ProtoMessage() {}
+func (*GetBaseRequest) ProtoMessage() {}
 
-func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_basecoat_transport_proto_msgTypes[52]
+func (x *GetBaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2608,43 +3274,43 @@ func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
-func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetBaseRequest.ProtoReflect.Descriptor instead.
+func (*GetBaseRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetJobRequest) GetId() string {
+func (x *GetBaseRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetJobResponse struct {
+type GetBaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Base *Base `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 }
 
-func (x *GetJobResponse) Reset() {
-	*x = GetJobResponse{}
+func (x *GetBaseResponse) Reset() {
+	*x = GetBaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[53]
+		mi := &file_basecoat_transport_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetJobResponse) String() string {
+func (x *GetBaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetJobResponse) ProtoMessage() {}
+func (*GetBaseResponse) ProtoMessage() {}
 
-func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_basecoat_transport_proto_msgTypes[53]
+func (x *GetBaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2655,49 +3321,41 @@ func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
-func (*GetJobResponse) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use GetBaseResponse.ProtoReflect.Descriptor instead.
+func (*GetBaseResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetJobResponse) GetJob() *Job {
+func (x *GetBaseResponse) GetBase() *Base {
 	if x != nil {
-		return x.Job
+		return x.Base
 	}
 	return nil
 }
 
-type ListJobsRequest struct {
+type ListBasesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// offset is a pagination parameter that defines where to start when counting
-	// the list of objects to return.
-	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	// limit is a pagination parameter that defines how many objects to return
-	// per result.
-	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"` // Allows you to fuzzy search
 }
 
-func (x *ListJobsRequest) Reset() {
-	*x = ListJobsRequest{}
+func (x *ListBasesRequest) Reset() {
+	*x = ListBasesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[54]
+		mi := &file_basecoat_transport_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListJobsRequest) String() string {
+func (x *ListBasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobsRequest) ProtoMessage() {}
+func (*ListBasesRequest) ProtoMessage() {}
 
-func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_basecoat_transport_proto_msgTypes[54]
+func (x *ListBasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2708,57 +3366,36 @@ func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
-func (*ListJobsRequest) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *ListJobsRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
-
-func (x *ListJobsRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *ListJobsRequest) GetFilter() string {
-	if x != nil {
-		return x.Filter
-	}
-	return ""
+// Deprecated: Use ListBasesRequest.ProtoReflect.Descriptor instead.
+func (*ListBasesRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{53}
 }
 
-type ListJobsResponse struct {
+type ListBasesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Bases []*BaseMetadata `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
 }
 
-func (x *ListJobsResponse) Reset() {
-	*x = ListJobsResponse{}
+func (x *ListBasesResponse) Reset() {
+	*x = ListBasesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[55]
+		mi := &file_basecoat_transport_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListJobsResponse) String() string {
+func (x *ListBasesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobsResponse) ProtoMessage() {}
+func (*ListBasesResponse) ProtoMessage() {}
 
-func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_basecoat_transport_proto_msgTypes[55]
+func (x *ListBasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2769,47 +3406,44 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
-func (*ListJobsResponse) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ListBasesResponse.ProtoReflect.Descriptor instead.
+func (*ListBasesResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ListJobsResponse) GetJobs() []*Job {
+func (x *ListBasesResponse) GetBases() []*BaseMetadata {
 	if x != nil {
-		return x.Jobs
+		return x.Bases
 	}
 	return nil
 }
 
-type CreateJobRequest struct {
+type CreateBaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address      *Address `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Notes        string   `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
-	ContractorId string   `protobuf:"bytes,4,opt,name=contractor_id,json=contractorId,proto3" json:"contractor_id,omitempty"`
-	ContactId    *string  `protobuf:"bytes,5,opt,name=contact_id,json=contactId,proto3,oneof" json:"contact_id,omitempty"`
+	Label        string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Manufacturer string `protobuf:"bytes,2,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
 }
 
-func (x *CreateJobRequest) Reset() {
-	*x = CreateJobRequest{}
+func (x *CreateBaseRequest) Reset() {
+	*x = CreateBaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[56]
+		mi := &file_basecoat_transport_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateJobRequest) String() string {
+func (x *CreateBaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJobRequest) ProtoMessage() {}
+func (*CreateBaseRequest) ProtoMessage() {}
 
-func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_basecoat_transport_proto_msgTypes[56]
+func (x *CreateBaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2820,71 +3454,50 @@ func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJobRequest.ProtoReflect.Descriptor instead.
-func (*CreateJobRequest) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{56}
-}
-
-func (x *CreateJobRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateJobRequest) GetAddress() *Address {
-	if x != nil {
-		return x.Address
-	}
-	return nil
+// Deprecated: Use CreateBaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateBaseRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateJobRequest) GetNotes() string {
+func (x *CreateBaseRequest) GetLabel() string {
 	if x != nil {
-		return x.Notes
+		return x.Label
 	}
 	return ""
 }
 
-func (x *CreateJobRequest) GetContractorId() string {
+func (x *CreateBaseRequest) GetManufacturer() string {
 	if x != nil {
-		return x.ContractorId
-	}
-	return ""
-}
-
-func (x *CreateJobRequest) GetContactId() string {
-	if x != nil && x.ContactId != nil {
-		return *x.ContactId
+		return x.Manufacturer
 	}
 	return ""
 }
 
-type CreateJobResponse struct {
+type CreateBaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Base *BaseMetadata `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 }
 
-func (x *CreateJobResponse) Reset() {
-	*x = CreateJobResponse{}
+func (x *CreateBaseResponse) Reset() {
+	*x = CreateBaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[57]
+		mi := &file_basecoat_transport_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateJobResponse) String() string {
+func (x *CreateBaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJobResponse) ProtoMessage() {}
+func (*CreateBaseResponse) ProtoMessage() {}
 
-func (x *CreateJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_basecoat_transport_proto_msgTypes[57]
+func (x *CreateBaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2895,47 +3508,48 @@ func (x *CreateJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJobResponse.ProtoReflect.Descriptor instead.
-func (*CreateJobResponse) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use CreateBaseResponse.ProtoReflect.Descriptor instead.
+func (*CreateBaseResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateJobResponse) GetJob() *Job {
+func (x *CreateBaseResponse) GetBase() *BaseMetadata {
 	if x != nil {
-		return x.Job
+		return x.Base
 	}
 	return nil
 }
 
-type UpdateJobRequest struct {
+// Only the fields which are set are changed. Version must match the base's
+// current version or the update is rejected with ABORTED.
+type UpdateBaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      *string  `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Address   *Address `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	Notes     *string  `protobuf:"bytes,4,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	ContactId *string  `protobuf:"bytes,5,opt,name=contact_id,json=contactId,proto3,oneof" json:"contact_id,omitempty"`
+	Id           string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label        *string `protobuf:"bytes,2,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	Manufacturer *string `protobuf:"bytes,3,opt,name=manufacturer,proto3,oneof" json:"manufacturer,omitempty"`
+	Version      int64   `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *UpdateJobRequest) Reset() {
-	*x = UpdateJobRequest{}
+func (x *UpdateBaseRequest) Reset() {
+	*x = UpdateBaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[58]
+		mi := &file_basecoat_transport_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateJobRequest) String() string {
+func (x *UpdateBaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobRequest) ProtoMessage() {}
+func (*UpdateBaseRequest) ProtoMessage() {}
 
-func (x *UpdateJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_basecoat_transport_proto_msgTypes[58]
+func (x *UpdateBaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2946,71 +3560,64 @@ func (x *UpdateJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJobRequest) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use UpdateBaseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBaseRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UpdateJobRequest) GetId() string {
+func (x *UpdateBaseRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateJobRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *UpdateBaseRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
 	}
 	return ""
 }
 
-func (x *UpdateJobRequest) GetAddress() *Address {
-	if x != nil {
-		return x.Address
-	}
-	return nil
-}
-
-func (x *UpdateJobRequest) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
+func (x *UpdateBaseRequest) GetManufacturer() string {
+	if x != nil && x.Manufacturer != nil {
+		return *x.Manufacturer
 	}
 	return ""
 }
 
-func (x *UpdateJobRequest) GetContactId() string {
-	if x != nil && x.ContactId != nil {
-		return *x.ContactId
+func (x *UpdateBaseRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type UpdateJobResponse struct {
+type UpdateBaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Base *BaseMetadata `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 }
 
-func (x *UpdateJobResponse) Reset() {
-	*x = UpdateJobResponse{}
+func (x *UpdateBaseResponse) Reset() {
+	*x = UpdateBaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[59]
+		mi := &file_basecoat_transport_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateJobResponse) String() string {
+func (x *UpdateBaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobResponse) ProtoMessage() {}
+func (*UpdateBaseResponse) ProtoMessage() {}
 
-func (x *UpdateJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_basecoat_transport_proto_msgTypes[59]
+func (x *UpdateBaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3021,28 +3628,103 @@ func (x *UpdateJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobResponse.ProtoReflect.Descriptor instead.
-func (*UpdateJobResponse) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use UpdateBaseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBaseResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UpdateJobResponse) GetJob() *Job {
+func (x *UpdateBaseResponse) GetBase() *BaseMetadata {
 	if x != nil {
-		return x.Job
+		return x.Base
 	}
 	return nil
 }
 
-type DeleteJobRequest struct {
+type AssociateBaseWithFormulaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Formula string `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
+	Base    string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// If set, must match the formula's current version or the change is
+	// rejected with ABORTED. Changing a formula's ingredients changes its
+	// version.
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *DeleteJobRequest) Reset() {
-	*x = DeleteJobRequest{}
+func (x *AssociateBaseWithFormulaRequest) Reset() {
+	*x = AssociateBaseWithFormulaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssociateBaseWithFormulaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssociateBaseWithFormulaRequest) ProtoMessage() {}
+
+func (x *AssociateBaseWithFormulaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssociateBaseWithFormulaRequest.ProtoReflect.Descriptor instead.
+func (*AssociateBaseWithFormulaRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AssociateBaseWithFormulaRequest) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+func (x *AssociateBaseWithFormulaRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *AssociateBaseWithFormulaRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AssociateBaseWithFormulaRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type AssociateBaseWithFormulaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The formula's version after the change.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *AssociateBaseWithFormulaResponse) Reset() {
+	*x = AssociateBaseWithFormulaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3050,13 +3732,13 @@ func (x *DeleteJobRequest) Reset() {
 	}
 }
 
-func (x *DeleteJobRequest) String() string {
+func (x *AssociateBaseWithFormulaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobRequest) ProtoMessage() {}
+func (*AssociateBaseWithFormulaResponse) ProtoMessage() {}
 
-func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+func (x *AssociateBaseWithFormulaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3068,26 +3750,33 @@ func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssociateBaseWithFormulaResponse.ProtoReflect.Descriptor instead.
+func (*AssociateBaseWithFormulaResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *DeleteJobRequest) GetId() string {
+func (x *AssociateBaseWithFormulaResponse) GetVersion() int64 {
 	if x != nil {
-		return x.Id
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type DeleteJobResponse struct {
+type DisassociateBaseFromFormulaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Formula string `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
+	Base    string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// If set, must match the formula's current version or the change is
+	// rejected with ABORTED. Changing a formula's ingredients changes its
+	// version.
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *DeleteJobResponse) Reset() {
-	*x = DeleteJobResponse{}
+func (x *DisassociateBaseFromFormulaRequest) Reset() {
+	*x = DisassociateBaseFromFormulaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3095,13 +3784,13 @@ func (x *DeleteJobResponse) Reset() {
 	}
 }
 
-func (x *DeleteJobResponse) String() string {
+func (x *DisassociateBaseFromFormulaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobResponse) ProtoMessage() {}
+func (*DisassociateBaseFromFormulaRequest) ProtoMessage() {}
 
-func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
+func (x *DisassociateBaseFromFormulaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3113,21 +3802,43 @@ func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
-func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisassociateBaseFromFormulaRequest.ProtoReflect.Descriptor instead.
+func (*DisassociateBaseFromFormulaRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{61}
 }
 
-type GetContractorRequest struct {
+func (x *DisassociateBaseFromFormulaRequest) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+func (x *DisassociateBaseFromFormulaRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *DisassociateBaseFromFormulaRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DisassociateBaseFromFormulaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The formula's version after the change.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *GetContractorRequest) Reset() {
-	*x = GetContractorRequest{}
+func (x *DisassociateBaseFromFormulaResponse) Reset() {
+	*x = DisassociateBaseFromFormulaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3135,13 +3846,13 @@ func (x *GetContractorRequest) Reset() {
 	}
 }
 
-func (x *GetContractorRequest) String() string {
+func (x *DisassociateBaseFromFormulaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContractorRequest) ProtoMessage() {}
+func (*DisassociateBaseFromFormulaResponse) ProtoMessage() {}
 
-func (x *GetContractorRequest) ProtoReflect() protoreflect.Message {
+func (x *DisassociateBaseFromFormulaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3153,28 +3864,32 @@ func (x *GetContractorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContractorRequest.ProtoReflect.Descriptor instead.
-func (*GetContractorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisassociateBaseFromFormulaResponse.ProtoReflect.Descriptor instead.
+func (*DisassociateBaseFromFormulaResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetContractorRequest) GetId() string {
+func (x *DisassociateBaseFromFormulaResponse) GetVersion() int64 {
 	if x != nil {
-		return x.Id
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type GetContractorResponse struct {
+type DeleteBaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contractor *Contractor `protobuf:"bytes,1,opt,name=contractor,proto3" json:"contractor,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Delete even if formulas still depend on the base.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Report what the delete would affect without deleting anything.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *GetContractorResponse) Reset() {
-	*x = GetContractorResponse{}
+func (x *DeleteBaseRequest) Reset() {
+	*x = DeleteBaseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3182,13 +3897,13 @@ func (x *GetContractorResponse) Reset() {
 	}
 }
 
-func (x *GetContractorResponse) String() string {
+func (x *DeleteBaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContractorResponse) ProtoMessage() {}
+func (*DeleteBaseRequest) ProtoMessage() {}
 
-func (x *GetContractorResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteBaseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3200,26 +3915,43 @@ func (x *GetContractorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContractorResponse.ProtoReflect.Descriptor instead.
-func (*GetContractorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBaseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBaseRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetContractorResponse) GetContractor() *Contractor {
+func (x *DeleteBaseRequest) GetId() string {
 	if x != nil {
-		return x.Contractor
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ListContractorsRequest struct {
+func (x *DeleteBaseRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteBaseRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type DeleteBaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The formulas which depend on the base and lose it once it is deleted.
+	Formulas []*FormulaMetadata `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
 }
 
-func (x *ListContractorsRequest) Reset() {
-	*x = ListContractorsRequest{}
+func (x *DeleteBaseResponse) Reset() {
+	*x = DeleteBaseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3227,13 +3959,13 @@ func (x *ListContractorsRequest) Reset() {
 	}
 }
 
-func (x *ListContractorsRequest) String() string {
+func (x *DeleteBaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContractorsRequest) ProtoMessage() {}
+func (*DeleteBaseResponse) ProtoMessage() {}
 
-func (x *ListContractorsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteBaseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3245,21 +3977,29 @@ func (x *ListContractorsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContractorsRequest.ProtoReflect.Descriptor instead.
-func (*ListContractorsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBaseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBaseResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{64}
 }
 
-type ListContractorsResponse struct {
+func (x *DeleteBaseResponse) GetFormulas() []*FormulaMetadata {
+	if x != nil {
+		return x.Formulas
+	}
+	return nil
+}
+
+// Colorant transport messages
+type GetColorantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contractors []*Contractor `protobuf:"bytes,1,rep,name=contractors,proto3" json:"contractors,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ListContractorsResponse) Reset() {
-	*x = ListContractorsResponse{}
+func (x *GetColorantRequest) Reset() {
+	*x = GetColorantRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3267,13 +4007,13 @@ func (x *ListContractorsResponse) Reset() {
 	}
 }
 
-func (x *ListContractorsResponse) String() string {
+func (x *GetColorantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContractorsResponse) ProtoMessage() {}
+func (*GetColorantRequest) ProtoMessage() {}
 
-func (x *ListContractorsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetColorantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3285,29 +4025,28 @@ func (x *ListContractorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContractorsResponse.ProtoReflect.Descriptor instead.
-func (*ListContractorsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetColorantRequest.ProtoReflect.Descriptor instead.
+func (*GetColorantRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ListContractorsResponse) GetContractors() []*Contractor {
+func (x *GetColorantRequest) GetId() string {
 	if x != nil {
-		return x.Contractors
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type CreateContractorRequest struct {
+type GetColorantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Company string  `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
-	Contact *string `protobuf:"bytes,2,opt,name=contact,proto3,oneof" json:"contact,omitempty"`
+	Colorant *Colorant `protobuf:"bytes,1,opt,name=colorant,proto3" json:"colorant,omitempty"`
 }
 
-func (x *CreateContractorRequest) Reset() {
-	*x = CreateContractorRequest{}
+func (x *GetColorantResponse) Reset() {
+	*x = GetColorantResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3315,13 +4054,13 @@ func (x *CreateContractorRequest) Reset() {
 	}
 }
 
-func (x *CreateContractorRequest) String() string {
+func (x *GetColorantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContractorRequest) ProtoMessage() {}
+func (*GetColorantResponse) ProtoMessage() {}
 
-func (x *CreateContractorRequest) ProtoReflect() protoreflect.Message {
+func (x *GetColorantResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3333,35 +4072,26 @@ func (x *CreateContractorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContractorRequest.ProtoReflect.Descriptor instead.
-func (*CreateContractorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetColorantResponse.ProtoReflect.Descriptor instead.
+func (*GetColorantResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CreateContractorRequest) GetCompany() string {
+func (x *GetColorantResponse) GetColorant() *Colorant {
 	if x != nil {
-		return x.Company
+		return x.Colorant
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateContractorRequest) GetContact() string {
-	if x != nil && x.Contact != nil {
-		return *x.Contact
-	}
-	return ""
-}
-
-type CreateContractorResponse struct {
+type ListColorantsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Contractor *Contractor `protobuf:"bytes,1,opt,name=contractor,proto3" json:"contractor,omitempty"`
 }
 
-func (x *CreateContractorResponse) Reset() {
-	*x = CreateContractorResponse{}
+func (x *ListColorantsRequest) Reset() {
+	*x = ListColorantsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3369,13 +4099,13 @@ func (x *CreateContractorResponse) Reset() {
 	}
 }
 
-func (x *CreateContractorResponse) String() string {
+func (x *ListColorantsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContractorResponse) ProtoMessage() {}
+func (*ListColorantsRequest) ProtoMessage() {}
 
-func (x *CreateContractorResponse) ProtoReflect() protoreflect.Message {
+func (x *ListColorantsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3387,30 +4117,21 @@ func (x *CreateContractorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContractorResponse.ProtoReflect.Descriptor instead.
-func (*CreateContractorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListColorantsRequest.ProtoReflect.Descriptor instead.
+func (*ListColorantsRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CreateContractorResponse) GetContractor() *Contractor {
-	if x != nil {
-		return x.Contractor
-	}
-	return nil
-}
-
-type UpdateContractorRequest struct {
+type ListColorantsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Company *string `protobuf:"bytes,2,opt,name=company,proto3,oneof" json:"company,omitempty"`
-	Contact *string `protobuf:"bytes,3,opt,name=contact,proto3,oneof" json:"contact,omitempty"`
+	Colorants []*ColorantMetadata `protobuf:"bytes,1,rep,name=colorants,proto3" json:"colorants,omitempty"`
 }
 
-func (x *UpdateContractorRequest) Reset() {
-	*x = UpdateContractorRequest{}
+func (x *ListColorantsResponse) Reset() {
+	*x = ListColorantsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3418,13 +4139,13 @@ func (x *UpdateContractorRequest) Reset() {
 	}
 }
 
-func (x *UpdateContractorRequest) String() string {
+func (x *ListColorantsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateContractorRequest) ProtoMessage() {}
+func (*ListColorantsResponse) ProtoMessage() {}
 
-func (x *UpdateContractorRequest) ProtoReflect() protoreflect.Message {
+func (x *ListColorantsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3436,42 +4157,30 @@ func (x *UpdateContractorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateContractorRequest.ProtoReflect.Descriptor instead.
-func (*UpdateContractorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListColorantsResponse.ProtoReflect.Descriptor instead.
+func (*ListColorantsResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UpdateContractorRequest) GetId() string {
+func (x *ListColorantsResponse) GetColorants() []*ColorantMetadata {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateContractorRequest) GetCompany() string {
-	if x != nil && x.Company != nil {
-		return *x.Company
-	}
-	return ""
-}
-
-func (x *UpdateContractorRequest) GetContact() string {
-	if x != nil && x.Contact != nil {
-		return *x.Contact
+		return x.Colorants
 	}
-	return ""
+	return nil
 }
 
-type UpdateContractorResponse struct {
+type CreateColorantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contractor *Contractor `protobuf:"bytes,1,opt,name=contractor,proto3" json:"contractor,omitempty"`
+	Label         string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Manufacturer  string `protobuf:"bytes,2,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	DispenserCode string `protobuf:"bytes,3,opt,name=dispenser_code,json=dispenserCode,proto3" json:"dispenser_code,omitempty"`
 }
 
-func (x *UpdateContractorResponse) Reset() {
-	*x = UpdateContractorResponse{}
+func (x *CreateColorantRequest) Reset() {
+	*x = CreateColorantRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3479,13 +4188,13 @@ func (x *UpdateContractorResponse) Reset() {
 	}
 }
 
-func (x *UpdateContractorResponse) String() string {
+func (x *CreateColorantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateContractorResponse) ProtoMessage() {}
+func (*CreateColorantRequest) ProtoMessage() {}
 
-func (x *UpdateContractorResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateColorantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3497,28 +4206,42 @@ func (x *UpdateContractorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateContractorResponse.ProtoReflect.Descriptor instead.
-func (*UpdateContractorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateColorantRequest.ProtoReflect.Descriptor instead.
+func (*CreateColorantRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UpdateContractorResponse) GetContractor() *Contractor {
+func (x *CreateColorantRequest) GetLabel() string {
 	if x != nil {
-		return x.Contractor
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-type DeleteContractorRequest struct {
+func (x *CreateColorantRequest) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *CreateColorantRequest) GetDispenserCode() string {
+	if x != nil {
+		return x.DispenserCode
+	}
+	return ""
+}
+
+type CreateColorantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Colorant *ColorantMetadata `protobuf:"bytes,1,opt,name=colorant,proto3" json:"colorant,omitempty"`
 }
 
-func (x *DeleteContractorRequest) Reset() {
-	*x = DeleteContractorRequest{}
+func (x *CreateColorantResponse) Reset() {
+	*x = CreateColorantResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3526,13 +4249,13 @@ func (x *DeleteContractorRequest) Reset() {
 	}
 }
 
-func (x *DeleteContractorRequest) String() string {
+func (x *CreateColorantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteContractorRequest) ProtoMessage() {}
+func (*CreateColorantResponse) ProtoMessage() {}
 
-func (x *DeleteContractorRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateColorantResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3544,26 +4267,34 @@ func (x *DeleteContractorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteContractorRequest.ProtoReflect.Descriptor instead.
-func (*DeleteContractorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateColorantResponse.ProtoReflect.Descriptor instead.
+func (*CreateColorantResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeleteContractorRequest) GetId() string {
+func (x *CreateColorantResponse) GetColorant() *ColorantMetadata {
 	if x != nil {
-		return x.Id
+		return x.Colorant
 	}
-	return ""
+	return nil
 }
 
-type DeleteContractorResponse struct {
+// Only the fields which are set are changed. Version must match the
+// colorant's current version or the update is rejected with ABORTED.
+type UpdateColorantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label         *string `protobuf:"bytes,2,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	Manufacturer  *string `protobuf:"bytes,3,opt,name=manufacturer,proto3,oneof" json:"manufacturer,omitempty"`
+	Version       int64   `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	DispenserCode *string `protobuf:"bytes,5,opt,name=dispenser_code,json=dispenserCode,proto3,oneof" json:"dispenser_code,omitempty"`
 }
 
-func (x *DeleteContractorResponse) Reset() {
-	*x = DeleteContractorResponse{}
+func (x *UpdateColorantRequest) Reset() {
+	*x = UpdateColorantRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3571,13 +4302,13 @@ func (x *DeleteContractorResponse) Reset() {
 	}
 }
 
-func (x *DeleteContractorResponse) String() string {
+func (x *UpdateColorantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteContractorResponse) ProtoMessage() {}
+func (*UpdateColorantRequest) ProtoMessage() {}
 
-func (x *DeleteContractorResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateColorantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3589,21 +4320,56 @@ func (x *DeleteContractorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteContractorResponse.ProtoReflect.Descriptor instead.
-func (*DeleteContractorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateColorantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateColorantRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{71}
 }
 
-type GetContactRequest struct {
+func (x *UpdateColorantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateColorantRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *UpdateColorantRequest) GetManufacturer() string {
+	if x != nil && x.Manufacturer != nil {
+		return *x.Manufacturer
+	}
+	return ""
+}
+
+func (x *UpdateColorantRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateColorantRequest) GetDispenserCode() string {
+	if x != nil && x.DispenserCode != nil {
+		return *x.DispenserCode
+	}
+	return ""
+}
+
+type UpdateColorantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Colorant *ColorantMetadata `protobuf:"bytes,1,opt,name=colorant,proto3" json:"colorant,omitempty"`
 }
 
-func (x *GetContactRequest) Reset() {
-	*x = GetContactRequest{}
+func (x *UpdateColorantResponse) Reset() {
+	*x = UpdateColorantResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3611,13 +4377,13 @@ func (x *GetContactRequest) Reset() {
 	}
 }
 
-func (x *GetContactRequest) String() string {
+func (x *UpdateColorantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactRequest) ProtoMessage() {}
+func (*UpdateColorantResponse) ProtoMessage() {}
 
-func (x *GetContactRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateColorantResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3629,28 +4395,34 @@ func (x *GetContactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactRequest.ProtoReflect.Descriptor instead.
-func (*GetContactRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateColorantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateColorantResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetContactRequest) GetId() string {
+func (x *UpdateColorantResponse) GetColorant() *ColorantMetadata {
 	if x != nil {
-		return x.Id
+		return x.Colorant
 	}
-	return ""
+	return nil
 }
 
-type GetContactResponse struct {
+type AssociateColorantWithFormulaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contact *Contact `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	Formula  string `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
+	Colorant string `protobuf:"bytes,2,opt,name=colorant,proto3" json:"colorant,omitempty"`
+	Amount   string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// If set, must match the formula's current version or the change is
+	// rejected with ABORTED. Changing a formula's ingredients changes its
+	// version.
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *GetContactResponse) Reset() {
-	*x = GetContactResponse{}
+func (x *AssociateColorantWithFormulaRequest) Reset() {
+	*x = AssociateColorantWithFormulaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3658,13 +4430,13 @@ func (x *GetContactResponse) Reset() {
 	}
 }
 
-func (x *GetContactResponse) String() string {
+func (x *AssociateColorantWithFormulaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactResponse) ProtoMessage() {}
+func (*AssociateColorantWithFormulaRequest) ProtoMessage() {}
 
-func (x *GetContactResponse) ProtoReflect() protoreflect.Message {
+func (x *AssociateColorantWithFormulaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3676,26 +4448,50 @@ func (x *GetContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactResponse.ProtoReflect.Descriptor instead.
-func (*GetContactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssociateColorantWithFormulaRequest.ProtoReflect.Descriptor instead.
+func (*AssociateColorantWithFormulaRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetContactResponse) GetContact() *Contact {
+func (x *AssociateColorantWithFormulaRequest) GetFormula() string {
 	if x != nil {
-		return x.Contact
+		return x.Formula
 	}
-	return nil
+	return ""
 }
 
-type ListContactsRequest struct {
+func (x *AssociateColorantWithFormulaRequest) GetColorant() string {
+	if x != nil {
+		return x.Colorant
+	}
+	return ""
+}
+
+func (x *AssociateColorantWithFormulaRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AssociateColorantWithFormulaRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type AssociateColorantWithFormulaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The formula's version after the change.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *ListContactsRequest) Reset() {
-	*x = ListContactsRequest{}
+func (x *AssociateColorantWithFormulaResponse) Reset() {
+	*x = AssociateColorantWithFormulaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3703,13 +4499,13 @@ func (x *ListContactsRequest) Reset() {
 	}
 }
 
-func (x *ListContactsRequest) String() string {
+func (x *AssociateColorantWithFormulaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContactsRequest) ProtoMessage() {}
+func (*AssociateColorantWithFormulaResponse) ProtoMessage() {}
 
-func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
+func (x *AssociateColorantWithFormulaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3721,21 +4517,33 @@ func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
-func (*ListContactsRequest) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use AssociateColorantWithFormulaResponse.ProtoReflect.Descriptor instead.
+func (*AssociateColorantWithFormulaResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{74}
 }
 
-type ListContactsResponse struct {
+func (x *AssociateColorantWithFormulaResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DisassociateColorantFromFormulaRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contacts []*Contact `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	Formula  string `protobuf:"bytes,1,opt,name=formula,proto3" json:"formula,omitempty"`
+	Colorant string `protobuf:"bytes,2,opt,name=colorant,proto3" json:"colorant,omitempty"`
+	// If set, must match the formula's current version or the change is
+	// rejected with ABORTED. Changing a formula's ingredients changes its
+	// version.
+	Version int64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *ListContactsResponse) Reset() {
-	*x = ListContactsResponse{}
+func (x *DisassociateColorantFromFormulaRequest) Reset() {
+	*x = DisassociateColorantFromFormulaRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3743,13 +4551,13 @@ func (x *ListContactsResponse) Reset() {
 	}
 }
 
-func (x *ListContactsResponse) String() string {
+func (x *DisassociateColorantFromFormulaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContactsResponse) ProtoMessage() {}
+func (*DisassociateColorantFromFormulaRequest) ProtoMessage() {}
 
-func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
+func (x *DisassociateColorantFromFormulaRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3761,30 +4569,43 @@ func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
-func (*ListContactsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisassociateColorantFromFormulaRequest.ProtoReflect.Descriptor instead.
+func (*DisassociateColorantFromFormulaRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ListContactsResponse) GetContacts() []*Contact {
+func (x *DisassociateColorantFromFormulaRequest) GetFormula() string {
 	if x != nil {
-		return x.Contacts
+		return x.Formula
 	}
-	return nil
+	return ""
 }
 
-type CreateContactRequest struct {
+func (x *DisassociateColorantFromFormulaRequest) GetColorant() string {
+	if x != nil {
+		return x.Colorant
+	}
+	return ""
+}
+
+func (x *DisassociateColorantFromFormulaRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DisassociateColorantFromFormulaResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Phone string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	// The formula's version after the change.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *CreateContactRequest) Reset() {
-	*x = CreateContactRequest{}
+func (x *DisassociateColorantFromFormulaResponse) Reset() {
+	*x = DisassociateColorantFromFormulaResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3792,13 +4613,13 @@ func (x *CreateContactRequest) Reset() {
 	}
 }
 
-func (x *CreateContactRequest) String() string {
+func (x *DisassociateColorantFromFormulaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContactRequest) ProtoMessage() {}
+func (*DisassociateColorantFromFormulaResponse) ProtoMessage() {}
 
-func (x *CreateContactRequest) ProtoReflect() protoreflect.Message {
+func (x *DisassociateColorantFromFormulaResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3810,42 +4631,32 @@ func (x *CreateContactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContactRequest.ProtoReflect.Descriptor instead.
-func (*CreateContactRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisassociateColorantFromFormulaResponse.ProtoReflect.Descriptor instead.
+func (*DisassociateColorantFromFormulaResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *CreateContactRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateContactRequest) GetEmail() string {
+func (x *DisassociateColorantFromFormulaResponse) GetVersion() int64 {
 	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *CreateContactRequest) GetPhone() string {
-	if x != nil {
-		return x.Phone
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-type CreateContactResponse struct {
+type DeleteColorantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contact *Contact `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Delete even if formulas still depend on the colorant.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Report what the delete would affect without deleting anything.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *CreateContactResponse) Reset() {
-	*x = CreateContactResponse{}
+func (x *DeleteColorantRequest) Reset() {
+	*x = DeleteColorantRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3853,13 +4664,13 @@ func (x *CreateContactResponse) Reset() {
 	}
 }
 
-func (x *CreateContactResponse) String() string {
+func (x *DeleteColorantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateContactResponse) ProtoMessage() {}
+func (*DeleteColorantRequest) ProtoMessage() {}
 
-func (x *CreateContactResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteColorantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3871,31 +4682,43 @@ func (x *CreateContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateContactResponse.ProtoReflect.Descriptor instead.
-func (*CreateContactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteColorantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteColorantRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *CreateContactResponse) GetContact() *Contact {
+func (x *DeleteColorantRequest) GetId() string {
 	if x != nil {
-		return x.Contact
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type UpdateContactRequest struct {
+func (x *DeleteColorantRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteColorantRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type DeleteColorantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name  *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Email *string `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	Phone *string `protobuf:"bytes,4,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	// The formulas which depend on the colorant and lose it once it is deleted.
+	Formulas []*FormulaMetadata `protobuf:"bytes,1,rep,name=formulas,proto3" json:"formulas,omitempty"`
 }
 
-func (x *UpdateContactRequest) Reset() {
-	*x = UpdateContactRequest{}
+func (x *DeleteColorantResponse) Reset() {
+	*x = DeleteColorantResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3903,13 +4726,13 @@ func (x *UpdateContactRequest) Reset() {
 	}
 }
 
-func (x *UpdateContactRequest) String() string {
+func (x *DeleteColorantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateContactRequest) ProtoMessage() {}
+func (*DeleteColorantResponse) ProtoMessage() {}
 
-func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteColorantResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3921,49 +4744,28 @@ func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateContactRequest.ProtoReflect.Descriptor instead.
-func (*UpdateContactRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteColorantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteColorantResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *UpdateContactRequest) GetId() string {
+func (x *DeleteColorantResponse) GetFormulas() []*FormulaMetadata {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateContactRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateContactRequest) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
-	}
-	return ""
-}
-
-func (x *UpdateContactRequest) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
+		return x.Formulas
 	}
-	return ""
+	return nil
 }
 
-type UpdateContactResponse struct {
+type GetJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contact *Contact `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *UpdateContactResponse) Reset() {
-	*x = UpdateContactResponse{}
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3971,13 +4773,13 @@ func (x *UpdateContactResponse) Reset() {
 	}
 }
 
-func (x *UpdateContactResponse) String() string {
+func (x *GetJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateContactResponse) ProtoMessage() {}
+func (*GetJobRequest) ProtoMessage() {}
 
-func (x *UpdateContactResponse) ProtoReflect() protoreflect.Message {
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3989,28 +4791,28 @@ func (x *UpdateContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateContactResponse.ProtoReflect.Descriptor instead.
-func (*UpdateContactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *UpdateContactResponse) GetContact() *Contact {
+func (x *GetJobRequest) GetId() string {
 	if x != nil {
-		return x.Contact
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type DeleteContactRequest struct {
+type GetJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *DeleteContactRequest) Reset() {
-	*x = DeleteContactRequest{}
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4018,13 +4820,13 @@ func (x *DeleteContactRequest) Reset() {
 	}
 }
 
-func (x *DeleteContactRequest) String() string {
+func (x *GetJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteContactRequest) ProtoMessage() {}
+func (*GetJobResponse) ProtoMessage() {}
 
-func (x *DeleteContactRequest) ProtoReflect() protoreflect.Message {
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4036,26 +4838,34 @@ func (x *DeleteContactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteContactRequest.ProtoReflect.Descriptor instead.
-func (*DeleteContactRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *DeleteContactRequest) GetId() string {
+func (x *GetJobResponse) GetJob() *Job {
 	if x != nil {
-		return x.Id
+		return x.Job
 	}
-	return ""
+	return nil
 }
 
-type DeleteContactResponse struct {
+type ListJobsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// offset is a pagination parameter that defines where to start when counting
+	// the list of objects to return.
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit is a pagination parameter that defines how many objects to return
+	// per result.
+	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"` // Allows you to fuzzy search
 }
 
-func (x *DeleteContactResponse) Reset() {
-	*x = DeleteContactResponse{}
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4063,13 +4873,13 @@ func (x *DeleteContactResponse) Reset() {
 	}
 }
 
-func (x *DeleteContactResponse) String() string {
+func (x *ListJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteContactResponse) ProtoMessage() {}
+func (*ListJobsRequest) ProtoMessage() {}
 
-func (x *DeleteContactResponse) ProtoReflect() protoreflect.Message {
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4081,36 +4891,56 @@ func (x *DeleteContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteContactResponse.ProtoReflect.Descriptor instead.
-func (*DeleteContactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{81}
 }
 
-type AssociateFormulaWithJobRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Job     string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	Formula string `protobuf:"bytes,2,opt,name=formula,proto3" json:"formula,omitempty"`
-}
-
-func (x *AssociateFormulaWithJobRequest) Reset() {
-	*x = AssociateFormulaWithJobRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[82]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListJobsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
 	}
+	return 0
 }
 
-func (x *AssociateFormulaWithJobRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListJobsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (*AssociateFormulaWithJobRequest) ProtoMessage() {}
+func (x *ListJobsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
 
-func (x *AssociateFormulaWithJobRequest) ProtoReflect() protoreflect.Message {
+type ListJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4122,33 +4952,32 @@ func (x *AssociateFormulaWithJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssociateFormulaWithJobRequest.ProtoReflect.Descriptor instead.
-func (*AssociateFormulaWithJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *AssociateFormulaWithJobRequest) GetJob() string {
-	if x != nil {
-		return x.Job
-	}
-	return ""
-}
-
-func (x *AssociateFormulaWithJobRequest) GetFormula() string {
+func (x *ListJobsResponse) GetJobs() []*Job {
 	if x != nil {
-		return x.Formula
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-type AssociateFormulaWithJobResponse struct {
+type CreateJobRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address      *Address `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Notes        string   `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	ContractorId string   `protobuf:"bytes,4,opt,name=contractor_id,json=contractorId,proto3" json:"contractor_id,omitempty"`
+	ContactId    *string  `protobuf:"bytes,5,opt,name=contact_id,json=contactId,proto3,oneof" json:"contact_id,omitempty"`
 }
 
-func (x *AssociateFormulaWithJobResponse) Reset() {
-	*x = AssociateFormulaWithJobResponse{}
+func (x *CreateJobRequest) Reset() {
+	*x = CreateJobRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4156,13 +4985,13 @@ func (x *AssociateFormulaWithJobResponse) Reset() {
 	}
 }
 
-func (x *AssociateFormulaWithJobResponse) String() string {
+func (x *CreateJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssociateFormulaWithJobResponse) ProtoMessage() {}
+func (*CreateJobRequest) ProtoMessage() {}
 
-func (x *AssociateFormulaWithJobResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateJobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4174,22 +5003,56 @@ func (x *AssociateFormulaWithJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssociateFormulaWithJobResponse.ProtoReflect.Descriptor instead.
-func (*AssociateFormulaWithJobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateJobRequest.ProtoReflect.Descriptor instead.
+func (*CreateJobRequest) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{83}
 }
 
-type DisassociateFormulaFromJobRequest struct {
+func (x *CreateJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateJobRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetContractorId() string {
+	if x != nil {
+		return x.ContractorId
+	}
+	return ""
+}
+
+func (x *CreateJobRequest) GetContactId() string {
+	if x != nil && x.ContactId != nil {
+		return *x.ContactId
+	}
+	return ""
+}
+
+type CreateJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Job     string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	Formula string `protobuf:"bytes,2,opt,name=formula,proto3" json:"formula,omitempty"`
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *DisassociateFormulaFromJobRequest) Reset() {
-	*x = DisassociateFormulaFromJobRequest{}
+func (x *CreateJobResponse) Reset() {
+	*x = CreateJobResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_basecoat_transport_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4197,13 +5060,13 @@ func (x *DisassociateFormulaFromJobRequest) Reset() {
 	}
 }
 
-func (x *DisassociateFormulaFromJobRequest) String() string {
+func (x *CreateJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisassociateFormulaFromJobRequest) ProtoMessage() {}
+func (*CreateJobResponse) ProtoMessage() {}
 
-func (x *DisassociateFormulaFromJobRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateJobResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_basecoat_transport_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4215,48 +5078,132 @@ func (x *DisassociateFormulaFromJobRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisassociateFormulaFromJobRequest.ProtoReflect.Descriptor instead.
-func (*DisassociateFormulaFromJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateJobResponse.ProtoReflect.Descriptor instead.
+func (*CreateJobResponse) Descriptor() ([]byte, []int) {
 	return file_basecoat_transport_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *DisassociateFormulaFromJobRequest) GetJob() string {
+func (x *CreateJobResponse) GetJob() *Job {
 	if x != nil {
 		return x.Job
 	}
+	return nil
+}
+
+type UpdateJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      *string  `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Address   *Address `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Notes     *string  `protobuf:"bytes,4,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	ContactId *string  `protobuf:"bytes,5,opt,name=contact_id,json=contactId,proto3,oneof" json:"contact_id,omitempty"`
+	// Must match the job's current version or the update is rejected with
+	// ABORTED.
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateJobRequest) Reset() {
+	*x = UpdateJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobRequest) ProtoMessage() {}
+
+func (x *UpdateJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UpdateJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
 	return ""
 }
 
-func (x *DisassociateFormulaFromJobRequest) GetFormula() string {
+func (x *UpdateJobRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateJobRequest) GetAddress() *Address {
 	if x != nil {
-		return x.Formula
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdateJobRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
 	}
 	return ""
 }
 
-type DisassociateFormulaFromJobResponse struct {
+func (x *UpdateJobRequest) GetContactId() string {
+	if x != nil && x.ContactId != nil {
+		return *x.ContactId
+	}
+	return ""
+}
+
+func (x *UpdateJobRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateJobResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Job *Job `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 }
 
-func (x *DisassociateFormulaFromJobResponse) Reset() {
-	*x = DisassociateFormulaFromJobResponse{}
+func (x *UpdateJobResponse) Reset() {
+	*x = UpdateJobResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_basecoat_transport_proto_msgTypes[85]
+		mi := &file_basecoat_transport_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DisassociateFormulaFromJobResponse) String() string {
+func (x *UpdateJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisassociateFormulaFromJobResponse) ProtoMessage() {}
+func (*UpdateJobResponse) ProtoMessage() {}
 
-func (x *DisassociateFormulaFromJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_basecoat_transport_proto_msgTypes[85]
+func (x *UpdateJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4267,531 +5214,5243 @@ func (x *DisassociateFormulaFromJobResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisassociateFormulaFromJobResponse.ProtoReflect.Descriptor instead.
-func (*DisassociateFormulaFromJobResponse) Descriptor() ([]byte, []int) {
-	return file_basecoat_transport_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use UpdateJobResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJobResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{86}
 }
 
-var File_basecoat_transport_proto protoreflect.FileDescriptor
+func (x *UpdateJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
 
-var file_basecoat_transport_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x62, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x16, 0x62, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a, 0x15, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50,
-	0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x14,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x22, 0x46, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x41, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x14, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x19,
-	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x1a, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x72, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x66,
-	0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x45,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x22, 0x23,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x6f, 0x72,
-	0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d,
-	0x75, 0x6c, 0x61, 0x22, 0x5b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75,
-	0x6c, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x22, 0x4a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x6d,
-	0x75, 0x6c, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x22, 0x58, 0x0a, 0x14,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x30, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
-	0x61, 0x22, 0x68, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75,
-	0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f,
-	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x66,
-	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x32, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x12, 0x0a,
-	0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x3e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61,
-	0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65,
-	0x73, 0x22, 0x4d, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c,
-	0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
-	0x22, 0x3d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22,
-	0x5d, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61,
-	0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x22, 0x3d,
-	0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x67, 0x0a,
-	0x1f, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61,
-	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x20, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75,
-	0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x22, 0x44, 0x69,
-	0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x46, 0x72,
-	0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61,
-	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x25,
-	0x0a, 0x23, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61,
-	0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
-	0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74,
-	0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x63,
-	0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
-	0x74, 0x73, 0x22, 0x51, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x33, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61,
-	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f,
-	0x72, 0x61, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66,
-	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x63, 0x6f,
-	0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x23, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46,
-	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
-	0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
-	0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x26, 0x0a, 0x24, 0x41,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74,
-	0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x26, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46,
-	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
-	0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
-	0x61, 0x6e, 0x74, 0x22, 0x29, 0x0a, 0x27, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46,
-	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a,
-	0x6f, 0x62, 0x22, 0x57, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x32, 0x0a, 0x10, 0x4c,
-	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1e, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22,
-	0xbe, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01,
-	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x22, 0x31, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03,
-	0x6a, 0x6f, 0x62, 0x22, 0xd7, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x48, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x19, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x02, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x31, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62,
-	0x22, 0x22, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x4a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x18, 0x0a,
-	0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x1d, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x4d, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x7f, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x88, 0x01, 0x01,
-	0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x4d, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x56, 0x0a,
-	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x41, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
-	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x41, 0x0a,
-	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x4c, 0x0a, 0x1e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f,
-	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x22,
-	0x21, 0x0a, 0x1f, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d,
-	0x75, 0x6c, 0x61, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x4f, 0x0a, 0x21, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
-	0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x46, 0x72, 0x6f, 0x6d, 0x4a, 0x6f, 0x62,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72,
-	0x6d, 0x75, 0x6c, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d,
-	0x75, 0x6c, 0x61, 0x22, 0x24, 0x0a, 0x22, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x46, 0x72, 0x6f, 0x6d, 0x4a, 0x6f,
-	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x69, 0x6e, 0x74, 0x6a, 0x65, 0x64,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type DeleteJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-var (
-	file_basecoat_transport_proto_rawDescOnce sync.Once
-	file_basecoat_transport_proto_rawDescData = file_basecoat_transport_proto_rawDesc
-)
+func (x *DeleteJobRequest) Reset() {
+	*x = DeleteJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_basecoat_transport_proto_rawDescGZIP() []byte {
-	file_basecoat_transport_proto_rawDescOnce.Do(func() {
-		file_basecoat_transport_proto_rawDescData = protoimpl.X.CompressGZIP(file_basecoat_transport_proto_rawDescData)
-	})
-	return file_basecoat_transport_proto_rawDescData
+func (x *DeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_basecoat_transport_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
-var file_basecoat_transport_proto_goTypes = []interface{}{
-	(*CreateAPITokenRequest)(nil),                   // 0: proto.CreateAPITokenRequest
-	(*CreateAPITokenResponse)(nil),                  // 1: proto.CreateAPITokenResponse
-	(*GetAccountRequest)(nil),                       // 2: proto.GetAccountRequest
-	(*GetAccountResponse)(nil),                      // 3: proto.GetAccountResponse
-	(*ListAccountsRequest)(nil),                     // 4: proto.ListAccountsRequest
-	(*ListAccountsResponse)(nil),                    // 5: proto.ListAccountsResponse
-	(*CreateAccountRequest)(nil),                    // 6: proto.CreateAccountRequest
-	(*CreateAccountResponse)(nil),                   // 7: proto.CreateAccountResponse
-	(*UpdateAccountRequest)(nil),                    // 8: proto.UpdateAccountRequest
-	(*UpdateAccountResponse)(nil),                   // 9: proto.UpdateAccountResponse
-	(*ToggleAccountStateRequest)(nil),               // 10: proto.ToggleAccountStateRequest
-	(*ToggleAccountStateResponse)(nil),              // 11: proto.ToggleAccountStateResponse
-	(*GetSystemInfoRequest)(nil),                    // 12: proto.GetSystemInfoRequest
-	(*GetSystemInfoResponse)(nil),                   // 13: proto.GetSystemInfoResponse
-	(*GetFormulaRequest)(nil),                       // 14: proto.GetFormulaRequest
-	(*GetFormulaResponse)(nil),                      // 15: proto.GetFormulaResponse
-	(*ListFormulasRequest)(nil),                     // 16: proto.ListFormulasRequest
-	(*ListFormulasResponse)(nil),                    // 17: proto.ListFormulasResponse
-	(*CreateFormulaRequest)(nil),                    // 18: proto.CreateFormulaRequest
-	(*CreateFormulaResponse)(nil),                   // 19: proto.CreateFormulaResponse
-	(*UpdateFormulaRequest)(nil),                    // 20: proto.UpdateFormulaRequest
-	(*UpdateFormulaResponse)(nil),                   // 21: proto.UpdateFormulaResponse
-	(*DeleteFormulaRequest)(nil),                    // 22: proto.DeleteFormulaRequest
-	(*DeleteFormulaResponse)(nil),                   // 23: proto.DeleteFormulaResponse
-	(*GetBaseRequest)(nil),                          // 24: proto.GetBaseRequest
-	(*GetBaseResponse)(nil),                         // 25: proto.GetBaseResponse
-	(*ListBasesRequest)(nil),                        // 26: proto.ListBasesRequest
-	(*ListBasesResponse)(nil),                       // 27: proto.ListBasesResponse
-	(*CreateBaseRequest)(nil),                       // 28: proto.CreateBaseRequest
-	(*CreateBaseResponse)(nil),                      // 29: proto.CreateBaseResponse
-	(*UpdateBaseRequest)(nil),                       // 30: proto.UpdateBaseRequest
-	(*UpdateBaseResponse)(nil),                      // 31: proto.UpdateBaseResponse
-	(*AssociateBaseWithFormulaRequest)(nil),         // 32: proto.AssociateBaseWithFormulaRequest
-	(*AssociateBaseWithFormulaResponse)(nil),        // 33: proto.AssociateBaseWithFormulaResponse
-	(*DisassociateBaseFromFormulaRequest)(nil),      // 34: proto.DisassociateBaseFromFormulaRequest
-	(*DisassociateBaseFromFormulaResponse)(nil),     // 35: proto.DisassociateBaseFromFormulaResponse
-	(*DeleteBaseRequest)(nil),                       // 36: proto.DeleteBaseRequest
-	(*DeleteBaseResponse)(nil),                      // 37: proto.DeleteBaseResponse
-	(*GetColorantRequest)(nil),                      // 38: proto.GetColorantRequest
-	(*GetColorantResponse)(nil),                     // 39: proto.GetColorantResponse
-	(*ListColorantsRequest)(nil),                    // 40: proto.ListColorantsRequest
-	(*ListColorantsResponse)(nil),                   // 41: proto.ListColorantsResponse
-	(*CreateColorantRequest)(nil),                   // 42: proto.CreateColorantRequest
-	(*CreateColorantResponse)(nil),                  // 43: proto.CreateColorantResponse
-	(*UpdateColorantRequest)(nil),                   // 44: proto.UpdateColorantRequest
-	(*UpdateColorantResponse)(nil),                  // 45: proto.UpdateColorantResponse
-	(*AssociateColorantWithFormulaRequest)(nil),     // 46: proto.AssociateColorantWithFormulaRequest
-	(*AssociateColorantWithFormulaResponse)(nil),    // 47: proto.AssociateColorantWithFormulaResponse
-	(*DisassociateColorantFromFormulaRequest)(nil),  // 48: proto.DisassociateColorantFromFormulaRequest
-	(*DisassociateColorantFromFormulaResponse)(nil), // 49: proto.DisassociateColorantFromFormulaResponse
-	(*DeleteColorantRequest)(nil),                   // 50: proto.DeleteColorantRequest
-	(*DeleteColorantResponse)(nil),                  // 51: proto.DeleteColorantResponse
-	(*GetJobRequest)(nil),                           // 52: proto.GetJobRequest
-	(*GetJobResponse)(nil),                          // 53: proto.GetJobResponse
-	(*ListJobsRequest)(nil),                         // 54: proto.ListJobsRequest
-	(*ListJobsResponse)(nil),                        // 55: proto.ListJobsResponse
-	(*CreateJobRequest)(nil),                        // 56: proto.CreateJobRequest
-	(*CreateJobResponse)(nil),                       // 57: proto.CreateJobResponse
-	(*UpdateJobRequest)(nil),                        // 58: proto.UpdateJobRequest
-	(*UpdateJobResponse)(nil),                       // 59: proto.UpdateJobResponse
-	(*DeleteJobRequest)(nil),                        // 60: proto.DeleteJobRequest
-	(*DeleteJobResponse)(nil),                       // 61: proto.DeleteJobResponse
-	(*GetContractorRequest)(nil),                    // 62: proto.GetContractorRequest
-	(*GetContractorResponse)(nil),                   // 63: proto.GetContractorResponse
-	(*ListContractorsRequest)(nil),                  // 64: proto.ListContractorsRequest
-	(*ListContractorsResponse)(nil),                 // 65: proto.ListContractorsResponse
-	(*CreateContractorRequest)(nil),                 // 66: proto.CreateContractorRequest
-	(*CreateContractorResponse)(nil),                // 67: proto.CreateContractorResponse
-	(*UpdateContractorRequest)(nil),                 // 68: proto.UpdateContractorRequest
-	(*UpdateContractorResponse)(nil),                // 69: proto.UpdateContractorResponse
-	(*DeleteContractorRequest)(nil),                 // 70: proto.DeleteContractorRequest
-	(*DeleteContractorResponse)(nil),                // 71: proto.DeleteContractorResponse
-	(*GetContactRequest)(nil),                       // 72: proto.GetContactRequest
-	(*GetContactResponse)(nil),                      // 73: proto.GetContactResponse
-	(*ListContactsRequest)(nil),                     // 74: proto.ListContactsRequest
-	(*ListContactsResponse)(nil),                    // 75: proto.ListContactsResponse
-	(*CreateContactRequest)(nil),                    // 76: proto.CreateContactRequest
-	(*CreateContactResponse)(nil),                   // 77: proto.CreateContactResponse
-	(*UpdateContactRequest)(nil),                    // 78: proto.UpdateContactRequest
-	(*UpdateContactResponse)(nil),                   // 79: proto.UpdateContactResponse
-	(*DeleteContactRequest)(nil),                    // 80: proto.DeleteContactRequest
-	(*DeleteContactResponse)(nil),                   // 81: proto.DeleteContactResponse
-	(*AssociateFormulaWithJobRequest)(nil),          // 82: proto.AssociateFormulaWithJobRequest
-	(*AssociateFormulaWithJobResponse)(nil),         // 83: proto.AssociateFormulaWithJobResponse
-	(*DisassociateFormulaFromJobRequest)(nil),       // 84: proto.DisassociateFormulaFromJobRequest
-	(*DisassociateFormulaFromJobResponse)(nil),      // 85: proto.DisassociateFormulaFromJobResponse
-	(*Account)(nil),                                 // 86: proto.Account
-	(AccountState)(0),                               // 87: proto.AccountState
-	(*Formula)(nil),                                 // 88: proto.Formula
-	(*FormulaMetadata)(nil),                         // 89: proto.FormulaMetadata
-	(*Base)(nil),                                    // 90: proto.Base
-	(*BaseMetadata)(nil),                            // 91: proto.BaseMetadata
-	(*Colorant)(nil),                                // 92: proto.Colorant
-	(*ColorantMetadata)(nil),                        // 93: proto.ColorantMetadata
-	(*Job)(nil),                                     // 94: proto.Job
-	(*Address)(nil),                                 // 95: proto.Address
-	(*Contractor)(nil),                              // 96: proto.Contractor
-	(*Contact)(nil),                                 // 97: proto.Contact
+func (*DeleteJobRequest) ProtoMessage() {}
+
+func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_basecoat_transport_proto_depIdxs = []int32{
-	86, // 0: proto.GetAccountResponse.account:type_name -> proto.Account
-	86, // 1: proto.ListAccountsResponse.accounts:type_name -> proto.Account
-	86, // 2: proto.CreateAccountResponse.account:type_name -> proto.Account
-	87, // 3: proto.ToggleAccountStateResponse.state:type_name -> proto.AccountState
-	88, // 4: proto.GetFormulaResponse.formula:type_name -> proto.Formula
-	89, // 5: proto.ListFormulasResponse.formulas:type_name -> proto.FormulaMetadata
-	89, // 6: proto.CreateFormulaResponse.formula:type_name -> proto.FormulaMetadata
-	89, // 7: proto.UpdateFormulaResponse.formula:type_name -> proto.FormulaMetadata
-	90, // 8: proto.GetBaseResponse.base:type_name -> proto.Base
-	91, // 9: proto.ListBasesResponse.bases:type_name -> proto.BaseMetadata
-	91, // 10: proto.CreateBaseResponse.base:type_name -> proto.BaseMetadata
-	91, // 11: proto.UpdateBaseResponse.base:type_name -> proto.BaseMetadata
-	92, // 12: proto.GetColorantResponse.colorant:type_name -> proto.Colorant
-	93, // 13: proto.ListColorantsResponse.colorants:type_name -> proto.ColorantMetadata
-	93, // 14: proto.CreateColorantResponse.colorant:type_name -> proto.ColorantMetadata
-	93, // 15: proto.UpdateColorantResponse.colorant:type_name -> proto.ColorantMetadata
-	94, // 16: proto.GetJobResponse.job:type_name -> proto.Job
-	94, // 17: proto.ListJobsResponse.jobs:type_name -> proto.Job
-	95, // 18: proto.CreateJobRequest.address:type_name -> proto.Address
-	94, // 19: proto.CreateJobResponse.job:type_name -> proto.Job
-	95, // 20: proto.UpdateJobRequest.address:type_name -> proto.Address
-	94, // 21: proto.UpdateJobResponse.job:type_name -> proto.Job
-	96, // 22: proto.GetContractorResponse.contractor:type_name -> proto.Contractor
-	96, // 23: proto.ListContractorsResponse.contractors:type_name -> proto.Contractor
-	96, // 24: proto.CreateContractorResponse.contractor:type_name -> proto.Contractor
-	96, // 25: proto.UpdateContractorResponse.contractor:type_name -> proto.Contractor
-	97, // 26: proto.GetContactResponse.contact:type_name -> proto.Contact
-	97, // 27: proto.ListContactsResponse.contacts:type_name -> proto.Contact
-	97, // 28: proto.CreateContactResponse.contact:type_name -> proto.Contact
-	97, // 29: proto.UpdateContactResponse.contact:type_name -> proto.Contact
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+
+// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{87}
 }
 
-func init() { file_basecoat_transport_proto_init() }
-func file_basecoat_transport_proto_init() {
-	if File_basecoat_transport_proto != nil {
-		return
+func (x *DeleteJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	file_basecoat_message_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_basecoat_transport_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAPITokenRequest); i {
+	return ""
+}
+
+type DeleteJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteJobResponse) Reset() {
+	*x = DeleteJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobResponse) ProtoMessage() {}
+
+func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{88}
+}
+
+// A report of every formula used on a job, handed to customers so that they
+// can buy or mix the same paint again for touch-ups.
+type GenerateJobReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format GenerateJobReportRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=proto.GenerateJobReportRequest_Format" json:"format,omitempty"`
+}
+
+func (x *GenerateJobReportRequest) Reset() {
+	*x = GenerateJobReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateJobReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateJobReportRequest) ProtoMessage() {}
+
+func (x *GenerateJobReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateJobReportRequest.ProtoReflect.Descriptor instead.
+func (*GenerateJobReportRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GenerateJobReportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GenerateJobReportRequest) GetFormat() GenerateJobReportRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return GenerateJobReportRequest_PDF
+}
+
+type GenerateJobReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content     []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// A suggested name to save the report under.
+	FileName string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+}
+
+func (x *GenerateJobReportResponse) Reset() {
+	*x = GenerateJobReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateJobReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateJobReportResponse) ProtoMessage() {}
+
+func (x *GenerateJobReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateJobReportResponse.ProtoReflect.Descriptor instead.
+func (*GenerateJobReportResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GenerateJobReportResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *GenerateJobReportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GenerateJobReportResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type GetContractorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetContractorRequest) Reset() {
+	*x = GetContractorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContractorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContractorRequest) ProtoMessage() {}
+
+func (x *GetContractorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContractorRequest.ProtoReflect.Descriptor instead.
+func (*GetContractorRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetContractorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetContractorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contractor *Contractor `protobuf:"bytes,1,opt,name=contractor,proto3" json:"contractor,omitempty"`
+}
+
+func (x *GetContractorResponse) Reset() {
+	*x = GetContractorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContractorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContractorResponse) ProtoMessage() {}
+
+func (x *GetContractorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContractorResponse.ProtoReflect.Descriptor instead.
+func (*GetContractorResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetContractorResponse) GetContractor() *Contractor {
+	if x != nil {
+		return x.Contractor
+	}
+	return nil
+}
+
+type ListContractorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListContractorsRequest) Reset() {
+	*x = ListContractorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContractorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContractorsRequest) ProtoMessage() {}
+
+func (x *ListContractorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContractorsRequest.ProtoReflect.Descriptor instead.
+func (*ListContractorsRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{93}
+}
+
+type ListContractorsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contractors []*Contractor `protobuf:"bytes,1,rep,name=contractors,proto3" json:"contractors,omitempty"`
+}
+
+func (x *ListContractorsResponse) Reset() {
+	*x = ListContractorsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContractorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContractorsResponse) ProtoMessage() {}
+
+func (x *ListContractorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContractorsResponse.ProtoReflect.Descriptor instead.
+func (*ListContractorsResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListContractorsResponse) GetContractors() []*Contractor {
+	if x != nil {
+		return x.Contractors
+	}
+	return nil
+}
+
+type CreateContractorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Company string  `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	Contact *string `protobuf:"bytes,2,opt,name=contact,proto3,oneof" json:"contact,omitempty"`
+}
+
+func (x *CreateContractorRequest) Reset() {
+	*x = CreateContractorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateContractorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContractorRequest) ProtoMessage() {}
+
+func (x *CreateContractorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContractorRequest.ProtoReflect.Descriptor instead.
+func (*CreateContractorRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CreateContractorRequest) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *CreateContractorRequest) GetContact() string {
+	if x != nil && x.Contact != nil {
+		return *x.Contact
+	}
+	return ""
+}
+
+type CreateContractorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contractor *Contractor `protobuf:"bytes,1,opt,name=contractor,proto3" json:"contractor,omitempty"`
+}
+
+func (x *CreateContractorResponse) Reset() {
+	*x = CreateContractorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateContractorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContractorResponse) ProtoMessage() {}
+
+func (x *CreateContractorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContractorResponse.ProtoReflect.Descriptor instead.
+func (*CreateContractorResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CreateContractorResponse) GetContractor() *Contractor {
+	if x != nil {
+		return x.Contractor
+	}
+	return nil
+}
+
+type UpdateContractorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Company *string `protobuf:"bytes,2,opt,name=company,proto3,oneof" json:"company,omitempty"`
+	Contact *string `protobuf:"bytes,3,opt,name=contact,proto3,oneof" json:"contact,omitempty"`
+	// Must match the contractor's current version or the update is rejected
+	// with ABORTED.
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateContractorRequest) Reset() {
+	*x = UpdateContractorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateContractorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContractorRequest) ProtoMessage() {}
+
+func (x *UpdateContractorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContractorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContractorRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateContractorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateContractorRequest) GetCompany() string {
+	if x != nil && x.Company != nil {
+		return *x.Company
+	}
+	return ""
+}
+
+func (x *UpdateContractorRequest) GetContact() string {
+	if x != nil && x.Contact != nil {
+		return *x.Contact
+	}
+	return ""
+}
+
+func (x *UpdateContractorRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateContractorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contractor *Contractor `protobuf:"bytes,1,opt,name=contractor,proto3" json:"contractor,omitempty"`
+}
+
+func (x *UpdateContractorResponse) Reset() {
+	*x = UpdateContractorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateContractorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContractorResponse) ProtoMessage() {}
+
+func (x *UpdateContractorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContractorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateContractorResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateContractorResponse) GetContractor() *Contractor {
+	if x != nil {
+		return x.Contractor
+	}
+	return nil
+}
+
+type DeleteContractorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Delete even if jobs still depend on the contractor.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Report what the delete would affect without deleting anything.
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *DeleteContractorRequest) Reset() {
+	*x = DeleteContractorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteContractorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContractorRequest) ProtoMessage() {}
+
+func (x *DeleteContractorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContractorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContractorRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DeleteContractorRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteContractorRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteContractorRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type DeleteContractorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The jobs which depend on the contractor and lose it once it is deleted.
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *DeleteContractorResponse) Reset() {
+	*x = DeleteContractorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteContractorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContractorResponse) ProtoMessage() {}
+
+func (x *DeleteContractorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContractorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContractorResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DeleteContractorResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type GetContactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetContactRequest) Reset() {
+	*x = GetContactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactRequest) ProtoMessage() {}
+
+func (x *GetContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactRequest.ProtoReflect.Descriptor instead.
+func (*GetContactRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetContactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contact *Contact `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+}
+
+func (x *GetContactResponse) Reset() {
+	*x = GetContactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactResponse) ProtoMessage() {}
+
+func (x *GetContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactResponse.ProtoReflect.Descriptor instead.
+func (*GetContactResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetContactResponse) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type ListContactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListContactsRequest) Reset() {
+	*x = ListContactsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsRequest) ProtoMessage() {}
+
+func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListContactsRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{103}
+}
+
+type ListContactsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contacts []*Contact `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+}
+
+func (x *ListContactsResponse) Reset() {
+	*x = ListContactsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactsResponse) ProtoMessage() {}
+
+func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListContactsResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListContactsResponse) GetContacts() []*Contact {
+	if x != nil {
+		return x.Contacts
+	}
+	return nil
+}
+
+type CreateContactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *CreateContactRequest) Reset() {
+	*x = CreateContactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContactRequest) ProtoMessage() {}
+
+func (x *CreateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContactRequest.ProtoReflect.Descriptor instead.
+func (*CreateContactRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CreateContactRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateContactRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateContactRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type CreateContactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contact *Contact `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+}
+
+func (x *CreateContactResponse) Reset() {
+	*x = CreateContactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContactResponse) ProtoMessage() {}
+
+func (x *CreateContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContactResponse.ProtoReflect.Descriptor instead.
+func (*CreateContactResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CreateContactResponse) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type UpdateContactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Email *string `protobuf:"bytes,3,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	Phone *string `protobuf:"bytes,4,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	// Must match the contact's current version or the update is rejected with
+	// ABORTED.
+	Version int64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateContactRequest) Reset() {
+	*x = UpdateContactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactRequest) ProtoMessage() {}
+
+func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContactRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *UpdateContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateContactRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateContactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contact *Contact `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+}
+
+func (x *UpdateContactResponse) Reset() {
+	*x = UpdateContactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactResponse) ProtoMessage() {}
+
+func (x *UpdateContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactResponse.ProtoReflect.Descriptor instead.
+func (*UpdateContactResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *UpdateContactResponse) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type DeleteContactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteContactRequest) Reset() {
+	*x = DeleteContactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactRequest) ProtoMessage() {}
+
+func (x *DeleteContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContactRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *DeleteContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteContactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteContactResponse) Reset() {
+	*x = DeleteContactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactResponse) ProtoMessage() {}
+
+func (x *DeleteContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContactResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{110}
+}
+
+type AssociateFormulaWithJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job     string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Formula string `protobuf:"bytes,2,opt,name=formula,proto3" json:"formula,omitempty"`
+	// Where on the job the formula was used; ex. "Kitchen walls". Printed in the
+	// job's report.
+	Area string `protobuf:"bytes,3,opt,name=area,proto3" json:"area,omitempty"`
+}
+
+func (x *AssociateFormulaWithJobRequest) Reset() {
+	*x = AssociateFormulaWithJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssociateFormulaWithJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssociateFormulaWithJobRequest) ProtoMessage() {}
+
+func (x *AssociateFormulaWithJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssociateFormulaWithJobRequest.ProtoReflect.Descriptor instead.
+func (*AssociateFormulaWithJobRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AssociateFormulaWithJobRequest) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *AssociateFormulaWithJobRequest) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+func (x *AssociateFormulaWithJobRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+type AssociateFormulaWithJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AssociateFormulaWithJobResponse) Reset() {
+	*x = AssociateFormulaWithJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssociateFormulaWithJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssociateFormulaWithJobResponse) ProtoMessage() {}
+
+func (x *AssociateFormulaWithJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssociateFormulaWithJobResponse.ProtoReflect.Descriptor instead.
+func (*AssociateFormulaWithJobResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{112}
+}
+
+type DisassociateFormulaFromJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job     string `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Formula string `protobuf:"bytes,2,opt,name=formula,proto3" json:"formula,omitempty"`
+}
+
+func (x *DisassociateFormulaFromJobRequest) Reset() {
+	*x = DisassociateFormulaFromJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisassociateFormulaFromJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisassociateFormulaFromJobRequest) ProtoMessage() {}
+
+func (x *DisassociateFormulaFromJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisassociateFormulaFromJobRequest.ProtoReflect.Descriptor instead.
+func (*DisassociateFormulaFromJobRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DisassociateFormulaFromJobRequest) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+func (x *DisassociateFormulaFromJobRequest) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+type DisassociateFormulaFromJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DisassociateFormulaFromJobResponse) Reset() {
+	*x = DisassociateFormulaFromJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisassociateFormulaFromJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisassociateFormulaFromJobResponse) ProtoMessage() {}
+
+func (x *DisassociateFormulaFromJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisassociateFormulaFromJobResponse.ProtoReflect.Descriptor instead.
+func (*DisassociateFormulaFromJobResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{114}
+}
+
+type ListDeletedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only list entities of this kind; unknown lists all kinds.
+	Kind EntityKind `protobuf:"varint,1,opt,name=kind,proto3,enum=proto.EntityKind" json:"kind,omitempty"`
+}
+
+func (x *ListDeletedRequest) Reset() {
+	*x = ListDeletedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedRequest) ProtoMessage() {}
+
+func (x *ListDeletedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListDeletedRequest) GetKind() EntityKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EntityKind_ENTITY_KIND_UNKNOWN
+}
+
+type ListDeletedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entities []*DeletedEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+}
+
+func (x *ListDeletedResponse) Reset() {
+	*x = ListDeletedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedResponse) ProtoMessage() {}
+
+func (x *ListDeletedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListDeletedResponse) GetEntities() []*DeletedEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type UndeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind EntityKind `protobuf:"varint,1,opt,name=kind,proto3,enum=proto.EntityKind" json:"kind,omitempty"`
+	Id   string     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UndeleteRequest) Reset() {
+	*x = UndeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteRequest) ProtoMessage() {}
+
+func (x *UndeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *UndeleteRequest) GetKind() EntityKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EntityKind_ENTITY_KIND_UNKNOWN
+}
+
+func (x *UndeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UndeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UndeleteResponse) Reset() {
+	*x = UndeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteResponse) ProtoMessage() {}
+
+func (x *UndeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{118}
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only list events for entities of this kind; unknown lists all kinds.
+	EntityKind EntityKind `protobuf:"varint,1,opt,name=entity_kind,json=entityKind,proto3,enum=proto.EntityKind" json:"entity_kind,omitempty"`
+	// Only list events for this entity.
+	EntityId string `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Only list events recorded at or after this time; in epoch milli.
+	Since int64 `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	// Only list events recorded before this time; in epoch milli.
+	Until  int64  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
+	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListAuditEventsRequest) GetEntityKind() EntityKind {
+	if x != nil {
+		return x.EntityKind
+	}
+	return EntityKind_ENTITY_KIND_UNKNOWN
+}
+
+func (x *ListAuditEventsRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type WatchEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resume after the event with this sequence; events recorded since then are
+	// sent before new ones. 0 only sends events recorded from now on.
+	After int64 `protobuf:"varint,1,opt,name=after,proto3" json:"after,omitempty"`
+	// Only send events for entities of these kinds; empty sends every kind.
+	EntityKinds []EntityKind `protobuf:"varint,2,rep,packed,name=entity_kinds,json=entityKinds,proto3,enum=proto.EntityKind" json:"entity_kinds,omitempty"`
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *WatchEventsRequest) GetAfter() int64 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+func (x *WatchEventsRequest) GetEntityKinds() []EntityKind {
+	if x != nil {
+		return x.EntityKinds
+	}
+	return nil
+}
+
+type WatchEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *WatchEventsResponse) Reset() {
+	*x = WatchEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsResponse) ProtoMessage() {}
+
+func (x *WatchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *WatchEventsResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type GetWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{125}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhooks []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url    string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Events []string `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// Key deliveries are signed with; one is generated if empty.
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *CreateWebhookRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// The secret is only ever returned here.
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Secret  string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CreateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *CreateWebhookResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// Only the fields which are set are changed.
+type UpdateWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url *string `protobuf:"bytes,2,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// Replaces the event types subscribed to; empty leaves them as they are.
+	Events []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	Secret *string  `protobuf:"bytes,4,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+}
+
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *UpdateWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *UpdateWebhookRequest) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+type UpdateWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+}
+
+func (x *UpdateWebhookResponse) Reset() {
+	*x = UpdateWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookResponse) ProtoMessage() {}
+
+func (x *UpdateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *UpdateWebhookResponse) GetWebhook() *Webhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *DeleteWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{132}
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Webhook string `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Offset  uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit   uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetWebhook() string {
+	if x != nil {
+		return x.Webhook
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// Posts a "ping" event to the webhook right away, without retrying it if it
+// fails, and records it in the webhook's delivery log.
+type TestWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TestWebhookRequest) Reset() {
+	*x = TestWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestWebhookRequest) ProtoMessage() {}
+
+func (x *TestWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestWebhookRequest.ProtoReflect.Descriptor instead.
+func (*TestWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *TestWebhookRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TestWebhookResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delivery *WebhookDelivery `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+}
+
+func (x *TestWebhookResponse) Reset() {
+	*x = TestWebhookResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestWebhookResponse) ProtoMessage() {}
+
+func (x *TestWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestWebhookResponse.ProtoReflect.Descriptor instead.
+func (*TestWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *TestWebhookResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// The outcome of a single item in a batch.
+type BatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Position of the item in the request.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// ID of the entity the item applied to; for creates the ID of the new
+	// entity. Empty when the item was not applied.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The gRPC status code the item would have returned had it been sent on its
+	// own; OK when it was applied.
+	Code  int32  `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Set when the item did not fail itself but was not applied because another
+	// item in an atomic batch failed.
+	RolledBack bool `protobuf:"varint,5,opt,name=rolled_back,json=rolledBack,proto3" json:"rolled_back,omitempty"`
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *BatchResult) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchResult) GetRolledBack() bool {
+	if x != nil {
+		return x.RolledBack
+	}
+	return false
+}
+
+type BatchCreateItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Item:
+	//	*BatchCreateItem_Formula
+	//	*BatchCreateItem_Base
+	//	*BatchCreateItem_Colorant
+	//	*BatchCreateItem_Contact
+	//	*BatchCreateItem_Job
+	Item isBatchCreateItem_Item `protobuf_oneof:"item"`
+}
+
+func (x *BatchCreateItem) Reset() {
+	*x = BatchCreateItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateItem) ProtoMessage() {}
+
+func (x *BatchCreateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateItem.ProtoReflect.Descriptor instead.
+func (*BatchCreateItem) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{138}
+}
+
+func (m *BatchCreateItem) GetItem() isBatchCreateItem_Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (x *BatchCreateItem) GetFormula() *CreateFormulaRequest {
+	if x, ok := x.GetItem().(*BatchCreateItem_Formula); ok {
+		return x.Formula
+	}
+	return nil
+}
+
+func (x *BatchCreateItem) GetBase() *CreateBaseRequest {
+	if x, ok := x.GetItem().(*BatchCreateItem_Base); ok {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *BatchCreateItem) GetColorant() *CreateColorantRequest {
+	if x, ok := x.GetItem().(*BatchCreateItem_Colorant); ok {
+		return x.Colorant
+	}
+	return nil
+}
+
+func (x *BatchCreateItem) GetContact() *CreateContactRequest {
+	if x, ok := x.GetItem().(*BatchCreateItem_Contact); ok {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *BatchCreateItem) GetJob() *CreateJobRequest {
+	if x, ok := x.GetItem().(*BatchCreateItem_Job); ok {
+		return x.Job
+	}
+	return nil
+}
+
+type isBatchCreateItem_Item interface {
+	isBatchCreateItem_Item()
+}
+
+type BatchCreateItem_Formula struct {
+	Formula *CreateFormulaRequest `protobuf:"bytes,1,opt,name=formula,proto3,oneof"`
+}
+
+type BatchCreateItem_Base struct {
+	Base *CreateBaseRequest `protobuf:"bytes,2,opt,name=base,proto3,oneof"`
+}
+
+type BatchCreateItem_Colorant struct {
+	Colorant *CreateColorantRequest `protobuf:"bytes,3,opt,name=colorant,proto3,oneof"`
+}
+
+type BatchCreateItem_Contact struct {
+	Contact *CreateContactRequest `protobuf:"bytes,4,opt,name=contact,proto3,oneof"`
+}
+
+type BatchCreateItem_Job struct {
+	Job *CreateJobRequest `protobuf:"bytes,5,opt,name=job,proto3,oneof"`
+}
+
+func (*BatchCreateItem_Formula) isBatchCreateItem_Item() {}
+
+func (*BatchCreateItem_Base) isBatchCreateItem_Item() {}
+
+func (*BatchCreateItem_Colorant) isBatchCreateItem_Item() {}
+
+func (*BatchCreateItem_Contact) isBatchCreateItem_Item() {}
+
+func (*BatchCreateItem_Job) isBatchCreateItem_Item() {}
+
+type BatchCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode  BatchMode          `protobuf:"varint,1,opt,name=mode,proto3,enum=proto.BatchMode" json:"mode,omitempty"`
+	Items []*BatchCreateItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BatchCreateRequest) Reset() {
+	*x = BatchCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateRequest) ProtoMessage() {}
+
+func (x *BatchCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *BatchCreateRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_ATOMIC
+}
+
+func (x *BatchCreateRequest) GetItems() []*BatchCreateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BatchCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One result per item in the order they were given.
+	Results []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Whether any of the batch was saved. False when an atomic batch was rolled
+	// back.
+	Committed bool `protobuf:"varint,2,opt,name=committed,proto3" json:"committed,omitempty"`
+}
+
+func (x *BatchCreateResponse) Reset() {
+	*x = BatchCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateResponse) ProtoMessage() {}
+
+func (x *BatchCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *BatchCreateResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchCreateResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+type BatchUpdateItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Item:
+	//	*BatchUpdateItem_Formula
+	//	*BatchUpdateItem_Base
+	//	*BatchUpdateItem_Colorant
+	//	*BatchUpdateItem_Contact
+	//	*BatchUpdateItem_Job
+	Item isBatchUpdateItem_Item `protobuf_oneof:"item"`
+}
+
+func (x *BatchUpdateItem) Reset() {
+	*x = BatchUpdateItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateItem) ProtoMessage() {}
+
+func (x *BatchUpdateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateItem.ProtoReflect.Descriptor instead.
+func (*BatchUpdateItem) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{141}
+}
+
+func (m *BatchUpdateItem) GetItem() isBatchUpdateItem_Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (x *BatchUpdateItem) GetFormula() *UpdateFormulaRequest {
+	if x, ok := x.GetItem().(*BatchUpdateItem_Formula); ok {
+		return x.Formula
+	}
+	return nil
+}
+
+func (x *BatchUpdateItem) GetBase() *UpdateBaseRequest {
+	if x, ok := x.GetItem().(*BatchUpdateItem_Base); ok {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *BatchUpdateItem) GetColorant() *UpdateColorantRequest {
+	if x, ok := x.GetItem().(*BatchUpdateItem_Colorant); ok {
+		return x.Colorant
+	}
+	return nil
+}
+
+func (x *BatchUpdateItem) GetContact() *UpdateContactRequest {
+	if x, ok := x.GetItem().(*BatchUpdateItem_Contact); ok {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *BatchUpdateItem) GetJob() *UpdateJobRequest {
+	if x, ok := x.GetItem().(*BatchUpdateItem_Job); ok {
+		return x.Job
+	}
+	return nil
+}
+
+type isBatchUpdateItem_Item interface {
+	isBatchUpdateItem_Item()
+}
+
+type BatchUpdateItem_Formula struct {
+	Formula *UpdateFormulaRequest `protobuf:"bytes,1,opt,name=formula,proto3,oneof"`
+}
+
+type BatchUpdateItem_Base struct {
+	Base *UpdateBaseRequest `protobuf:"bytes,2,opt,name=base,proto3,oneof"`
+}
+
+type BatchUpdateItem_Colorant struct {
+	Colorant *UpdateColorantRequest `protobuf:"bytes,3,opt,name=colorant,proto3,oneof"`
+}
+
+type BatchUpdateItem_Contact struct {
+	Contact *UpdateContactRequest `protobuf:"bytes,4,opt,name=contact,proto3,oneof"`
+}
+
+type BatchUpdateItem_Job struct {
+	Job *UpdateJobRequest `protobuf:"bytes,5,opt,name=job,proto3,oneof"`
+}
+
+func (*BatchUpdateItem_Formula) isBatchUpdateItem_Item() {}
+
+func (*BatchUpdateItem_Base) isBatchUpdateItem_Item() {}
+
+func (*BatchUpdateItem_Colorant) isBatchUpdateItem_Item() {}
+
+func (*BatchUpdateItem_Contact) isBatchUpdateItem_Item() {}
+
+func (*BatchUpdateItem_Job) isBatchUpdateItem_Item() {}
+
+type BatchUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode  BatchMode          `protobuf:"varint,1,opt,name=mode,proto3,enum=proto.BatchMode" json:"mode,omitempty"`
+	Items []*BatchUpdateItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BatchUpdateRequest) Reset() {
+	*x = BatchUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateRequest) ProtoMessage() {}
+
+func (x *BatchUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *BatchUpdateRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_ATOMIC
+}
+
+func (x *BatchUpdateRequest) GetItems() []*BatchUpdateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BatchUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results   []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Committed bool           `protobuf:"varint,2,opt,name=committed,proto3" json:"committed,omitempty"`
+}
+
+func (x *BatchUpdateResponse) Reset() {
+	*x = BatchUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateResponse) ProtoMessage() {}
+
+func (x *BatchUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *BatchUpdateResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchUpdateResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+type BatchDeleteItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Item:
+	//	*BatchDeleteItem_Formula
+	//	*BatchDeleteItem_Base
+	//	*BatchDeleteItem_Colorant
+	//	*BatchDeleteItem_Contact
+	//	*BatchDeleteItem_Job
+	Item isBatchDeleteItem_Item `protobuf_oneof:"item"`
+}
+
+func (x *BatchDeleteItem) Reset() {
+	*x = BatchDeleteItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteItem) ProtoMessage() {}
+
+func (x *BatchDeleteItem) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteItem.ProtoReflect.Descriptor instead.
+func (*BatchDeleteItem) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{144}
+}
+
+func (m *BatchDeleteItem) GetItem() isBatchDeleteItem_Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (x *BatchDeleteItem) GetFormula() *DeleteFormulaRequest {
+	if x, ok := x.GetItem().(*BatchDeleteItem_Formula); ok {
+		return x.Formula
+	}
+	return nil
+}
+
+func (x *BatchDeleteItem) GetBase() *DeleteBaseRequest {
+	if x, ok := x.GetItem().(*BatchDeleteItem_Base); ok {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *BatchDeleteItem) GetColorant() *DeleteColorantRequest {
+	if x, ok := x.GetItem().(*BatchDeleteItem_Colorant); ok {
+		return x.Colorant
+	}
+	return nil
+}
+
+func (x *BatchDeleteItem) GetContact() *DeleteContactRequest {
+	if x, ok := x.GetItem().(*BatchDeleteItem_Contact); ok {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *BatchDeleteItem) GetJob() *DeleteJobRequest {
+	if x, ok := x.GetItem().(*BatchDeleteItem_Job); ok {
+		return x.Job
+	}
+	return nil
+}
+
+type isBatchDeleteItem_Item interface {
+	isBatchDeleteItem_Item()
+}
+
+type BatchDeleteItem_Formula struct {
+	Formula *DeleteFormulaRequest `protobuf:"bytes,1,opt,name=formula,proto3,oneof"`
+}
+
+type BatchDeleteItem_Base struct {
+	Base *DeleteBaseRequest `protobuf:"bytes,2,opt,name=base,proto3,oneof"`
+}
+
+type BatchDeleteItem_Colorant struct {
+	Colorant *DeleteColorantRequest `protobuf:"bytes,3,opt,name=colorant,proto3,oneof"`
+}
+
+type BatchDeleteItem_Contact struct {
+	Contact *DeleteContactRequest `protobuf:"bytes,4,opt,name=contact,proto3,oneof"`
+}
+
+type BatchDeleteItem_Job struct {
+	Job *DeleteJobRequest `protobuf:"bytes,5,opt,name=job,proto3,oneof"`
+}
+
+func (*BatchDeleteItem_Formula) isBatchDeleteItem_Item() {}
+
+func (*BatchDeleteItem_Base) isBatchDeleteItem_Item() {}
+
+func (*BatchDeleteItem_Colorant) isBatchDeleteItem_Item() {}
+
+func (*BatchDeleteItem_Contact) isBatchDeleteItem_Item() {}
+
+func (*BatchDeleteItem_Job) isBatchDeleteItem_Item() {}
+
+type BatchDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode  BatchMode          `protobuf:"varint,1,opt,name=mode,proto3,enum=proto.BatchMode" json:"mode,omitempty"`
+	Items []*BatchDeleteItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BatchDeleteRequest) Reset() {
+	*x = BatchDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteRequest) ProtoMessage() {}
+
+func (x *BatchDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *BatchDeleteRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_ATOMIC
+}
+
+func (x *BatchDeleteRequest) GetItems() []*BatchDeleteItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BatchDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results   []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Committed bool           `protobuf:"varint,2,opt,name=committed,proto3" json:"committed,omitempty"`
+}
+
+func (x *BatchDeleteResponse) Reset() {
+	*x = BatchDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_basecoat_transport_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteResponse) ProtoMessage() {}
+
+func (x *BatchDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_basecoat_transport_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_basecoat_transport_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *BatchDeleteResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchDeleteResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+var File_basecoat_transport_proto protoreflect.FileDescriptor
+
+var file_basecoat_transport_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x62, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x16, 0x62, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50,
+	0x49, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x22, 0x46, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x41, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x14, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x19,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x1a, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x15, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x76, 0x65,
+	0x72, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x22,
+	0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x72, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x64, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x22, 0x23, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x22, 0x5b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x4a, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x46, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xec, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x36, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x09,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x65,
+	0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x22, 0xb3, 0x03, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x62,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x19,
+	0x0a, 0x05, 0x73, 0x68, 0x65, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x05, 0x73, 0x68, 0x65, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x68, 0x65, 0x65, 0x6e, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x64, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c,
+	0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x72,
+	0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x62, 0x61,
+	0x73, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x15, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x32, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x22, 0x57, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xaf, 0x02, 0x0a, 0x16, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0f, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x73,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x14, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x15, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x69, 0x78, 0x22, 0x6f,
+	0x0a, 0x13, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x47, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x72, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9e, 0x01, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x8e, 0x01,
+	0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x10, 0x0a, 0x03,
+	0x6a, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x31,
+	0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6d, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x78, 0x52, 0x03, 0x6d, 0x69,
+	0x78, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6d, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x78, 0x52, 0x03, 0x6d,
+	0x69, 0x78, 0x22, 0x6c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x10,
+	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62,
+	0x22, 0x35, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x69, 0x78, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x6d, 0x69, 0x78, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x78,
+	0x52, 0x05, 0x6d, 0x69, 0x78, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x32, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xac,
+	0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x12, 0x1e, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x62, 0x48, 0x00, 0x52, 0x03,
+	0x6a, 0x6f, 0x62, 0x12, 0x1e, 0x0a, 0x03, 0x6d, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x78, 0x48, 0x00, 0x52, 0x03,
+	0x6d, 0x69, 0x78, 0x42, 0x08, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x20, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x32, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x04, 0x62,
+	0x61, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05,
+	0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x22, 0x3d, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c,
+	0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x72, 0x22, 0x3d, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x62,
+	0x61, 0x73, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x1f, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3c, 0x0a, 0x20, 0x41, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x22, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f,
+	0x63, 0x69, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66,
+	0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x23, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x48, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x16, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x61, 0x6e, 0x74, 0x73, 0x22, 0x78, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66,
+	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x65,
+	0x6e, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x69, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x4d,
+	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x22, 0xdf, 0x01,
+	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x75,
+	0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x65, 0x6e, 0x73,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x0d, 0x64, 0x69, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x64, 0x69, 0x73, 0x70, 0x65, 0x6e, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x4d, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x8d,
+	0x01, 0x0a, 0x23, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x61, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x40,
+	0x0a, 0x24, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x61, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x78, 0x0a, 0x26, 0x44, 0x69, 0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x27, 0x44, 0x69,
+	0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61,
+	0x6e, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x56, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x4c, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x66, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x73, 0x22, 0x1f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f,
+	0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x57, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0x32, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a,
+	0x6f, 0x62, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x03, 0x6a, 0x6f, 0x62,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a,
+	0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0xf1, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1c, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0x22,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x22, 0x1b, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07,
+	0x0a, 0x03, 0x50, 0x44, 0x46, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x4d, 0x4c, 0x10,
+	0x01, 0x22, 0x75, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x4a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x18, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x1d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x4d, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x22, 0x4d, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x22, 0x58, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x3a, 0x0a, 0x18, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x6f, 0x62,
+	0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x41,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x22, 0xac, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x22, 0x41, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x1e, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0x21, 0x0a, 0x1f, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x57, 0x69, 0x74, 0x68, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x21, 0x44, 0x69, 0x73,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61,
+	0x46, 0x72, 0x6f, 0x6d, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62,
+	0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x22, 0x24, 0x0a, 0x22, 0x44, 0x69,
+	0x73, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x46, 0x72, 0x6f, 0x6d, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x47, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x0f, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x12, 0x0a, 0x10, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b,
+	0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x44, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x60, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0c,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x69, 0x6e,
+	0x64, 0x73, 0x22, 0x39, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x23, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x58, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x59, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x6c, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x41, 0x0a, 0x15, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x22, 0x26, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x66,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x57, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x24, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x13, 0x54, 0x65, 0x73, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x22, 0x7e, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b,
+	0x22, 0xa4, 0x02, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x37, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x2e, 0x0a,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x42,
+	0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x68, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x61, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x22, 0xa4, 0x02, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x37, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x12, 0x2e, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x3a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x37, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03,
+	0x6a, 0x6f, 0x62, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x68, 0x0a, 0x12, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x61, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0xa4, 0x02, 0x0a, 0x0f, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x37, 0x0a, 0x07,
+	0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x66, 0x6f,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x2e, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x61, 0x6e,
+	0x74, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x6a, 0x6f,
+	0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22,
+	0x68, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x61, 0x0a, 0x13, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x2a, 0x3b, 0x0a, 0x14,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x4f, 0x56, 0x45, 0x52, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x2a, 0x1f, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x44, 0x46, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x5a, 0x50, 0x4c, 0x10, 0x01, 0x2a, 0x28, 0x0a, 0x09, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x54, 0x4f, 0x4d, 0x49,
+	0x43, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x46, 0x46, 0x4f,
+	0x52, 0x54, 0x10, 0x01, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x69, 0x6e, 0x74, 0x6a, 0x65, 0x64, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x63, 0x6f, 0x61, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_basecoat_transport_proto_rawDescOnce sync.Once
+	file_basecoat_transport_proto_rawDescData = file_basecoat_transport_proto_rawDesc
+)
+
+func file_basecoat_transport_proto_rawDescGZIP() []byte {
+	file_basecoat_transport_proto_rawDescOnce.Do(func() {
+		file_basecoat_transport_proto_rawDescData = protoimpl.X.CompressGZIP(file_basecoat_transport_proto_rawDescData)
+	})
+	return file_basecoat_transport_proto_rawDescData
+}
+
+var file_basecoat_transport_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_basecoat_transport_proto_msgTypes = make([]protoimpl.MessageInfo, 147)
+var file_basecoat_transport_proto_goTypes = []interface{}{
+	(ImportConflictPolicy)(0),                       // 0: proto.ImportConflictPolicy
+	(LabelFormat)(0),                                // 1: proto.LabelFormat
+	(BatchMode)(0),                                  // 2: proto.BatchMode
+	(GenerateJobReportRequest_Format)(0),            // 3: proto.GenerateJobReportRequest.Format
+	(*CreateAPITokenRequest)(nil),                   // 4: proto.CreateAPITokenRequest
+	(*CreateAPITokenResponse)(nil),                  // 5: proto.CreateAPITokenResponse
+	(*GetAccountRequest)(nil),                       // 6: proto.GetAccountRequest
+	(*GetAccountResponse)(nil),                      // 7: proto.GetAccountResponse
+	(*ListAccountsRequest)(nil),                     // 8: proto.ListAccountsRequest
+	(*ListAccountsResponse)(nil),                    // 9: proto.ListAccountsResponse
+	(*CreateAccountRequest)(nil),                    // 10: proto.CreateAccountRequest
+	(*CreateAccountResponse)(nil),                   // 11: proto.CreateAccountResponse
+	(*UpdateAccountRequest)(nil),                    // 12: proto.UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),                   // 13: proto.UpdateAccountResponse
+	(*ToggleAccountStateRequest)(nil),               // 14: proto.ToggleAccountStateRequest
+	(*ToggleAccountStateResponse)(nil),              // 15: proto.ToggleAccountStateResponse
+	(*ExportAccountRequest)(nil),                    // 16: proto.ExportAccountRequest
+	(*ExportAccountResponse)(nil),                   // 17: proto.ExportAccountResponse
+	(*ImportAccountRequest)(nil),                    // 18: proto.ImportAccountRequest
+	(*ImportAccountResponse)(nil),                   // 19: proto.ImportAccountResponse
+	(*GetSystemInfoRequest)(nil),                    // 20: proto.GetSystemInfoRequest
+	(*GetSystemInfoResponse)(nil),                   // 21: proto.GetSystemInfoResponse
+	(*GetFormulaRequest)(nil),                       // 22: proto.GetFormulaRequest
+	(*GetFormulaResponse)(nil),                      // 23: proto.GetFormulaResponse
+	(*ListFormulasRequest)(nil),                     // 24: proto.ListFormulasRequest
+	(*ListFormulasResponse)(nil),                    // 25: proto.ListFormulasResponse
+	(*FormulaIngredient)(nil),                       // 26: proto.FormulaIngredient
+	(*CreateFormulaRequest)(nil),                    // 27: proto.CreateFormulaRequest
+	(*CreateFormulaResponse)(nil),                   // 28: proto.CreateFormulaResponse
+	(*UpdateFormulaRequest)(nil),                    // 29: proto.UpdateFormulaRequest
+	(*UpdateFormulaResponse)(nil),                   // 30: proto.UpdateFormulaResponse
+	(*DeleteFormulaRequest)(nil),                    // 31: proto.DeleteFormulaRequest
+	(*DeleteFormulaResponse)(nil),                   // 32: proto.DeleteFormulaResponse
+	(*ImportIngredient)(nil),                        // 33: proto.ImportIngredient
+	(*ImportFormulaRecord)(nil),                     // 34: proto.ImportFormulaRecord
+	(*ImportFormulasRequest)(nil),                   // 35: proto.ImportFormulasRequest
+	(*ImportSkippedRecord)(nil),                     // 36: proto.ImportSkippedRecord
+	(*ImportFormulasResponse)(nil),                  // 37: proto.ImportFormulasResponse
+	(*ExportFormulaRequest)(nil),                    // 38: proto.ExportFormulaRequest
+	(*ExportFormulaResponse)(nil),                   // 39: proto.ExportFormulaResponse
+	(*RenderLabelRequest)(nil),                      // 40: proto.RenderLabelRequest
+	(*RenderLabelResponse)(nil),                     // 41: proto.RenderLabelResponse
+	(*GetFormulaRecipeRequest)(nil),                 // 42: proto.GetFormulaRecipeRequest
+	(*RecipeIngredient)(nil),                        // 43: proto.RecipeIngredient
+	(*GetFormulaRecipeResponse)(nil),                // 44: proto.GetFormulaRecipeResponse
+	(*RecordMixRequest)(nil),                        // 45: proto.RecordMixRequest
+	(*RecordMixResponse)(nil),                       // 46: proto.RecordMixResponse
+	(*GetMixRequest)(nil),                           // 47: proto.GetMixRequest
+	(*GetMixResponse)(nil),                          // 48: proto.GetMixResponse
+	(*ListMixesRequest)(nil),                        // 49: proto.ListMixesRequest
+	(*ListMixesResponse)(nil),                       // 50: proto.ListMixesResponse
+	(*GetCodeRequest)(nil),                          // 51: proto.GetCodeRequest
+	(*GetCodeResponse)(nil),                         // 52: proto.GetCodeResponse
+	(*ResolveCodeRequest)(nil),                      // 53: proto.ResolveCodeRequest
+	(*ResolveCodeResponse)(nil),                     // 54: proto.ResolveCodeResponse
+	(*GetBaseRequest)(nil),                          // 55: proto.GetBaseRequest
+	(*GetBaseResponse)(nil),                         // 56: proto.GetBaseResponse
+	(*ListBasesRequest)(nil),                        // 57: proto.ListBasesRequest
+	(*ListBasesResponse)(nil),                       // 58: proto.ListBasesResponse
+	(*CreateBaseRequest)(nil),                       // 59: proto.CreateBaseRequest
+	(*CreateBaseResponse)(nil),                      // 60: proto.CreateBaseResponse
+	(*UpdateBaseRequest)(nil),                       // 61: proto.UpdateBaseRequest
+	(*UpdateBaseResponse)(nil),                      // 62: proto.UpdateBaseResponse
+	(*AssociateBaseWithFormulaRequest)(nil),         // 63: proto.AssociateBaseWithFormulaRequest
+	(*AssociateBaseWithFormulaResponse)(nil),        // 64: proto.AssociateBaseWithFormulaResponse
+	(*DisassociateBaseFromFormulaRequest)(nil),      // 65: proto.DisassociateBaseFromFormulaRequest
+	(*DisassociateBaseFromFormulaResponse)(nil),     // 66: proto.DisassociateBaseFromFormulaResponse
+	(*DeleteBaseRequest)(nil),                       // 67: proto.DeleteBaseRequest
+	(*DeleteBaseResponse)(nil),                      // 68: proto.DeleteBaseResponse
+	(*GetColorantRequest)(nil),                      // 69: proto.GetColorantRequest
+	(*GetColorantResponse)(nil),                     // 70: proto.GetColorantResponse
+	(*ListColorantsRequest)(nil),                    // 71: proto.ListColorantsRequest
+	(*ListColorantsResponse)(nil),                   // 72: proto.ListColorantsResponse
+	(*CreateColorantRequest)(nil),                   // 73: proto.CreateColorantRequest
+	(*CreateColorantResponse)(nil),                  // 74: proto.CreateColorantResponse
+	(*UpdateColorantRequest)(nil),                   // 75: proto.UpdateColorantRequest
+	(*UpdateColorantResponse)(nil),                  // 76: proto.UpdateColorantResponse
+	(*AssociateColorantWithFormulaRequest)(nil),     // 77: proto.AssociateColorantWithFormulaRequest
+	(*AssociateColorantWithFormulaResponse)(nil),    // 78: proto.AssociateColorantWithFormulaResponse
+	(*DisassociateColorantFromFormulaRequest)(nil),  // 79: proto.DisassociateColorantFromFormulaRequest
+	(*DisassociateColorantFromFormulaResponse)(nil), // 80: proto.DisassociateColorantFromFormulaResponse
+	(*DeleteColorantRequest)(nil),                   // 81: proto.DeleteColorantRequest
+	(*DeleteColorantResponse)(nil),                  // 82: proto.DeleteColorantResponse
+	(*GetJobRequest)(nil),                           // 83: proto.GetJobRequest
+	(*GetJobResponse)(nil),                          // 84: proto.GetJobResponse
+	(*ListJobsRequest)(nil),                         // 85: proto.ListJobsRequest
+	(*ListJobsResponse)(nil),                        // 86: proto.ListJobsResponse
+	(*CreateJobRequest)(nil),                        // 87: proto.CreateJobRequest
+	(*CreateJobResponse)(nil),                       // 88: proto.CreateJobResponse
+	(*UpdateJobRequest)(nil),                        // 89: proto.UpdateJobRequest
+	(*UpdateJobResponse)(nil),                       // 90: proto.UpdateJobResponse
+	(*DeleteJobRequest)(nil),                        // 91: proto.DeleteJobRequest
+	(*DeleteJobResponse)(nil),                       // 92: proto.DeleteJobResponse
+	(*GenerateJobReportRequest)(nil),                // 93: proto.GenerateJobReportRequest
+	(*GenerateJobReportResponse)(nil),               // 94: proto.GenerateJobReportResponse
+	(*GetContractorRequest)(nil),                    // 95: proto.GetContractorRequest
+	(*GetContractorResponse)(nil),                   // 96: proto.GetContractorResponse
+	(*ListContractorsRequest)(nil),                  // 97: proto.ListContractorsRequest
+	(*ListContractorsResponse)(nil),                 // 98: proto.ListContractorsResponse
+	(*CreateContractorRequest)(nil),                 // 99: proto.CreateContractorRequest
+	(*CreateContractorResponse)(nil),                // 100: proto.CreateContractorResponse
+	(*UpdateContractorRequest)(nil),                 // 101: proto.UpdateContractorRequest
+	(*UpdateContractorResponse)(nil),                // 102: proto.UpdateContractorResponse
+	(*DeleteContractorRequest)(nil),                 // 103: proto.DeleteContractorRequest
+	(*DeleteContractorResponse)(nil),                // 104: proto.DeleteContractorResponse
+	(*GetContactRequest)(nil),                       // 105: proto.GetContactRequest
+	(*GetContactResponse)(nil),                      // 106: proto.GetContactResponse
+	(*ListContactsRequest)(nil),                     // 107: proto.ListContactsRequest
+	(*ListContactsResponse)(nil),                    // 108: proto.ListContactsResponse
+	(*CreateContactRequest)(nil),                    // 109: proto.CreateContactRequest
+	(*CreateContactResponse)(nil),                   // 110: proto.CreateContactResponse
+	(*UpdateContactRequest)(nil),                    // 111: proto.UpdateContactRequest
+	(*UpdateContactResponse)(nil),                   // 112: proto.UpdateContactResponse
+	(*DeleteContactRequest)(nil),                    // 113: proto.DeleteContactRequest
+	(*DeleteContactResponse)(nil),                   // 114: proto.DeleteContactResponse
+	(*AssociateFormulaWithJobRequest)(nil),          // 115: proto.AssociateFormulaWithJobRequest
+	(*AssociateFormulaWithJobResponse)(nil),         // 116: proto.AssociateFormulaWithJobResponse
+	(*DisassociateFormulaFromJobRequest)(nil),       // 117: proto.DisassociateFormulaFromJobRequest
+	(*DisassociateFormulaFromJobResponse)(nil),      // 118: proto.DisassociateFormulaFromJobResponse
+	(*ListDeletedRequest)(nil),                      // 119: proto.ListDeletedRequest
+	(*ListDeletedResponse)(nil),                     // 120: proto.ListDeletedResponse
+	(*UndeleteRequest)(nil),                         // 121: proto.UndeleteRequest
+	(*UndeleteResponse)(nil),                        // 122: proto.UndeleteResponse
+	(*ListAuditEventsRequest)(nil),                  // 123: proto.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),                 // 124: proto.ListAuditEventsResponse
+	(*WatchEventsRequest)(nil),                      // 125: proto.WatchEventsRequest
+	(*WatchEventsResponse)(nil),                     // 126: proto.WatchEventsResponse
+	(*GetWebhookRequest)(nil),                       // 127: proto.GetWebhookRequest
+	(*GetWebhookResponse)(nil),                      // 128: proto.GetWebhookResponse
+	(*ListWebhooksRequest)(nil),                     // 129: proto.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),                    // 130: proto.ListWebhooksResponse
+	(*CreateWebhookRequest)(nil),                    // 131: proto.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),                   // 132: proto.CreateWebhookResponse
+	(*UpdateWebhookRequest)(nil),                    // 133: proto.UpdateWebhookRequest
+	(*UpdateWebhookResponse)(nil),                   // 134: proto.UpdateWebhookResponse
+	(*DeleteWebhookRequest)(nil),                    // 135: proto.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),                   // 136: proto.DeleteWebhookResponse
+	(*ListWebhookDeliveriesRequest)(nil),            // 137: proto.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),           // 138: proto.ListWebhookDeliveriesResponse
+	(*TestWebhookRequest)(nil),                      // 139: proto.TestWebhookRequest
+	(*TestWebhookResponse)(nil),                     // 140: proto.TestWebhookResponse
+	(*BatchResult)(nil),                             // 141: proto.BatchResult
+	(*BatchCreateItem)(nil),                         // 142: proto.BatchCreateItem
+	(*BatchCreateRequest)(nil),                      // 143: proto.BatchCreateRequest
+	(*BatchCreateResponse)(nil),                     // 144: proto.BatchCreateResponse
+	(*BatchUpdateItem)(nil),                         // 145: proto.BatchUpdateItem
+	(*BatchUpdateRequest)(nil),                      // 146: proto.BatchUpdateRequest
+	(*BatchUpdateResponse)(nil),                     // 147: proto.BatchUpdateResponse
+	(*BatchDeleteItem)(nil),                         // 148: proto.BatchDeleteItem
+	(*BatchDeleteRequest)(nil),                      // 149: proto.BatchDeleteRequest
+	(*BatchDeleteResponse)(nil),                     // 150: proto.BatchDeleteResponse
+	(*Account)(nil),                                 // 151: proto.Account
+	(AccountState)(0),                               // 152: proto.AccountState
+	(*AccountArchiveRecord)(nil),                    // 153: proto.AccountArchiveRecord
+	(*Formula)(nil),                                 // 154: proto.Formula
+	(*FormulaMetadata)(nil),                         // 155: proto.FormulaMetadata
+	(*BaseMetadata)(nil),                            // 156: proto.BaseMetadata
+	(*ColorantMetadata)(nil),                        // 157: proto.ColorantMetadata
+	(*Mix)(nil),                                     // 158: proto.Mix
+	(EntityKind)(0),                                 // 159: proto.EntityKind
+	(*Code)(nil),                                    // 160: proto.Code
+	(*Job)(nil),                                     // 161: proto.Job
+	(*Base)(nil),                                    // 162: proto.Base
+	(*Colorant)(nil),                                // 163: proto.Colorant
+	(*Address)(nil),                                 // 164: proto.Address
+	(*Contractor)(nil),                              // 165: proto.Contractor
+	(*Contact)(nil),                                 // 166: proto.Contact
+	(*DeletedEntity)(nil),                           // 167: proto.DeletedEntity
+	(*AuditEvent)(nil),                              // 168: proto.AuditEvent
+	(*Event)(nil),                                   // 169: proto.Event
+	(*Webhook)(nil),                                 // 170: proto.Webhook
+	(*WebhookDelivery)(nil),                         // 171: proto.WebhookDelivery
+}
+var file_basecoat_transport_proto_depIdxs = []int32{
+	151, // 0: proto.GetAccountResponse.account:type_name -> proto.Account
+	151, // 1: proto.ListAccountsResponse.accounts:type_name -> proto.Account
+	151, // 2: proto.CreateAccountResponse.account:type_name -> proto.Account
+	152, // 3: proto.ToggleAccountStateResponse.state:type_name -> proto.AccountState
+	153, // 4: proto.ExportAccountResponse.record:type_name -> proto.AccountArchiveRecord
+	0,   // 5: proto.ImportAccountRequest.policy:type_name -> proto.ImportConflictPolicy
+	153, // 6: proto.ImportAccountRequest.record:type_name -> proto.AccountArchiveRecord
+	154, // 7: proto.GetFormulaResponse.formula:type_name -> proto.Formula
+	155, // 8: proto.ListFormulasResponse.formulas:type_name -> proto.FormulaMetadata
+	26,  // 9: proto.CreateFormulaRequest.bases:type_name -> proto.FormulaIngredient
+	26,  // 10: proto.CreateFormulaRequest.colorants:type_name -> proto.FormulaIngredient
+	155, // 11: proto.CreateFormulaResponse.formula:type_name -> proto.FormulaMetadata
+	26,  // 12: proto.UpdateFormulaRequest.bases:type_name -> proto.FormulaIngredient
+	26,  // 13: proto.UpdateFormulaRequest.colorants:type_name -> proto.FormulaIngredient
+	155, // 14: proto.UpdateFormulaResponse.formula:type_name -> proto.FormulaMetadata
+	33,  // 15: proto.ImportFormulaRecord.bases:type_name -> proto.ImportIngredient
+	33,  // 16: proto.ImportFormulaRecord.colorants:type_name -> proto.ImportIngredient
+	34,  // 17: proto.ImportFormulasRequest.record:type_name -> proto.ImportFormulaRecord
+	155, // 18: proto.ImportFormulasResponse.formulas_created:type_name -> proto.FormulaMetadata
+	156, // 19: proto.ImportFormulasResponse.bases_created:type_name -> proto.BaseMetadata
+	157, // 20: proto.ImportFormulasResponse.colorants_created:type_name -> proto.ColorantMetadata
+	36,  // 21: proto.ImportFormulasResponse.skipped:type_name -> proto.ImportSkippedRecord
+	1,   // 22: proto.RenderLabelRequest.format:type_name -> proto.LabelFormat
+	43,  // 23: proto.GetFormulaRecipeResponse.bases:type_name -> proto.RecipeIngredient
+	43,  // 24: proto.GetFormulaRecipeResponse.colorants:type_name -> proto.RecipeIngredient
+	158, // 25: proto.RecordMixResponse.mix:type_name -> proto.Mix
+	158, // 26: proto.GetMixResponse.mix:type_name -> proto.Mix
+	158, // 27: proto.ListMixesResponse.mixes:type_name -> proto.Mix
+	159, // 28: proto.GetCodeRequest.kind:type_name -> proto.EntityKind
+	160, // 29: proto.GetCodeResponse.code:type_name -> proto.Code
+	160, // 30: proto.ResolveCodeResponse.code:type_name -> proto.Code
+	154, // 31: proto.ResolveCodeResponse.formula:type_name -> proto.Formula
+	161, // 32: proto.ResolveCodeResponse.job:type_name -> proto.Job
+	158, // 33: proto.ResolveCodeResponse.mix:type_name -> proto.Mix
+	162, // 34: proto.GetBaseResponse.base:type_name -> proto.Base
+	156, // 35: proto.ListBasesResponse.bases:type_name -> proto.BaseMetadata
+	156, // 36: proto.CreateBaseResponse.base:type_name -> proto.BaseMetadata
+	156, // 37: proto.UpdateBaseResponse.base:type_name -> proto.BaseMetadata
+	155, // 38: proto.DeleteBaseResponse.formulas:type_name -> proto.FormulaMetadata
+	163, // 39: proto.GetColorantResponse.colorant:type_name -> proto.Colorant
+	157, // 40: proto.ListColorantsResponse.colorants:type_name -> proto.ColorantMetadata
+	157, // 41: proto.CreateColorantResponse.colorant:type_name -> proto.ColorantMetadata
+	157, // 42: proto.UpdateColorantResponse.colorant:type_name -> proto.ColorantMetadata
+	155, // 43: proto.DeleteColorantResponse.formulas:type_name -> proto.FormulaMetadata
+	161, // 44: proto.GetJobResponse.job:type_name -> proto.Job
+	161, // 45: proto.ListJobsResponse.jobs:type_name -> proto.Job
+	164, // 46: proto.CreateJobRequest.address:type_name -> proto.Address
+	161, // 47: proto.CreateJobResponse.job:type_name -> proto.Job
+	164, // 48: proto.UpdateJobRequest.address:type_name -> proto.Address
+	161, // 49: proto.UpdateJobResponse.job:type_name -> proto.Job
+	3,   // 50: proto.GenerateJobReportRequest.format:type_name -> proto.GenerateJobReportRequest.Format
+	165, // 51: proto.GetContractorResponse.contractor:type_name -> proto.Contractor
+	165, // 52: proto.ListContractorsResponse.contractors:type_name -> proto.Contractor
+	165, // 53: proto.CreateContractorResponse.contractor:type_name -> proto.Contractor
+	165, // 54: proto.UpdateContractorResponse.contractor:type_name -> proto.Contractor
+	161, // 55: proto.DeleteContractorResponse.jobs:type_name -> proto.Job
+	166, // 56: proto.GetContactResponse.contact:type_name -> proto.Contact
+	166, // 57: proto.ListContactsResponse.contacts:type_name -> proto.Contact
+	166, // 58: proto.CreateContactResponse.contact:type_name -> proto.Contact
+	166, // 59: proto.UpdateContactResponse.contact:type_name -> proto.Contact
+	159, // 60: proto.ListDeletedRequest.kind:type_name -> proto.EntityKind
+	167, // 61: proto.ListDeletedResponse.entities:type_name -> proto.DeletedEntity
+	159, // 62: proto.UndeleteRequest.kind:type_name -> proto.EntityKind
+	159, // 63: proto.ListAuditEventsRequest.entity_kind:type_name -> proto.EntityKind
+	168, // 64: proto.ListAuditEventsResponse.events:type_name -> proto.AuditEvent
+	159, // 65: proto.WatchEventsRequest.entity_kinds:type_name -> proto.EntityKind
+	169, // 66: proto.WatchEventsResponse.event:type_name -> proto.Event
+	170, // 67: proto.GetWebhookResponse.webhook:type_name -> proto.Webhook
+	170, // 68: proto.ListWebhooksResponse.webhooks:type_name -> proto.Webhook
+	170, // 69: proto.CreateWebhookResponse.webhook:type_name -> proto.Webhook
+	170, // 70: proto.UpdateWebhookResponse.webhook:type_name -> proto.Webhook
+	171, // 71: proto.ListWebhookDeliveriesResponse.deliveries:type_name -> proto.WebhookDelivery
+	171, // 72: proto.TestWebhookResponse.delivery:type_name -> proto.WebhookDelivery
+	27,  // 73: proto.BatchCreateItem.formula:type_name -> proto.CreateFormulaRequest
+	59,  // 74: proto.BatchCreateItem.base:type_name -> proto.CreateBaseRequest
+	73,  // 75: proto.BatchCreateItem.colorant:type_name -> proto.CreateColorantRequest
+	109, // 76: proto.BatchCreateItem.contact:type_name -> proto.CreateContactRequest
+	87,  // 77: proto.BatchCreateItem.job:type_name -> proto.CreateJobRequest
+	2,   // 78: proto.BatchCreateRequest.mode:type_name -> proto.BatchMode
+	142, // 79: proto.BatchCreateRequest.items:type_name -> proto.BatchCreateItem
+	141, // 80: proto.BatchCreateResponse.results:type_name -> proto.BatchResult
+	29,  // 81: proto.BatchUpdateItem.formula:type_name -> proto.UpdateFormulaRequest
+	61,  // 82: proto.BatchUpdateItem.base:type_name -> proto.UpdateBaseRequest
+	75,  // 83: proto.BatchUpdateItem.colorant:type_name -> proto.UpdateColorantRequest
+	111, // 84: proto.BatchUpdateItem.contact:type_name -> proto.UpdateContactRequest
+	89,  // 85: proto.BatchUpdateItem.job:type_name -> proto.UpdateJobRequest
+	2,   // 86: proto.BatchUpdateRequest.mode:type_name -> proto.BatchMode
+	145, // 87: proto.BatchUpdateRequest.items:type_name -> proto.BatchUpdateItem
+	141, // 88: proto.BatchUpdateResponse.results:type_name -> proto.BatchResult
+	31,  // 89: proto.BatchDeleteItem.formula:type_name -> proto.DeleteFormulaRequest
+	67,  // 90: proto.BatchDeleteItem.base:type_name -> proto.DeleteBaseRequest
+	81,  // 91: proto.BatchDeleteItem.colorant:type_name -> proto.DeleteColorantRequest
+	113, // 92: proto.BatchDeleteItem.contact:type_name -> proto.DeleteContactRequest
+	91,  // 93: proto.BatchDeleteItem.job:type_name -> proto.DeleteJobRequest
+	2,   // 94: proto.BatchDeleteRequest.mode:type_name -> proto.BatchMode
+	148, // 95: proto.BatchDeleteRequest.items:type_name -> proto.BatchDeleteItem
+	141, // 96: proto.BatchDeleteResponse.results:type_name -> proto.BatchResult
+	97,  // [97:97] is the sub-list for method output_type
+	97,  // [97:97] is the sub-list for method input_type
+	97,  // [97:97] is the sub-list for extension type_name
+	97,  // [97:97] is the sub-list for extension extendee
+	0,   // [0:97] is the sub-list for field type_name
+}
+
+func init() { file_basecoat_transport_proto_init() }
+func file_basecoat_transport_proto_init() {
+	if File_basecoat_transport_proto != nil {
+		return
+	}
+	file_basecoat_message_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_basecoat_transport_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPITokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPITokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleAccountStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleAccountStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFormulaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFormulaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFormulasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFormulasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormulaIngredient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFormulaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFormulaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFormulaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFormulaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFormulaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFormulaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportIngredient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportFormulaRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportFormulasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSkippedRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportFormulasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportFormulaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportFormulaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFormulaRecipeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecipeIngredient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFormulaRecipeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordMixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordMixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMixesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMixesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssociateBaseWithFormulaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssociateBaseWithFormulaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_basecoat_transport_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisassociateBaseFromFormulaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4802,8 +10461,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAPITokenResponse); i {
+		file_basecoat_transport_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisassociateBaseFromFormulaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4814,8 +10473,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccountRequest); i {
+		file_basecoat_transport_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4826,8 +10485,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAccountResponse); i {
+		file_basecoat_transport_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4838,8 +10497,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountsRequest); i {
+		file_basecoat_transport_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetColorantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4850,8 +10509,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAccountsResponse); i {
+		file_basecoat_transport_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetColorantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4862,8 +10521,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAccountRequest); i {
+		file_basecoat_transport_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListColorantsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4874,8 +10533,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAccountResponse); i {
+		file_basecoat_transport_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListColorantsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4886,8 +10545,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAccountRequest); i {
+		file_basecoat_transport_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateColorantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4898,8 +10557,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAccountResponse); i {
+		file_basecoat_transport_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateColorantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4910,8 +10569,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleAccountStateRequest); i {
+		file_basecoat_transport_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateColorantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4922,8 +10581,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleAccountStateResponse); i {
+		file_basecoat_transport_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateColorantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4934,8 +10593,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSystemInfoRequest); i {
+		file_basecoat_transport_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssociateColorantWithFormulaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4946,8 +10605,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSystemInfoResponse); i {
+		file_basecoat_transport_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssociateColorantWithFormulaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4958,8 +10617,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFormulaRequest); i {
+		file_basecoat_transport_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisassociateColorantFromFormulaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4970,8 +10629,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFormulaResponse); i {
+		file_basecoat_transport_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisassociateColorantFromFormulaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4982,8 +10641,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFormulasRequest); i {
+		file_basecoat_transport_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteColorantRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4994,8 +10653,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListFormulasResponse); i {
+		file_basecoat_transport_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteColorantResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5006,8 +10665,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateFormulaRequest); i {
+		file_basecoat_transport_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5018,8 +10677,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateFormulaResponse); i {
+		file_basecoat_transport_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5030,8 +10689,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFormulaRequest); i {
+		file_basecoat_transport_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5042,8 +10701,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFormulaResponse); i {
+		file_basecoat_transport_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5054,8 +10713,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFormulaRequest); i {
+		file_basecoat_transport_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5066,8 +10725,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFormulaResponse); i {
+		file_basecoat_transport_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5078,8 +10737,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBaseRequest); i {
+		file_basecoat_transport_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5090,8 +10749,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBaseResponse); i {
+		file_basecoat_transport_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5102,8 +10761,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBasesRequest); i {
+		file_basecoat_transport_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5114,8 +10773,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBasesResponse); i {
+		file_basecoat_transport_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5126,8 +10785,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBaseRequest); i {
+		file_basecoat_transport_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateJobReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5138,8 +10797,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBaseResponse); i {
+		file_basecoat_transport_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateJobReportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5150,8 +10809,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBaseRequest); i {
+		file_basecoat_transport_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContractorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5162,8 +10821,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBaseResponse); i {
+		file_basecoat_transport_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContractorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5174,8 +10833,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssociateBaseWithFormulaRequest); i {
+		file_basecoat_transport_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContractorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5186,8 +10845,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssociateBaseWithFormulaResponse); i {
+		file_basecoat_transport_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContractorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5198,8 +10857,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisassociateBaseFromFormulaRequest); i {
+		file_basecoat_transport_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateContractorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5210,8 +10869,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisassociateBaseFromFormulaResponse); i {
+		file_basecoat_transport_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateContractorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5222,8 +10881,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBaseRequest); i {
+		file_basecoat_transport_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateContractorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5234,8 +10893,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBaseResponse); i {
+		file_basecoat_transport_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateContractorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5246,8 +10905,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColorantRequest); i {
+		file_basecoat_transport_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteContractorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5258,8 +10917,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetColorantResponse); i {
+		file_basecoat_transport_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteContractorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5270,8 +10929,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListColorantsRequest); i {
+		file_basecoat_transport_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5282,8 +10941,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListColorantsResponse); i {
+		file_basecoat_transport_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5294,8 +10953,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateColorantRequest); i {
+		file_basecoat_transport_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContactsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5306,8 +10965,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateColorantResponse); i {
+		file_basecoat_transport_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContactsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5318,8 +10977,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateColorantRequest); i {
+		file_basecoat_transport_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateContactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5330,8 +10989,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateColorantResponse); i {
+		file_basecoat_transport_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateContactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5342,8 +11001,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssociateColorantWithFormulaRequest); i {
+		file_basecoat_transport_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateContactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5354,8 +11013,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssociateColorantWithFormulaResponse); i {
+		file_basecoat_transport_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateContactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5366,8 +11025,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisassociateColorantFromFormulaRequest); i {
+		file_basecoat_transport_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteContactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5378,8 +11037,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisassociateColorantFromFormulaResponse); i {
+		file_basecoat_transport_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteContactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5390,8 +11049,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteColorantRequest); i {
+		file_basecoat_transport_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssociateFormulaWithJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5402,8 +11061,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteColorantResponse); i {
+		file_basecoat_transport_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssociateFormulaWithJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5414,8 +11073,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobRequest); i {
+		file_basecoat_transport_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisassociateFormulaFromJobRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5426,8 +11085,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetJobResponse); i {
+		file_basecoat_transport_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisassociateFormulaFromJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5438,8 +11097,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsRequest); i {
+		file_basecoat_transport_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5450,8 +11109,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListJobsResponse); i {
+		file_basecoat_transport_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeletedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5462,8 +11121,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJobRequest); i {
+		file_basecoat_transport_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5474,8 +11133,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJobResponse); i {
+		file_basecoat_transport_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5486,8 +11145,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateJobRequest); i {
+		file_basecoat_transport_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5498,8 +11157,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateJobResponse); i {
+		file_basecoat_transport_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5510,8 +11169,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobRequest); i {
+		file_basecoat_transport_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5522,8 +11181,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJobResponse); i {
+		file_basecoat_transport_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5534,8 +11193,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContractorRequest); i {
+		file_basecoat_transport_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5546,8 +11205,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContractorResponse); i {
+		file_basecoat_transport_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5558,8 +11217,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListContractorsRequest); i {
+		file_basecoat_transport_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5570,8 +11229,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListContractorsResponse); i {
+		file_basecoat_transport_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhooksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5582,8 +11241,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateContractorRequest); i {
+		file_basecoat_transport_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5594,8 +11253,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateContractorResponse); i {
+		file_basecoat_transport_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5606,8 +11265,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateContractorRequest); i {
+		file_basecoat_transport_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5618,8 +11277,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateContractorResponse); i {
+		file_basecoat_transport_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWebhookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5630,8 +11289,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteContractorRequest); i {
+		file_basecoat_transport_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5642,8 +11301,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteContractorResponse); i {
+		file_basecoat_transport_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5654,8 +11313,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContactRequest); i {
+		file_basecoat_transport_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5666,8 +11325,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContactResponse); i {
+		file_basecoat_transport_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5678,8 +11337,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListContactsRequest); i {
+		file_basecoat_transport_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5690,8 +11349,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListContactsResponse); i {
+		file_basecoat_transport_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestWebhookResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5702,8 +11361,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateContactRequest); i {
+		file_basecoat_transport_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5714,8 +11373,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateContactResponse); i {
+		file_basecoat_transport_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5726,8 +11385,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateContactRequest); i {
+		file_basecoat_transport_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5738,8 +11397,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateContactResponse); i {
+		file_basecoat_transport_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5750,8 +11409,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteContactRequest); i {
+		file_basecoat_transport_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5762,8 +11421,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteContactResponse); i {
+		file_basecoat_transport_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5774,8 +11433,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssociateFormulaWithJobRequest); i {
+		file_basecoat_transport_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5786,8 +11445,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssociateFormulaWithJobResponse); i {
+		file_basecoat_transport_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5798,8 +11457,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisassociateFormulaFromJobRequest); i {
+		file_basecoat_transport_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5810,8 +11469,8 @@ func file_basecoat_transport_proto_init() {
 				return nil
 			}
 		}
-		file_basecoat_transport_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisassociateFormulaFromJobResponse); i {
+		file_basecoat_transport_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5823,23 +11482,54 @@ func file_basecoat_transport_proto_init() {
 			}
 		}
 	}
-	file_basecoat_transport_proto_msgTypes[56].OneofWrappers = []interface{}{}
-	file_basecoat_transport_proto_msgTypes[58].OneofWrappers = []interface{}{}
-	file_basecoat_transport_proto_msgTypes[66].OneofWrappers = []interface{}{}
-	file_basecoat_transport_proto_msgTypes[68].OneofWrappers = []interface{}{}
-	file_basecoat_transport_proto_msgTypes[78].OneofWrappers = []interface{}{}
+	file_basecoat_transport_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_basecoat_transport_proto_msgTypes[50].OneofWrappers = []interface{}{
+		(*ResolveCodeResponse_Formula)(nil),
+		(*ResolveCodeResponse_Job)(nil),
+		(*ResolveCodeResponse_Mix)(nil),
+	}
+	file_basecoat_transport_proto_msgTypes[57].OneofWrappers = []interface{}{}
+	file_basecoat_transport_proto_msgTypes[71].OneofWrappers = []interface{}{}
+	file_basecoat_transport_proto_msgTypes[83].OneofWrappers = []interface{}{}
+	file_basecoat_transport_proto_msgTypes[85].OneofWrappers = []interface{}{}
+	file_basecoat_transport_proto_msgTypes[95].OneofWrappers = []interface{}{}
+	file_basecoat_transport_proto_msgTypes[97].OneofWrappers = []interface{}{}
+	file_basecoat_transport_proto_msgTypes[107].OneofWrappers = []interface{}{}
+	file_basecoat_transport_proto_msgTypes[129].OneofWrappers = []interface{}{}
+	file_basecoat_transport_proto_msgTypes[138].OneofWrappers = []interface{}{
+		(*BatchCreateItem_Formula)(nil),
+		(*BatchCreateItem_Base)(nil),
+		(*BatchCreateItem_Colorant)(nil),
+		(*BatchCreateItem_Contact)(nil),
+		(*BatchCreateItem_Job)(nil),
+	}
+	file_basecoat_transport_proto_msgTypes[141].OneofWrappers = []interface{}{
+		(*BatchUpdateItem_Formula)(nil),
+		(*BatchUpdateItem_Base)(nil),
+		(*BatchUpdateItem_Colorant)(nil),
+		(*BatchUpdateItem_Contact)(nil),
+		(*BatchUpdateItem_Job)(nil),
+	}
+	file_basecoat_transport_proto_msgTypes[144].OneofWrappers = []interface{}{
+		(*BatchDeleteItem_Formula)(nil),
+		(*BatchDeleteItem_Base)(nil),
+		(*BatchDeleteItem_Colorant)(nil),
+		(*BatchDeleteItem_Contact)(nil),
+		(*BatchDeleteItem_Job)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_basecoat_transport_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   86,
+			NumEnums:      4,
+			NumMessages:   147,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_basecoat_transport_proto_goTypes,
 		DependencyIndexes: file_basecoat_transport_proto_depIdxs,
+		EnumInfos:         file_basecoat_transport_proto_enumTypes,
 		MessageInfos:      file_basecoat_transport_proto_msgTypes,
 	}.Build()
 	File_basecoat_transport_proto = out.File